@@ -0,0 +1,163 @@
+// Package approval 多级审批链引擎
+// 审批链按产品配置,每个环节指定可审批角色,并可按申请金额阈值启用(如超过一定金额需增加风控复核)
+// 贷款与租赁审批共用该引擎,各服务只需提供申请金额、已完成的审批记录与当前审核员信息
+package approval
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// 审批角色
+const (
+	RoleOperator = "operator" // 普通操作员
+	RoleAdmin    = "admin"    // 管理员
+)
+
+// 审批动作
+const (
+	ActionApprove = "approve"
+	ActionReject  = "reject"
+)
+
+// 审批链结束后的申请状态
+const (
+	StatusApproved = "approved"
+	StatusRejected = "rejected"
+)
+
+// Step 审批环节
+type Step struct {
+	Name      string  `json:"name"`                 // 环节名称,如 初审/终审/风控复核
+	Role      string  `json:"role,omitempty"`       // 可审批角色 operator/admin,为空表示不限;管理员可处理任意环节
+	MinAmount float64 `json:"min_amount,omitempty"` // 申请金额达到该阈值时启用该环节,0表示始终启用
+}
+
+// Chain 审批链
+type Chain struct {
+	Steps []Step
+}
+
+// Record 已完成的审批环节
+type Record struct {
+	Stage     int    // 环节序号,从1开始
+	Action    string // approve/reject
+	AuditorId int64  // 审核员ID
+}
+
+// Decision 本次审批结果
+type Decision struct {
+	Stage      int    // 本次处理的环节序号,从1开始
+	StepName   string // 本次处理的环节名称
+	TotalStage int    // 该申请需要经过的环节总数
+	Finished   bool   // 审批链是否已结束
+	Status     string // 审批链结束后的申请状态 approved/rejected,未结束为空
+}
+
+// DefaultChain 未配置审批链时使用单级审批,任意审核员均可审批
+func DefaultChain() Chain {
+	return Chain{Steps: []Step{{Name: "审批"}}}
+}
+
+// Parse 解析产品配置的审批链(JSON数组),为空时返回默认单级审批链
+// 示例: [{"name":"初审","role":"operator"},{"name":"终审","role":"admin"},{"name":"风控复核","role":"admin","min_amount":500000}]
+func Parse(config string) (Chain, error) {
+	config = strings.TrimSpace(config)
+	if config == "" {
+		return DefaultChain(), nil
+	}
+
+	var steps []Step
+	if err := json.Unmarshal([]byte(config), &steps); err != nil {
+		return Chain{}, fmt.Errorf("审批链配置格式错误: %v", err)
+	}
+
+	chain := Chain{Steps: steps}
+	if err := chain.Validate(); err != nil {
+		return Chain{}, err
+	}
+	return chain, nil
+}
+
+// Validate 校验审批链配置
+func (c Chain) Validate() error {
+	if len(c.Steps) == 0 {
+		return fmt.Errorf("审批链至少需要一个环节")
+	}
+	for i, step := range c.Steps {
+		if strings.TrimSpace(step.Name) == "" {
+			return fmt.Errorf("审批链第%d个环节名称不能为空", i+1)
+		}
+		if step.Role != "" && step.Role != RoleOperator && step.Role != RoleAdmin {
+			return fmt.Errorf("审批链第%d个环节角色必须为operator或admin", i+1)
+		}
+		if step.MinAmount < 0 {
+			return fmt.Errorf("审批链第%d个环节金额阈值不能小于0", i+1)
+		}
+	}
+	if c.Steps[0].MinAmount > 0 {
+		return fmt.Errorf("审批链第1个环节不能设置金额阈值")
+	}
+	return nil
+}
+
+// String 将审批链序列化为配置字符串
+func (c Chain) String() string {
+	data, _ := json.Marshal(c.Steps)
+	return string(data)
+}
+
+// StepsFor 返回指定申请金额需要经过的审批环节
+func (c Chain) StepsFor(amount float64) []Step {
+	steps := make([]Step, 0, len(c.Steps))
+	for _, step := range c.Steps {
+		if step.MinAmount <= 0 || amount >= step.MinAmount {
+			steps = append(steps, step)
+		}
+	}
+	return steps
+}
+
+// Next 根据已完成的审批记录处理当前环节
+// 同一审核员不能重复处理同一申请的多个环节;环节指定角色时仅该角色或管理员可审批
+func (c Chain) Next(amount float64, records []Record, action, role string, auditorId int64) (Decision, error) {
+	if action != ActionApprove && action != ActionReject {
+		return Decision{}, fmt.Errorf("审批动作必须为approve或reject")
+	}
+
+	steps := c.StepsFor(amount)
+	completed := 0
+	for _, record := range records {
+		if record.Action == ActionReject {
+			return Decision{}, fmt.Errorf("申请状态错误，审批流程已结束")
+		}
+		if record.AuditorId == auditorId {
+			return Decision{}, fmt.Errorf("无权限审批，同一审核员不能重复审批同一申请")
+		}
+		completed++
+	}
+	if completed >= len(steps) {
+		return Decision{}, fmt.Errorf("申请状态错误，审批流程已结束")
+	}
+
+	step := steps[completed]
+	if step.Role != "" && role != step.Role && role != RoleAdmin {
+		return Decision{}, fmt.Errorf("无权限审批%s环节，需要%s角色", step.Name, step.Role)
+	}
+
+	decision := Decision{
+		Stage:      completed + 1,
+		StepName:   step.Name,
+		TotalStage: len(steps),
+	}
+	switch {
+	case action == ActionReject:
+		decision.Finished = true
+		decision.Status = StatusRejected
+	case decision.Stage == len(steps):
+		decision.Finished = true
+		decision.Status = StatusApproved
+	}
+	return decision, nil
+}
@@ -0,0 +1,171 @@
+// Package eligibility 产品准入规则
+// 规则按产品配置,限定申请人的职业、年龄区间与最低月收入,未配置的条件不做限制
+// 贷款与租赁产品共用该规则,申请人信息取自 appuser 服务的用户资料
+package eligibility
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// 未满足准入规则的原因
+const (
+	ReasonOccupation = "occupation"  // 职业不符合
+	ReasonAge        = "age"         // 年龄不在范围内
+	ReasonIncome     = "income"      // 月收入低于下限
+	ReasonProfile    = "profile_gap" // 资料未填写,无法评估
+)
+
+// Rule 产品准入规则
+type Rule struct {
+	Occupations []string `json:"occupations,omitempty"` // 职业关键字,申请人职业包含任一关键字即满足,如 ["农","牧","渔"]
+	MinAge      int      `json:"min_age,omitempty"`     // 最小年龄,0表示不限
+	MaxAge      int      `json:"max_age,omitempty"`     // 最大年龄,0表示不限
+	MinIncome   float64  `json:"min_income,omitempty"`  // 最低月收入(元),0表示不限
+}
+
+// Applicant 申请人资料
+type Applicant struct {
+	Age        int
+	Occupation string
+	Income     float64 // 月收入(元)
+}
+
+// Reason 未满足的准入条件
+type Reason struct {
+	Code    string
+	Message string
+	Limit   float64
+	Actual  float64
+}
+
+// Parse 解析产品配置的准入规则(JSON对象),为空时返回不限制的规则
+// 示例: {"occupations":["农","牧"],"min_age":18,"max_age":60,"min_income":3000}
+func Parse(config string) (Rule, error) {
+	config = strings.TrimSpace(config)
+	if config == "" {
+		return Rule{}, nil
+	}
+
+	var rule Rule
+	if err := json.Unmarshal([]byte(config), &rule); err != nil {
+		return Rule{}, fmt.Errorf("准入规则配置格式错误: %v", err)
+	}
+	if err := rule.Validate(); err != nil {
+		return Rule{}, err
+	}
+	return rule, nil
+}
+
+// Validate 校验准入规则配置
+func (r Rule) Validate() error {
+	for i, occupation := range r.Occupations {
+		if strings.TrimSpace(occupation) == "" {
+			return fmt.Errorf("准入规则第%d个职业关键字不能为空", i+1)
+		}
+	}
+	if r.MinAge < 0 || r.MaxAge < 0 {
+		return fmt.Errorf("准入规则年龄不能小于0")
+	}
+	if r.MaxAge > 0 && r.MinAge > r.MaxAge {
+		return fmt.Errorf("准入规则最小年龄不能大于最大年龄")
+	}
+	if r.MinIncome < 0 {
+		return fmt.Errorf("准入规则最低月收入不能小于0")
+	}
+	return nil
+}
+
+// IsEmpty 规则未限制任何条件
+func (r Rule) IsEmpty() bool {
+	return len(r.Occupations) == 0 && r.MinAge == 0 && r.MaxAge == 0 && r.MinIncome == 0
+}
+
+// String 将规则序列化为配置字符串,不限制任何条件时返回空
+func (r Rule) String() string {
+	if r.IsEmpty() {
+		return ""
+	}
+	for i, occupation := range r.Occupations {
+		r.Occupations[i] = strings.TrimSpace(occupation)
+	}
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+// Check 校验申请人是否满足规则,返回全部未满足的条件,全部满足时返回空
+func (r Rule) Check(applicant Applicant) []Reason {
+	var reasons []Reason
+
+	// 1. 职业
+	if len(r.Occupations) > 0 {
+		occupation := strings.TrimSpace(applicant.Occupation)
+		if occupation == "" {
+			reasons = append(reasons, Reason{
+				Code:    ReasonProfile,
+				Message: "未填写职业，无法评估是否符合产品申请条件，请先完善个人信息",
+			})
+		} else if !r.matchOccupation(occupation) {
+			reasons = append(reasons, Reason{
+				Code:    ReasonOccupation,
+				Message: fmt.Sprintf("该产品仅面向%s相关职业，您的职业为%s", strings.Join(r.Occupations, "/"), occupation),
+			})
+		}
+	}
+
+	// 2. 年龄区间
+	if r.MinAge > 0 || r.MaxAge > 0 {
+		switch {
+		case applicant.Age <= 0:
+			reasons = append(reasons, Reason{
+				Code:    ReasonProfile,
+				Message: "未填写年龄，无法评估是否符合产品申请条件，请先完善个人信息",
+			})
+		case r.MinAge > 0 && applicant.Age < r.MinAge:
+			reasons = append(reasons, Reason{
+				Code:    ReasonAge,
+				Message: fmt.Sprintf("该产品要求申请人年龄不低于%d周岁，您的年龄为%d周岁", r.MinAge, applicant.Age),
+				Limit:   float64(r.MinAge),
+				Actual:  float64(applicant.Age),
+			})
+		case r.MaxAge > 0 && applicant.Age > r.MaxAge:
+			reasons = append(reasons, Reason{
+				Code:    ReasonAge,
+				Message: fmt.Sprintf("该产品要求申请人年龄不超过%d周岁，您的年龄为%d周岁", r.MaxAge, applicant.Age),
+				Limit:   float64(r.MaxAge),
+				Actual:  float64(applicant.Age),
+			})
+		}
+	}
+
+	// 3. 最低月收入
+	if r.MinIncome > 0 {
+		if applicant.Income <= 0 {
+			reasons = append(reasons, Reason{
+				Code:    ReasonProfile,
+				Message: "未填写月收入，无法评估是否符合产品申请条件，请先完善个人信息",
+				Limit:   r.MinIncome,
+			})
+		} else if applicant.Income < r.MinIncome {
+			reasons = append(reasons, Reason{
+				Code:    ReasonIncome,
+				Message: fmt.Sprintf("该产品要求月收入不低于%.2f元，您的月收入为%.2f元", r.MinIncome, applicant.Income),
+				Limit:   r.MinIncome,
+				Actual:  applicant.Income,
+			})
+		}
+	}
+
+	return reasons
+}
+
+// matchOccupation 申请人职业包含任一职业关键字
+func (r Rule) matchOccupation(occupation string) bool {
+	for _, keyword := range r.Occupations {
+		if strings.Contains(occupation, strings.TrimSpace(keyword)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,235 @@
+// Package leasepricing 租赁产品定价规则
+// 产品在统一日租金的基础上按季节调价,再按租期长短与提前预订天数给予折扣
+// 租金总额 = Σ(日租金 × 当日季节系数) × 租期折扣 × 提前预订折扣,未配置的规则不调整价格
+package leasepricing
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DurationTier 租期折扣档位,租期达到最少天数即适用,多档满足时取天数最多的一档
+type DurationTier struct {
+	MinDays  int     `json:"min_days"` // 最少租期(天),如7表示按周、30表示按月
+	Discount float64 `json:"discount"` // 折扣系数,如0.9表示九折
+}
+
+// Season 季节调价窗口,按月日每年重复,开始晚于结束时表示跨年,两端均包含
+type Season struct {
+	Name       string  `json:"name"`       // 名称,如秋收旺季
+	Start      string  `json:"start"`      // 开始月日 MM-DD
+	End        string  `json:"end"`        // 结束月日 MM-DD
+	Multiplier float64 `json:"multiplier"` // 日租金系数,大于1为旺季加价,小于1为淡季让利
+}
+
+// EarlyBooking 提前预订折扣,开始日期距报价日达到最少天数即适用,多档满足时取天数最多的一档
+type EarlyBooking struct {
+	MinDaysAhead int     `json:"min_days_ahead"` // 最少提前天数
+	Discount     float64 `json:"discount"`       // 折扣系数
+}
+
+// Rule 产品定价规则
+type Rule struct {
+	DurationTiers []DurationTier `json:"duration_tiers,omitempty"`
+	Seasons       []Season       `json:"seasons,omitempty"`
+	EarlyBooking  []EarlyBooking `json:"early_booking,omitempty"`
+}
+
+// Quote 租期报价明细
+type Quote struct {
+	Duration             int     // 租期(天)
+	BaseAmount           float64 // 日租金 × 租期
+	SeasonalDays         int     // 落在季节调价窗口内的天数
+	SeasonalAmount       float64 // 按季节系数调整后的租金
+	DurationDiscount     float64 // 适用的租期折扣,1表示无折扣
+	EarlyBookingDiscount float64 // 适用的提前预订折扣,1表示无折扣
+	TotalAmount          float64 // 租金总额,四舍五入到分
+}
+
+// Parse 解析产品配置的定价规则(JSON对象),为空时返回不调价的规则
+// 示例: {"duration_tiers":[{"min_days":7,"discount":0.95},{"min_days":30,"discount":0.85}],
+// "seasons":[{"name":"秋收旺季","start":"09-15","end":"10-15","multiplier":1.5},{"name":"冬季淡季","start":"12-01","end":"02-28","multiplier":0.7}],
+// "early_booking":[{"min_days_ahead":30,"discount":0.95}]}
+func Parse(config string) (Rule, error) {
+	config = strings.TrimSpace(config)
+	if config == "" {
+		return Rule{}, nil
+	}
+
+	var rule Rule
+	if err := json.Unmarshal([]byte(config), &rule); err != nil {
+		return Rule{}, fmt.Errorf("定价规则配置格式错误: %v", err)
+	}
+	if err := rule.Validate(); err != nil {
+		return Rule{}, err
+	}
+	return rule, nil
+}
+
+// Validate 校验定价规则配置
+func (r Rule) Validate() error {
+	days := make(map[int]bool, len(r.DurationTiers))
+	for i, tier := range r.DurationTiers {
+		if tier.MinDays < 1 {
+			return fmt.Errorf("定价规则第%d个租期折扣的最少租期必须大于0", i+1)
+		}
+		if days[tier.MinDays] {
+			return fmt.Errorf("定价规则租期折扣的最少租期%d天重复", tier.MinDays)
+		}
+		days[tier.MinDays] = true
+		if tier.Discount <= 0 || tier.Discount > 1 {
+			return fmt.Errorf("定价规则第%d个租期折扣必须大于0且不超过1", i+1)
+		}
+	}
+
+	for i, season := range r.Seasons {
+		if strings.TrimSpace(season.Name) == "" {
+			return fmt.Errorf("定价规则第%d个季节调价名称不能为空", i+1)
+		}
+		if _, err := parseMonthDay(season.Start); err != nil {
+			return fmt.Errorf("定价规则第%d个季节调价开始日期格式错误，应为MM-DD", i+1)
+		}
+		if _, err := parseMonthDay(season.End); err != nil {
+			return fmt.Errorf("定价规则第%d个季节调价结束日期格式错误，应为MM-DD", i+1)
+		}
+		if season.Multiplier <= 0 || season.Multiplier > 10 {
+			return fmt.Errorf("定价规则第%d个季节调价系数必须大于0且不超过10", i+1)
+		}
+	}
+	// 同一天只能落在一个季节窗口内,按闰年逐日检查
+	for day := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC); day.Year() == 2000; day = day.AddDate(0, 0, 1) {
+		var matched []string
+		for _, season := range r.Seasons {
+			if season.contains(day) {
+				matched = append(matched, season.Name)
+			}
+		}
+		if len(matched) > 1 {
+			return fmt.Errorf("定价规则季节调价%s在%s重叠", strings.Join(matched, "与"), day.Format("01-02"))
+		}
+	}
+
+	ahead := make(map[int]bool, len(r.EarlyBooking))
+	for i, early := range r.EarlyBooking {
+		if early.MinDaysAhead < 1 {
+			return fmt.Errorf("定价规则第%d个提前预订折扣的最少提前天数必须大于0", i+1)
+		}
+		if ahead[early.MinDaysAhead] {
+			return fmt.Errorf("定价规则提前预订折扣的最少提前天数%d天重复", early.MinDaysAhead)
+		}
+		ahead[early.MinDaysAhead] = true
+		if early.Discount <= 0 || early.Discount > 1 {
+			return fmt.Errorf("定价规则第%d个提前预订折扣必须大于0且不超过1", i+1)
+		}
+	}
+	return nil
+}
+
+// IsEmpty 规则未配置任何调价
+func (r Rule) IsEmpty() bool {
+	return len(r.DurationTiers) == 0 && len(r.Seasons) == 0 && len(r.EarlyBooking) == 0
+}
+
+// String 将规则序列化为配置字符串,档位按天数升序排列,未配置任何调价时返回空
+func (r Rule) String() string {
+	if r.IsEmpty() {
+		return ""
+	}
+	sort.SliceStable(r.DurationTiers, func(i, j int) bool {
+		return r.DurationTiers[i].MinDays < r.DurationTiers[j].MinDays
+	})
+	sort.SliceStable(r.EarlyBooking, func(i, j int) bool {
+		return r.EarlyBooking[i].MinDaysAhead < r.EarlyBooking[j].MinDaysAhead
+	})
+	for i := range r.Seasons {
+		r.Seasons[i].Name = strings.TrimSpace(r.Seasons[i].Name)
+	}
+	data, _ := json.Marshal(r)
+	return string(data)
+}
+
+// Quote 按日租金计算租期报价,起止日期两端均包含,today 为报价日,用于计算提前预订天数
+func (r Rule) Quote(dailyRate float64, start, end, today time.Time) Quote {
+	start, end, today = dateOf(start), dateOf(end), dateOf(today)
+
+	quote := Quote{
+		DurationDiscount:     1,
+		EarlyBookingDiscount: 1,
+	}
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		quote.Duration++
+		quote.BaseAmount += dailyRate
+		multiplier := 1.0
+		if season, ok := r.season(day); ok {
+			quote.SeasonalDays++
+			multiplier = season.Multiplier
+		}
+		quote.SeasonalAmount += dailyRate * multiplier
+	}
+
+	best := 0
+	for _, tier := range r.DurationTiers {
+		if quote.Duration >= tier.MinDays && tier.MinDays > best {
+			best = tier.MinDays
+			quote.DurationDiscount = tier.Discount
+		}
+	}
+
+	daysAhead := int(start.Sub(today).Hours() / 24)
+	best = 0
+	for _, early := range r.EarlyBooking {
+		if daysAhead >= early.MinDaysAhead && early.MinDaysAhead > best {
+			best = early.MinDaysAhead
+			quote.EarlyBookingDiscount = early.Discount
+		}
+	}
+
+	quote.BaseAmount = round2(quote.BaseAmount)
+	quote.SeasonalAmount = round2(quote.SeasonalAmount)
+	quote.TotalAmount = round2(quote.SeasonalAmount * quote.DurationDiscount * quote.EarlyBookingDiscount)
+	return quote
+}
+
+// season 返回日期所在的季节窗口
+func (r Rule) season(day time.Time) (Season, bool) {
+	for _, season := range r.Seasons {
+		if season.contains(day) {
+			return season, true
+		}
+	}
+	return Season{}, false
+}
+
+// contains 日期是否落在季节窗口内,开始晚于结束时表示跨年
+func (s Season) contains(day time.Time) bool {
+	start, _ := parseMonthDay(s.Start)
+	end, _ := parseMonthDay(s.End)
+	md := int(day.Month())*100 + day.Day()
+	if start <= end {
+		return md >= start && md <= end
+	}
+	return md >= start || md <= end
+}
+
+// parseMonthDay 解析 MM-DD 为 月*100+日,按闰年校验以允许 02-29
+func parseMonthDay(value string) (int, error) {
+	t, err := time.Parse("2006-01-02", "2000-"+strings.TrimSpace(value))
+	if err != nil {
+		return 0, err
+	}
+	return int(t.Month())*100 + t.Day(), nil
+}
+
+// dateOf 取日期部分
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// round2 四舍五入保留两位小数
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,66 @@
+// Package productschedule 产品排期上下架规则
+// 贷款与租赁产品共用: 管理员设置计划上架、下架时间,由各产品服务的后台任务到期切换状态
+package productschedule
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// 产品状态
+const (
+	StatusOnSale  uint64 = 1 // 上架
+	StatusOffSale uint64 = 2 // 下架
+)
+
+// Validate 校验排期时间(Unix秒),0表示不设置
+func Validate(launchAt, delistAt int64, now time.Time) error {
+	if launchAt < 0 || delistAt < 0 {
+		return fmt.Errorf("排期时间不合法")
+	}
+	if delistAt > 0 && delistAt <= now.Unix() {
+		return fmt.Errorf("计划下架时间必须晚于当前时间")
+	}
+	if launchAt > 0 && delistAt > 0 && delistAt <= launchAt {
+		return fmt.Errorf("计划下架时间必须晚于计划上架时间")
+	}
+	return nil
+}
+
+// ToNullTime 将 Unix 秒转换为可空时间,0表示不设置
+func ToNullTime(unix int64) sql.NullTime {
+	if unix <= 0 {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: time.Unix(unix, 0), Valid: true}
+}
+
+// ToUnix 将可空时间转换为 Unix 秒,未设置时返回0
+func ToUnix(t sql.NullTime) int64 {
+	if !t.Valid {
+		return 0
+	}
+	return t.Time.Unix()
+}
+
+// Status 按排期计算产品此刻应处的状态
+// 已到下架时间或尚未到上架时间的产品应下架; 已到上架时间的产品应上架; 未排期时保持当前状态
+// 人工上下架会清除计划上架时间,因此计划上架时间已过且处于下架状态的产品只可能是待自动上架
+func Status(current uint64, launchAt, delistAt sql.NullTime, now time.Time) uint64 {
+	if delistAt.Valid && !delistAt.Time.After(now) {
+		return StatusOffSale
+	}
+	if launchAt.Valid {
+		if launchAt.Time.After(now) {
+			return StatusOffSale
+		}
+		return StatusOnSale
+	}
+	return current
+}
+
+// Expired 判断产品是否已过计划下架时间
+func Expired(delistAt sql.NullTime, now time.Time) bool {
+	return delistAt.Valid && !delistAt.Time.After(now)
+}
@@ -0,0 +1,91 @@
+package productversion
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// 版本状态
+const (
+	StatusScheduled  = "scheduled"  // 待生效
+	StatusActive     = "active"     // 生效中
+	StatusSuperseded = "superseded" // 已被新版本取代
+	StatusCancelled  = "cancelled"  // 产品删除时取消的待生效版本
+)
+
+// Change 单个字段的变更
+type Change struct {
+	Field string `json:"field"` // 字段名(json标签)
+	From  any    `json:"from"`  // 变更前取值
+	To    any    `json:"to"`    // 变更后取值
+}
+
+// Diff 按 json 标签逐字段比较两个同类型结构体,返回发生变化的字段
+func Diff(from, to any) []Change {
+	fv, tv := reflect.Indirect(reflect.ValueOf(from)), reflect.Indirect(reflect.ValueOf(to))
+	if fv.Type() != tv.Type() || fv.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("productversion: 无法比较 %T 与 %T", from, to))
+	}
+
+	changes := make([]Change, 0)
+	for i := 0; i < fv.NumField(); i++ {
+		name := fieldName(fv.Type().Field(i))
+		if name == "" {
+			continue
+		}
+		a, b := fv.Field(i).Interface(), tv.Field(i).Interface()
+		if !reflect.DeepEqual(a, b) {
+			changes = append(changes, Change{Field: name, From: a, To: b})
+		}
+	}
+	return changes
+}
+
+// Apply 将变更的新值写入结构体指针,未涉及的字段保持不变
+// 定时生效的版本只应用自身变更的字段,避免覆盖排期之后的其他修改
+func Apply(dst any, changes []Change) error {
+	data, err := json.Marshal(dst)
+	if err != nil {
+		return err
+	}
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	for _, change := range changes {
+		value, err := json.Marshal(change.To)
+		if err != nil {
+			return err
+		}
+		fields[change.Field] = value
+	}
+
+	if data, err = json.Marshal(fields); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, dst)
+}
+
+// IsScheduled 判断生效时间是否晚于当前时间,未指定生效时间时立即生效
+func IsScheduled(effectiveFrom int64, now time.Time) bool {
+	return effectiveFrom > now.Unix()
+}
+
+// fieldName 返回字段的 json 名称,忽略未导出及标记为"-"的字段
+func fieldName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" {
+		name = field.Name
+	}
+	return name
+}
@@ -0,0 +1,129 @@
+// Package ratetier 贷款产品分档利率
+// 产品按借款金额、期限划分利率档位,每档给出挂牌利率及审批可调整的利率区间
+// 报价按档位挂牌利率试算,审批时批准利率须落在批准金额、期限对应档位的区间内;未配置档位的产品沿用产品利率
+package ratetier
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Tier 利率档位
+// 金额区间左闭右开,期限区间两端均包含,上限为0表示不设上限
+type Tier struct {
+	MinAmount    float64 // 最小金额(元),含
+	MaxAmount    float64 // 最大金额(元),不含,0表示不设上限
+	MinDuration  int     // 最小期限(月),含
+	MaxDuration  int     // 最大期限(月),含,0表示不设上限
+	InterestRate float64 // 挂牌年利率(%),报价使用
+	MinRate      float64 // 审批可批准的最低年利率(%),0表示与挂牌利率相同
+	MaxRate      float64 // 审批可批准的最高年利率(%),0表示与挂牌利率相同
+}
+
+// Contains 金额、期限是否落在档位内
+func (t Tier) Contains(amount float64, duration int) bool {
+	if amount < t.MinAmount || (t.MaxAmount > 0 && amount >= t.MaxAmount) {
+		return false
+	}
+	if duration < t.MinDuration || (t.MaxDuration > 0 && duration > t.MaxDuration) {
+		return false
+	}
+	return true
+}
+
+// Allows 批准利率是否在档位允许的区间内
+func (t Tier) Allows(rate float64) bool {
+	return rate >= t.MinRate && rate <= t.MaxRate
+}
+
+// overlaps 两个档位的金额、期限区间是否有交集
+func (t Tier) overlaps(other Tier) bool {
+	amountOverlap := (t.MaxAmount == 0 || other.MinAmount < t.MaxAmount) && (other.MaxAmount == 0 || t.MinAmount < other.MaxAmount)
+	durationOverlap := (t.MaxDuration == 0 || other.MinDuration <= t.MaxDuration) && (other.MaxDuration == 0 || t.MinDuration <= other.MaxDuration)
+	return amountOverlap && durationOverlap
+}
+
+// Normalize 校验档位配置并补全审批利率区间,按最小金额、最小期限排序
+// 同一金额、期限只能落在一个档位内,避免报价与审批取到不同的利率
+func Normalize(tiers []Tier) ([]Tier, error) {
+	result := make([]Tier, 0, len(tiers))
+	for i, tier := range tiers {
+		if tier.MinAmount < 0 || tier.MaxAmount < 0 {
+			return nil, fmt.Errorf("利率档位第%d档金额不能小于0", i+1)
+		}
+		if tier.MaxAmount > 0 && tier.MinAmount >= tier.MaxAmount {
+			return nil, fmt.Errorf("利率档位第%d档最小金额必须小于最大金额", i+1)
+		}
+		if tier.MinDuration < 0 || tier.MaxDuration < 0 {
+			return nil, fmt.Errorf("利率档位第%d档期限不能小于0", i+1)
+		}
+		if tier.MaxDuration > 0 && tier.MinDuration > tier.MaxDuration {
+			return nil, fmt.Errorf("利率档位第%d档最小期限不能大于最大期限", i+1)
+		}
+		if tier.InterestRate <= 0 {
+			return nil, fmt.Errorf("利率档位第%d档利率必须大于0", i+1)
+		}
+		if tier.MinRate == 0 {
+			tier.MinRate = tier.InterestRate
+		}
+		if tier.MaxRate == 0 {
+			tier.MaxRate = tier.InterestRate
+		}
+		if tier.MinRate < 0 || tier.MinRate > tier.InterestRate || tier.MaxRate < tier.InterestRate {
+			return nil, fmt.Errorf("利率档位第%d档审批利率区间必须包含挂牌利率", i+1)
+		}
+		result = append(result, tier)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].MinAmount != result[j].MinAmount {
+			return result[i].MinAmount < result[j].MinAmount
+		}
+		return result[i].MinDuration < result[j].MinDuration
+	})
+	for i := range result {
+		for j := i + 1; j < len(result); j++ {
+			if result[i].overlaps(result[j]) {
+				return nil, fmt.Errorf("利率档位金额、期限区间存在重叠")
+			}
+		}
+	}
+	return result, nil
+}
+
+// Match 查找金额、期限适用的档位
+func Match(tiers []Tier, amount float64, duration int) (Tier, bool) {
+	for _, tier := range tiers {
+		if tier.Contains(amount, duration) {
+			return tier, true
+		}
+	}
+	return Tier{}, false
+}
+
+// Rate 返回金额、期限适用的挂牌利率,未配置档位时返回产品利率
+func Rate(tiers []Tier, baseRate, amount float64, duration int) (float64, error) {
+	if len(tiers) == 0 {
+		return baseRate, nil
+	}
+	tier, ok := Match(tiers, amount, duration)
+	if !ok {
+		return 0, fmt.Errorf("借款金额%.2f元、期限%d个月没有适用的利率档位", amount, duration)
+	}
+	return tier.InterestRate, nil
+}
+
+// CheckRate 校验批准利率是否符合适用档位,未配置档位时不限制
+func CheckRate(tiers []Tier, amount float64, duration int, rate float64) error {
+	if len(tiers) == 0 {
+		return nil
+	}
+	tier, ok := Match(tiers, amount, duration)
+	if !ok {
+		return fmt.Errorf("批准金额%.2f元、期限%d个月没有适用的利率档位", amount, duration)
+	}
+	if !tier.Allows(rate) {
+		return fmt.Errorf("批准利率应在%.2f%%到%.2f%%之间", tier.MinRate, tier.MaxRate)
+	}
+	return nil
+}
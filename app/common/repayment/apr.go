@@ -0,0 +1,77 @@
+package repayment
+
+import (
+	"math"
+	"time"
+)
+
+// Summary 还款计划汇总
+type Summary struct {
+	MonthlyPayment float64 // 首期应还金额
+	TotalInterest  float64 // 利息合计
+	TotalAmount    float64 // 本息合计
+}
+
+// Summarize 汇总还款计划
+func Summarize(list []Installment) Summary {
+	var s Summary
+	if len(list) > 0 {
+		s.MonthlyPayment = list[0].Total
+	}
+	for _, item := range list {
+		s.TotalInterest += item.Interest
+		s.TotalAmount += item.Total
+	}
+	s.TotalInterest = Round2(s.TotalInterest)
+	s.TotalAmount = Round2(s.TotalAmount)
+	return s
+}
+
+// APR 按内部收益率(IRR)计算年化利率(%)
+// received 借款人起息日实际到手金额, start 起息日, payments 各期还款(按应还日所在月份折现)
+// 月内部收益率乘以12折算为年化利率,保留两位小数
+func APR(received float64, start time.Time, payments []Installment) float64 {
+	if received <= 0 || len(payments) == 0 {
+		return 0
+	}
+
+	npv := func(rate float64) float64 {
+		v := -received
+		for _, p := range payments {
+			v += p.Total / math.Pow(1+rate, float64(monthsBetween(start, p.DueDate)))
+		}
+		return v
+	}
+
+	// 还款总额不超过到手金额时没有融资成本
+	if npv(0) <= 0 {
+		return 0
+	}
+
+	// 净现值随月利率单调递减,二分查找零点
+	low, high := 0.0, 1.0
+	for npv(high) > 0 {
+		high *= 2
+		if high > 1e3 {
+			return 0
+		}
+	}
+	for i := 0; i < 200 && high-low > 1e-12; i++ {
+		mid := (low + high) / 2
+		if npv(mid) > 0 {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return Round2((low + high) / 2 * 12 * 100)
+}
+
+// monthsBetween 返回两个日期相差的自然月数,不足一月按一月计
+func monthsBetween(from, to time.Time) int {
+	months := (to.Year()-from.Year())*12 + int(to.Month()-from.Month())
+	if months < 1 {
+		months = 1
+	}
+	return months
+}
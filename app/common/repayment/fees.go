@@ -0,0 +1,56 @@
+package repayment
+
+import "time"
+
+// Fees 产品费用配置
+type Fees struct {
+	OriginationRate float64 // 手续费率(%),放款时按本金一次性收取
+	ServiceRate     float64 // 服务费月费率(%),按本金随每期还款收取
+	GuaranteeRate   float64 // 担保费率(%),放款时按本金一次性收取
+}
+
+// Upfront 计算放款时一次性收取的手续费与担保费
+func (f Fees) Upfront(principal float64) (origination, guarantee float64) {
+	principal = Round2(principal)
+	return Round2(principal * f.OriginationRate / 100), Round2(principal * f.GuaranteeRate / 100)
+}
+
+// Disclosure 综合融资成本披露
+type Disclosure struct {
+	OriginationFee float64   // 手续费
+	GuaranteeFee   float64   // 担保费
+	ServiceFee     float64   // 服务费合计
+	ServiceFees    []float64 // 各期服务费
+	TotalFee       float64   // 费用合计
+	Received       float64   // 借款人实际到手金额
+	TotalCost      float64   // 利息与费用合计
+	APR            float64   // 综合年化利率(IRR,%)
+}
+
+// Disclose 计算还款计划叠加费用后的综合融资成本
+// 一次性费用从到手金额中扣除,服务费按各期覆盖的月数随还款收取
+func Disclose(principal float64, start time.Time, list []Installment, fees Fees) Disclosure {
+	principal = Round2(principal)
+	var d Disclosure
+	d.OriginationFee, d.GuaranteeFee = fees.Upfront(principal)
+	d.Received = Round2(principal - d.OriginationFee - d.GuaranteeFee)
+
+	monthlyService := principal * fees.ServiceRate / 100
+	d.ServiceFees = make([]float64, len(list))
+	payments := make([]Installment, len(list))
+	prev := start
+	for i, item := range list {
+		fee := Round2(monthlyService * float64(monthsBetween(prev, item.DueDate)))
+		payments[i] = item
+		payments[i].Total = Round2(item.Total + fee)
+		d.ServiceFees[i] = fee
+		d.ServiceFee += fee
+		d.TotalCost += item.Interest
+		prev = item.DueDate
+	}
+	d.ServiceFee = Round2(d.ServiceFee)
+	d.TotalFee = Round2(d.OriginationFee + d.GuaranteeFee + d.ServiceFee)
+	d.TotalCost = Round2(d.TotalCost + d.TotalFee)
+	d.APR = APR(d.Received, start, payments)
+	return d
+}
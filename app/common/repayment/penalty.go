@@ -0,0 +1,22 @@
+package repayment
+
+import "time"
+
+// OverdueDays 计算截至指定日期的逾期天数,应还日当天不算逾期
+func OverdueDays(dueDate, asOf time.Time) int {
+	due := time.Date(dueDate.Year(), dueDate.Month(), dueDate.Day(), 0, 0, 0, 0, time.Local)
+	day := time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, time.Local)
+	days := int(day.Sub(due).Hours() / 24)
+	if days < 0 {
+		return 0
+	}
+	return days
+}
+
+// Penalty 按日计算罚息: 逾期未还本息 × 罚息日利率(%) × 逾期天数
+func Penalty(overdueAmount, dailyRate float64, days int) float64 {
+	if overdueAmount <= 0 || dailyRate <= 0 || days <= 0 {
+		return 0
+	}
+	return Round2(overdueAmount * dailyRate / 100 * float64(days))
+}
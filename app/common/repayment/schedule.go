@@ -0,0 +1,158 @@
+package repayment
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// 还款方式
+const (
+	MethodEqualInstallment = "equal_installment" // 等额本息
+	MethodEqualPrincipal   = "equal_principal"   // 等额本金
+	MethodInterestOnly     = "interest_only"     // 先息后本(到期一次还本)
+)
+
+// Installment 单期还款计划
+type Installment struct {
+	No                 int       // 期数,从1开始
+	DueDate            time.Time // 应还日期
+	Principal          float64   // 应还本金
+	Interest           float64   // 应还利息
+	Total              float64   // 应还总额
+	RemainingPrincipal float64   // 本期还款后剩余本金
+}
+
+// IsValidMethod 检查还款方式是否支持
+func IsValidMethod(method string) bool {
+	switch method {
+	case MethodEqualInstallment, MethodEqualPrincipal, MethodInterestOnly:
+		return true
+	}
+	return false
+}
+
+// Generate 按还款方式生成还款计划
+// principal 贷款本金, annualRate 年利率(%), months 期限(月), start 起息日(首期应还日为起息日后一个月)
+func Generate(method string, principal, annualRate float64, months int, start time.Time) ([]Installment, error) {
+	if principal <= 0 {
+		return nil, fmt.Errorf("贷款本金必须大于0")
+	}
+	if months <= 0 {
+		return nil, fmt.Errorf("贷款期限必须大于0")
+	}
+	if annualRate < 0 {
+		return nil, fmt.Errorf("利率不能小于0")
+	}
+
+	monthlyRate := annualRate / 100 / 12
+
+	switch method {
+	case MethodEqualInstallment:
+		return equalInstallment(principal, monthlyRate, months, start), nil
+	case MethodEqualPrincipal:
+		return equalPrincipal(principal, monthlyRate, months, start), nil
+	case MethodInterestOnly:
+		return interestOnly(principal, monthlyRate, months, start), nil
+	default:
+		return nil, fmt.Errorf("不支持的还款方式: %s", method)
+	}
+}
+
+// equalInstallment 等额本息: 每期还款总额相同,最后一期本金轧差
+func equalInstallment(principal, monthlyRate float64, months int, start time.Time) []Installment {
+	var payment float64
+	if monthlyRate == 0 {
+		payment = principal / float64(months)
+	} else {
+		factor := math.Pow(1+monthlyRate, float64(months))
+		payment = principal * monthlyRate * factor / (factor - 1)
+	}
+	payment = Round2(payment)
+
+	list := make([]Installment, 0, months)
+	remaining := Round2(principal)
+	for i := 1; i <= months; i++ {
+		interest := Round2(remaining * monthlyRate)
+		p := Round2(payment - interest)
+		if i == months || p > remaining {
+			p = remaining
+		}
+		remaining = Round2(remaining - p)
+		list = append(list, Installment{
+			No:                 i,
+			DueDate:            AddMonths(start, i),
+			Principal:          p,
+			Interest:           interest,
+			Total:              Round2(p + interest),
+			RemainingPrincipal: remaining,
+		})
+	}
+	return list
+}
+
+// equalPrincipal 等额本金: 每期本金相同,利息按剩余本金递减
+func equalPrincipal(principal, monthlyRate float64, months int, start time.Time) []Installment {
+	perPrincipal := Round2(principal / float64(months))
+
+	list := make([]Installment, 0, months)
+	remaining := Round2(principal)
+	for i := 1; i <= months; i++ {
+		interest := Round2(remaining * monthlyRate)
+		p := perPrincipal
+		if i == months || p > remaining {
+			p = remaining
+		}
+		remaining = Round2(remaining - p)
+		list = append(list, Installment{
+			No:                 i,
+			DueDate:            AddMonths(start, i),
+			Principal:          p,
+			Interest:           interest,
+			Total:              Round2(p + interest),
+			RemainingPrincipal: remaining,
+		})
+	}
+	return list
+}
+
+// interestOnly 先息后本: 每期只还利息,最后一期一次性归还全部本金
+func interestOnly(principal, monthlyRate float64, months int, start time.Time) []Installment {
+	principal = Round2(principal)
+	interest := Round2(principal * monthlyRate)
+
+	list := make([]Installment, 0, months)
+	for i := 1; i <= months; i++ {
+		var p float64
+		remaining := principal
+		if i == months {
+			p = principal
+			remaining = 0
+		}
+		list = append(list, Installment{
+			No:                 i,
+			DueDate:            AddMonths(start, i),
+			Principal:          p,
+			Interest:           interest,
+			Total:              Round2(p + interest),
+			RemainingPrincipal: remaining,
+		})
+	}
+	return list
+}
+
+// AddMonths 在日期上增加月份,目标月份天数不足时取当月最后一天
+func AddMonths(t time.Time, months int) time.Time {
+	y, m, d := t.Date()
+	first := time.Date(y, m+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	if d > lastDay {
+		d = lastDay
+	}
+	return time.Date(first.Year(), first.Month(), d, 0, 0, 0, 0, t.Location())
+}
+
+// Round2 四舍五入保留两位小数
+func Round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,112 @@
+package repayment
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MethodSeasonal 按收获季还款,由产品还款模式决定,不可在审批时直接选择
+const MethodSeasonal = "seasonal"
+
+// Profile 产品还款模式
+type Profile struct {
+	Seasonal      bool  // 是否按收获季还款
+	GraceMonths   int   // 宽限期(月),宽限期内不还款,利息累计至首个还款日
+	HarvestMonths []int // 收获月份(1-12)
+}
+
+// ParseHarvestMonths 解析逗号分隔的收获月份,忽略非法值
+func ParseHarvestMonths(s string) []int {
+	var months []int
+	for _, part := range strings.Split(s, ",") {
+		month, err := strconv.Atoi(strings.TrimSpace(part))
+		if err == nil && month >= 1 && month <= 12 {
+			months = append(months, month)
+		}
+	}
+	return months
+}
+
+// Build 结合产品还款模式生成还款计划,返回实际采用的还款方式
+func Build(method string, principal, annualRate float64, months int, start time.Time, profile Profile) (string, []Installment, error) {
+	if months <= 0 {
+		return "", nil, fmt.Errorf("贷款期限必须大于0")
+	}
+	if profile.GraceMonths < 0 || profile.GraceMonths >= months {
+		return "", nil, fmt.Errorf("宽限期必须小于贷款期限")
+	}
+
+	if profile.Seasonal {
+		list, err := seasonal(principal, annualRate, months, start, profile.GraceMonths, profile.HarvestMonths)
+		return MethodSeasonal, list, err
+	}
+
+	if profile.GraceMonths == 0 {
+		list, err := Generate(method, principal, annualRate, months, start)
+		return method, list, err
+	}
+
+	// 宽限期: 从宽限期结束后开始按月还款,宽限期利息计入首期
+	list, err := Generate(method, principal, annualRate, months-profile.GraceMonths, start)
+	if err != nil {
+		return "", nil, err
+	}
+	for i := range list {
+		list[i].DueDate = AddMonths(start, profile.GraceMonths+list[i].No)
+	}
+	graceInterest := Round2(Round2(principal) * annualRate / 100 / 12 * float64(profile.GraceMonths))
+	list[0].Interest = Round2(list[0].Interest + graceInterest)
+	list[0].Total = Round2(list[0].Total + graceInterest)
+	return method, list, nil
+}
+
+// seasonal 按收获季还款: 宽限期后的收获月份及到期月为还款日,
+// 本金在各还款日平均分摊,利息按剩余本金逐月累计至还款日一并偿还
+func seasonal(principal, annualRate float64, months int, start time.Time, graceMonths int, harvestMonths []int) ([]Installment, error) {
+	if principal <= 0 {
+		return nil, fmt.Errorf("贷款本金必须大于0")
+	}
+	if annualRate < 0 {
+		return nil, fmt.Errorf("利率不能小于0")
+	}
+
+	harvest := make(map[time.Month]bool, len(harvestMonths))
+	for _, month := range harvestMonths {
+		harvest[time.Month(month)] = true
+	}
+
+	// 确定还款月(相对起息日的月数)
+	var paymentMonths []int
+	for i := graceMonths + 1; i <= months; i++ {
+		if i == months || harvest[AddMonths(start, i).Month()] {
+			paymentMonths = append(paymentMonths, i)
+		}
+	}
+
+	monthlyRate := annualRate / 100 / 12
+	remaining := Round2(principal)
+	perPrincipal := Round2(remaining / float64(len(paymentMonths)))
+
+	list := make([]Installment, 0, len(paymentMonths))
+	last := 0
+	for idx, month := range paymentMonths {
+		interest := Round2(remaining * monthlyRate * float64(month-last))
+		p := perPrincipal
+		if idx == len(paymentMonths)-1 || p > remaining {
+			p = remaining
+		}
+		remaining = Round2(remaining - p)
+		list = append(list, Installment{
+			No:                 idx + 1,
+			DueDate:            AddMonths(start, month),
+			Principal:          p,
+			Interest:           interest,
+			Total:              Round2(p + interest),
+			RemainingPrincipal: remaining,
+		})
+		last = month
+	}
+	return list, nil
+}
@@ -0,0 +1,37 @@
+package statemachine
+
+// 申请状态,贷款与租赁共用
+const (
+	StatusPending   = "pending"
+	StatusApproved  = "approved"
+	StatusRejected  = "rejected"
+	StatusCancelled = "cancelled"
+)
+
+// 申请事件,贷款与租赁共用
+const (
+	EventUpdate  = "update"  // 修改申请内容
+	EventReview  = "review"  // 审批链中间环节通过,申请仍待后续环节审批
+	EventApprove = "approve" // 审批链最终批准
+	EventReject  = "reject"  // 审批拒绝
+	EventCancel  = "cancel"  // 用户撤销
+)
+
+// NewApplicationMachine 申请审批状态机
+// pending --update/review--> pending
+// pending --approve--> approved
+// pending --reject--> rejected
+// pending --cancel--> cancelled
+// 各业务可在此基础上声明后续状态,如贷款的放款、结清
+func NewApplicationMachine(subject string) *Machine {
+	return New(subject).
+		State(StatusPending, "待审批").
+		State(StatusApproved, "已批准").
+		State(StatusRejected, "已拒绝").
+		State(StatusCancelled, "已撤销").
+		Permit(EventUpdate, "修改", StatusPending, StatusPending).
+		Permit(EventReview, "审批", StatusPending, StatusPending).
+		Permit(EventApprove, "审批", StatusApproved, StatusPending).
+		Permit(EventReject, "审批", StatusRejected, StatusPending).
+		Permit(EventCancel, "撤销", StatusCancelled, StatusPending)
+}
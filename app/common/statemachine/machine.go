@@ -0,0 +1,141 @@
+// Package statemachine 业务状态机
+// 通过事件声明允许的状态迁移,迁移前执行前置校验(Guard),迁移成功后执行回调(Hook)
+package statemachine
+
+import (
+	"context"
+	"fmt"
+)
+
+// Transition 一次状态迁移
+type Transition struct {
+	Key     string // 业务主键,如申请编号
+	Event   string // 触发事件
+	From    string // 迁移前状态
+	To      string // 迁移后状态
+	Payload any    // 调用方附带的业务数据,供Guard/Hook使用
+}
+
+// Guard 迁移前置校验,返回错误时拒绝迁移
+type Guard func(ctx context.Context, t Transition) error
+
+// Hook 迁移成功后的回调
+type Hook func(ctx context.Context, t Transition)
+
+// TransitionError 非法状态迁移
+type TransitionError struct {
+	Subject    string
+	Event      string
+	From       string
+	EventLabel string
+	FromLabel  string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("%s状态错误，当前状态[%s]不允许%s", e.Subject, e.FromLabel, e.EventLabel)
+}
+
+// Machine 状态机定义,定义完成后只读,可并发使用
+type Machine struct {
+	subject string                       // 业务对象名称,用于错误提示
+	states  map[string]string            // 状态 -> 名称
+	events  map[string]string            // 事件 -> 名称
+	routes  map[string]map[string]string // 事件 -> 迁移前状态 -> 迁移后状态
+	guards  map[string][]Guard
+	hooks   []Hook
+}
+
+// New 创建状态机,subject 为业务对象名称,如"贷款申请"
+func New(subject string) *Machine {
+	return &Machine{
+		subject: subject,
+		states:  make(map[string]string),
+		events:  make(map[string]string),
+		routes:  make(map[string]map[string]string),
+		guards:  make(map[string][]Guard),
+	}
+}
+
+// State 声明状态及其名称
+func (m *Machine) State(state, label string) *Machine {
+	m.states[state] = label
+	return m
+}
+
+// Permit 声明事件触发的状态迁移: from 中任一状态经 event 迁移至 to
+func (m *Machine) Permit(event, label, to string, from ...string) *Machine {
+	m.events[event] = label
+	if m.routes[event] == nil {
+		m.routes[event] = make(map[string]string)
+	}
+	for _, state := range from {
+		m.routes[event][state] = to
+	}
+	return m
+}
+
+// Guard 为事件添加前置校验
+func (m *Machine) Guard(event string, guard Guard) *Machine {
+	m.guards[event] = append(m.guards[event], guard)
+	return m
+}
+
+// OnTransition 添加迁移成功后的回调
+func (m *Machine) OnTransition(hook Hook) *Machine {
+	m.hooks = append(m.hooks, hook)
+	return m
+}
+
+// Can 判断当前状态是否允许触发事件(不执行前置校验)
+func (m *Machine) Can(from, event string) bool {
+	_, ok := m.routes[event][from]
+	return ok
+}
+
+// Target 返回当前状态触发事件后的目标状态,不允许时返回 *TransitionError
+func (m *Machine) Target(from, event string) (string, error) {
+	to, ok := m.routes[event][from]
+	if !ok {
+		return "", &TransitionError{
+			Subject:    m.subject,
+			Event:      event,
+			From:       from,
+			EventLabel: m.label(m.events, event),
+			FromLabel:  m.label(m.states, from),
+		}
+	}
+	return to, nil
+}
+
+// Fire 触发事件: 校验迁移是否合法并执行前置校验,再由 apply 持久化迁移结果,成功后执行回调
+// t 需填写 Key/Event/From/Payload,To 由状态机计算
+func (m *Machine) Fire(ctx context.Context, t Transition, apply func(t Transition) error) (Transition, error) {
+	to, err := m.Target(t.From, t.Event)
+	if err != nil {
+		return t, err
+	}
+	t.To = to
+
+	for _, guard := range m.guards[t.Event] {
+		if err := guard(ctx, t); err != nil {
+			return t, err
+		}
+	}
+
+	if err := apply(t); err != nil {
+		return t, err
+	}
+
+	for _, hook := range m.hooks {
+		hook(ctx, t)
+	}
+	return t, nil
+}
+
+// label 返回状态或事件名称,未声明时返回原值
+func (m *Machine) label(labels map[string]string, key string) string {
+	if label, ok := labels[key]; ok {
+		return label
+	}
+	return key
+}
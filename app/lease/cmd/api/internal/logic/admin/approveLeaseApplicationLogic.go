@@ -42,15 +42,21 @@ func (l *ApproveLeaseApplicationLogic) ApproveLeaseApplication(req *types.Approv
 		auditorName = "系统管理员"
 	}
 
+	// 获取审核员角色,用于校验审批链环节权限
+	auditorRole := l.getUserRoleFromJWT()
+
 	// 调用 Lease RPC 审批申请 - 使用熔断器
-	_, err = breaker.DoWithBreakerResultAcceptable(l.ctx, "lease-rpc", func() (*leaseclient.ApproveLeaseApplicationResp, error) {
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "lease-rpc", func() (*leaseclient.ApproveLeaseApplicationResp, error) {
 		return l.svcCtx.LeaseRpc.ApproveLeaseApplication(l.ctx, &leaseclient.ApproveLeaseApplicationReq{
-			ApplicationId:   req.ApplicationId,
-			AuditorId:       auditorId,
-			AuditorName:     auditorName,
-			Action:          req.Action,
-			Suggestions:     req.Suggestions,
-			ApprovedDeposit: req.ApprovedDeposit,
+			ApplicationId:    req.ApplicationId,
+			AuditorId:        auditorId,
+			AuditorName:      auditorName,
+			AuditorRole:      auditorRole,
+			Action:           req.Action,
+			Suggestions:      req.Suggestions,
+			ApprovedDuration: req.ApprovedDuration,
+			ApprovedAmount:   req.ApprovedAmount,
+			ApprovedDeposit:  req.ApprovedDeposit,
 		})
 	}, breaker.IsAcceptableError)
 	if err != nil {
@@ -59,7 +65,13 @@ func (l *ApproveLeaseApplicationLogic) ApproveLeaseApplication(req *types.Approv
 	}
 
 	// 转换 RPC 响应为 API 响应
-	return &types.ApproveLeaseApplicationResp{}, nil
+	return &types.ApproveLeaseApplicationResp{
+		Stage:      rpcResp.Stage,
+		StageName:  rpcResp.StageName,
+		TotalStage: rpcResp.TotalStage,
+		Finished:   rpcResp.Finished,
+		Status:     rpcResp.Status,
+	}, nil
 }
 
 // 从JWT中获取用户ID的辅助方法
@@ -138,3 +150,13 @@ func (l *ApproveLeaseApplicationLogic) getUserNameFromJWT() string {
 	}
 	return ""
 }
+
+// 从JWT中获取用户角色的辅助方法 (admin/operator)
+func (l *ApproveLeaseApplicationLogic) getUserRoleFromJWT() string {
+	if roleVal := l.ctx.Value("role"); roleVal != nil {
+		if role, ok := roleVal.(string); ok {
+			return role
+		}
+	}
+	return ""
+}
@@ -45,27 +45,29 @@ func (l *ListAllLeaseApplicationsLogic) ListAllLeaseApplications(req *types.List
 	var applications []types.LeaseApplicationInfo
 	for _, item := range rpcResp.List {
 		applications = append(applications, types.LeaseApplicationInfo{
-			Id:              item.Id,
-			ApplicationId:   item.ApplicationId,
-			UserId:          item.UserId,
-			ApplicantName:   item.ApplicantName,
-			ProductId:       item.ProductId,
-			ProductCode:     item.ProductCode,
-			Name:            item.Name,
-			Type:            item.Type,
-			Machinery:       item.Machinery,
-			StartDate:       item.StartDate,
-			EndDate:         item.EndDate,
-			Duration:        item.Duration,
-			DailyRate:       item.DailyRate,
-			TotalAmount:     item.TotalAmount,
-			Deposit:         item.Deposit,
-			DeliveryAddress: item.DeliveryAddress,
-			ContactPhone:    item.ContactPhone,
-			Purpose:         item.Purpose,
-			Status:          item.Status,
-			CreatedAt:       item.CreatedAt,
-			UpdatedAt:       item.UpdatedAt,
+			Id:               item.Id,
+			ApplicationId:    item.ApplicationId,
+			UserId:           item.UserId,
+			ApplicantName:    item.ApplicantName,
+			ProductId:        item.ProductId,
+			ProductCode:      item.ProductCode,
+			Name:             item.Name,
+			Type:             item.Type,
+			Machinery:        item.Machinery,
+			StartDate:        item.StartDate,
+			EndDate:          item.EndDate,
+			Duration:         item.Duration,
+			DailyRate:        item.DailyRate,
+			TotalAmount:      item.TotalAmount,
+			Deposit:          item.Deposit,
+			DeliveryAddress:  item.DeliveryAddress,
+			ContactPhone:     item.ContactPhone,
+			Purpose:          item.Purpose,
+			Status:           item.Status,
+			CreatedAt:        item.CreatedAt,
+			UpdatedAt:        item.UpdatedAt,
+			UnitId:           item.UnitId,
+			UnitSerialNumber: item.UnitSerialNumber,
 		})
 	}
 
@@ -51,6 +51,9 @@ func (l *ListLeaseApprovalsLogic) ListLeaseApprovals(req *types.ListLeaseApprova
 			ApprovedAmount:   item.ApprovedAmount,
 			ApprovedDeposit:  item.ApprovedDeposit,
 			CreatedAt:        item.CreatedAt,
+			Stage:            item.Stage,
+			StageName:        item.StageName,
+			AuditorRole:      item.AuditorRole,
 		})
 	}
 
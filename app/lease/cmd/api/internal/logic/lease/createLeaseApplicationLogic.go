@@ -57,6 +57,7 @@ func (l *CreateLeaseApplicationLogic) CreateLeaseApplication(req *types.CreateLe
 			DeliveryAddress: req.DeliveryAddress,
 			ContactPhone:    req.ContactPhone,
 			Purpose:         req.Purpose,
+			IdempotencyKey:  req.IdempotencyKey,
 		})
 	}, breaker.IsAcceptableError)
 	if err != nil {
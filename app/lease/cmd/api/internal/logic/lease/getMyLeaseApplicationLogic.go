@@ -40,27 +40,52 @@ func (l *GetMyLeaseApplicationLogic) GetMyLeaseApplication(applicationId string)
 	// 转换申请信息
 	return &types.GetLeaseApplicationResp{
 		ApplicationInfo: types.LeaseApplicationInfo{
-			Id:              rpcResp.ApplicationInfo.Id,
-			ApplicationId:   rpcResp.ApplicationInfo.ApplicationId,
-			UserId:          rpcResp.ApplicationInfo.UserId,
-			ApplicantName:   rpcResp.ApplicationInfo.ApplicantName,
-			ProductId:       rpcResp.ApplicationInfo.ProductId,
-			ProductCode:     rpcResp.ApplicationInfo.ProductCode,
-			Name:            rpcResp.ApplicationInfo.Name,
-			Type:            rpcResp.ApplicationInfo.Type,
-			Machinery:       rpcResp.ApplicationInfo.Machinery,
-			StartDate:       rpcResp.ApplicationInfo.StartDate,
-			EndDate:         rpcResp.ApplicationInfo.EndDate,
-			Duration:        rpcResp.ApplicationInfo.Duration,
-			DailyRate:       rpcResp.ApplicationInfo.DailyRate,
-			TotalAmount:     rpcResp.ApplicationInfo.TotalAmount,
-			Deposit:         rpcResp.ApplicationInfo.Deposit,
-			DeliveryAddress: rpcResp.ApplicationInfo.DeliveryAddress,
-			ContactPhone:    rpcResp.ApplicationInfo.ContactPhone,
-			Purpose:         rpcResp.ApplicationInfo.Purpose,
-			Status:          rpcResp.ApplicationInfo.Status,
-			CreatedAt:       rpcResp.ApplicationInfo.CreatedAt,
-			UpdatedAt:       rpcResp.ApplicationInfo.UpdatedAt,
+			Id:               rpcResp.ApplicationInfo.Id,
+			ApplicationId:    rpcResp.ApplicationInfo.ApplicationId,
+			UserId:           rpcResp.ApplicationInfo.UserId,
+			ApplicantName:    rpcResp.ApplicationInfo.ApplicantName,
+			ProductId:        rpcResp.ApplicationInfo.ProductId,
+			ProductCode:      rpcResp.ApplicationInfo.ProductCode,
+			Name:             rpcResp.ApplicationInfo.Name,
+			Type:             rpcResp.ApplicationInfo.Type,
+			Machinery:        rpcResp.ApplicationInfo.Machinery,
+			StartDate:        rpcResp.ApplicationInfo.StartDate,
+			EndDate:          rpcResp.ApplicationInfo.EndDate,
+			Duration:         rpcResp.ApplicationInfo.Duration,
+			DailyRate:        rpcResp.ApplicationInfo.DailyRate,
+			TotalAmount:      rpcResp.ApplicationInfo.TotalAmount,
+			Deposit:          rpcResp.ApplicationInfo.Deposit,
+			DeliveryAddress:  rpcResp.ApplicationInfo.DeliveryAddress,
+			ContactPhone:     rpcResp.ApplicationInfo.ContactPhone,
+			Purpose:          rpcResp.ApplicationInfo.Purpose,
+			Status:           rpcResp.ApplicationInfo.Status,
+			CreatedAt:        rpcResp.ApplicationInfo.CreatedAt,
+			UpdatedAt:        rpcResp.ApplicationInfo.UpdatedAt,
+			UnitId:           rpcResp.ApplicationInfo.UnitId,
+			UnitSerialNumber: rpcResp.ApplicationInfo.UnitSerialNumber,
 		},
+		ProductSnapshot: convertProductSnapshot(rpcResp.ProductSnapshot),
 	}, nil
 }
+
+// convertProductSnapshot 转换申请时的产品条款快照,无快照时返回空
+func convertProductSnapshot(snapshot *leaseclient.LeaseProductSnapshot) *types.LeaseProductSnapshot {
+	if snapshot == nil {
+		return nil
+	}
+
+	return &types.LeaseProductSnapshot{
+		ProductCode:      snapshot.ProductCode,
+		Name:             snapshot.Name,
+		Type:             snapshot.Type,
+		Machinery:        snapshot.Machinery,
+		Brand:            snapshot.Brand,
+		Model:            snapshot.Model,
+		DailyRate:        snapshot.DailyRate,
+		Deposit:          snapshot.Deposit,
+		MinDuration:      snapshot.MinDuration,
+		MaxDuration:      snapshot.MaxDuration,
+		ProductUpdatedAt: snapshot.ProductUpdatedAt,
+		SnapshotAt:       snapshot.SnapshotAt,
+	}
+}
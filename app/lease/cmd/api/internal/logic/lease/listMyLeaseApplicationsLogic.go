@@ -53,27 +53,29 @@ func (l *ListMyLeaseApplicationsLogic) ListMyLeaseApplications(req *types.ListLe
 	applications := make([]types.LeaseApplicationInfo, 0, len(rpcResp.List))
 	for _, app := range rpcResp.List {
 		applications = append(applications, types.LeaseApplicationInfo{
-			Id:              app.Id,
-			ApplicationId:   app.ApplicationId,
-			UserId:          app.UserId,
-			ApplicantName:   app.ApplicantName,
-			ProductId:       app.ProductId,
-			ProductCode:     app.ProductCode,
-			Name:            app.Name,
-			Type:            app.Type,
-			Machinery:       app.Machinery,
-			StartDate:       app.StartDate,
-			EndDate:         app.EndDate,
-			Duration:        app.Duration,
-			DailyRate:       app.DailyRate,
-			TotalAmount:     app.TotalAmount,
-			Deposit:         app.Deposit,
-			DeliveryAddress: app.DeliveryAddress,
-			ContactPhone:    app.ContactPhone,
-			Purpose:         app.Purpose,
-			Status:          app.Status,
-			CreatedAt:       app.CreatedAt,
-			UpdatedAt:       app.UpdatedAt,
+			Id:               app.Id,
+			ApplicationId:    app.ApplicationId,
+			UserId:           app.UserId,
+			ApplicantName:    app.ApplicantName,
+			ProductId:        app.ProductId,
+			ProductCode:      app.ProductCode,
+			Name:             app.Name,
+			Type:             app.Type,
+			Machinery:        app.Machinery,
+			StartDate:        app.StartDate,
+			EndDate:          app.EndDate,
+			Duration:         app.Duration,
+			DailyRate:        app.DailyRate,
+			TotalAmount:      app.TotalAmount,
+			Deposit:          app.Deposit,
+			DeliveryAddress:  app.DeliveryAddress,
+			ContactPhone:     app.ContactPhone,
+			Purpose:          app.Purpose,
+			Status:           app.Status,
+			CreatedAt:        app.CreatedAt,
+			UpdatedAt:        app.UpdatedAt,
+			UnitId:           app.UnitId,
+			UnitSerialNumber: app.UnitSerialNumber,
 		})
 	}
 
@@ -43,27 +43,29 @@ func (l *UpdateMyLeaseApplicationLogic) UpdateMyLeaseApplication(req *types.Upda
 	// 转换申请信息
 	return &types.UpdateLeaseApplicationResp{
 		ApplicationInfo: types.LeaseApplicationInfo{
-			Id:              rpcResp.ApplicationInfo.Id,
-			ApplicationId:   rpcResp.ApplicationInfo.ApplicationId,
-			UserId:          rpcResp.ApplicationInfo.UserId,
-			ApplicantName:   rpcResp.ApplicationInfo.ApplicantName,
-			ProductId:       rpcResp.ApplicationInfo.ProductId,
-			ProductCode:     rpcResp.ApplicationInfo.ProductCode,
-			Name:            rpcResp.ApplicationInfo.Name,
-			Type:            rpcResp.ApplicationInfo.Type,
-			Machinery:       rpcResp.ApplicationInfo.Machinery,
-			StartDate:       rpcResp.ApplicationInfo.StartDate,
-			EndDate:         rpcResp.ApplicationInfo.EndDate,
-			Duration:        rpcResp.ApplicationInfo.Duration,
-			DailyRate:       rpcResp.ApplicationInfo.DailyRate,
-			TotalAmount:     rpcResp.ApplicationInfo.TotalAmount,
-			Deposit:         rpcResp.ApplicationInfo.Deposit,
-			DeliveryAddress: rpcResp.ApplicationInfo.DeliveryAddress,
-			ContactPhone:    rpcResp.ApplicationInfo.ContactPhone,
-			Purpose:         rpcResp.ApplicationInfo.Purpose,
-			Status:          rpcResp.ApplicationInfo.Status,
-			CreatedAt:       rpcResp.ApplicationInfo.CreatedAt,
-			UpdatedAt:       rpcResp.ApplicationInfo.UpdatedAt,
+			Id:               rpcResp.ApplicationInfo.Id,
+			ApplicationId:    rpcResp.ApplicationInfo.ApplicationId,
+			UserId:           rpcResp.ApplicationInfo.UserId,
+			ApplicantName:    rpcResp.ApplicationInfo.ApplicantName,
+			ProductId:        rpcResp.ApplicationInfo.ProductId,
+			ProductCode:      rpcResp.ApplicationInfo.ProductCode,
+			Name:             rpcResp.ApplicationInfo.Name,
+			Type:             rpcResp.ApplicationInfo.Type,
+			Machinery:        rpcResp.ApplicationInfo.Machinery,
+			StartDate:        rpcResp.ApplicationInfo.StartDate,
+			EndDate:          rpcResp.ApplicationInfo.EndDate,
+			Duration:         rpcResp.ApplicationInfo.Duration,
+			DailyRate:        rpcResp.ApplicationInfo.DailyRate,
+			TotalAmount:      rpcResp.ApplicationInfo.TotalAmount,
+			Deposit:          rpcResp.ApplicationInfo.Deposit,
+			DeliveryAddress:  rpcResp.ApplicationInfo.DeliveryAddress,
+			ContactPhone:     rpcResp.ApplicationInfo.ContactPhone,
+			Purpose:          rpcResp.ApplicationInfo.Purpose,
+			Status:           rpcResp.ApplicationInfo.Status,
+			CreatedAt:        rpcResp.ApplicationInfo.CreatedAt,
+			UpdatedAt:        rpcResp.ApplicationInfo.UpdatedAt,
+			UnitId:           rpcResp.ApplicationInfo.UnitId,
+			UnitSerialNumber: rpcResp.ApplicationInfo.UnitSerialNumber,
 		},
 	}, nil
 }
@@ -13,6 +13,11 @@ type ApproveLeaseApplicationReq struct {
 }
 
 type ApproveLeaseApplicationResp struct {
+	Stage      int32  `json:"stage"`       // 本次处理的审批环节序号
+	StageName  string `json:"stage_name"`  // 本次处理的审批环节名称
+	TotalStage int32  `json:"total_stage"` // 该申请需要经过的审批环节总数
+	Finished   bool   `json:"finished"`    // 审批链是否已结束
+	Status     string `json:"status"`      // 审批后申请状态
 }
 
 type CancelLeaseApplicationReq struct {
@@ -38,6 +43,7 @@ type CreateLeaseApplicationReq struct {
 	DeliveryAddress string  `json:"delivery_address"`
 	ContactPhone    string  `json:"contact_phone"`
 	Purpose         string  `json:"purpose"`
+	IdempotencyKey  string  `header:"Idempotency-Key,optional"`
 }
 
 type CreateLeaseApplicationResp struct {
@@ -49,31 +55,34 @@ type GetLeaseApplicationReq struct {
 }
 
 type GetLeaseApplicationResp struct {
-	ApplicationInfo LeaseApplicationInfo `json:"application_info"`
+	ApplicationInfo LeaseApplicationInfo  `json:"application_info"`
+	ProductSnapshot *LeaseProductSnapshot `json:"product_snapshot,omitempty"`
 }
 
 type LeaseApplicationInfo struct {
-	Id              int64   `json:"id"`
-	ApplicationId   string  `json:"application_id"`
-	UserId          int64   `json:"user_id"`
-	ApplicantName   string  `json:"applicant_name"`
-	ProductId       int64   `json:"product_id"`
-	ProductCode     string  `json:"product_code"`
-	Name            string  `json:"name"`
-	Type            string  `json:"type"`
-	Machinery       string  `json:"machinery"`
-	StartDate       string  `json:"start_date"`
-	EndDate         string  `json:"end_date"`
-	Duration        int32   `json:"duration"`
-	DailyRate       float64 `json:"daily_rate"`
-	TotalAmount     float64 `json:"total_amount"`
-	Deposit         float64 `json:"deposit"`
-	DeliveryAddress string  `json:"delivery_address"`
-	ContactPhone    string  `json:"contact_phone"`
-	Purpose         string  `json:"purpose"`
-	Status          string  `json:"status"`
-	CreatedAt       int64   `json:"created_at"`
-	UpdatedAt       int64   `json:"updated_at"`
+	Id               int64   `json:"id"`
+	ApplicationId    string  `json:"application_id"`
+	UserId           int64   `json:"user_id"`
+	ApplicantName    string  `json:"applicant_name"`
+	ProductId        int64   `json:"product_id"`
+	ProductCode      string  `json:"product_code"`
+	Name             string  `json:"name"`
+	Type             string  `json:"type"`
+	Machinery        string  `json:"machinery"`
+	StartDate        string  `json:"start_date"`
+	EndDate          string  `json:"end_date"`
+	Duration         int32   `json:"duration"`
+	DailyRate        float64 `json:"daily_rate"`
+	TotalAmount      float64 `json:"total_amount"`
+	Deposit          float64 `json:"deposit"`
+	DeliveryAddress  string  `json:"delivery_address"`
+	ContactPhone     string  `json:"contact_phone"`
+	Purpose          string  `json:"purpose"`
+	Status           string  `json:"status"`
+	CreatedAt        int64   `json:"created_at"`
+	UpdatedAt        int64   `json:"updated_at"`
+	UnitId           int64   `json:"unit_id"`            // 分配的设备ID,审批通过时分配,0表示未分配
+	UnitSerialNumber string  `json:"unit_serial_number"` // 分配的设备序列号
 }
 
 type LeaseApprovalInfo struct {
@@ -87,6 +96,24 @@ type LeaseApprovalInfo struct {
 	ApprovedAmount   float64 `json:"approved_amount"`
 	ApprovedDeposit  float64 `json:"approved_deposit"`
 	CreatedAt        int64   `json:"created_at"`
+	Stage            int32   `json:"stage"`
+	StageName        string  `json:"stage_name"`
+	AuditorRole      string  `json:"auditor_role"` // admin/operator
+}
+
+type LeaseProductSnapshot struct {
+	ProductCode      string  `json:"product_code"`
+	Name             string  `json:"name"`
+	Type             string  `json:"type"`
+	Machinery        string  `json:"machinery"`
+	Brand            string  `json:"brand"`
+	Model            string  `json:"model"`
+	DailyRate        float64 `json:"daily_rate"`
+	Deposit          float64 `json:"deposit"`
+	MinDuration      int32   `json:"min_duration"` // 最小租期(天)
+	MaxDuration      int32   `json:"max_duration"` // 最大租期(天)
+	ProductUpdatedAt int64   `json:"product_updated_at"`
+	SnapshotAt       int64   `json:"snapshot_at"`
 }
 
 type ListLeaseApplicationsReq struct {
@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"leaseproductrpc/internal/svc"
+	"leaseproductrpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateEquipmentUnitLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateEquipmentUnitLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateEquipmentUnitLogic {
+	return &CreateEquipmentUnitLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CreateEquipmentUnitLogic) CreateEquipmentUnit(in *leaseproduct.CreateEquipmentUnitReq) (*leaseproduct.CreateEquipmentUnitResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &leaseproduct.CreateEquipmentUnitResp{}, nil
+}
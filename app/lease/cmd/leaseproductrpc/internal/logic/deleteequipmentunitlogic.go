@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"leaseproductrpc/internal/svc"
+	"leaseproductrpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteEquipmentUnitLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteEquipmentUnitLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteEquipmentUnitLogic {
+	return &DeleteEquipmentUnitLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *DeleteEquipmentUnitLogic) DeleteEquipmentUnit(in *leaseproduct.DeleteEquipmentUnitReq) (*leaseproduct.DeleteEquipmentUnitResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &leaseproduct.DeleteEquipmentUnitResp{}, nil
+}
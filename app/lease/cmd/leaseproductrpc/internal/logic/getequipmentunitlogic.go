@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"leaseproductrpc/internal/svc"
+	"leaseproductrpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetEquipmentUnitLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetEquipmentUnitLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetEquipmentUnitLogic {
+	return &GetEquipmentUnitLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetEquipmentUnitLogic) GetEquipmentUnit(in *leaseproduct.GetEquipmentUnitReq) (*leaseproduct.GetEquipmentUnitResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &leaseproduct.GetEquipmentUnitResp{}, nil
+}
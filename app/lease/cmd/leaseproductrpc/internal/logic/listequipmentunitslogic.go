@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"leaseproductrpc/internal/svc"
+	"leaseproductrpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListEquipmentUnitsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListEquipmentUnitsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListEquipmentUnitsLogic {
+	return &ListEquipmentUnitsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListEquipmentUnitsLogic) ListEquipmentUnits(in *leaseproduct.ListEquipmentUnitsReq) (*leaseproduct.ListEquipmentUnitsResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &leaseproduct.ListEquipmentUnitsResp{}, nil
+}
@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"leaseproductrpc/internal/svc"
+	"leaseproductrpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListLeaseProductVersionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListLeaseProductVersionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListLeaseProductVersionsLogic {
+	return &ListLeaseProductVersionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListLeaseProductVersionsLogic) ListLeaseProductVersions(in *leaseproduct.ListLeaseProductVersionsReq) (*leaseproduct.ListLeaseProductVersionsResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &leaseproduct.ListLeaseProductVersionsResp{}, nil
+}
@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"leaseproductrpc/internal/svc"
+	"leaseproductrpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type QuoteLeaseLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewQuoteLeaseLogic(ctx context.Context, svcCtx *svc.ServiceContext) *QuoteLeaseLogic {
+	return &QuoteLeaseLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *QuoteLeaseLogic) QuoteLease(in *leaseproduct.QuoteLeaseReq) (*leaseproduct.QuoteLeaseResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &leaseproduct.QuoteLeaseResp{}, nil
+}
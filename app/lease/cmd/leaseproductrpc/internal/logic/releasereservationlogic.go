@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"leaseproductrpc/internal/svc"
+	"leaseproductrpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReleaseReservationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReleaseReservationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReleaseReservationLogic {
+	return &ReleaseReservationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ReleaseReservationLogic) ReleaseReservation(in *leaseproduct.ReleaseReservationReq) (*leaseproduct.ReleaseReservationResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &leaseproduct.ReleaseReservationResp{}, nil
+}
@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"leaseproductrpc/internal/svc"
+	"leaseproductrpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReserveInventoryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReserveInventoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReserveInventoryLogic {
+	return &ReserveInventoryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ReserveInventoryLogic) ReserveInventory(in *leaseproduct.ReserveInventoryReq) (*leaseproduct.ReserveInventoryResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &leaseproduct.ReserveInventoryResp{}, nil
+}
@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"leaseproductrpc/internal/svc"
+	"leaseproductrpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ScheduleLeaseProductLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewScheduleLeaseProductLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ScheduleLeaseProductLogic {
+	return &ScheduleLeaseProductLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ScheduleLeaseProductLogic) ScheduleLeaseProduct(in *leaseproduct.ScheduleLeaseProductReq) (*leaseproduct.ScheduleLeaseProductResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &leaseproduct.ScheduleLeaseProductResp{}, nil
+}
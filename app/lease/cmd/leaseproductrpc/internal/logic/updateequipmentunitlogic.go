@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"leaseproductrpc/internal/svc"
+	"leaseproductrpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateEquipmentUnitLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateEquipmentUnitLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateEquipmentUnitLogic {
+	return &UpdateEquipmentUnitLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *UpdateEquipmentUnitLogic) UpdateEquipmentUnit(in *leaseproduct.UpdateEquipmentUnitReq) (*leaseproduct.UpdateEquipmentUnitResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &leaseproduct.UpdateEquipmentUnitResp{}, nil
+}
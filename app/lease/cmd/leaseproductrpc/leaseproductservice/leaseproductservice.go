@@ -14,17 +14,43 @@ import (
 )
 
 type (
+	CheckEligibilityReq            = leaseproduct.CheckEligibilityReq
+	CheckEligibilityResp           = leaseproduct.CheckEligibilityResp
 	CheckInventoryAvailabilityReq  = leaseproduct.CheckInventoryAvailabilityReq
 	CheckInventoryAvailabilityResp = leaseproduct.CheckInventoryAvailabilityResp
+	CreateEquipmentUnitReq         = leaseproduct.CreateEquipmentUnitReq
+	CreateEquipmentUnitResp        = leaseproduct.CreateEquipmentUnitResp
 	CreateLeaseProductReq          = leaseproduct.CreateLeaseProductReq
 	CreateLeaseProductResp         = leaseproduct.CreateLeaseProductResp
+	DeleteEquipmentUnitReq         = leaseproduct.DeleteEquipmentUnitReq
+	DeleteEquipmentUnitResp        = leaseproduct.DeleteEquipmentUnitResp
 	DeleteLeaseProductReq          = leaseproduct.DeleteLeaseProductReq
 	DeleteLeaseProductResp         = leaseproduct.DeleteLeaseProductResp
+	EligibilityReason              = leaseproduct.EligibilityReason
+	EquipmentUnitInfo              = leaseproduct.EquipmentUnitInfo
+	GetEquipmentUnitReq            = leaseproduct.GetEquipmentUnitReq
+	GetEquipmentUnitResp           = leaseproduct.GetEquipmentUnitResp
 	GetLeaseProductReq             = leaseproduct.GetLeaseProductReq
 	GetLeaseProductResp            = leaseproduct.GetLeaseProductResp
 	LeaseProductInfo               = leaseproduct.LeaseProductInfo
+	LeaseProductVersionInfo        = leaseproduct.LeaseProductVersionInfo
+	ListEquipmentUnitsReq          = leaseproduct.ListEquipmentUnitsReq
+	ListEquipmentUnitsResp         = leaseproduct.ListEquipmentUnitsResp
+	ListLeaseProductVersionsReq    = leaseproduct.ListLeaseProductVersionsReq
+	ListLeaseProductVersionsResp   = leaseproduct.ListLeaseProductVersionsResp
 	ListLeaseProductsReq           = leaseproduct.ListLeaseProductsReq
 	ListLeaseProductsResp          = leaseproduct.ListLeaseProductsResp
+	ProductVersionChange           = leaseproduct.ProductVersionChange
+	QuoteLeaseReq                  = leaseproduct.QuoteLeaseReq
+	QuoteLeaseResp                 = leaseproduct.QuoteLeaseResp
+	ReleaseReservationReq          = leaseproduct.ReleaseReservationReq
+	ReleaseReservationResp         = leaseproduct.ReleaseReservationResp
+	ReserveInventoryReq            = leaseproduct.ReserveInventoryReq
+	ReserveInventoryResp           = leaseproduct.ReserveInventoryResp
+	ScheduleLeaseProductReq        = leaseproduct.ScheduleLeaseProductReq
+	ScheduleLeaseProductResp       = leaseproduct.ScheduleLeaseProductResp
+	UpdateEquipmentUnitReq         = leaseproduct.UpdateEquipmentUnitReq
+	UpdateEquipmentUnitResp        = leaseproduct.UpdateEquipmentUnitResp
 	UpdateLeaseProductReq          = leaseproduct.UpdateLeaseProductReq
 	UpdateLeaseProductResp         = leaseproduct.UpdateLeaseProductResp
 
@@ -32,12 +58,24 @@ type (
 		// 产品查询
 		GetLeaseProduct(ctx context.Context, in *GetLeaseProductReq, opts ...grpc.CallOption) (*GetLeaseProductResp, error)
 		ListLeaseProducts(ctx context.Context, in *ListLeaseProductsReq, opts ...grpc.CallOption) (*ListLeaseProductsResp, error)
+		CheckEligibility(ctx context.Context, in *CheckEligibilityReq, opts ...grpc.CallOption) (*CheckEligibilityResp, error)
+		QuoteLease(ctx context.Context, in *QuoteLeaseReq, opts ...grpc.CallOption) (*QuoteLeaseResp, error)
 		// 产品管理
 		CreateLeaseProduct(ctx context.Context, in *CreateLeaseProductReq, opts ...grpc.CallOption) (*CreateLeaseProductResp, error)
 		UpdateLeaseProduct(ctx context.Context, in *UpdateLeaseProductReq, opts ...grpc.CallOption) (*UpdateLeaseProductResp, error)
 		DeleteLeaseProduct(ctx context.Context, in *DeleteLeaseProductReq, opts ...grpc.CallOption) (*DeleteLeaseProductResp, error)
+		ListLeaseProductVersions(ctx context.Context, in *ListLeaseProductVersionsReq, opts ...grpc.CallOption) (*ListLeaseProductVersionsResp, error)
+		ScheduleLeaseProduct(ctx context.Context, in *ScheduleLeaseProductReq, opts ...grpc.CallOption) (*ScheduleLeaseProductResp, error)
 		// 库存检查
 		CheckInventoryAvailability(ctx context.Context, in *CheckInventoryAvailabilityReq, opts ...grpc.CallOption) (*CheckInventoryAvailabilityResp, error)
+		ReserveInventory(ctx context.Context, in *ReserveInventoryReq, opts ...grpc.CallOption) (*ReserveInventoryResp, error)
+		ReleaseReservation(ctx context.Context, in *ReleaseReservationReq, opts ...grpc.CallOption) (*ReleaseReservationResp, error)
+		// 设备台账
+		ListEquipmentUnits(ctx context.Context, in *ListEquipmentUnitsReq, opts ...grpc.CallOption) (*ListEquipmentUnitsResp, error)
+		GetEquipmentUnit(ctx context.Context, in *GetEquipmentUnitReq, opts ...grpc.CallOption) (*GetEquipmentUnitResp, error)
+		CreateEquipmentUnit(ctx context.Context, in *CreateEquipmentUnitReq, opts ...grpc.CallOption) (*CreateEquipmentUnitResp, error)
+		UpdateEquipmentUnit(ctx context.Context, in *UpdateEquipmentUnitReq, opts ...grpc.CallOption) (*UpdateEquipmentUnitResp, error)
+		DeleteEquipmentUnit(ctx context.Context, in *DeleteEquipmentUnitReq, opts ...grpc.CallOption) (*DeleteEquipmentUnitResp, error)
 	}
 
 	defaultLeaseProductService struct {
@@ -62,6 +100,16 @@ func (m *defaultLeaseProductService) ListLeaseProducts(ctx context.Context, in *
 	return client.ListLeaseProducts(ctx, in, opts...)
 }
 
+func (m *defaultLeaseProductService) CheckEligibility(ctx context.Context, in *CheckEligibilityReq, opts ...grpc.CallOption) (*CheckEligibilityResp, error) {
+	client := leaseproduct.NewLeaseProductServiceClient(m.cli.Conn())
+	return client.CheckEligibility(ctx, in, opts...)
+}
+
+func (m *defaultLeaseProductService) QuoteLease(ctx context.Context, in *QuoteLeaseReq, opts ...grpc.CallOption) (*QuoteLeaseResp, error) {
+	client := leaseproduct.NewLeaseProductServiceClient(m.cli.Conn())
+	return client.QuoteLease(ctx, in, opts...)
+}
+
 // 产品管理
 func (m *defaultLeaseProductService) CreateLeaseProduct(ctx context.Context, in *CreateLeaseProductReq, opts ...grpc.CallOption) (*CreateLeaseProductResp, error) {
 	client := leaseproduct.NewLeaseProductServiceClient(m.cli.Conn())
@@ -78,8 +126,54 @@ func (m *defaultLeaseProductService) DeleteLeaseProduct(ctx context.Context, in
 	return client.DeleteLeaseProduct(ctx, in, opts...)
 }
 
+func (m *defaultLeaseProductService) ListLeaseProductVersions(ctx context.Context, in *ListLeaseProductVersionsReq, opts ...grpc.CallOption) (*ListLeaseProductVersionsResp, error) {
+	client := leaseproduct.NewLeaseProductServiceClient(m.cli.Conn())
+	return client.ListLeaseProductVersions(ctx, in, opts...)
+}
+
+func (m *defaultLeaseProductService) ScheduleLeaseProduct(ctx context.Context, in *ScheduleLeaseProductReq, opts ...grpc.CallOption) (*ScheduleLeaseProductResp, error) {
+	client := leaseproduct.NewLeaseProductServiceClient(m.cli.Conn())
+	return client.ScheduleLeaseProduct(ctx, in, opts...)
+}
+
 // 库存检查
 func (m *defaultLeaseProductService) CheckInventoryAvailability(ctx context.Context, in *CheckInventoryAvailabilityReq, opts ...grpc.CallOption) (*CheckInventoryAvailabilityResp, error) {
 	client := leaseproduct.NewLeaseProductServiceClient(m.cli.Conn())
 	return client.CheckInventoryAvailability(ctx, in, opts...)
 }
+
+func (m *defaultLeaseProductService) ReserveInventory(ctx context.Context, in *ReserveInventoryReq, opts ...grpc.CallOption) (*ReserveInventoryResp, error) {
+	client := leaseproduct.NewLeaseProductServiceClient(m.cli.Conn())
+	return client.ReserveInventory(ctx, in, opts...)
+}
+
+func (m *defaultLeaseProductService) ReleaseReservation(ctx context.Context, in *ReleaseReservationReq, opts ...grpc.CallOption) (*ReleaseReservationResp, error) {
+	client := leaseproduct.NewLeaseProductServiceClient(m.cli.Conn())
+	return client.ReleaseReservation(ctx, in, opts...)
+}
+
+// 设备台账
+func (m *defaultLeaseProductService) ListEquipmentUnits(ctx context.Context, in *ListEquipmentUnitsReq, opts ...grpc.CallOption) (*ListEquipmentUnitsResp, error) {
+	client := leaseproduct.NewLeaseProductServiceClient(m.cli.Conn())
+	return client.ListEquipmentUnits(ctx, in, opts...)
+}
+
+func (m *defaultLeaseProductService) GetEquipmentUnit(ctx context.Context, in *GetEquipmentUnitReq, opts ...grpc.CallOption) (*GetEquipmentUnitResp, error) {
+	client := leaseproduct.NewLeaseProductServiceClient(m.cli.Conn())
+	return client.GetEquipmentUnit(ctx, in, opts...)
+}
+
+func (m *defaultLeaseProductService) CreateEquipmentUnit(ctx context.Context, in *CreateEquipmentUnitReq, opts ...grpc.CallOption) (*CreateEquipmentUnitResp, error) {
+	client := leaseproduct.NewLeaseProductServiceClient(m.cli.Conn())
+	return client.CreateEquipmentUnit(ctx, in, opts...)
+}
+
+func (m *defaultLeaseProductService) UpdateEquipmentUnit(ctx context.Context, in *UpdateEquipmentUnitReq, opts ...grpc.CallOption) (*UpdateEquipmentUnitResp, error) {
+	client := leaseproduct.NewLeaseProductServiceClient(m.cli.Conn())
+	return client.UpdateEquipmentUnit(ctx, in, opts...)
+}
+
+func (m *defaultLeaseProductService) DeleteEquipmentUnit(ctx context.Context, in *DeleteEquipmentUnitReq, opts ...grpc.CallOption) (*DeleteEquipmentUnitResp, error) {
+	client := leaseproduct.NewLeaseProductServiceClient(m.cli.Conn())
+	return client.DeleteEquipmentUnit(ctx, in, opts...)
+}
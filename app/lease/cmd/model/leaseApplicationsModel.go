@@ -2,12 +2,18 @@ package model
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
 )
 
+// leaseApplicationsRowsWithVersion 按版本号更新时的字段,version 由数据库自增
+var leaseApplicationsRowsWithVersion = strings.Join(stringx.Remove(leaseApplicationsFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`", "`version`"), "=?,") + "=?"
+
 var _ LeaseApplicationsModel = (*customLeaseApplicationsModel)(nil)
 
 type (
@@ -18,6 +24,11 @@ type (
 		// 自定义方法
 		CountWithConditions(ctx context.Context, whereClause string, args []interface{}) (int64, error)
 		ListWithConditions(ctx context.Context, whereClause string, args []interface{}, limit, offset int32) ([]*LeaseApplications, error)
+		UpdateWithVersion(ctx context.Context, data *LeaseApplications) error
+		// 事务方法: 在 TransactCtx 中调用 *Session 方法,提交后调用 DelApplicationCache 清理缓存
+		TransactCtx(ctx context.Context, fn func(context.Context, sqlx.Session) error) error
+		UpdateWithVersionSession(ctx context.Context, session sqlx.Session, data *LeaseApplications) error
+		DelApplicationCache(ctx context.Context, data *LeaseApplications) error
 	}
 
 	customLeaseApplicationsModel struct {
@@ -53,3 +64,52 @@ func (m *customLeaseApplicationsModel) ListWithConditions(ctx context.Context, w
 
 	return applications, nil
 }
+
+// UpdateWithVersion 按版本号更新申请(乐观锁),版本号不一致时返回 ErrVersionConflict
+func (m *customLeaseApplicationsModel) UpdateWithVersion(ctx context.Context, data *LeaseApplications) error {
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		return nil, updateLeaseApplicationWithVersion(ctx, conn, data)
+	}, leaseApplicationCacheKeys(data)...)
+	return err
+}
+
+// UpdateWithVersionSession 在事务中按版本号更新申请,事务提交后需调用 DelApplicationCache
+func (m *customLeaseApplicationsModel) UpdateWithVersionSession(ctx context.Context, session sqlx.Session, data *LeaseApplications) error {
+	return updateLeaseApplicationWithVersion(ctx, session, data)
+}
+
+// DelApplicationCache 清理申请缓存
+func (m *customLeaseApplicationsModel) DelApplicationCache(ctx context.Context, data *LeaseApplications) error {
+	return m.DelCacheCtx(ctx, leaseApplicationCacheKeys(data)...)
+}
+
+// updateLeaseApplicationWithVersion 按版本号更新申请,成功后版本号加1
+func updateLeaseApplicationWithVersion(ctx context.Context, session sqlx.Session, data *LeaseApplications) error {
+	query := fmt.Sprintf("update `lease_applications` set %s, `version` = `version` + 1 where `id` = ? and `version` = ?", leaseApplicationsRowsWithVersion)
+	result, err := session.ExecCtx(ctx, query, data.ApplicationId, data.UserId, data.ApplicantName, data.ProductId, data.ProductCode,
+		data.Name, data.Type, data.Machinery, data.StartDate, data.EndDate, data.Duration, data.DailyRate, data.TotalAmount,
+		data.Deposit, data.DeliveryAddress, data.ContactPhone, data.Purpose, data.ProductSnapshot,
+		data.UnitId, data.UnitSerialNumber, data.Status, data.IdempotencyKey, data.Id, data.Version)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrVersionConflict
+	}
+
+	data.Version++
+	return nil
+}
+
+// leaseApplicationCacheKeys 申请缓存键
+func leaseApplicationCacheKeys(data *LeaseApplications) []string {
+	return []string{
+		fmt.Sprintf("%s%v", cacheLeaseApplicationsIdPrefix, data.Id),
+		fmt.Sprintf("%s%v", cacheLeaseApplicationsApplicationIdPrefix, data.ApplicationId),
+		fmt.Sprintf("%s%v:%v", cacheLeaseApplicationsUserIdIdempotencyKeyPrefix, data.UserId, data.IdempotencyKey),
+	}
+}
@@ -24,8 +24,9 @@ var (
 	leaseApplicationsRowsExpectAutoSet   = strings.Join(stringx.Remove(leaseApplicationsFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
 	leaseApplicationsRowsWithPlaceHolder = strings.Join(stringx.Remove(leaseApplicationsFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
 
-	cacheLeaseApplicationsIdPrefix            = "cache:leaseApplications:id:"
-	cacheLeaseApplicationsApplicationIdPrefix = "cache:leaseApplications:applicationId:"
+	cacheLeaseApplicationsIdPrefix                   = "cache:leaseApplications:id:"
+	cacheLeaseApplicationsApplicationIdPrefix        = "cache:leaseApplications:applicationId:"
+	cacheLeaseApplicationsUserIdIdempotencyKeyPrefix = "cache:leaseApplications:userId:idempotencyKey:"
 )
 
 type (
@@ -33,6 +34,7 @@ type (
 		Insert(ctx context.Context, data *LeaseApplications) (sql.Result, error)
 		FindOne(ctx context.Context, id uint64) (*LeaseApplications, error)
 		FindOneByApplicationId(ctx context.Context, applicationId string) (*LeaseApplications, error)
+		FindOneByUserIdIdempotencyKey(ctx context.Context, userId uint64, idempotencyKey sql.NullString) (*LeaseApplications, error)
 		Update(ctx context.Context, data *LeaseApplications) error
 		Delete(ctx context.Context, id uint64) error
 	}
@@ -43,27 +45,32 @@ type (
 	}
 
 	LeaseApplications struct {
-		Id              uint64         `db:"id"`               // 申请ID
-		ApplicationId   string         `db:"application_id"`   // 申请编号
-		UserId          uint64         `db:"user_id"`          // 用户ID
-		ApplicantName   string         `db:"applicant_name"`   // 申请人姓名
-		ProductId       uint64         `db:"product_id"`       // 租赁产品ID
-		ProductCode     string         `db:"product_code"`     // 产品编码
-		Name            string         `db:"name"`             // 申请名称
-		Type            string         `db:"type"`             // 租赁类型
-		Machinery       string         `db:"machinery"`        // 设备名称
-		StartDate       time.Time      `db:"start_date"`       // 开始日期
-		EndDate         time.Time      `db:"end_date"`         // 结束日期
-		Duration        uint64         `db:"duration"`         // 租期(天)
-		DailyRate       float64        `db:"daily_rate"`       // 日租金
-		TotalAmount     float64        `db:"total_amount"`     // 总金额
-		Deposit         float64        `db:"deposit"`          // 押金
-		DeliveryAddress string         `db:"delivery_address"` // 交付地址
-		ContactPhone    string         `db:"contact_phone"`    // 联系电话
-		Purpose         sql.NullString `db:"purpose"`          // 使用目的
-		Status          string         `db:"status"`           // 状态 pending/approved/rejected/cancelled
-		CreatedAt       time.Time      `db:"created_at"`       // 创建时间
-		UpdatedAt       time.Time      `db:"updated_at"`       // 更新时间
+		Id               uint64         `db:"id"`                 // 申请ID
+		ApplicationId    string         `db:"application_id"`     // 申请编号
+		UserId           uint64         `db:"user_id"`            // 用户ID
+		ApplicantName    string         `db:"applicant_name"`     // 申请人姓名
+		ProductId        uint64         `db:"product_id"`         // 租赁产品ID
+		ProductCode      string         `db:"product_code"`       // 产品编码
+		Name             string         `db:"name"`               // 申请名称
+		Type             string         `db:"type"`               // 租赁类型
+		Machinery        string         `db:"machinery"`          // 设备名称
+		StartDate        time.Time      `db:"start_date"`         // 开始日期
+		EndDate          time.Time      `db:"end_date"`           // 结束日期
+		Duration         uint64         `db:"duration"`           // 租期(天)
+		DailyRate        float64        `db:"daily_rate"`         // 日租金
+		TotalAmount      float64        `db:"total_amount"`       // 总金额
+		Deposit          float64        `db:"deposit"`            // 押金
+		DeliveryAddress  string         `db:"delivery_address"`   // 交付地址
+		ContactPhone     string         `db:"contact_phone"`      // 联系电话
+		Purpose          sql.NullString `db:"purpose"`            // 使用目的
+		ProductSnapshot  sql.NullString `db:"product_snapshot"`   // 产品条款快照(JSON),创建申请时记录日租金、押金、租期等
+		UnitId           uint64         `db:"unit_id"`            // 分配的设备ID,审批通过时由租赁产品服务分配,0表示未分配
+		UnitSerialNumber string         `db:"unit_serial_number"` // 分配的设备序列号
+		Status           string         `db:"status"`             // 状态 pending/approved/rejected/cancelled
+		Version          uint64         `db:"version"`            // 乐观锁版本号
+		IdempotencyKey   sql.NullString `db:"idempotency_key"`    // 幂等键(客户端Idempotency-Key)
+		CreatedAt        time.Time      `db:"created_at"`         // 创建时间
+		UpdatedAt        time.Time      `db:"updated_at"`         // 更新时间
 	}
 )
 
@@ -82,10 +89,11 @@ func (m *defaultLeaseApplicationsModel) Delete(ctx context.Context, id uint64) e
 
 	leaseApplicationsApplicationIdKey := fmt.Sprintf("%s%v", cacheLeaseApplicationsApplicationIdPrefix, data.ApplicationId)
 	leaseApplicationsIdKey := fmt.Sprintf("%s%v", cacheLeaseApplicationsIdPrefix, id)
+	leaseApplicationsUserIdIdempotencyKeyKey := fmt.Sprintf("%s%v:%v", cacheLeaseApplicationsUserIdIdempotencyKeyPrefix, data.UserId, data.IdempotencyKey)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
 		return conn.ExecCtx(ctx, query, id)
-	}, leaseApplicationsApplicationIdKey, leaseApplicationsIdKey)
+	}, leaseApplicationsApplicationIdKey, leaseApplicationsIdKey, leaseApplicationsUserIdIdempotencyKeyKey)
 	return err
 }
 
@@ -126,13 +134,34 @@ func (m *defaultLeaseApplicationsModel) FindOneByApplicationId(ctx context.Conte
 	}
 }
 
+func (m *defaultLeaseApplicationsModel) FindOneByUserIdIdempotencyKey(ctx context.Context, userId uint64, idempotencyKey sql.NullString) (*LeaseApplications, error) {
+	leaseApplicationsUserIdIdempotencyKeyKey := fmt.Sprintf("%s%v:%v", cacheLeaseApplicationsUserIdIdempotencyKeyPrefix, userId, idempotencyKey)
+	var resp LeaseApplications
+	err := m.QueryRowIndexCtx(ctx, &resp, leaseApplicationsUserIdIdempotencyKeyKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `user_id` = ? and `idempotency_key` = ? limit 1", leaseApplicationsRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, userId, idempotencyKey); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
 func (m *defaultLeaseApplicationsModel) Insert(ctx context.Context, data *LeaseApplications) (sql.Result, error) {
 	leaseApplicationsApplicationIdKey := fmt.Sprintf("%s%v", cacheLeaseApplicationsApplicationIdPrefix, data.ApplicationId)
 	leaseApplicationsIdKey := fmt.Sprintf("%s%v", cacheLeaseApplicationsIdPrefix, data.Id)
+	leaseApplicationsUserIdIdempotencyKeyKey := fmt.Sprintf("%s%v:%v", cacheLeaseApplicationsUserIdIdempotencyKeyPrefix, data.UserId, data.IdempotencyKey)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, leaseApplicationsRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.ApplicationId, data.UserId, data.ApplicantName, data.ProductId, data.ProductCode, data.Name, data.Type, data.Machinery, data.StartDate, data.EndDate, data.Duration, data.DailyRate, data.TotalAmount, data.Deposit, data.DeliveryAddress, data.ContactPhone, data.Purpose, data.Status)
-	}, leaseApplicationsApplicationIdKey, leaseApplicationsIdKey)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, leaseApplicationsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.ApplicationId, data.UserId, data.ApplicantName, data.ProductId, data.ProductCode, data.Name, data.Type, data.Machinery, data.StartDate, data.EndDate, data.Duration, data.DailyRate, data.TotalAmount, data.Deposit, data.DeliveryAddress, data.ContactPhone, data.Purpose, data.ProductSnapshot, data.UnitId, data.UnitSerialNumber, data.Status, data.Version, data.IdempotencyKey)
+	}, leaseApplicationsApplicationIdKey, leaseApplicationsIdKey, leaseApplicationsUserIdIdempotencyKeyKey)
 	return ret, err
 }
 
@@ -144,10 +173,11 @@ func (m *defaultLeaseApplicationsModel) Update(ctx context.Context, newData *Lea
 
 	leaseApplicationsApplicationIdKey := fmt.Sprintf("%s%v", cacheLeaseApplicationsApplicationIdPrefix, data.ApplicationId)
 	leaseApplicationsIdKey := fmt.Sprintf("%s%v", cacheLeaseApplicationsIdPrefix, data.Id)
+	leaseApplicationsUserIdIdempotencyKeyKey := fmt.Sprintf("%s%v:%v", cacheLeaseApplicationsUserIdIdempotencyKeyPrefix, data.UserId, data.IdempotencyKey)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, leaseApplicationsRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.ApplicationId, newData.UserId, newData.ApplicantName, newData.ProductId, newData.ProductCode, newData.Name, newData.Type, newData.Machinery, newData.StartDate, newData.EndDate, newData.Duration, newData.DailyRate, newData.TotalAmount, newData.Deposit, newData.DeliveryAddress, newData.ContactPhone, newData.Purpose, newData.Status, newData.Id)
-	}, leaseApplicationsApplicationIdKey, leaseApplicationsIdKey)
+		return conn.ExecCtx(ctx, query, newData.ApplicationId, newData.UserId, newData.ApplicantName, newData.ProductId, newData.ProductCode, newData.Name, newData.Type, newData.Machinery, newData.StartDate, newData.EndDate, newData.Duration, newData.DailyRate, newData.TotalAmount, newData.Deposit, newData.DeliveryAddress, newData.ContactPhone, newData.Purpose, newData.ProductSnapshot, newData.UnitId, newData.UnitSerialNumber, newData.Status, newData.Version, newData.IdempotencyKey, newData.Id)
+	}, leaseApplicationsApplicationIdKey, leaseApplicationsIdKey, leaseApplicationsUserIdIdempotencyKeyKey)
 	return err
 }
 
@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
@@ -17,6 +18,7 @@ type (
 		leaseApprovalsModel
 		// 自定义方法
 		FindByApplicationId(ctx context.Context, applicationId int64) ([]*LeaseApprovals, error)
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *LeaseApprovals) (sql.Result, error)
 	}
 
 	customLeaseApprovalsModel struct {
@@ -43,3 +45,9 @@ func (m *customLeaseApprovalsModel) FindByApplicationId(ctx context.Context, app
 
 	return approvals, nil
 }
+
+// InsertWithSession 在事务中写入审批记录
+func (m *customLeaseApprovalsModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *LeaseApprovals) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, leaseApprovalsRowsExpectAutoSet)
+	return session.ExecCtx(ctx, query, data.ApplicationId, data.AuditorId, data.AuditorName, data.Action, data.Stage, data.StageName, data.AuditorRole, data.Suggestions, data.ApprovedDuration, data.ApprovedAmount, data.ApprovedDeposit)
+}
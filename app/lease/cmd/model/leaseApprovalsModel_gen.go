@@ -46,6 +46,9 @@ type (
 		AuditorId        uint64          `db:"auditor_id"`        // 审核员ID
 		AuditorName      string          `db:"auditor_name"`      // 审核员姓名
 		Action           string          `db:"action"`            // 审批动作 approve/reject
+		Stage            uint64          `db:"stage"`             // 审批环节序号,从1开始
+		StageName        string          `db:"stage_name"`        // 审批环节名称
+		AuditorRole      string          `db:"auditor_role"`      // 审核员角色 admin/operator
 		Suggestions      sql.NullString  `db:"suggestions"`       // 审批意见
 		ApprovedDuration sql.NullInt64   `db:"approved_duration"` // 批准租期(天)
 		ApprovedAmount   sql.NullFloat64 `db:"approved_amount"`   // 批准金额
@@ -90,8 +93,8 @@ func (m *defaultLeaseApprovalsModel) FindOne(ctx context.Context, id uint64) (*L
 func (m *defaultLeaseApprovalsModel) Insert(ctx context.Context, data *LeaseApprovals) (sql.Result, error) {
 	leaseApprovalsIdKey := fmt.Sprintf("%s%v", cacheLeaseApprovalsIdPrefix, data.Id)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?)", m.table, leaseApprovalsRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.ApplicationId, data.AuditorId, data.AuditorName, data.Action, data.Suggestions, data.ApprovedDuration, data.ApprovedAmount, data.ApprovedDeposit)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, leaseApprovalsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.ApplicationId, data.AuditorId, data.AuditorName, data.Action, data.Stage, data.StageName, data.AuditorRole, data.Suggestions, data.ApprovedDuration, data.ApprovedAmount, data.ApprovedDeposit)
 	}, leaseApprovalsIdKey)
 	return ret, err
 }
@@ -100,7 +103,7 @@ func (m *defaultLeaseApprovalsModel) Update(ctx context.Context, data *LeaseAppr
 	leaseApprovalsIdKey := fmt.Sprintf("%s%v", cacheLeaseApprovalsIdPrefix, data.Id)
 	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, leaseApprovalsRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, data.ApplicationId, data.AuditorId, data.AuditorName, data.Action, data.Suggestions, data.ApprovedDuration, data.ApprovedAmount, data.ApprovedDeposit, data.Id)
+		return conn.ExecCtx(ctx, query, data.ApplicationId, data.AuditorId, data.AuditorName, data.Action, data.Stage, data.StageName, data.AuditorRole, data.Suggestions, data.ApprovedDuration, data.ApprovedAmount, data.ApprovedDeposit, data.Id)
 	}, leaseApprovalsIdKey)
 	return err
 }
@@ -0,0 +1,109 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ LeaseInventorySagasModel = (*customLeaseInventorySagasModel)(nil)
+
+type (
+	// LeaseInventorySagasModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customLeaseInventorySagasModel.
+	LeaseInventorySagasModel interface {
+		leaseInventorySagasModel
+		// 自定义方法: 状态均按原状态条件更新,并发的审批、撤销与恢复任务只有一方能推进同一 Saga
+		Transit(ctx context.Context, data *LeaseInventorySagas, from []string, to, lastError string) (bool, error)
+		Restart(ctx context.Context, data *LeaseInventorySagas, from []string, to string) (bool, error)
+		FindStale(ctx context.Context, statuses []string, before time.Time, limit int) ([]*LeaseInventorySagas, error)
+	}
+
+	customLeaseInventorySagasModel struct {
+		*defaultLeaseInventorySagasModel
+	}
+)
+
+// NewLeaseInventorySagasModel returns a model for the database table.
+func NewLeaseInventorySagasModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) LeaseInventorySagasModel {
+	return &customLeaseInventorySagasModel{
+		defaultLeaseInventorySagasModel: newLeaseInventorySagasModel(conn, c, opts...),
+	}
+}
+
+// Transit 仅当 Saga 仍处于 from 中的状态时切换为 to,已被其他操作推进时返回 false
+func (m *customLeaseInventorySagasModel) Transit(ctx context.Context, data *LeaseInventorySagas, from []string, to, lastError string) (bool, error) {
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(from)), ",")
+	args := []any{to, lastError, data.Id}
+	for _, status := range from {
+		args = append(args, status)
+	}
+
+	ok, err := m.execIfMatch(ctx, data, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `status` = ?, `last_error` = ? where `id` = ? and `status` in (%s)", m.table, placeholders)
+		return conn.ExecCtx(ctx, query, args...)
+	})
+	if ok {
+		data.Status, data.LastError = to, lastError
+	}
+	return ok, err
+}
+
+// Restart 已补偿或已释放的 Saga 再次审批时按新的租期重新发起,审批预占次数加1
+func (m *customLeaseInventorySagasModel) Restart(ctx context.Context, data *LeaseInventorySagas, from []string, to string) (bool, error) {
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(from)), ",")
+	args := []any{to, data.ProductCode, data.Quantity, data.StartDate, data.EndDate, data.Id}
+	for _, status := range from {
+		args = append(args, status)
+	}
+
+	ok, err := m.execIfMatch(ctx, data, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `status` = ?, `product_code` = ?, `quantity` = ?, `start_date` = ?, `end_date` = ?, `attempts` = `attempts` + 1, `last_error` = '' where `id` = ? and `status` in (%s)", m.table, placeholders)
+		return conn.ExecCtx(ctx, query, args...)
+	})
+	if ok {
+		data.Status, data.LastError = to, ""
+		data.Attempts++
+	}
+	return ok, err
+}
+
+// FindStale 查询处于指定状态且超过 before 未更新的 Saga,用于恢复中断的预占、补偿与释放
+func (m *customLeaseInventorySagasModel) FindStale(ctx context.Context, statuses []string, before time.Time, limit int) ([]*LeaseInventorySagas, error) {
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(statuses)), ",")
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `status` IN (%s) AND `updated_at` < ? ORDER BY `id` ASC LIMIT ?", leaseInventorySagasRows, m.table, placeholders)
+	args := make([]any, 0, len(statuses)+2)
+	for _, status := range statuses {
+		args = append(args, status)
+	}
+	args = append(args, before, limit)
+
+	var sagas []*LeaseInventorySagas
+	err := m.QueryRowsNoCacheCtx(ctx, &sagas, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return sagas, nil
+}
+
+// execIfMatch 执行条件更新并清理缓存,返回是否命中
+func (m *customLeaseInventorySagasModel) execIfMatch(ctx context.Context, data *LeaseInventorySagas, exec func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error)) (bool, error) {
+	result, err := m.ExecCtx(ctx, exec,
+		fmt.Sprintf("%s%v", cacheLeaseInventorySagasIdPrefix, data.Id),
+		fmt.Sprintf("%s%v", cacheLeaseInventorySagasApplicationIdPrefix, data.ApplicationId),
+	)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
@@ -20,6 +20,18 @@ type Config struct {
 	// Redis 缓存配置
 	CacheConf cache.CacheConf
 
+	// 提交幂等配置 - 幂等键处理结果默认在Redis中保留24小时,过期后由数据库唯一索引兜底
+	Idempotency struct {
+		Expire int `json:",default=86400"`
+	}
+
+	// 库存预占 Saga 恢复任务配置 - 默认每60秒扫描一次超过300秒未推进的 Saga
+	SagaJob struct {
+		Enabled  bool `json:",default=true"`
+		Interval int  `json:",default=60,range=[1:3600]"`
+		Timeout  int  `json:",default=300,range=[10:86400]"`
+	}
+
 	// 其他RPC服务配置
 	LeaseProductRpc zrpc.RpcClientConf
 	AppUserRpc      zrpc.RpcClientConf
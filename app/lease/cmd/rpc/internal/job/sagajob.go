@@ -0,0 +1,69 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"rpc/internal/saga"
+	"rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// sagaBatchSize 每次扫描恢复的 Saga 数量上限
+const sagaBatchSize = 100
+
+// SagaJob 库存预占 Saga 恢复任务
+// 启动时与定时扫描超时未推进的 Saga,按申请状态完成预占、补偿释放或重试释放,服务在审批途中崩溃后由此收尾
+type SagaJob struct {
+	svcCtx *svc.ServiceContext
+	saga   *saga.InventorySaga
+	done   chan struct{}
+}
+
+func NewSagaJob(svcCtx *svc.ServiceContext) *SagaJob {
+	return &SagaJob{
+		svcCtx: svcCtx,
+		saga:   saga.NewInventorySaga(svcCtx),
+		done:   make(chan struct{}),
+	}
+}
+
+// Start 启动任务: 启动时执行一次,之后按配置的间隔执行
+func (j *SagaJob) Start() {
+	if !j.svcCtx.Config.SagaJob.Enabled {
+		logx.Info("库存预占Saga恢复任务未启用")
+		return
+	}
+
+	j.Run(context.Background())
+	ticker := time.NewTicker(time.Duration(j.svcCtx.Config.SagaJob.Interval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			j.Run(context.Background())
+		case <-j.done:
+			return
+		}
+	}
+}
+
+// Stop 停止任务
+func (j *SagaJob) Stop() {
+	close(j.done)
+}
+
+// Run 执行一次 Saga 恢复
+func (j *SagaJob) Run(ctx context.Context) {
+	logger := logx.WithContext(ctx)
+
+	count, err := j.saga.Recover(ctx, time.Duration(j.svcCtx.Config.SagaJob.Timeout)*time.Second, sagaBatchSize)
+	if err != nil {
+		logger.Errorf("查询待恢复的库存预占Saga失败: %v", err)
+		return
+	}
+	if count > 0 {
+		logger.Infof("库存预占Saga恢复任务完成, 处理: %d", count)
+	}
+}
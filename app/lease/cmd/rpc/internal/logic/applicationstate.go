@@ -0,0 +1,105 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"common/statemachine"
+	"model"
+	"rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// leaseApplicationMachine 租赁申请状态机
+// 在通用审批状态机基础上允许撤销租期尚未开始的已批准申请,撤销后释放预占的库存
+var leaseApplicationMachine = statemachine.NewApplicationMachine("租赁申请").
+	Permit(statemachine.EventCancel, "撤销", statemachine.StatusCancelled, statemachine.StatusPending, statemachine.StatusApproved).
+	Guard(statemachine.EventCancel, guardCancelBeforeStart).
+	OnTransition(logTransition)
+
+// guardCancelBeforeStart 已批准的申请仅在租期开始前允许撤销,Payload 为租期开始日期
+func guardCancelBeforeStart(ctx context.Context, t statemachine.Transition) error {
+	if t.From != statemachine.StatusApproved {
+		return nil
+	}
+	if startDate, ok := t.Payload.(time.Time); ok && !time.Now().Before(startDate) {
+		return fmt.Errorf("申请状态错误，租期已于%s开始，不能撤销", startDate.Format(time.DateOnly))
+	}
+	return nil
+}
+
+// logTransition 记录申请状态变更
+func logTransition(ctx context.Context, t statemachine.Transition) {
+	logx.WithContext(ctx).Infof("租赁申请状态变更: %s %s -> %s, 事件: %s", t.Key, t.From, t.To, t.Event)
+}
+
+// fireApplicationEvent 触发申请状态迁移并按版本号持久化
+// persist 为空时使用 UpdateWithVersion 更新申请,非空时由调用方在事务中完成持久化
+func fireApplicationEvent(ctx context.Context, svcCtx *svc.ServiceContext, application *model.LeaseApplications, event string,
+	payload any, persist func(application *model.LeaseApplications) error) error {
+	if persist == nil {
+		persist = func(application *model.LeaseApplications) error {
+			return svcCtx.LeaseApplicationsModel.UpdateWithVersion(ctx, application)
+		}
+	}
+
+	from, version := application.Status, application.Version
+	_, err := leaseApplicationMachine.Fire(ctx, statemachine.Transition{
+		Key:     application.ApplicationId,
+		Event:   event,
+		From:    from,
+		Payload: payload,
+	}, func(t statemachine.Transition) error {
+		application.Status = t.To
+		application.UpdatedAt = time.Now()
+		return persist(application)
+	})
+	if err != nil {
+		application.Status, application.Version = from, version
+		if errors.Is(err, model.ErrVersionConflict) {
+			return errApplicationConflict
+		}
+	}
+	return err
+}
+
+// saveApplicationWithApproval 在同一事务中按版本号更新申请并写入审批记录,事务提交后清理申请缓存
+// approval 为空时仅更新申请
+func saveApplicationWithApproval(ctx context.Context, svcCtx *svc.ServiceContext, approval *model.LeaseApprovals) func(application *model.LeaseApplications) error {
+	return func(application *model.LeaseApplications) error {
+		err := svcCtx.LeaseApplicationsModel.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+			if err := svcCtx.LeaseApplicationsModel.UpdateWithVersionSession(ctx, session, application); err != nil {
+				return err
+			}
+			if approval == nil {
+				return nil
+			}
+			_, err := svcCtx.LeaseApprovalsModel.InsertWithSession(ctx, session, approval)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		return svcCtx.LeaseApplicationsModel.DelApplicationCache(ctx, application)
+	}
+}
+
+// checkApplicationEvent 校验申请当前状态是否允许触发事件,用于执行外部操作前提前拒绝非法迁移
+func checkApplicationEvent(application *model.LeaseApplications, event string) error {
+	_, err := leaseApplicationMachine.Target(application.Status, event)
+	return err
+}
+
+// errApplicationConflict 申请已被并发修改
+var errApplicationConflict = errors.New("申请状态错误，申请已被其他操作修改，请刷新后重试")
+
+// isApplicationStateError 是否为状态机返回的业务错误(非法迁移、前置校验失败或并发冲突)
+func isApplicationStateError(err error) bool {
+	var transitionErr *statemachine.TransitionError
+	return errors.As(err, &transitionErr) || errors.Is(err, errApplicationConflict)
+}
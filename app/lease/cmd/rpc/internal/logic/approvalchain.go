@@ -0,0 +1,42 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"common/approval"
+	"leaseproductrpc/leaseproductservice"
+	"model"
+	"rpc/internal/breaker"
+	"rpc/internal/svc"
+)
+
+// loadApprovalChain 查询产品配置的审批链
+func loadApprovalChain(ctx context.Context, svcCtx *svc.ServiceContext, productCode string) (approval.Chain, error) {
+	productResp, err := breaker.DoWithBreakerResultAcceptable(ctx, "leaseproduct-rpc", func() (*leaseproductservice.GetLeaseProductResp, error) {
+		return svcCtx.LeaseProductClient.GetLeaseProduct(ctx, &leaseproductservice.GetLeaseProductReq{
+			ProductCode: productCode,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		return approval.Chain{}, fmt.Errorf("查询产品审批链失败: %v", err)
+	}
+	if productResp.Data == nil {
+		return approval.Chain{}, fmt.Errorf("产品不存在")
+	}
+
+	return approval.Parse(productResp.Data.ApprovalChain)
+}
+
+// approvalRecords 将审批记录转换为审批链已完成环节
+func approvalRecords(approvals []*model.LeaseApprovals) []approval.Record {
+	records := make([]approval.Record, 0, len(approvals))
+	for _, item := range approvals {
+		records = append(records, approval.Record{
+			Stage:     int(item.Stage),
+			Action:    item.Action,
+			AuditorId: int64(item.AuditorId),
+		})
+	}
+	return records
+}
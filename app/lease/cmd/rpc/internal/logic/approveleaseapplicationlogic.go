@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"time"
 
+	"common/statemachine"
 	"model"
+	"rpc/internal/saga"
 	"rpc/internal/svc"
 	"rpc/lease"
 
@@ -42,33 +44,38 @@ func (l *ApproveLeaseApplicationLogic) ApproveLeaseApplication(in *lease.Approve
 	}
 
 	// 检查申请状态是否可以审批
-	if application.Status != "pending" {
-		return nil, fmt.Errorf("申请状态不允许审批")
+	if err := checkApplicationEvent(application, statemachine.EventReview); err != nil {
+		return nil, err
 	}
 
-	// 开始事务处理
-	// 1. 更新申请状态
-	now := time.Now()
-	newStatus := "approved"
-	if in.Action == "reject" {
-		newStatus = "rejected"
+	// 按产品审批链确定本次审批环节
+	chain, err := loadApprovalChain(l.ctx, l.svcCtx, application.ProductCode)
+	if err != nil {
+		l.Errorf("加载审批链失败: %v", err)
+		return nil, err
 	}
 
-	application.Status = newStatus
-	application.UpdatedAt = now
+	approvals, err := l.svcCtx.LeaseApprovalsModel.FindByApplicationId(l.ctx, int64(application.Id))
+	if err != nil {
+		l.Errorf("查询审批记录失败: %v", err)
+		return nil, fmt.Errorf("查询审批记录失败")
+	}
 
-	err = l.svcCtx.LeaseApplicationsModel.Update(l.ctx, application)
+	decision, err := chain.Next(application.TotalAmount, approvalRecords(approvals), in.Action, in.AuditorRole, in.AuditorId)
 	if err != nil {
-		l.Errorf("更新申请状态失败: %v", err)
-		return nil, fmt.Errorf("审批失败")
+		return nil, err
 	}
 
-	// 2. 创建审批记录
+	// 1. 组装审批记录
+	now := time.Now()
 	approval := &model.LeaseApprovals{
 		ApplicationId:    application.Id,
 		AuditorId:        uint64(in.AuditorId),
 		AuditorName:      in.AuditorName,
 		Action:           in.Action,
+		Stage:            uint64(decision.Stage),
+		StageName:        decision.StepName,
+		AuditorRole:      in.AuditorRole,
 		Suggestions:      sql.NullString{String: in.Suggestions, Valid: in.Suggestions != ""},
 		ApprovedDuration: sql.NullInt64{Int64: int64(in.ApprovedDuration), Valid: in.Action == "approve"},
 		ApprovedAmount:   sql.NullFloat64{Float64: in.ApprovedAmount, Valid: in.Action == "approve"},
@@ -76,16 +83,52 @@ func (l *ApproveLeaseApplicationLogic) ApproveLeaseApplication(in *lease.Approve
 		CreatedAt:        now,
 	}
 
-	_, err = l.svcCtx.LeaseApprovalsModel.Insert(l.ctx, approval)
-	if err != nil {
-		l.Errorf("创建审批记录失败: %v", err)
-		return nil, fmt.Errorf("审批记录创建失败")
+	// 2. 审批链结束时迁移申请状态,未结束时申请保持pending等待下一环节
+	// 每个环节均按版本号更新申请并在同一事务中写入审批记录,防止同一环节被并发审批或审批无记录
+	event := statemachine.EventReview
+	if decision.Finished {
+		event = statemachine.EventReject
+		if decision.Status == statemachine.StatusApproved {
+			event = statemachine.EventApprove
+		}
 	}
 
-	// TODO: 如果是批准，可能需要调用其他服务执行后续操作
-	// 比如更新库存、发送通知等
+	// 3. 最终批准前通过 Saga 预占租期内的库存并分配设备,申请状态迁移失败时释放预占
+	inventorySaga := saga.NewInventorySaga(l.svcCtx)
+	var reservation *model.LeaseInventorySagas
+	if event == statemachine.EventApprove {
+		reservation, err = inventorySaga.Reserve(l.ctx, application)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	return &lease.ApproveLeaseApplicationResp{}, nil
+	if err := fireApplicationEvent(l.ctx, l.svcCtx, application, event, nil, saveApplicationWithApproval(l.ctx, l.svcCtx, approval)); err != nil {
+		l.Errorf("更新申请状态失败: %v", err)
+		if reservation != nil {
+			inventorySaga.Compensate(l.ctx, reservation, err.Error())
+		}
+		if isApplicationStateError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("审批失败")
+	}
+
+	// 4. 批准后预占生效,拒绝时释放此前审批可能遗留的预占
+	switch event {
+	case statemachine.EventApprove:
+		inventorySaga.Complete(l.ctx, reservation)
+	case statemachine.EventReject:
+		inventorySaga.Release(l.ctx, application.ApplicationId)
+	}
+
+	return &lease.ApproveLeaseApplicationResp{
+		Stage:      int32(decision.Stage),
+		StageName:  decision.StepName,
+		TotalStage: int32(decision.TotalStage),
+		Finished:   decision.Finished,
+		Status:     application.Status,
+	}, nil
 }
 
 // validateApproveRequest 验证审批请求参数
@@ -102,6 +145,9 @@ func (l *ApproveLeaseApplicationLogic) validateApproveRequest(in *lease.ApproveL
 	if in.Action != "approve" && in.Action != "reject" {
 		return fmt.Errorf("审批动作必须为approve或reject")
 	}
+	if in.AuditorRole != "" && in.AuditorRole != "admin" && in.AuditorRole != "operator" {
+		return fmt.Errorf("审核员角色必须为admin或operator")
+	}
 	if in.Action == "approve" {
 		if in.ApprovedDuration <= 0 {
 			return fmt.Errorf("批准租期必须大于0")
@@ -3,8 +3,9 @@ package logic
 import (
 	"context"
 	"fmt"
-	"time"
 
+	"common/statemachine"
+	"rpc/internal/saga"
 	"rpc/internal/svc"
 	"rpc/lease"
 
@@ -38,27 +39,21 @@ func (l *CancelLeaseApplicationLogic) CancelLeaseApplication(in *lease.CancelLea
 		return nil, fmt.Errorf("申请不存在")
 	}
 
-	// 检查申请状态是否可以撤销
-	if application.Status != "pending" {
-		return nil, fmt.Errorf("只有待审批状态的申请才可以撤销")
-	}
-
-	// 更新申请状态为已撤销
-	now := time.Now()
-	application.Status = "cancelled"
-	application.UpdatedAt = now
-
-	err = l.svcCtx.LeaseApplicationsModel.Update(l.ctx, application)
+	// 按状态机将申请迁移为已撤销,已批准的申请需租期尚未开始
+	err = fireApplicationEvent(l.ctx, l.svcCtx, application, statemachine.EventCancel, application.StartDate, saveApplicationWithApproval(l.ctx, l.svcCtx, nil))
 	if err != nil {
 		l.Errorf("撤销申请失败: %v", err)
+		if isApplicationStateError(err) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("撤销申请失败")
 	}
 
 	// 记录撤销原因 (可以考虑在future增加撤销原因字段到数据库)
 	l.Infof("租赁申请已撤销 - 申请编号: %s, 撤销原因: %s", in.ApplicationId, in.Reason)
 
-	// TODO: 可能需要调用其他服务进行后续处理
-	// 比如释放库存预占、发送通知等
+	// 释放审批时预占的库存,释放失败时由 Saga 恢复任务重试
+	saga.NewInventorySaga(l.svcCtx).Release(l.ctx, application.ApplicationId)
 
 	return &lease.CancelLeaseApplicationResp{}, nil
 }
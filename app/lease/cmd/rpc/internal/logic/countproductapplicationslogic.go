@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"rpc/internal/svc"
+	"rpc/lease"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// productActiveStatuses 引用产品的进行中申请状态,已批准的租赁仍在履约
+const productActiveStatuses = "'pending','approved'"
+
+type CountProductApplicationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCountProductApplicationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CountProductApplicationsLogic {
+	return &CountProductApplicationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 产品引用检查
+func (l *CountProductApplicationsLogic) CountProductApplications(in *lease.CountProductApplicationsReq) (*lease.CountProductApplicationsResp, error) {
+	// 参数验证
+	if in.ProductId <= 0 {
+		return nil, fmt.Errorf("产品ID不能为空")
+	}
+
+	total, err := l.svcCtx.LeaseApplicationsModel.CountWithConditions(l.ctx, "WHERE product_id = ?", []interface{}{in.ProductId})
+	if err != nil {
+		l.Errorf("统计产品申请失败: %v", err)
+		return nil, fmt.Errorf("统计产品申请失败")
+	}
+
+	active, err := l.svcCtx.LeaseApplicationsModel.CountWithConditions(l.ctx,
+		"WHERE product_id = ? AND status IN ("+productActiveStatuses+")", []interface{}{in.ProductId})
+	if err != nil {
+		l.Errorf("统计产品进行中申请失败: %v", err)
+		return nil, fmt.Errorf("统计产品申请失败")
+	}
+
+	return &lease.CountProductApplicationsResp{
+		Active: active,
+		Total:  total,
+	}, nil
+}
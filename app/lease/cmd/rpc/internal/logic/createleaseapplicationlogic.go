@@ -3,13 +3,18 @@ package logic
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"appuserrpc/appuserclient"
+	"common/eligibility"
 	"leaseproductrpc/leaseproductservice"
 	"model"
 	"rpc/internal/breaker"
+	"rpc/internal/pkg/idempotency"
 	"rpc/internal/svc"
 	"rpc/lease"
 
@@ -38,6 +43,48 @@ func (l *CreateLeaseApplicationLogic) CreateLeaseApplication(in *lease.CreateLea
 		return nil, err
 	}
 
+	if in.IdempotencyKey == "" {
+		return l.createApplication(in)
+	}
+
+	// 幂等校验: 相同幂等键的重复提交直接返回首次创建的申请编号
+	applicationId, err := l.findIdempotentApplication(in.UserId, in.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	if applicationId != "" {
+		l.Infof("幂等键重复提交，返回已创建的申请 - 用户ID: %d, 申请编号: %s", in.UserId, applicationId)
+		return &lease.CreateLeaseApplicationResp{
+			ApplicationId: applicationId,
+		}, nil
+	}
+
+	// 处理期间占用幂等键,Redis不可用时由数据库唯一索引保证幂等
+	acquired, err := l.svcCtx.Idempotency.Acquire(l.ctx, in.UserId, in.IdempotencyKey)
+	if err != nil {
+		l.Errorf("占用幂等键失败: %v", err)
+	} else if !acquired {
+		return nil, idempotency.ErrProcessing
+	}
+
+	resp, err := l.createApplication(in)
+	if err != nil {
+		if acquired {
+			if err := l.svcCtx.Idempotency.Release(l.ctx, in.UserId, in.IdempotencyKey); err != nil {
+				l.Errorf("释放幂等键失败: %v", err)
+			}
+		}
+		return nil, err
+	}
+
+	if err := l.svcCtx.Idempotency.Save(l.ctx, in.UserId, in.IdempotencyKey, resp.ApplicationId); err != nil {
+		l.Errorf("记录幂等键失败: %v", err)
+	}
+	return resp, nil
+}
+
+// createApplication 校验用户与产品并创建申请
+func (l *CreateLeaseApplicationLogic) createApplication(in *lease.CreateLeaseApplicationReq) (*lease.CreateLeaseApplicationResp, error) {
 	// 1. 使用熔断器调用AppUser RPC验证用户信息并获取用户姓名
 	userResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "appuser-rpc", func() (*appuserclient.GetUserInfoResp, error) {
 		return l.svcCtx.AppUserClient.GetUserById(l.ctx, &appuserclient.GetUserByIdReq{
@@ -56,7 +103,31 @@ func (l *CreateLeaseApplicationLogic) CreateLeaseApplication(in *lease.CreateLea
 
 	applicantName := userResp.UserInfo.Name
 
-	// 2. 使用熔断器调用LeaseProduct RPC检查库存和验证产品信息
+	// 2. 查询产品条款并记录快照,产品后续修改不影响本次申请
+	productResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "leaseproduct-rpc", func() (*leaseproductservice.GetLeaseProductResp, error) {
+		return l.svcCtx.LeaseProductClient.GetLeaseProduct(l.ctx, &leaseproductservice.GetLeaseProductReq{
+			ProductCode: in.ProductCode,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用LeaseProduct服务失败: %v", err)
+		if breaker.IsAcceptableError(err) {
+			return nil, fmt.Errorf("产品不存在或已删除")
+		}
+		return nil, fmt.Errorf("产品信息验证失败，请稍后重试")
+	}
+	if productResp.Data == nil {
+		return nil, fmt.Errorf("产品不存在")
+	}
+	// 已下架的产品不再受理新申请
+	if productResp.Data.Status != 1 {
+		return nil, fmt.Errorf("状态错误，产品已下架，暂不受理申请")
+	}
+	if productResp.Data.Id != in.ProductId {
+		return nil, fmt.Errorf("参数错误，产品ID与产品编码不匹配")
+	}
+
+	// 3. 使用熔断器调用LeaseProduct RPC检查库存
 	stockResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "leaseproduct-rpc", func() (*leaseproductservice.CheckInventoryAvailabilityResp, error) {
 		return l.svcCtx.LeaseProductClient.CheckInventoryAvailability(l.ctx, &leaseproductservice.CheckInventoryAvailabilityReq{
 			ProductCode: in.ProductCode,
@@ -75,13 +146,57 @@ func (l *CreateLeaseApplicationLogic) CreateLeaseApplication(in *lease.CreateLea
 		return nil, fmt.Errorf("产品库存不足或时间段不可用")
 	}
 
-	// 3. 生成申请ID
+	// 4. 按产品服务报价校验租期与金额,客户端提交的价格与报价不一致时拒绝
+	quote, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "leaseproduct-rpc", func() (*leaseproductservice.QuoteLeaseResp, error) {
+		return l.svcCtx.LeaseProductClient.QuoteLease(l.ctx, &leaseproductservice.QuoteLeaseReq{
+			ProductCode: in.ProductCode,
+			StartDate:   in.StartDate,
+			EndDate:     in.EndDate,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用LeaseProduct服务报价失败: %v", err)
+		return nil, fmt.Errorf("租赁报价失败，请稍后重试")
+	}
+	if err := checkQuotedPrice(in, quote); err != nil {
+		l.Infof("租赁申请价格与报价不一致 - 用户ID: %d, 产品编码: %s, %v", in.UserId, in.ProductCode, err)
+		return nil, err
+	}
+
+	now := time.Now()
+	snapshot, err := encodeProductSnapshot(productResp.Data, now)
+	if err != nil {
+		l.Errorf("记录产品条款快照失败: %v", err)
+		return nil, fmt.Errorf("创建申请失败，请稍后重试")
+	}
+
+	// 5. 校验产品准入规则: 职业、年龄区间与最低月收入
+	rule, err := eligibility.Parse(productResp.Data.EligibilityRule)
+	if err != nil {
+		l.Errorf("解析产品准入规则失败: %v", err)
+		return nil, fmt.Errorf("创建申请失败，请稍后重试")
+	}
+	reasons := rule.Check(eligibility.Applicant{
+		Age:        int(userResp.UserInfo.Age),
+		Occupation: userResp.UserInfo.Occupation,
+		Income:     userResp.UserInfo.Income,
+	})
+	if len(reasons) > 0 {
+		messages := make([]string, 0, len(reasons))
+		for _, reason := range reasons {
+			messages = append(messages, reason.Message)
+		}
+		l.Infof("租赁申请未通过产品准入规则 - 用户ID: %d, 产品编码: %s, 原因数: %d", in.UserId, in.ProductCode, len(reasons))
+		return nil, fmt.Errorf("不符合申请条件，%s", strings.Join(messages, "；"))
+	}
+
+	// 6. 生成申请ID
 	applicationId := l.generateApplicationId()
 
-	// 4. 创建租赁申请记录
+	// 7. 创建租赁申请记录,租期与金额以报价为准
 	startDate, _ := time.Parse("2006-01-02", in.StartDate)
 	endDate, _ := time.Parse("2006-01-02", in.EndDate)
-	
+
 	application := &model.LeaseApplications{
 		ApplicationId:   applicationId,
 		UserId:          uint64(in.UserId),
@@ -93,20 +208,30 @@ func (l *CreateLeaseApplicationLogic) CreateLeaseApplication(in *lease.CreateLea
 		Machinery:       in.Machinery,
 		StartDate:       startDate,
 		EndDate:         endDate,
-		Duration:        uint64(in.Duration),
-		DailyRate:       in.DailyRate,
-		TotalAmount:     in.TotalAmount,
-		Deposit:         in.Deposit,
+		Duration:        uint64(quote.Duration),
+		DailyRate:       quote.DailyRate,
+		TotalAmount:     quote.TotalAmount,
+		Deposit:         quote.Deposit,
 		DeliveryAddress: in.DeliveryAddress,
 		ContactPhone:    in.ContactPhone,
 		Purpose:         sql.NullString{String: in.Purpose, Valid: in.Purpose != ""},
+		ProductSnapshot: snapshot,
 		Status:          "pending", // 待审核
-		CreatedAt:       time.Now(),
-		UpdatedAt:       time.Now(),
+		IdempotencyKey:  sql.NullString{String: in.IdempotencyKey, Valid: in.IdempotencyKey != ""},
+		CreatedAt:       now,
+		UpdatedAt:       now,
 	}
 
 	_, err = l.svcCtx.LeaseApplicationsModel.Insert(l.ctx, application)
 	if err != nil {
+		// 并发提交相同幂等键时唯一索引冲突,返回先创建的申请
+		if in.IdempotencyKey != "" {
+			if existing, findErr := l.svcCtx.LeaseApplicationsModel.FindOneByUserIdIdempotencyKey(l.ctx, uint64(in.UserId), application.IdempotencyKey); findErr == nil {
+				return &lease.CreateLeaseApplicationResp{
+					ApplicationId: existing.ApplicationId,
+				}, nil
+			}
+		}
 		l.Errorf("创建租赁申请失败: %v", err)
 		return nil, fmt.Errorf("创建申请失败，请稍后重试")
 	}
@@ -121,6 +246,9 @@ func (l *CreateLeaseApplicationLogic) validateCreateRequest(in *lease.CreateLeas
 	if in.UserId <= 0 {
 		return fmt.Errorf("用户ID无效")
 	}
+	if len(in.IdempotencyKey) > idempotency.MaxKeyLength {
+		return fmt.Errorf("参数错误，幂等键长度不能超过%d个字符", idempotency.MaxKeyLength)
+	}
 	if in.ProductId <= 0 {
 		return fmt.Errorf("产品ID无效")
 	}
@@ -151,6 +279,21 @@ func (l *CreateLeaseApplicationLogic) validateCreateRequest(in *lease.CreateLeas
 	return nil
 }
 
+// checkQuotedPrice 校验客户端提交的租期、日租金、租金总额与押金是否与报价一致,金额允许分以内的误差
+func checkQuotedPrice(in *lease.CreateLeaseApplicationReq, quote *leaseproductservice.QuoteLeaseResp) error {
+	switch {
+	case in.Duration != quote.Duration:
+		return fmt.Errorf("参数错误，租期应为%d天", quote.Duration)
+	case math.Abs(in.DailyRate-quote.DailyRate) >= 0.005:
+		return fmt.Errorf("参数错误，日租金应为%.2f元", quote.DailyRate)
+	case math.Abs(in.TotalAmount-quote.TotalAmount) >= 0.005:
+		return fmt.Errorf("参数错误，租金总额应为%.2f元", quote.TotalAmount)
+	case math.Abs(in.Deposit-quote.Deposit) >= 0.005:
+		return fmt.Errorf("参数错误，押金应为%.2f元", quote.Deposit)
+	}
+	return nil
+}
+
 // 生成申请ID
 func (l *CreateLeaseApplicationLogic) generateApplicationId() string {
 	// 生成格式：LEASE + 年月日 + 6位随机数
@@ -159,3 +302,28 @@ func (l *CreateLeaseApplicationLogic) generateApplicationId() string {
 	randomStr := stringx.Randn(6)
 	return fmt.Sprintf("LEASE%s%s", dateStr, randomStr)
 }
+
+// findIdempotentApplication 查询幂等键对应的申请编号,未提交过时返回空字符串
+func (l *CreateLeaseApplicationLogic) findIdempotentApplication(userId int64, key string) (string, error) {
+	applicationId, err := l.svcCtx.Idempotency.Get(l.ctx, userId, key)
+	if errors.Is(err, idempotency.ErrProcessing) {
+		return "", err
+	}
+	if err != nil {
+		l.Errorf("查询幂等键失败: %v", err)
+	}
+	if applicationId != "" {
+		return applicationId, nil
+	}
+
+	// Redis未命中(结果过期或Redis不可用)时以数据库为准
+	application, err := l.svcCtx.LeaseApplicationsModel.FindOneByUserIdIdempotencyKey(l.ctx, uint64(userId), sql.NullString{String: key, Valid: true})
+	if err == model.ErrNotFound {
+		return "", nil
+	}
+	if err != nil {
+		l.Errorf("查询幂等申请失败: %v", err)
+		return "", fmt.Errorf("创建申请失败，请稍后重试")
+	}
+	return application.ApplicationId, nil
+}
@@ -37,30 +37,39 @@ func (l *GetLeaseApplicationLogic) GetLeaseApplication(in *lease.GetLeaseApplica
 		return nil, fmt.Errorf("申请不存在")
 	}
 
+	// 解析申请时的产品条款快照
+	snapshot, err := decodeProductSnapshot(application)
+	if err != nil {
+		l.Errorf("解析产品条款快照失败: %v", err)
+	}
+
 	// 转换为响应格式
 	return &lease.GetLeaseApplicationResp{
 		ApplicationInfo: &lease.LeaseApplicationInfo{
-			Id:              int64(application.Id),
-			ApplicationId:   application.ApplicationId,
-			UserId:          int64(application.UserId),
-			ApplicantName:   application.ApplicantName,
-			ProductId:       int64(application.ProductId),
-			ProductCode:     application.ProductCode,
-			Name:            application.Name,
-			Type:            application.Type,
-			Machinery:       application.Machinery,
-			StartDate:       application.StartDate.Format("2006-01-02"),
-			EndDate:         application.EndDate.Format("2006-01-02"),
-			Duration:        int32(application.Duration),
-			DailyRate:       application.DailyRate,
-			TotalAmount:     application.TotalAmount,
-			Deposit:         application.Deposit,
-			DeliveryAddress: application.DeliveryAddress,
-			ContactPhone:    application.ContactPhone,
-			Purpose:         application.Purpose.String,
-			Status:          application.Status,
-			CreatedAt:       application.CreatedAt.Unix(),
-			UpdatedAt:       application.UpdatedAt.Unix(),
+			Id:               int64(application.Id),
+			ApplicationId:    application.ApplicationId,
+			UserId:           int64(application.UserId),
+			ApplicantName:    application.ApplicantName,
+			ProductId:        int64(application.ProductId),
+			ProductCode:      application.ProductCode,
+			Name:             application.Name,
+			Type:             application.Type,
+			Machinery:        application.Machinery,
+			StartDate:        application.StartDate.Format("2006-01-02"),
+			EndDate:          application.EndDate.Format("2006-01-02"),
+			Duration:         int32(application.Duration),
+			DailyRate:        application.DailyRate,
+			TotalAmount:      application.TotalAmount,
+			Deposit:          application.Deposit,
+			DeliveryAddress:  application.DeliveryAddress,
+			ContactPhone:     application.ContactPhone,
+			Purpose:          application.Purpose.String,
+			Status:           application.Status,
+			CreatedAt:        application.CreatedAt.Unix(),
+			UpdatedAt:        application.UpdatedAt.Unix(),
+			UnitId:           int64(application.UnitId),
+			UnitSerialNumber: application.UnitSerialNumber,
 		},
+		ProductSnapshot: snapshot,
 	}, nil
 }
@@ -83,27 +83,29 @@ func (l *ListLeaseApplicationsLogic) ListLeaseApplications(in *lease.ListLeaseAp
 	var applicationList []*lease.LeaseApplicationInfo
 	for _, app := range applications {
 		applicationList = append(applicationList, &lease.LeaseApplicationInfo{
-			Id:              int64(app.Id),
-			ApplicationId:   app.ApplicationId,
-			UserId:          int64(app.UserId),
-			ApplicantName:   app.ApplicantName,
-			ProductId:       int64(app.ProductId),
-			ProductCode:     app.ProductCode,
-			Name:            app.Name,
-			Type:            app.Type,
-			Machinery:       app.Machinery,
-			StartDate:       app.StartDate.Format("2006-01-02"),
-			EndDate:         app.EndDate.Format("2006-01-02"),
-			Duration:        int32(app.Duration),
-			DailyRate:       app.DailyRate,
-			TotalAmount:     app.TotalAmount,
-			Deposit:         app.Deposit,
-			DeliveryAddress: app.DeliveryAddress,
-			ContactPhone:    app.ContactPhone,
-			Purpose:         app.Purpose.String,
-			Status:          app.Status,
-			CreatedAt:       app.CreatedAt.Unix(),
-			UpdatedAt:       app.UpdatedAt.Unix(),
+			Id:               int64(app.Id),
+			ApplicationId:    app.ApplicationId,
+			UserId:           int64(app.UserId),
+			ApplicantName:    app.ApplicantName,
+			ProductId:        int64(app.ProductId),
+			ProductCode:      app.ProductCode,
+			Name:             app.Name,
+			Type:             app.Type,
+			Machinery:        app.Machinery,
+			StartDate:        app.StartDate.Format("2006-01-02"),
+			EndDate:          app.EndDate.Format("2006-01-02"),
+			Duration:         int32(app.Duration),
+			DailyRate:        app.DailyRate,
+			TotalAmount:      app.TotalAmount,
+			Deposit:          app.Deposit,
+			DeliveryAddress:  app.DeliveryAddress,
+			ContactPhone:     app.ContactPhone,
+			Purpose:          app.Purpose.String,
+			Status:           app.Status,
+			CreatedAt:        app.CreatedAt.Unix(),
+			UpdatedAt:        app.UpdatedAt.Unix(),
+			UnitId:           int64(app.UnitId),
+			UnitSerialNumber: app.UnitSerialNumber,
 		})
 	}
 
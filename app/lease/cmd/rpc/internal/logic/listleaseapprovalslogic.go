@@ -58,6 +58,9 @@ func (l *ListLeaseApprovalsLogic) ListLeaseApprovals(in *lease.ListLeaseApproval
 			ApprovedAmount:   approval.ApprovedAmount.Float64,
 			ApprovedDeposit:  approval.ApprovedDeposit.Float64,
 			CreatedAt:        approval.CreatedAt.Unix(),
+			Stage:            int32(approval.Stage),
+			StageName:        approval.StageName,
+			AuditorRole:      approval.AuditorRole,
 		}
 		approvalList = append(approvalList, approvalInfo)
 	}
@@ -0,0 +1,75 @@
+package logic
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"leaseproductrpc/leaseproductservice"
+	"model"
+	"rpc/lease"
+)
+
+// productSnapshot 申请创建时的产品条款快照,产品后续修改不影响已提交的申请
+type productSnapshot struct {
+	ProductCode      string  `json:"product_code"`
+	Name             string  `json:"name"`
+	Type             string  `json:"type"`
+	Machinery        string  `json:"machinery"`
+	Brand            string  `json:"brand"`
+	Model            string  `json:"model"`
+	DailyRate        float64 `json:"daily_rate"`
+	Deposit          float64 `json:"deposit"`
+	MinDuration      int32   `json:"min_duration"`
+	MaxDuration      int32   `json:"max_duration"`
+	ProductUpdatedAt int64   `json:"product_updated_at"`
+	SnapshotAt       int64   `json:"snapshot_at"`
+}
+
+// encodeProductSnapshot 记录产品当前条款
+func encodeProductSnapshot(product *leaseproductservice.LeaseProductInfo, now time.Time) (sql.NullString, error) {
+	data, err := json.Marshal(productSnapshot{
+		ProductCode:      product.ProductCode,
+		Name:             product.Name,
+		Type:             product.Type,
+		Machinery:        product.Machinery,
+		Brand:            product.Brand,
+		Model:            product.Model,
+		DailyRate:        product.DailyRate,
+		Deposit:          product.Deposit,
+		MinDuration:      product.MinDuration,
+		MaxDuration:      product.MaxDuration,
+		ProductUpdatedAt: product.UpdatedAt,
+		SnapshotAt:       now.Unix(),
+	})
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// decodeProductSnapshot 解析申请的产品条款快照,历史申请无快照时返回空
+func decodeProductSnapshot(application *model.LeaseApplications) (*lease.LeaseProductSnapshot, error) {
+	if !application.ProductSnapshot.Valid || application.ProductSnapshot.String == "" {
+		return nil, nil
+	}
+
+	var snapshot productSnapshot
+	if err := json.Unmarshal([]byte(application.ProductSnapshot.String), &snapshot); err != nil {
+		return nil, err
+	}
+	return &lease.LeaseProductSnapshot{
+		ProductCode:      snapshot.ProductCode,
+		Name:             snapshot.Name,
+		Type:             snapshot.Type,
+		Machinery:        snapshot.Machinery,
+		Brand:            snapshot.Brand,
+		Model:            snapshot.Model,
+		DailyRate:        snapshot.DailyRate,
+		Deposit:          snapshot.Deposit,
+		MinDuration:      snapshot.MinDuration,
+		MaxDuration:      snapshot.MaxDuration,
+		ProductUpdatedAt: snapshot.ProductUpdatedAt,
+		SnapshotAt:       snapshot.SnapshotAt,
+	}, nil
+}
@@ -3,7 +3,8 @@ package logic
 import (
 	"context"
 	"fmt"
-	"time"
+
+	"common/statemachine"
 
 	"rpc/internal/svc"
 	"rpc/lease"
@@ -39,12 +40,11 @@ func (l *UpdateLeaseApplicationLogic) UpdateLeaseApplication(in *lease.UpdateLea
 	}
 
 	// 检查申请状态是否可以修改
-	if application.Status != "pending" {
-		return nil, fmt.Errorf("只有待审批状态的申请才可以修改")
+	if err := checkApplicationEvent(application, statemachine.EventUpdate); err != nil {
+		return nil, err
 	}
 
 	// 更新允许修改的字段
-	now := time.Now()
 	if in.Purpose != "" {
 		application.Purpose.String = in.Purpose
 		application.Purpose.Valid = true
@@ -55,12 +55,14 @@ func (l *UpdateLeaseApplicationLogic) UpdateLeaseApplication(in *lease.UpdateLea
 	if in.ContactPhone != "" {
 		application.ContactPhone = in.ContactPhone
 	}
-	application.UpdatedAt = now
 
 	// 执行更新
-	err = l.svcCtx.LeaseApplicationsModel.Update(l.ctx, application)
+	err = fireApplicationEvent(l.ctx, l.svcCtx, application, statemachine.EventUpdate, nil, saveApplicationWithApproval(l.ctx, l.svcCtx, nil))
 	if err != nil {
 		l.Errorf("更新申请失败: %v", err)
+		if isApplicationStateError(err) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("更新申请失败")
 	}
 
@@ -74,27 +76,29 @@ func (l *UpdateLeaseApplicationLogic) UpdateLeaseApplication(in *lease.UpdateLea
 	// 转换为响应格式
 	return &lease.UpdateLeaseApplicationResp{
 		ApplicationInfo: &lease.LeaseApplicationInfo{
-			Id:              int64(updatedApplication.Id),
-			ApplicationId:   updatedApplication.ApplicationId,
-			UserId:          int64(updatedApplication.UserId),
-			ApplicantName:   updatedApplication.ApplicantName,
-			ProductId:       int64(updatedApplication.ProductId),
-			ProductCode:     updatedApplication.ProductCode,
-			Name:            updatedApplication.Name,
-			Type:            updatedApplication.Type,
-			Machinery:       updatedApplication.Machinery,
-			StartDate:       updatedApplication.StartDate.Format("2006-01-02"),
-			EndDate:         updatedApplication.EndDate.Format("2006-01-02"),
-			Duration:        int32(updatedApplication.Duration),
-			DailyRate:       updatedApplication.DailyRate,
-			TotalAmount:     updatedApplication.TotalAmount,
-			Deposit:         updatedApplication.Deposit,
-			DeliveryAddress: updatedApplication.DeliveryAddress,
-			ContactPhone:    updatedApplication.ContactPhone,
-			Purpose:         updatedApplication.Purpose.String,
-			Status:          updatedApplication.Status,
-			CreatedAt:       updatedApplication.CreatedAt.Unix(),
-			UpdatedAt:       updatedApplication.UpdatedAt.Unix(),
+			Id:               int64(updatedApplication.Id),
+			ApplicationId:    updatedApplication.ApplicationId,
+			UserId:           int64(updatedApplication.UserId),
+			ApplicantName:    updatedApplication.ApplicantName,
+			ProductId:        int64(updatedApplication.ProductId),
+			ProductCode:      updatedApplication.ProductCode,
+			Name:             updatedApplication.Name,
+			Type:             updatedApplication.Type,
+			Machinery:        updatedApplication.Machinery,
+			StartDate:        updatedApplication.StartDate.Format("2006-01-02"),
+			EndDate:          updatedApplication.EndDate.Format("2006-01-02"),
+			Duration:         int32(updatedApplication.Duration),
+			DailyRate:        updatedApplication.DailyRate,
+			TotalAmount:      updatedApplication.TotalAmount,
+			Deposit:          updatedApplication.Deposit,
+			DeliveryAddress:  updatedApplication.DeliveryAddress,
+			ContactPhone:     updatedApplication.ContactPhone,
+			Purpose:          updatedApplication.Purpose.String,
+			Status:           updatedApplication.Status,
+			CreatedAt:        updatedApplication.CreatedAt.Unix(),
+			UpdatedAt:        updatedApplication.UpdatedAt.Unix(),
+			UnitId:           int64(updatedApplication.UnitId),
+			UnitSerialNumber: updatedApplication.UnitSerialNumber,
 		},
 	}, nil
 }
@@ -0,0 +1,245 @@
+// Package saga 租赁审批与租赁产品库存之间的 Saga 协调
+// 最终批准前先持久化 Saga 并调用租赁产品服务预占库存(登记了设备台账的产品同时分配设备),预占成功后再迁移申请状态;
+// 申请状态迁移失败时释放已预占的库存(补偿),申请撤销或拒绝时释放预占
+// 每一步调用前先记录 Saga 状态,服务中途崩溃后由恢复任务按 Saga 状态与申请状态继续补偿、释放或完成
+package saga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"common/statemachine"
+	"leaseproductrpc/leaseproductservice"
+	"model"
+	"rpc/internal/breaker"
+	"rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// Saga 状态
+// reserving --> reserved --> completed --> releasing --> released
+// reserving/reserved --> compensating --> compensated
+// compensated/released 后再次审批时重新进入 reserving
+const (
+	StatusReserving    = "reserving"    // 已记录,正在预占库存
+	StatusReserved     = "reserved"     // 库存已预占,等待申请状态迁移
+	StatusCompleted    = "completed"    // 申请已批准,预占生效
+	StatusCompensating = "compensating" // 审批未生效,正在释放预占
+	StatusCompensated  = "compensated"  // 审批未生效,预占已释放
+	StatusReleasing    = "releasing"    // 申请撤销或拒绝,正在释放预占
+	StatusReleased     = "released"     // 申请撤销或拒绝,预占已释放
+)
+
+const (
+	reserveQuantity    = 1   // 每份租赁申请租用一台设备
+	maxLastErrorLength = 500 // 失败原因字段长度
+)
+
+// ErrInProgress 同一申请的预占正在进行或等待恢复
+var ErrInProgress = errors.New("申请状态错误，申请的库存预占正在处理中，请稍后重试")
+
+// InventorySaga 库存预占 Saga 协调器
+type InventorySaga struct {
+	svcCtx *svc.ServiceContext
+}
+
+func NewInventorySaga(svcCtx *svc.ServiceContext) *InventorySaga {
+	return &InventorySaga{
+		svcCtx: svcCtx,
+	}
+}
+
+// Reserve 最终批准前预占申请租期内的库存
+// 产品登记了设备台账时,分配的设备记录到 application,随申请状态迁移一并保存
+// 返回的 Saga 需在申请状态迁移成功后调用 Complete,失败时调用 Compensate
+func (s *InventorySaga) Reserve(ctx context.Context, application *model.LeaseApplications) (*model.LeaseInventorySagas, error) {
+	logger := logx.WithContext(ctx)
+
+	saga, err := s.begin(ctx, application)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := breaker.DoWithBreakerResultAcceptable(ctx, "leaseproduct-rpc", func() (*leaseproductservice.ReserveInventoryResp, error) {
+		return s.svcCtx.LeaseProductClient.ReserveInventory(ctx, &leaseproductservice.ReserveInventoryReq{
+			ProductCode:   saga.ProductCode,
+			ApplicationId: saga.ApplicationId,
+			Quantity:      int32(saga.Quantity),
+			StartDate:     saga.StartDate.Format(time.DateOnly),
+			EndDate:       saga.EndDate.Format(time.DateOnly),
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		logger.Errorf("预占库存失败, 申请编号: %s, 错误: %v", saga.ApplicationId, err)
+		// 业务错误时产品服务未写入预占,直接结束;其他错误无法确定是否已预占,按补偿释放
+		if breaker.IsAcceptableError(err) {
+			s.transit(ctx, saga, []string{StatusReserving}, StatusCompensated, err.Error())
+			return nil, err
+		}
+		s.Compensate(ctx, saga, err.Error())
+		return nil, fmt.Errorf("预占库存失败，请稍后重试")
+	}
+
+	if !s.transit(ctx, saga, []string{StatusReserving}, StatusReserved, "") {
+		s.Compensate(ctx, saga, "记录预占结果失败")
+		return nil, fmt.Errorf("预占库存失败，请稍后重试")
+	}
+
+	application.UnitId, application.UnitSerialNumber = uint64(resp.UnitId), resp.SerialNumber
+	if resp.UnitId > 0 {
+		logger.Infof("分配设备, 申请编号: %s, 设备ID: %d, 序列号: %s", saga.ApplicationId, resp.UnitId, resp.SerialNumber)
+	}
+	return saga, nil
+}
+
+// Complete 申请已批准,预占生效
+// 记录失败时由恢复任务按申请状态完成
+func (s *InventorySaga) Complete(ctx context.Context, saga *model.LeaseInventorySagas) {
+	s.transit(ctx, saga, []string{StatusReserved}, StatusCompleted, "")
+}
+
+// Compensate 审批未生效时释放已预占的库存
+// 释放失败时 Saga 停留在补偿中,由恢复任务重试
+func (s *InventorySaga) Compensate(ctx context.Context, saga *model.LeaseInventorySagas, reason string) {
+	if !s.transit(ctx, saga, []string{StatusReserving, StatusReserved}, StatusCompensating, reason) {
+		return
+	}
+	s.release(ctx, saga, StatusCompensating, StatusCompensated)
+}
+
+// Release 申请撤销或拒绝时释放预占,申请未预占时直接返回
+// 预占仍在进行中的 Saga 由恢复任务按申请状态补偿
+func (s *InventorySaga) Release(ctx context.Context, applicationId string) {
+	logger := logx.WithContext(ctx)
+
+	saga, err := s.svcCtx.LeaseInventorySagasModel.FindOneByApplicationId(ctx, applicationId)
+	if err == model.ErrNotFound {
+		return
+	}
+	if err != nil {
+		logger.Errorf("查询库存预占Saga失败, 申请编号: %s, 错误: %v", applicationId, err)
+		return
+	}
+
+	switch saga.Status {
+	case StatusCompleted:
+		if !s.transit(ctx, saga, []string{StatusCompleted}, StatusReleasing, "") {
+			return
+		}
+		s.release(ctx, saga, StatusReleasing, StatusReleased)
+	case StatusReleasing:
+		s.release(ctx, saga, StatusReleasing, StatusReleased)
+	}
+}
+
+// Recover 恢复超过 timeout 未推进的 Saga,返回本次处理的数量
+// 预占中、已预占: 申请已批准时完成,否则补偿; 补偿中、释放中: 重试释放
+func (s *InventorySaga) Recover(ctx context.Context, timeout time.Duration, limit int) (int, error) {
+	logger := logx.WithContext(ctx)
+
+	sagas, err := s.svcCtx.LeaseInventorySagasModel.FindStale(ctx,
+		[]string{StatusReserving, StatusReserved, StatusCompensating, StatusReleasing}, time.Now().Add(-timeout), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, saga := range sagas {
+		switch saga.Status {
+		case StatusReserving, StatusReserved:
+			application, err := s.svcCtx.LeaseApplicationsModel.FindOneByApplicationId(ctx, saga.ApplicationId)
+			if err != nil && err != model.ErrNotFound {
+				logger.Errorf("查询申请失败, 申请编号: %s, 错误: %v", saga.ApplicationId, err)
+				continue
+			}
+			if application != nil && application.Status == statemachine.StatusApproved {
+				s.transit(ctx, saga, []string{StatusReserving, StatusReserved}, StatusCompleted, "")
+				continue
+			}
+			s.Compensate(ctx, saga, "审批中断,恢复任务补偿")
+		case StatusCompensating:
+			s.release(ctx, saga, StatusCompensating, StatusCompensated)
+		case StatusReleasing:
+			s.release(ctx, saga, StatusReleasing, StatusReleased)
+		}
+	}
+	return len(sagas), nil
+}
+
+// begin 记录 Saga 为预占中
+// 同一申请已补偿或已释放时重新发起,预占仍在进行中时返回 ErrInProgress
+func (s *InventorySaga) begin(ctx context.Context, application *model.LeaseApplications) (*model.LeaseInventorySagas, error) {
+	saga := &model.LeaseInventorySagas{
+		ApplicationId: application.ApplicationId,
+		ProductCode:   application.ProductCode,
+		Quantity:      reserveQuantity,
+		StartDate:     application.StartDate,
+		EndDate:       application.EndDate,
+		Status:        StatusReserving,
+		Attempts:      1,
+	}
+
+	existing, err := s.svcCtx.LeaseInventorySagasModel.FindOneByApplicationId(ctx, application.ApplicationId)
+	switch {
+	case err == model.ErrNotFound:
+		result, err := s.svcCtx.LeaseInventorySagasModel.Insert(ctx, saga)
+		if err != nil {
+			// 并发审批已写入同一申请的 Saga
+			logx.WithContext(ctx).Errorf("记录库存预占Saga失败, 申请编号: %s, 错误: %v", application.ApplicationId, err)
+			return nil, ErrInProgress
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return nil, fmt.Errorf("预占库存失败，请稍后重试")
+		}
+		saga.Id = uint64(id)
+		return saga, nil
+	case err != nil:
+		logx.WithContext(ctx).Errorf("查询库存预占Saga失败, 申请编号: %s, 错误: %v", application.ApplicationId, err)
+		return nil, fmt.Errorf("预占库存失败，请稍后重试")
+	}
+
+	saga.Id, saga.Attempts = existing.Id, existing.Attempts
+	ok, err := s.svcCtx.LeaseInventorySagasModel.Restart(ctx, saga, []string{StatusCompensated, StatusReleased}, StatusReserving)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("重新发起库存预占Saga失败, 申请编号: %s, 错误: %v", application.ApplicationId, err)
+		return nil, fmt.Errorf("预占库存失败，请稍后重试")
+	}
+	if !ok {
+		return nil, ErrInProgress
+	}
+	return saga, nil
+}
+
+// release 调用产品服务释放预占,成功后将 Saga 从 from 推进到 to
+func (s *InventorySaga) release(ctx context.Context, saga *model.LeaseInventorySagas, from, to string) {
+	_, err := breaker.DoWithBreakerResultAcceptable(ctx, "leaseproduct-rpc", func() (*leaseproductservice.ReleaseReservationResp, error) {
+		return s.svcCtx.LeaseProductClient.ReleaseReservation(ctx, &leaseproductservice.ReleaseReservationReq{
+			ApplicationId: saga.ApplicationId,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("释放库存预占失败, 等待恢复任务重试, 申请编号: %s, 错误: %v", saga.ApplicationId, err)
+		s.transit(ctx, saga, []string{from}, from, err.Error())
+		return
+	}
+	s.transit(ctx, saga, []string{from}, to, "")
+}
+
+// transit 按原状态条件推进 Saga,返回是否推进成功
+func (s *InventorySaga) transit(ctx context.Context, saga *model.LeaseInventorySagas, from []string, to, lastError string) bool {
+	if runes := []rune(lastError); len(runes) > maxLastErrorLength {
+		lastError = string(runes[:maxLastErrorLength])
+	}
+	ok, err := s.svcCtx.LeaseInventorySagasModel.Transit(ctx, saga, from, to, lastError)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("更新库存预占Saga失败, 申请编号: %s, %s -> %s, 错误: %v", saga.ApplicationId, saga.Status, to, err)
+		return false
+	}
+	if !ok {
+		logx.WithContext(ctx).Infof("库存预占Saga已被其他操作推进, 申请编号: %s, 目标状态: %s", saga.ApplicationId, to)
+	}
+	return ok
+}
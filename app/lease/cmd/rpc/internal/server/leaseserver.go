@@ -59,3 +59,9 @@ func (s *LeaseServer) ListLeaseApprovals(ctx context.Context, in *lease.ListLeas
 	l := logic.NewListLeaseApprovalsLogic(ctx, s.svcCtx)
 	return l.ListLeaseApprovals(in)
 }
+
+// 产品引用检查
+func (s *LeaseServer) CountProductApplications(ctx context.Context, in *lease.CountProductApplicationsReq) (*lease.CountProductApplicationsResp, error) {
+	l := logic.NewCountProductApplicationsLogic(ctx, s.svcCtx)
+	return l.CountProductApplications(in)
+}
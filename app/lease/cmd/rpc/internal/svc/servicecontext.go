@@ -6,7 +6,9 @@ import (
 	"model"
 	"rpc/internal/breaker"
 	"rpc/internal/config"
+	"rpc/internal/pkg/idempotency"
 
+	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -16,6 +18,12 @@ type ServiceContext struct {
 	LeaseApplicationsModel model.LeaseApplicationsModel
 	LeaseApprovalsModel    model.LeaseApprovalsModel
 
+	// 审批与租赁产品库存预占的 Saga 状态
+	LeaseInventorySagasModel model.LeaseInventorySagasModel
+
+	// 申请提交幂等控制
+	Idempotency *idempotency.Store
+
 	// RPC 客户端 - 通过consul服务发现调用其他服务
 	LeaseProductClient leaseproductservice.LeaseProductService
 	AppUserClient      appuserclient.AppUser
@@ -32,6 +40,11 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		LeaseApplicationsModel: model.NewLeaseApplicationsModel(conn, c.CacheConf),
 		LeaseApprovalsModel:    model.NewLeaseApprovalsModel(conn, c.CacheConf),
 
+		LeaseInventorySagasModel: model.NewLeaseInventorySagasModel(conn, c.CacheConf),
+
+		// 幂等键与模型缓存共用Redis
+		Idempotency: idempotency.NewStore(redis.MustNewRedis(c.CacheConf[0].RedisConf), "lease:create", c.Idempotency.Expire),
+
 		// 通过consul服务发现初始化RPC客户端
 		LeaseProductClient: leaseproductservice.NewLeaseProductService(zrpc.MustNewClient(c.LeaseProductRpc)),
 		AppUserClient:      appuserclient.NewAppUser(zrpc.MustNewClient(c.AppUserRpc)),
@@ -24,30 +24,32 @@ const (
 
 // 租赁申请基础信息
 type LeaseApplicationInfo struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                  // 申请ID
-	ApplicationId   string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`        // 申请编号
-	UserId          int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                            // 用户ID
-	ApplicantName   string                 `protobuf:"bytes,4,opt,name=applicant_name,json=applicantName,proto3" json:"applicant_name,omitempty"`        // 申请人姓名
-	ProductId       int64                  `protobuf:"varint,5,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`                   // 产品ID
-	ProductCode     string                 `protobuf:"bytes,6,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`              // 产品编码
-	Name            string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`                                               // 申请名称
-	Type            string                 `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`                                               // 租赁类型
-	Machinery       string                 `protobuf:"bytes,9,opt,name=machinery,proto3" json:"machinery,omitempty"`                                     // 设备名称
-	StartDate       string                 `protobuf:"bytes,10,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                   // 开始日期
-	EndDate         string                 `protobuf:"bytes,11,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                         // 结束日期
-	Duration        int32                  `protobuf:"varint,12,opt,name=duration,proto3" json:"duration,omitempty"`                                     // 租期(天)
-	DailyRate       float64                `protobuf:"fixed64,13,opt,name=daily_rate,json=dailyRate,proto3" json:"daily_rate,omitempty"`                 // 日租金
-	TotalAmount     float64                `protobuf:"fixed64,14,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`           // 总金额
-	Deposit         float64                `protobuf:"fixed64,15,opt,name=deposit,proto3" json:"deposit,omitempty"`                                      // 押金
-	DeliveryAddress string                 `protobuf:"bytes,16,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"` // 交付地址
-	ContactPhone    string                 `protobuf:"bytes,17,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`          // 联系电话
-	Purpose         string                 `protobuf:"bytes,18,opt,name=purpose,proto3" json:"purpose,omitempty"`                                        // 使用目的
-	Status          string                 `protobuf:"bytes,19,opt,name=status,proto3" json:"status,omitempty"`                                          // 状态 pending/approved/rejected/cancelled
-	CreatedAt       int64                  `protobuf:"varint,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                  // 创建时间
-	UpdatedAt       int64                  `protobuf:"varint,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                  // 更新时间
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                       // 申请ID
+	ApplicationId    string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`             // 申请编号
+	UserId           int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                 // 用户ID
+	ApplicantName    string                 `protobuf:"bytes,4,opt,name=applicant_name,json=applicantName,proto3" json:"applicant_name,omitempty"`             // 申请人姓名
+	ProductId        int64                  `protobuf:"varint,5,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`                        // 产品ID
+	ProductCode      string                 `protobuf:"bytes,6,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                   // 产品编码
+	Name             string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`                                                    // 申请名称
+	Type             string                 `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`                                                    // 租赁类型
+	Machinery        string                 `protobuf:"bytes,9,opt,name=machinery,proto3" json:"machinery,omitempty"`                                          // 设备名称
+	StartDate        string                 `protobuf:"bytes,10,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                        // 开始日期
+	EndDate          string                 `protobuf:"bytes,11,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                              // 结束日期
+	Duration         int32                  `protobuf:"varint,12,opt,name=duration,proto3" json:"duration,omitempty"`                                          // 租期(天)
+	DailyRate        float64                `protobuf:"fixed64,13,opt,name=daily_rate,json=dailyRate,proto3" json:"daily_rate,omitempty"`                      // 日租金
+	TotalAmount      float64                `protobuf:"fixed64,14,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`                // 总金额
+	Deposit          float64                `protobuf:"fixed64,15,opt,name=deposit,proto3" json:"deposit,omitempty"`                                           // 押金
+	DeliveryAddress  string                 `protobuf:"bytes,16,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"`      // 交付地址
+	ContactPhone     string                 `protobuf:"bytes,17,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`               // 联系电话
+	Purpose          string                 `protobuf:"bytes,18,opt,name=purpose,proto3" json:"purpose,omitempty"`                                             // 使用目的
+	Status           string                 `protobuf:"bytes,19,opt,name=status,proto3" json:"status,omitempty"`                                               // 状态 pending/approved/rejected/cancelled
+	CreatedAt        int64                  `protobuf:"varint,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                       // 创建时间
+	UpdatedAt        int64                  `protobuf:"varint,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                       // 更新时间
+	UnitId           int64                  `protobuf:"varint,22,opt,name=unit_id,json=unitId,proto3" json:"unit_id,omitempty"`                                // 分配的设备ID,审批通过时分配,0表示未分配
+	UnitSerialNumber string                 `protobuf:"bytes,23,opt,name=unit_serial_number,json=unitSerialNumber,proto3" json:"unit_serial_number,omitempty"` // 分配的设备序列号
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *LeaseApplicationInfo) Reset() {
@@ -227,6 +229,153 @@ func (x *LeaseApplicationInfo) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *LeaseApplicationInfo) GetUnitId() int64 {
+	if x != nil {
+		return x.UnitId
+	}
+	return 0
+}
+
+func (x *LeaseApplicationInfo) GetUnitSerialNumber() string {
+	if x != nil {
+		return x.UnitSerialNumber
+	}
+	return ""
+}
+
+// 申请时的产品条款快照
+type LeaseProductSnapshot struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode      string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                    // 产品编码
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                     // 产品名称
+	Type             string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                                     // 租赁类型
+	Machinery        string                 `protobuf:"bytes,4,opt,name=machinery,proto3" json:"machinery,omitempty"`                                           // 设备名称
+	Brand            string                 `protobuf:"bytes,5,opt,name=brand,proto3" json:"brand,omitempty"`                                                   // 品牌
+	Model            string                 `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`                                                   // 型号
+	DailyRate        float64                `protobuf:"fixed64,7,opt,name=daily_rate,json=dailyRate,proto3" json:"daily_rate,omitempty"`                        // 日租金
+	Deposit          float64                `protobuf:"fixed64,8,opt,name=deposit,proto3" json:"deposit,omitempty"`                                             // 押金
+	MinDuration      int32                  `protobuf:"varint,9,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`                   // 最小租期(天)
+	MaxDuration      int32                  `protobuf:"varint,10,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`                  // 最大租期(天)
+	ProductUpdatedAt int64                  `protobuf:"varint,11,opt,name=product_updated_at,json=productUpdatedAt,proto3" json:"product_updated_at,omitempty"` // 快照对应的产品更新时间
+	SnapshotAt       int64                  `protobuf:"varint,12,opt,name=snapshot_at,json=snapshotAt,proto3" json:"snapshot_at,omitempty"`                     // 快照时间
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LeaseProductSnapshot) Reset() {
+	*x = LeaseProductSnapshot{}
+	mi := &file_lease_rpc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaseProductSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseProductSnapshot) ProtoMessage() {}
+
+func (x *LeaseProductSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_lease_rpc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseProductSnapshot.ProtoReflect.Descriptor instead.
+func (*LeaseProductSnapshot) Descriptor() ([]byte, []int) {
+	return file_lease_rpc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LeaseProductSnapshot) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *LeaseProductSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LeaseProductSnapshot) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LeaseProductSnapshot) GetMachinery() string {
+	if x != nil {
+		return x.Machinery
+	}
+	return ""
+}
+
+func (x *LeaseProductSnapshot) GetBrand() string {
+	if x != nil {
+		return x.Brand
+	}
+	return ""
+}
+
+func (x *LeaseProductSnapshot) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *LeaseProductSnapshot) GetDailyRate() float64 {
+	if x != nil {
+		return x.DailyRate
+	}
+	return 0
+}
+
+func (x *LeaseProductSnapshot) GetDeposit() float64 {
+	if x != nil {
+		return x.Deposit
+	}
+	return 0
+}
+
+func (x *LeaseProductSnapshot) GetMinDuration() int32 {
+	if x != nil {
+		return x.MinDuration
+	}
+	return 0
+}
+
+func (x *LeaseProductSnapshot) GetMaxDuration() int32 {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return 0
+}
+
+func (x *LeaseProductSnapshot) GetProductUpdatedAt() int64 {
+	if x != nil {
+		return x.ProductUpdatedAt
+	}
+	return 0
+}
+
+func (x *LeaseProductSnapshot) GetSnapshotAt() int64 {
+	if x != nil {
+		return x.SnapshotAt
+	}
+	return 0
+}
+
 // 租赁审批记录基础信息
 type LeaseApprovalInfo struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -240,13 +389,16 @@ type LeaseApprovalInfo struct {
 	ApprovedAmount   float64                `protobuf:"fixed64,8,opt,name=approved_amount,json=approvedAmount,proto3" json:"approved_amount,omitempty"`      // 批准金额
 	ApprovedDeposit  float64                `protobuf:"fixed64,9,opt,name=approved_deposit,json=approvedDeposit,proto3" json:"approved_deposit,omitempty"`   // 批准押金
 	CreatedAt        int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                     // 创建时间
+	Stage            int32                  `protobuf:"varint,11,opt,name=stage,proto3" json:"stage,omitempty"`                                              // 审批环节序号
+	StageName        string                 `protobuf:"bytes,12,opt,name=stage_name,json=stageName,proto3" json:"stage_name,omitempty"`                      // 审批环节名称
+	AuditorRole      string                 `protobuf:"bytes,13,opt,name=auditor_role,json=auditorRole,proto3" json:"auditor_role,omitempty"`                // 审核员角色 admin/operator
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *LeaseApprovalInfo) Reset() {
 	*x = LeaseApprovalInfo{}
-	mi := &file_lease_rpc_proto_msgTypes[1]
+	mi := &file_lease_rpc_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -258,7 +410,7 @@ func (x *LeaseApprovalInfo) String() string {
 func (*LeaseApprovalInfo) ProtoMessage() {}
 
 func (x *LeaseApprovalInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_lease_rpc_proto_msgTypes[1]
+	mi := &file_lease_rpc_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -271,7 +423,7 @@ func (x *LeaseApprovalInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeaseApprovalInfo.ProtoReflect.Descriptor instead.
 func (*LeaseApprovalInfo) Descriptor() ([]byte, []int) {
-	return file_lease_rpc_proto_rawDescGZIP(), []int{1}
+	return file_lease_rpc_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *LeaseApprovalInfo) GetId() int64 {
@@ -344,7 +496,28 @@ func (x *LeaseApprovalInfo) GetCreatedAt() int64 {
 	return 0
 }
 
-// 创建租赁申请
+func (x *LeaseApprovalInfo) GetStage() int32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *LeaseApprovalInfo) GetStageName() string {
+	if x != nil {
+		return x.StageName
+	}
+	return ""
+}
+
+func (x *LeaseApprovalInfo) GetAuditorRole() string {
+	if x != nil {
+		return x.AuditorRole
+	}
+	return ""
+}
+
+// 创建租赁申请 - 租期、日租金、租金总额与押金须与租赁产品服务的报价(QuoteLease)一致
 type CreateLeaseApplicationReq struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -362,13 +535,14 @@ type CreateLeaseApplicationReq struct {
 	DeliveryAddress string                 `protobuf:"bytes,13,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"`
 	ContactPhone    string                 `protobuf:"bytes,14,opt,name=contact_phone,json=contactPhone,proto3" json:"contact_phone,omitempty"`
 	Purpose         string                 `protobuf:"bytes,15,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	IdempotencyKey  string                 `protobuf:"bytes,16,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 幂等键,同一用户相同幂等键的重复提交返回首次创建的申请编号
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateLeaseApplicationReq) Reset() {
 	*x = CreateLeaseApplicationReq{}
-	mi := &file_lease_rpc_proto_msgTypes[2]
+	mi := &file_lease_rpc_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -380,7 +554,7 @@ func (x *CreateLeaseApplicationReq) String() string {
 func (*CreateLeaseApplicationReq) ProtoMessage() {}
 
 func (x *CreateLeaseApplicationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_lease_rpc_proto_msgTypes[2]
+	mi := &file_lease_rpc_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -393,7 +567,7 @@ func (x *CreateLeaseApplicationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLeaseApplicationReq.ProtoReflect.Descriptor instead.
 func (*CreateLeaseApplicationReq) Descriptor() ([]byte, []int) {
-	return file_lease_rpc_proto_rawDescGZIP(), []int{2}
+	return file_lease_rpc_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateLeaseApplicationReq) GetUserId() int64 {
@@ -501,6 +675,13 @@ func (x *CreateLeaseApplicationReq) GetPurpose() string {
 	return ""
 }
 
+func (x *CreateLeaseApplicationReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type CreateLeaseApplicationResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
@@ -510,7 +691,7 @@ type CreateLeaseApplicationResp struct {
 
 func (x *CreateLeaseApplicationResp) Reset() {
 	*x = CreateLeaseApplicationResp{}
-	mi := &file_lease_rpc_proto_msgTypes[3]
+	mi := &file_lease_rpc_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -522,7 +703,7 @@ func (x *CreateLeaseApplicationResp) String() string {
 func (*CreateLeaseApplicationResp) ProtoMessage() {}
 
 func (x *CreateLeaseApplicationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_lease_rpc_proto_msgTypes[3]
+	mi := &file_lease_rpc_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,7 +716,7 @@ func (x *CreateLeaseApplicationResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLeaseApplicationResp.ProtoReflect.Descriptor instead.
 func (*CreateLeaseApplicationResp) Descriptor() ([]byte, []int) {
-	return file_lease_rpc_proto_rawDescGZIP(), []int{3}
+	return file_lease_rpc_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateLeaseApplicationResp) GetApplicationId() string {
@@ -555,7 +736,7 @@ type GetLeaseApplicationReq struct {
 
 func (x *GetLeaseApplicationReq) Reset() {
 	*x = GetLeaseApplicationReq{}
-	mi := &file_lease_rpc_proto_msgTypes[4]
+	mi := &file_lease_rpc_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -567,7 +748,7 @@ func (x *GetLeaseApplicationReq) String() string {
 func (*GetLeaseApplicationReq) ProtoMessage() {}
 
 func (x *GetLeaseApplicationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_lease_rpc_proto_msgTypes[4]
+	mi := &file_lease_rpc_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +761,7 @@ func (x *GetLeaseApplicationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeaseApplicationReq.ProtoReflect.Descriptor instead.
 func (*GetLeaseApplicationReq) Descriptor() ([]byte, []int) {
-	return file_lease_rpc_proto_rawDescGZIP(), []int{4}
+	return file_lease_rpc_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetLeaseApplicationReq) GetApplicationId() string {
@@ -593,13 +774,14 @@ func (x *GetLeaseApplicationReq) GetApplicationId() string {
 type GetLeaseApplicationResp struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ApplicationInfo *LeaseApplicationInfo  `protobuf:"bytes,1,opt,name=application_info,json=applicationInfo,proto3" json:"application_info,omitempty"`
+	ProductSnapshot *LeaseProductSnapshot  `protobuf:"bytes,2,opt,name=product_snapshot,json=productSnapshot,proto3" json:"product_snapshot,omitempty"` // 申请时的产品条款,历史申请无快照时为空
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *GetLeaseApplicationResp) Reset() {
 	*x = GetLeaseApplicationResp{}
-	mi := &file_lease_rpc_proto_msgTypes[5]
+	mi := &file_lease_rpc_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -611,7 +793,7 @@ func (x *GetLeaseApplicationResp) String() string {
 func (*GetLeaseApplicationResp) ProtoMessage() {}
 
 func (x *GetLeaseApplicationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_lease_rpc_proto_msgTypes[5]
+	mi := &file_lease_rpc_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -624,7 +806,7 @@ func (x *GetLeaseApplicationResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLeaseApplicationResp.ProtoReflect.Descriptor instead.
 func (*GetLeaseApplicationResp) Descriptor() ([]byte, []int) {
-	return file_lease_rpc_proto_rawDescGZIP(), []int{5}
+	return file_lease_rpc_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetLeaseApplicationResp) GetApplicationInfo() *LeaseApplicationInfo {
@@ -634,6 +816,13 @@ func (x *GetLeaseApplicationResp) GetApplicationInfo() *LeaseApplicationInfo {
 	return nil
 }
 
+func (x *GetLeaseApplicationResp) GetProductSnapshot() *LeaseProductSnapshot {
+	if x != nil {
+		return x.ProductSnapshot
+	}
+	return nil
+}
+
 // 获取租赁申请列表
 type ListLeaseApplicationsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -648,7 +837,7 @@ type ListLeaseApplicationsReq struct {
 
 func (x *ListLeaseApplicationsReq) Reset() {
 	*x = ListLeaseApplicationsReq{}
-	mi := &file_lease_rpc_proto_msgTypes[6]
+	mi := &file_lease_rpc_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -660,7 +849,7 @@ func (x *ListLeaseApplicationsReq) String() string {
 func (*ListLeaseApplicationsReq) ProtoMessage() {}
 
 func (x *ListLeaseApplicationsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_lease_rpc_proto_msgTypes[6]
+	mi := &file_lease_rpc_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,7 +862,7 @@ func (x *ListLeaseApplicationsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLeaseApplicationsReq.ProtoReflect.Descriptor instead.
 func (*ListLeaseApplicationsReq) Descriptor() ([]byte, []int) {
-	return file_lease_rpc_proto_rawDescGZIP(), []int{6}
+	return file_lease_rpc_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListLeaseApplicationsReq) GetPage() int32 {
@@ -721,7 +910,7 @@ type ListLeaseApplicationsResp struct {
 
 func (x *ListLeaseApplicationsResp) Reset() {
 	*x = ListLeaseApplicationsResp{}
-	mi := &file_lease_rpc_proto_msgTypes[7]
+	mi := &file_lease_rpc_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -733,7 +922,7 @@ func (x *ListLeaseApplicationsResp) String() string {
 func (*ListLeaseApplicationsResp) ProtoMessage() {}
 
 func (x *ListLeaseApplicationsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_lease_rpc_proto_msgTypes[7]
+	mi := &file_lease_rpc_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -746,7 +935,7 @@ func (x *ListLeaseApplicationsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLeaseApplicationsResp.ProtoReflect.Descriptor instead.
 func (*ListLeaseApplicationsResp) Descriptor() ([]byte, []int) {
-	return file_lease_rpc_proto_rawDescGZIP(), []int{7}
+	return file_lease_rpc_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListLeaseApplicationsResp) GetList() []*LeaseApplicationInfo {
@@ -776,7 +965,7 @@ type UpdateLeaseApplicationReq struct {
 
 func (x *UpdateLeaseApplicationReq) Reset() {
 	*x = UpdateLeaseApplicationReq{}
-	mi := &file_lease_rpc_proto_msgTypes[8]
+	mi := &file_lease_rpc_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -788,7 +977,7 @@ func (x *UpdateLeaseApplicationReq) String() string {
 func (*UpdateLeaseApplicationReq) ProtoMessage() {}
 
 func (x *UpdateLeaseApplicationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_lease_rpc_proto_msgTypes[8]
+	mi := &file_lease_rpc_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -801,7 +990,7 @@ func (x *UpdateLeaseApplicationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLeaseApplicationReq.ProtoReflect.Descriptor instead.
 func (*UpdateLeaseApplicationReq) Descriptor() ([]byte, []int) {
-	return file_lease_rpc_proto_rawDescGZIP(), []int{8}
+	return file_lease_rpc_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateLeaseApplicationReq) GetApplicationId() string {
@@ -841,7 +1030,7 @@ type UpdateLeaseApplicationResp struct {
 
 func (x *UpdateLeaseApplicationResp) Reset() {
 	*x = UpdateLeaseApplicationResp{}
-	mi := &file_lease_rpc_proto_msgTypes[9]
+	mi := &file_lease_rpc_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -853,7 +1042,7 @@ func (x *UpdateLeaseApplicationResp) String() string {
 func (*UpdateLeaseApplicationResp) ProtoMessage() {}
 
 func (x *UpdateLeaseApplicationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_lease_rpc_proto_msgTypes[9]
+	mi := &file_lease_rpc_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -866,7 +1055,7 @@ func (x *UpdateLeaseApplicationResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLeaseApplicationResp.ProtoReflect.Descriptor instead.
 func (*UpdateLeaseApplicationResp) Descriptor() ([]byte, []int) {
-	return file_lease_rpc_proto_rawDescGZIP(), []int{9}
+	return file_lease_rpc_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateLeaseApplicationResp) GetApplicationInfo() *LeaseApplicationInfo {
@@ -887,7 +1076,7 @@ type CancelLeaseApplicationReq struct {
 
 func (x *CancelLeaseApplicationReq) Reset() {
 	*x = CancelLeaseApplicationReq{}
-	mi := &file_lease_rpc_proto_msgTypes[10]
+	mi := &file_lease_rpc_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -899,7 +1088,7 @@ func (x *CancelLeaseApplicationReq) String() string {
 func (*CancelLeaseApplicationReq) ProtoMessage() {}
 
 func (x *CancelLeaseApplicationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_lease_rpc_proto_msgTypes[10]
+	mi := &file_lease_rpc_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,7 +1101,7 @@ func (x *CancelLeaseApplicationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelLeaseApplicationReq.ProtoReflect.Descriptor instead.
 func (*CancelLeaseApplicationReq) Descriptor() ([]byte, []int) {
-	return file_lease_rpc_proto_rawDescGZIP(), []int{10}
+	return file_lease_rpc_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CancelLeaseApplicationReq) GetApplicationId() string {
@@ -937,7 +1126,7 @@ type CancelLeaseApplicationResp struct {
 
 func (x *CancelLeaseApplicationResp) Reset() {
 	*x = CancelLeaseApplicationResp{}
-	mi := &file_lease_rpc_proto_msgTypes[11]
+	mi := &file_lease_rpc_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -949,7 +1138,7 @@ func (x *CancelLeaseApplicationResp) String() string {
 func (*CancelLeaseApplicationResp) ProtoMessage() {}
 
 func (x *CancelLeaseApplicationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_lease_rpc_proto_msgTypes[11]
+	mi := &file_lease_rpc_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -962,7 +1151,7 @@ func (x *CancelLeaseApplicationResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelLeaseApplicationResp.ProtoReflect.Descriptor instead.
 func (*CancelLeaseApplicationResp) Descriptor() ([]byte, []int) {
-	return file_lease_rpc_proto_rawDescGZIP(), []int{11}
+	return file_lease_rpc_proto_rawDescGZIP(), []int{12}
 }
 
 // 审批租赁申请
@@ -976,13 +1165,14 @@ type ApproveLeaseApplicationReq struct {
 	ApprovedDuration int32                  `protobuf:"varint,6,opt,name=approved_duration,json=approvedDuration,proto3" json:"approved_duration,omitempty"`
 	ApprovedAmount   float64                `protobuf:"fixed64,7,opt,name=approved_amount,json=approvedAmount,proto3" json:"approved_amount,omitempty"`
 	ApprovedDeposit  float64                `protobuf:"fixed64,8,opt,name=approved_deposit,json=approvedDeposit,proto3" json:"approved_deposit,omitempty"`
+	AuditorRole      string                 `protobuf:"bytes,9,opt,name=auditor_role,json=auditorRole,proto3" json:"auditor_role,omitempty"` // 审核员角色 admin/operator,按产品审批链校验
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ApproveLeaseApplicationReq) Reset() {
 	*x = ApproveLeaseApplicationReq{}
-	mi := &file_lease_rpc_proto_msgTypes[12]
+	mi := &file_lease_rpc_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -994,7 +1184,7 @@ func (x *ApproveLeaseApplicationReq) String() string {
 func (*ApproveLeaseApplicationReq) ProtoMessage() {}
 
 func (x *ApproveLeaseApplicationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_lease_rpc_proto_msgTypes[12]
+	mi := &file_lease_rpc_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1007,7 +1197,7 @@ func (x *ApproveLeaseApplicationReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveLeaseApplicationReq.ProtoReflect.Descriptor instead.
 func (*ApproveLeaseApplicationReq) Descriptor() ([]byte, []int) {
-	return file_lease_rpc_proto_rawDescGZIP(), []int{12}
+	return file_lease_rpc_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ApproveLeaseApplicationReq) GetApplicationId() string {
@@ -1066,15 +1256,28 @@ func (x *ApproveLeaseApplicationReq) GetApprovedDeposit() float64 {
 	return 0
 }
 
+func (x *ApproveLeaseApplicationReq) GetAuditorRole() string {
+	if x != nil {
+		return x.AuditorRole
+	}
+	return ""
+}
+
+// 按产品审批链逐级审批,最后一个环节批准后申请才变为approved
 type ApproveLeaseApplicationResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stage         int32                  `protobuf:"varint,1,opt,name=stage,proto3" json:"stage,omitempty"`                             // 本次处理的审批环节序号
+	StageName     string                 `protobuf:"bytes,2,opt,name=stage_name,json=stageName,proto3" json:"stage_name,omitempty"`     // 本次处理的审批环节名称
+	TotalStage    int32                  `protobuf:"varint,3,opt,name=total_stage,json=totalStage,proto3" json:"total_stage,omitempty"` // 该申请需要经过的审批环节总数
+	Finished      bool                   `protobuf:"varint,4,opt,name=finished,proto3" json:"finished,omitempty"`                       // 审批链是否已结束
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                            // 审批后申请状态
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ApproveLeaseApplicationResp) Reset() {
 	*x = ApproveLeaseApplicationResp{}
-	mi := &file_lease_rpc_proto_msgTypes[13]
+	mi := &file_lease_rpc_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1086,7 +1289,7 @@ func (x *ApproveLeaseApplicationResp) String() string {
 func (*ApproveLeaseApplicationResp) ProtoMessage() {}
 
 func (x *ApproveLeaseApplicationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_lease_rpc_proto_msgTypes[13]
+	mi := &file_lease_rpc_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1099,7 +1302,42 @@ func (x *ApproveLeaseApplicationResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveLeaseApplicationResp.ProtoReflect.Descriptor instead.
 func (*ApproveLeaseApplicationResp) Descriptor() ([]byte, []int) {
-	return file_lease_rpc_proto_rawDescGZIP(), []int{13}
+	return file_lease_rpc_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ApproveLeaseApplicationResp) GetStage() int32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *ApproveLeaseApplicationResp) GetStageName() string {
+	if x != nil {
+		return x.StageName
+	}
+	return ""
+}
+
+func (x *ApproveLeaseApplicationResp) GetTotalStage() int32 {
+	if x != nil {
+		return x.TotalStage
+	}
+	return 0
+}
+
+func (x *ApproveLeaseApplicationResp) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *ApproveLeaseApplicationResp) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
 // 获取审批记录列表
@@ -1112,7 +1350,7 @@ type ListLeaseApprovalsReq struct {
 
 func (x *ListLeaseApprovalsReq) Reset() {
 	*x = ListLeaseApprovalsReq{}
-	mi := &file_lease_rpc_proto_msgTypes[14]
+	mi := &file_lease_rpc_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1124,7 +1362,7 @@ func (x *ListLeaseApprovalsReq) String() string {
 func (*ListLeaseApprovalsReq) ProtoMessage() {}
 
 func (x *ListLeaseApprovalsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_lease_rpc_proto_msgTypes[14]
+	mi := &file_lease_rpc_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,7 +1375,7 @@ func (x *ListLeaseApprovalsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLeaseApprovalsReq.ProtoReflect.Descriptor instead.
 func (*ListLeaseApprovalsReq) Descriptor() ([]byte, []int) {
-	return file_lease_rpc_proto_rawDescGZIP(), []int{14}
+	return file_lease_rpc_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *ListLeaseApprovalsReq) GetApplicationId() string {
@@ -1156,7 +1394,7 @@ type ListLeaseApprovalsResp struct {
 
 func (x *ListLeaseApprovalsResp) Reset() {
 	*x = ListLeaseApprovalsResp{}
-	mi := &file_lease_rpc_proto_msgTypes[15]
+	mi := &file_lease_rpc_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1168,7 +1406,7 @@ func (x *ListLeaseApprovalsResp) String() string {
 func (*ListLeaseApprovalsResp) ProtoMessage() {}
 
 func (x *ListLeaseApprovalsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_lease_rpc_proto_msgTypes[15]
+	mi := &file_lease_rpc_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,7 +1419,7 @@ func (x *ListLeaseApprovalsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLeaseApprovalsResp.ProtoReflect.Descriptor instead.
 func (*ListLeaseApprovalsResp) Descriptor() ([]byte, []int) {
-	return file_lease_rpc_proto_rawDescGZIP(), []int{15}
+	return file_lease_rpc_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListLeaseApprovalsResp) GetList() []*LeaseApprovalInfo {
@@ -1191,11 +1429,108 @@ func (x *ListLeaseApprovalsResp) GetList() []*LeaseApprovalInfo {
 	return nil
 }
 
+// 产品引用检查 - 产品服务删除产品前确认是否仍有进行中的申请
+type CountProductApplicationsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountProductApplicationsReq) Reset() {
+	*x = CountProductApplicationsReq{}
+	mi := &file_lease_rpc_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountProductApplicationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountProductApplicationsReq) ProtoMessage() {}
+
+func (x *CountProductApplicationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_lease_rpc_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountProductApplicationsReq.ProtoReflect.Descriptor instead.
+func (*CountProductApplicationsReq) Descriptor() ([]byte, []int) {
+	return file_lease_rpc_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CountProductApplicationsReq) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type CountProductApplicationsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Active        int64                  `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"` // 进行中(待审批/已批准)的申请数
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`   // 引用该产品的申请总数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountProductApplicationsResp) Reset() {
+	*x = CountProductApplicationsResp{}
+	mi := &file_lease_rpc_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountProductApplicationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountProductApplicationsResp) ProtoMessage() {}
+
+func (x *CountProductApplicationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_lease_rpc_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountProductApplicationsResp.ProtoReflect.Descriptor instead.
+func (*CountProductApplicationsResp) Descriptor() ([]byte, []int) {
+	return file_lease_rpc_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CountProductApplicationsResp) GetActive() int64 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *CountProductApplicationsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 var File_lease_rpc_proto protoreflect.FileDescriptor
 
 const file_lease_rpc_proto_rawDesc = "" +
 	"\n" +
-	"\x0flease-rpc.proto\x12\x05lease\x1a\x1bgoogle/protobuf/empty.proto\"\x87\x05\n" +
+	"\x0flease-rpc.proto\x12\x05lease\x1a\x1bgoogle/protobuf/empty.proto\"\xce\x05\n" +
 	"\x14LeaseApplicationInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
 	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12\x17\n" +
@@ -1223,7 +1558,25 @@ const file_lease_rpc_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x14 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x15 \x01(\x03R\tupdatedAt\"\xe6\x02\n" +
+	"updated_at\x18\x15 \x01(\x03R\tupdatedAt\x12\x17\n" +
+	"\aunit_id\x18\x16 \x01(\x03R\x06unitId\x12,\n" +
+	"\x12unit_serial_number\x18\x17 \x01(\tR\x10unitSerialNumber\"\xf9\x02\n" +
+	"\x14LeaseProductSnapshot\x12!\n" +
+	"\fproduct_code\x18\x01 \x01(\tR\vproductCode\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1c\n" +
+	"\tmachinery\x18\x04 \x01(\tR\tmachinery\x12\x14\n" +
+	"\x05brand\x18\x05 \x01(\tR\x05brand\x12\x14\n" +
+	"\x05model\x18\x06 \x01(\tR\x05model\x12\x1d\n" +
+	"\n" +
+	"daily_rate\x18\a \x01(\x01R\tdailyRate\x12\x18\n" +
+	"\adeposit\x18\b \x01(\x01R\adeposit\x12!\n" +
+	"\fmin_duration\x18\t \x01(\x05R\vminDuration\x12!\n" +
+	"\fmax_duration\x18\n" +
+	" \x01(\x05R\vmaxDuration\x12,\n" +
+	"\x12product_updated_at\x18\v \x01(\x03R\x10productUpdatedAt\x12\x1f\n" +
+	"\vsnapshot_at\x18\f \x01(\x03R\n" +
+	"snapshotAt\"\xbe\x03\n" +
 	"\x11LeaseApprovalInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
 	"\x0eapplication_id\x18\x02 \x01(\x03R\rapplicationId\x12\x1d\n" +
@@ -1237,7 +1590,11 @@ const file_lease_rpc_proto_rawDesc = "" +
 	"\x10approved_deposit\x18\t \x01(\x01R\x0fapprovedDeposit\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\n" +
-	" \x01(\x03R\tcreatedAt\"\xd8\x03\n" +
+	" \x01(\x03R\tcreatedAt\x12\x14\n" +
+	"\x05stage\x18\v \x01(\x05R\x05stage\x12\x1d\n" +
+	"\n" +
+	"stage_name\x18\f \x01(\tR\tstageName\x12!\n" +
+	"\fauditor_role\x18\r \x01(\tR\vauditorRole\"\x81\x04\n" +
 	"\x19CreateLeaseApplicationReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
 	"\n" +
@@ -1257,13 +1614,15 @@ const file_lease_rpc_proto_rawDesc = "" +
 	"\adeposit\x18\f \x01(\x01R\adeposit\x12)\n" +
 	"\x10delivery_address\x18\r \x01(\tR\x0fdeliveryAddress\x12#\n" +
 	"\rcontact_phone\x18\x0e \x01(\tR\fcontactPhone\x12\x18\n" +
-	"\apurpose\x18\x0f \x01(\tR\apurpose\"C\n" +
+	"\apurpose\x18\x0f \x01(\tR\apurpose\x12'\n" +
+	"\x0fidempotency_key\x18\x10 \x01(\tR\x0eidempotencyKey\"C\n" +
 	"\x1aCreateLeaseApplicationResp\x12%\n" +
 	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"?\n" +
 	"\x16GetLeaseApplicationReq\x12%\n" +
-	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"a\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"\xa9\x01\n" +
 	"\x17GetLeaseApplicationResp\x12F\n" +
-	"\x10application_info\x18\x01 \x01(\v2\x1b.lease.LeaseApplicationInfoR\x0fapplicationInfo\"\x96\x01\n" +
+	"\x10application_info\x18\x01 \x01(\v2\x1b.lease.LeaseApplicationInfoR\x0fapplicationInfo\x12F\n" +
+	"\x10product_snapshot\x18\x02 \x01(\v2\x1b.lease.LeaseProductSnapshotR\x0fproductSnapshot\"\x96\x01\n" +
 	"\x18ListLeaseApplicationsReq\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x17\n" +
@@ -1283,7 +1642,7 @@ const file_lease_rpc_proto_rawDesc = "" +
 	"\x19CancelLeaseApplicationReq\x12%\n" +
 	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x1c\n" +
-	"\x1aCancelLeaseApplicationResp\"\xc0\x02\n" +
+	"\x1aCancelLeaseApplicationResp\"\xe3\x02\n" +
 	"\x1aApproveLeaseApplicationReq\x12%\n" +
 	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1d\n" +
 	"\n" +
@@ -1293,12 +1652,26 @@ const file_lease_rpc_proto_rawDesc = "" +
 	"\vsuggestions\x18\x05 \x01(\tR\vsuggestions\x12+\n" +
 	"\x11approved_duration\x18\x06 \x01(\x05R\x10approvedDuration\x12'\n" +
 	"\x0fapproved_amount\x18\a \x01(\x01R\x0eapprovedAmount\x12)\n" +
-	"\x10approved_deposit\x18\b \x01(\x01R\x0fapprovedDeposit\"\x1d\n" +
-	"\x1bApproveLeaseApplicationResp\">\n" +
+	"\x10approved_deposit\x18\b \x01(\x01R\x0fapprovedDeposit\x12!\n" +
+	"\fauditor_role\x18\t \x01(\tR\vauditorRole\"\xa7\x01\n" +
+	"\x1bApproveLeaseApplicationResp\x12\x14\n" +
+	"\x05stage\x18\x01 \x01(\x05R\x05stage\x12\x1d\n" +
+	"\n" +
+	"stage_name\x18\x02 \x01(\tR\tstageName\x12\x1f\n" +
+	"\vtotal_stage\x18\x03 \x01(\x05R\n" +
+	"totalStage\x12\x1a\n" +
+	"\bfinished\x18\x04 \x01(\bR\bfinished\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\">\n" +
 	"\x15ListLeaseApprovalsReq\x12%\n" +
 	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"F\n" +
 	"\x16ListLeaseApprovalsResp\x12,\n" +
-	"\x04list\x18\x01 \x03(\v2\x18.lease.LeaseApprovalInfoR\x04list2\x8b\x05\n" +
+	"\x04list\x18\x01 \x03(\v2\x18.lease.LeaseApprovalInfoR\x04list\"<\n" +
+	"\x1bCountProductApplicationsReq\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\"L\n" +
+	"\x1cCountProductApplicationsResp\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\x03R\x06active\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total2\xf0\x05\n" +
 	"\x05Lease\x12]\n" +
 	"\x16CreateLeaseApplication\x12 .lease.CreateLeaseApplicationReq\x1a!.lease.CreateLeaseApplicationResp\x12T\n" +
 	"\x13GetLeaseApplication\x12\x1d.lease.GetLeaseApplicationReq\x1a\x1e.lease.GetLeaseApplicationResp\x12Z\n" +
@@ -1306,7 +1679,8 @@ const file_lease_rpc_proto_rawDesc = "" +
 	"\x16UpdateLeaseApplication\x12 .lease.UpdateLeaseApplicationReq\x1a!.lease.UpdateLeaseApplicationResp\x12]\n" +
 	"\x16CancelLeaseApplication\x12 .lease.CancelLeaseApplicationReq\x1a!.lease.CancelLeaseApplicationResp\x12`\n" +
 	"\x17ApproveLeaseApplication\x12!.lease.ApproveLeaseApplicationReq\x1a\".lease.ApproveLeaseApplicationResp\x12Q\n" +
-	"\x12ListLeaseApprovals\x12\x1c.lease.ListLeaseApprovalsReq\x1a\x1d.lease.ListLeaseApprovalsRespB\tZ\a./leaseb\x06proto3"
+	"\x12ListLeaseApprovals\x12\x1c.lease.ListLeaseApprovalsReq\x1a\x1d.lease.ListLeaseApprovalsResp\x12c\n" +
+	"\x18CountProductApplications\x12\".lease.CountProductApplicationsReq\x1a#.lease.CountProductApplicationsRespB\tZ\a./leaseb\x06proto3"
 
 var (
 	file_lease_rpc_proto_rawDescOnce sync.Once
@@ -1320,49 +1694,55 @@ func file_lease_rpc_proto_rawDescGZIP() []byte {
 	return file_lease_rpc_proto_rawDescData
 }
 
-var file_lease_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_lease_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_lease_rpc_proto_goTypes = []any{
-	(*LeaseApplicationInfo)(nil),        // 0: lease.LeaseApplicationInfo
-	(*LeaseApprovalInfo)(nil),           // 1: lease.LeaseApprovalInfo
-	(*CreateLeaseApplicationReq)(nil),   // 2: lease.CreateLeaseApplicationReq
-	(*CreateLeaseApplicationResp)(nil),  // 3: lease.CreateLeaseApplicationResp
-	(*GetLeaseApplicationReq)(nil),      // 4: lease.GetLeaseApplicationReq
-	(*GetLeaseApplicationResp)(nil),     // 5: lease.GetLeaseApplicationResp
-	(*ListLeaseApplicationsReq)(nil),    // 6: lease.ListLeaseApplicationsReq
-	(*ListLeaseApplicationsResp)(nil),   // 7: lease.ListLeaseApplicationsResp
-	(*UpdateLeaseApplicationReq)(nil),   // 8: lease.UpdateLeaseApplicationReq
-	(*UpdateLeaseApplicationResp)(nil),  // 9: lease.UpdateLeaseApplicationResp
-	(*CancelLeaseApplicationReq)(nil),   // 10: lease.CancelLeaseApplicationReq
-	(*CancelLeaseApplicationResp)(nil),  // 11: lease.CancelLeaseApplicationResp
-	(*ApproveLeaseApplicationReq)(nil),  // 12: lease.ApproveLeaseApplicationReq
-	(*ApproveLeaseApplicationResp)(nil), // 13: lease.ApproveLeaseApplicationResp
-	(*ListLeaseApprovalsReq)(nil),       // 14: lease.ListLeaseApprovalsReq
-	(*ListLeaseApprovalsResp)(nil),      // 15: lease.ListLeaseApprovalsResp
+	(*LeaseApplicationInfo)(nil),         // 0: lease.LeaseApplicationInfo
+	(*LeaseProductSnapshot)(nil),         // 1: lease.LeaseProductSnapshot
+	(*LeaseApprovalInfo)(nil),            // 2: lease.LeaseApprovalInfo
+	(*CreateLeaseApplicationReq)(nil),    // 3: lease.CreateLeaseApplicationReq
+	(*CreateLeaseApplicationResp)(nil),   // 4: lease.CreateLeaseApplicationResp
+	(*GetLeaseApplicationReq)(nil),       // 5: lease.GetLeaseApplicationReq
+	(*GetLeaseApplicationResp)(nil),      // 6: lease.GetLeaseApplicationResp
+	(*ListLeaseApplicationsReq)(nil),     // 7: lease.ListLeaseApplicationsReq
+	(*ListLeaseApplicationsResp)(nil),    // 8: lease.ListLeaseApplicationsResp
+	(*UpdateLeaseApplicationReq)(nil),    // 9: lease.UpdateLeaseApplicationReq
+	(*UpdateLeaseApplicationResp)(nil),   // 10: lease.UpdateLeaseApplicationResp
+	(*CancelLeaseApplicationReq)(nil),    // 11: lease.CancelLeaseApplicationReq
+	(*CancelLeaseApplicationResp)(nil),   // 12: lease.CancelLeaseApplicationResp
+	(*ApproveLeaseApplicationReq)(nil),   // 13: lease.ApproveLeaseApplicationReq
+	(*ApproveLeaseApplicationResp)(nil),  // 14: lease.ApproveLeaseApplicationResp
+	(*ListLeaseApprovalsReq)(nil),        // 15: lease.ListLeaseApprovalsReq
+	(*ListLeaseApprovalsResp)(nil),       // 16: lease.ListLeaseApprovalsResp
+	(*CountProductApplicationsReq)(nil),  // 17: lease.CountProductApplicationsReq
+	(*CountProductApplicationsResp)(nil), // 18: lease.CountProductApplicationsResp
 }
 var file_lease_rpc_proto_depIdxs = []int32{
 	0,  // 0: lease.GetLeaseApplicationResp.application_info:type_name -> lease.LeaseApplicationInfo
-	0,  // 1: lease.ListLeaseApplicationsResp.list:type_name -> lease.LeaseApplicationInfo
-	0,  // 2: lease.UpdateLeaseApplicationResp.application_info:type_name -> lease.LeaseApplicationInfo
-	1,  // 3: lease.ListLeaseApprovalsResp.list:type_name -> lease.LeaseApprovalInfo
-	2,  // 4: lease.Lease.CreateLeaseApplication:input_type -> lease.CreateLeaseApplicationReq
-	4,  // 5: lease.Lease.GetLeaseApplication:input_type -> lease.GetLeaseApplicationReq
-	6,  // 6: lease.Lease.ListLeaseApplications:input_type -> lease.ListLeaseApplicationsReq
-	8,  // 7: lease.Lease.UpdateLeaseApplication:input_type -> lease.UpdateLeaseApplicationReq
-	10, // 8: lease.Lease.CancelLeaseApplication:input_type -> lease.CancelLeaseApplicationReq
-	12, // 9: lease.Lease.ApproveLeaseApplication:input_type -> lease.ApproveLeaseApplicationReq
-	14, // 10: lease.Lease.ListLeaseApprovals:input_type -> lease.ListLeaseApprovalsReq
-	3,  // 11: lease.Lease.CreateLeaseApplication:output_type -> lease.CreateLeaseApplicationResp
-	5,  // 12: lease.Lease.GetLeaseApplication:output_type -> lease.GetLeaseApplicationResp
-	7,  // 13: lease.Lease.ListLeaseApplications:output_type -> lease.ListLeaseApplicationsResp
-	9,  // 14: lease.Lease.UpdateLeaseApplication:output_type -> lease.UpdateLeaseApplicationResp
-	11, // 15: lease.Lease.CancelLeaseApplication:output_type -> lease.CancelLeaseApplicationResp
-	13, // 16: lease.Lease.ApproveLeaseApplication:output_type -> lease.ApproveLeaseApplicationResp
-	15, // 17: lease.Lease.ListLeaseApprovals:output_type -> lease.ListLeaseApprovalsResp
-	11, // [11:18] is the sub-list for method output_type
-	4,  // [4:11] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	1,  // 1: lease.GetLeaseApplicationResp.product_snapshot:type_name -> lease.LeaseProductSnapshot
+	0,  // 2: lease.ListLeaseApplicationsResp.list:type_name -> lease.LeaseApplicationInfo
+	0,  // 3: lease.UpdateLeaseApplicationResp.application_info:type_name -> lease.LeaseApplicationInfo
+	2,  // 4: lease.ListLeaseApprovalsResp.list:type_name -> lease.LeaseApprovalInfo
+	3,  // 5: lease.Lease.CreateLeaseApplication:input_type -> lease.CreateLeaseApplicationReq
+	5,  // 6: lease.Lease.GetLeaseApplication:input_type -> lease.GetLeaseApplicationReq
+	7,  // 7: lease.Lease.ListLeaseApplications:input_type -> lease.ListLeaseApplicationsReq
+	9,  // 8: lease.Lease.UpdateLeaseApplication:input_type -> lease.UpdateLeaseApplicationReq
+	11, // 9: lease.Lease.CancelLeaseApplication:input_type -> lease.CancelLeaseApplicationReq
+	13, // 10: lease.Lease.ApproveLeaseApplication:input_type -> lease.ApproveLeaseApplicationReq
+	15, // 11: lease.Lease.ListLeaseApprovals:input_type -> lease.ListLeaseApprovalsReq
+	17, // 12: lease.Lease.CountProductApplications:input_type -> lease.CountProductApplicationsReq
+	4,  // 13: lease.Lease.CreateLeaseApplication:output_type -> lease.CreateLeaseApplicationResp
+	6,  // 14: lease.Lease.GetLeaseApplication:output_type -> lease.GetLeaseApplicationResp
+	8,  // 15: lease.Lease.ListLeaseApplications:output_type -> lease.ListLeaseApplicationsResp
+	10, // 16: lease.Lease.UpdateLeaseApplication:output_type -> lease.UpdateLeaseApplicationResp
+	12, // 17: lease.Lease.CancelLeaseApplication:output_type -> lease.CancelLeaseApplicationResp
+	14, // 18: lease.Lease.ApproveLeaseApplication:output_type -> lease.ApproveLeaseApplicationResp
+	16, // 19: lease.Lease.ListLeaseApprovals:output_type -> lease.ListLeaseApprovalsResp
+	18, // 20: lease.Lease.CountProductApplications:output_type -> lease.CountProductApplicationsResp
+	13, // [13:21] is the sub-list for method output_type
+	5,  // [5:13] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_lease_rpc_proto_init() }
@@ -1376,7 +1756,7 @@ func file_lease_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_lease_rpc_proto_rawDesc), len(file_lease_rpc_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
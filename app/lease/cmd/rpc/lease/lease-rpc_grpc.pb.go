@@ -19,13 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Lease_CreateLeaseApplication_FullMethodName  = "/lease.Lease/CreateLeaseApplication"
-	Lease_GetLeaseApplication_FullMethodName     = "/lease.Lease/GetLeaseApplication"
-	Lease_ListLeaseApplications_FullMethodName   = "/lease.Lease/ListLeaseApplications"
-	Lease_UpdateLeaseApplication_FullMethodName  = "/lease.Lease/UpdateLeaseApplication"
-	Lease_CancelLeaseApplication_FullMethodName  = "/lease.Lease/CancelLeaseApplication"
-	Lease_ApproveLeaseApplication_FullMethodName = "/lease.Lease/ApproveLeaseApplication"
-	Lease_ListLeaseApprovals_FullMethodName      = "/lease.Lease/ListLeaseApprovals"
+	Lease_CreateLeaseApplication_FullMethodName   = "/lease.Lease/CreateLeaseApplication"
+	Lease_GetLeaseApplication_FullMethodName      = "/lease.Lease/GetLeaseApplication"
+	Lease_ListLeaseApplications_FullMethodName    = "/lease.Lease/ListLeaseApplications"
+	Lease_UpdateLeaseApplication_FullMethodName   = "/lease.Lease/UpdateLeaseApplication"
+	Lease_CancelLeaseApplication_FullMethodName   = "/lease.Lease/CancelLeaseApplication"
+	Lease_ApproveLeaseApplication_FullMethodName  = "/lease.Lease/ApproveLeaseApplication"
+	Lease_ListLeaseApprovals_FullMethodName       = "/lease.Lease/ListLeaseApprovals"
+	Lease_CountProductApplications_FullMethodName = "/lease.Lease/CountProductApplications"
 )
 
 // LeaseClient is the client API for Lease service.
@@ -43,6 +44,8 @@ type LeaseClient interface {
 	// 租赁审批管理
 	ApproveLeaseApplication(ctx context.Context, in *ApproveLeaseApplicationReq, opts ...grpc.CallOption) (*ApproveLeaseApplicationResp, error)
 	ListLeaseApprovals(ctx context.Context, in *ListLeaseApprovalsReq, opts ...grpc.CallOption) (*ListLeaseApprovalsResp, error)
+	// 产品引用检查
+	CountProductApplications(ctx context.Context, in *CountProductApplicationsReq, opts ...grpc.CallOption) (*CountProductApplicationsResp, error)
 }
 
 type leaseClient struct {
@@ -123,6 +126,16 @@ func (c *leaseClient) ListLeaseApprovals(ctx context.Context, in *ListLeaseAppro
 	return out, nil
 }
 
+func (c *leaseClient) CountProductApplications(ctx context.Context, in *CountProductApplicationsReq, opts ...grpc.CallOption) (*CountProductApplicationsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountProductApplicationsResp)
+	err := c.cc.Invoke(ctx, Lease_CountProductApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LeaseServer is the server API for Lease service.
 // All implementations must embed UnimplementedLeaseServer
 // for forward compatibility.
@@ -138,6 +151,8 @@ type LeaseServer interface {
 	// 租赁审批管理
 	ApproveLeaseApplication(context.Context, *ApproveLeaseApplicationReq) (*ApproveLeaseApplicationResp, error)
 	ListLeaseApprovals(context.Context, *ListLeaseApprovalsReq) (*ListLeaseApprovalsResp, error)
+	// 产品引用检查
+	CountProductApplications(context.Context, *CountProductApplicationsReq) (*CountProductApplicationsResp, error)
 	mustEmbedUnimplementedLeaseServer()
 }
 
@@ -169,6 +184,9 @@ func (UnimplementedLeaseServer) ApproveLeaseApplication(context.Context, *Approv
 func (UnimplementedLeaseServer) ListLeaseApprovals(context.Context, *ListLeaseApprovalsReq) (*ListLeaseApprovalsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLeaseApprovals not implemented")
 }
+func (UnimplementedLeaseServer) CountProductApplications(context.Context, *CountProductApplicationsReq) (*CountProductApplicationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountProductApplications not implemented")
+}
 func (UnimplementedLeaseServer) mustEmbedUnimplementedLeaseServer() {}
 func (UnimplementedLeaseServer) testEmbeddedByValue()               {}
 
@@ -316,6 +334,24 @@ func _Lease_ListLeaseApprovals_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lease_CountProductApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountProductApplicationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseServer).CountProductApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Lease_CountProductApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseServer).CountProductApplications(ctx, req.(*CountProductApplicationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Lease_ServiceDesc is the grpc.ServiceDesc for Lease service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -351,6 +387,10 @@ var Lease_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLeaseApprovals",
 			Handler:    _Lease_ListLeaseApprovals_Handler,
 		},
+		{
+			MethodName: "CountProductApplications",
+			Handler:    _Lease_CountProductApplications_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lease-rpc.proto",
@@ -14,22 +14,25 @@ import (
 )
 
 type (
-	ApproveLeaseApplicationReq  = lease.ApproveLeaseApplicationReq
-	ApproveLeaseApplicationResp = lease.ApproveLeaseApplicationResp
-	CancelLeaseApplicationReq   = lease.CancelLeaseApplicationReq
-	CancelLeaseApplicationResp  = lease.CancelLeaseApplicationResp
-	CreateLeaseApplicationReq   = lease.CreateLeaseApplicationReq
-	CreateLeaseApplicationResp  = lease.CreateLeaseApplicationResp
-	GetLeaseApplicationReq      = lease.GetLeaseApplicationReq
-	GetLeaseApplicationResp     = lease.GetLeaseApplicationResp
-	LeaseApplicationInfo        = lease.LeaseApplicationInfo
-	LeaseApprovalInfo           = lease.LeaseApprovalInfo
-	ListLeaseApplicationsReq    = lease.ListLeaseApplicationsReq
-	ListLeaseApplicationsResp   = lease.ListLeaseApplicationsResp
-	ListLeaseApprovalsReq       = lease.ListLeaseApprovalsReq
-	ListLeaseApprovalsResp      = lease.ListLeaseApprovalsResp
-	UpdateLeaseApplicationReq   = lease.UpdateLeaseApplicationReq
-	UpdateLeaseApplicationResp  = lease.UpdateLeaseApplicationResp
+	ApproveLeaseApplicationReq   = lease.ApproveLeaseApplicationReq
+	ApproveLeaseApplicationResp  = lease.ApproveLeaseApplicationResp
+	CancelLeaseApplicationReq    = lease.CancelLeaseApplicationReq
+	CancelLeaseApplicationResp   = lease.CancelLeaseApplicationResp
+	CountProductApplicationsReq  = lease.CountProductApplicationsReq
+	CountProductApplicationsResp = lease.CountProductApplicationsResp
+	CreateLeaseApplicationReq    = lease.CreateLeaseApplicationReq
+	CreateLeaseApplicationResp   = lease.CreateLeaseApplicationResp
+	GetLeaseApplicationReq       = lease.GetLeaseApplicationReq
+	GetLeaseApplicationResp      = lease.GetLeaseApplicationResp
+	LeaseApplicationInfo         = lease.LeaseApplicationInfo
+	LeaseApprovalInfo            = lease.LeaseApprovalInfo
+	LeaseProductSnapshot         = lease.LeaseProductSnapshot
+	ListLeaseApplicationsReq     = lease.ListLeaseApplicationsReq
+	ListLeaseApplicationsResp    = lease.ListLeaseApplicationsResp
+	ListLeaseApprovalsReq        = lease.ListLeaseApprovalsReq
+	ListLeaseApprovalsResp       = lease.ListLeaseApprovalsResp
+	UpdateLeaseApplicationReq    = lease.UpdateLeaseApplicationReq
+	UpdateLeaseApplicationResp   = lease.UpdateLeaseApplicationResp
 
 	Lease interface {
 		// 租赁申请管理
@@ -41,6 +44,8 @@ type (
 		// 租赁审批管理
 		ApproveLeaseApplication(ctx context.Context, in *ApproveLeaseApplicationReq, opts ...grpc.CallOption) (*ApproveLeaseApplicationResp, error)
 		ListLeaseApprovals(ctx context.Context, in *ListLeaseApprovalsReq, opts ...grpc.CallOption) (*ListLeaseApprovalsResp, error)
+		// 产品引用检查
+		CountProductApplications(ctx context.Context, in *CountProductApplicationsReq, opts ...grpc.CallOption) (*CountProductApplicationsResp, error)
 	}
 
 	defaultLease struct {
@@ -90,3 +95,9 @@ func (m *defaultLease) ListLeaseApprovals(ctx context.Context, in *ListLeaseAppr
 	client := lease.NewLeaseClient(m.cli.Conn())
 	return client.ListLeaseApprovals(ctx, in, opts...)
 }
+
+// 产品引用检查
+func (m *defaultLease) CountProductApplications(ctx context.Context, in *CountProductApplicationsReq, opts ...grpc.CallOption) (*CountProductApplicationsResp, error) {
+	client := lease.NewLeaseClient(m.cli.Conn())
+	return client.CountProductApplications(ctx, in, opts...)
+}
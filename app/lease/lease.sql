@@ -33,11 +33,17 @@ CREATE TABLE `lease_applications` (
   `delivery_address` varchar(500) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '交付地址',
   `contact_phone` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '联系电话',
   `purpose` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT '使用目的',
+  `product_snapshot` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT '产品条款快照(JSON),创建申请时记录日租金、押金、租期等',
+  `unit_id` bigint UNSIGNED NOT NULL DEFAULT 0 COMMENT '分配的设备ID,审批通过时由租赁产品服务分配,0表示未分配',
+  `unit_serial_number` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '分配的设备序列号',
   `status` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT 'pending' COMMENT '状态 pending/approved/rejected/cancelled',
+  `version` int UNSIGNED NOT NULL DEFAULT 0 COMMENT '乐观锁版本号',
+  `idempotency_key` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT NULL COMMENT '幂等键(客户端Idempotency-Key)',
   `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
   PRIMARY KEY (`id`),
   UNIQUE KEY `uk_application_id` (`application_id`),
+  UNIQUE KEY `uk_user_idempotency_key` (`user_id`, `idempotency_key`),
   KEY `idx_user_id` (`user_id`),
   KEY `idx_product_id` (`product_id`),
   KEY `idx_status` (`status`)
@@ -53,6 +59,9 @@ CREATE TABLE `lease_approvals` (
   `auditor_id` bigint UNSIGNED NOT NULL COMMENT '审核员ID',
   `auditor_name` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '审核员姓名',
   `action` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '审批动作 approve/reject',
+  `stage` int UNSIGNED DEFAULT 1 COMMENT '审批环节序号,从1开始',
+  `stage_name` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '审批环节名称',
+  `auditor_role` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '审核员角色 admin/operator',
   `suggestions` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT '审批意见',
   `approved_duration` int UNSIGNED DEFAULT NULL COMMENT '批准租期(天)',
   `approved_amount` decimal(10,2) DEFAULT NULL COMMENT '批准金额',
@@ -64,6 +73,27 @@ CREATE TABLE `lease_approvals` (
   KEY `idx_action` (`action`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='租赁审批记录表';
 
+-- ----------------------------
+-- 租赁库存预占Saga表
+-- ----------------------------
+DROP TABLE IF EXISTS `lease_inventory_sagas`;
+CREATE TABLE `lease_inventory_sagas` (
+  `id` bigint UNSIGNED NOT NULL AUTO_INCREMENT COMMENT 'Saga记录ID',
+  `application_id` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '申请编号',
+  `product_code` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '产品编码',
+  `quantity` int UNSIGNED NOT NULL DEFAULT 1 COMMENT '预占数量',
+  `start_date` date NOT NULL COMMENT '租期开始日期',
+  `end_date` date NOT NULL COMMENT '租期结束日期',
+  `status` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '状态 reserving:预占中 reserved:已预占 completed:已完成 compensating:补偿中 compensated:已补偿 releasing:释放中 released:已释放',
+  `attempts` int UNSIGNED NOT NULL DEFAULT 1 COMMENT '审批预占次数,补偿后再次审批时递增',
+  `last_error` varchar(500) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '最近一次失败原因',
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_application_id` (`application_id`),
+  KEY `idx_status_updated` (`status`, `updated_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='租赁库存预占Saga表';
+
 -- ----------------------------
 -- 初始化数据
 -- ----------------------------
@@ -0,0 +1,30 @@
+package admin
+
+import (
+	"net/http"
+
+	"api/internal/logic/admin"
+	"api/internal/svc"
+	"api/internal/types"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 获取产品设备台账
+func ListEquipmentUnitsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ListEquipmentUnitsReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewListEquipmentUnitsLogic(r.Context(), svcCtx)
+		resp, err := l.ListEquipmentUnits(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
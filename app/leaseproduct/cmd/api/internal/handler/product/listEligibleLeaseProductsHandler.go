@@ -0,0 +1,30 @@
+package product
+
+import (
+	"net/http"
+
+	"api/internal/logic/product"
+	"api/internal/svc"
+	"api/internal/types"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 获取当前用户满足准入条件的租赁产品列表
+func ListEligibleLeaseProductsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ListLeaseProductsReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := product.NewListEligibleLeaseProductsLogic(r.Context(), svcCtx)
+		resp, err := l.ListEligibleLeaseProducts(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package product
+
+import (
+	"net/http"
+
+	"api/internal/logic/product"
+	"api/internal/svc"
+	"api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 租赁报价
+func QuoteLeaseHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.QuoteLeaseReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := product.NewQuoteLeaseLogic(r.Context(), svcCtx)
+		resp, err := l.QuoteLease(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -48,6 +48,48 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/products/:productCode",
 					Handler: admin.DeleteLeaseProductHandler(serverCtx),
 				},
+				{
+					// 排期上下架租赁产品
+					Method:  http.MethodPut,
+					Path:    "/products/:productCode/schedule",
+					Handler: admin.ScheduleLeaseProductHandler(serverCtx),
+				},
+				{
+					// 获取产品设备台账
+					Method:  http.MethodGet,
+					Path:    "/products/:productCode/units",
+					Handler: admin.ListEquipmentUnitsHandler(serverCtx),
+				},
+				{
+					// 登记设备
+					Method:  http.MethodPost,
+					Path:    "/products/:productCode/units",
+					Handler: admin.CreateEquipmentUnitHandler(serverCtx),
+				},
+				{
+					// 获取租赁产品版本历史
+					Method:  http.MethodGet,
+					Path:    "/products/:productCode/versions",
+					Handler: admin.ListLeaseProductVersionsHandler(serverCtx),
+				},
+				{
+					// 获取设备详情
+					Method:  http.MethodGet,
+					Path:    "/units/:id",
+					Handler: admin.GetEquipmentUnitHandler(serverCtx),
+				},
+				{
+					// 更新设备
+					Method:  http.MethodPut,
+					Path:    "/units/:id",
+					Handler: admin.UpdateEquipmentUnitHandler(serverCtx),
+				},
+				{
+					// 删除设备
+					Method:  http.MethodDelete,
+					Path:    "/units/:id",
+					Handler: admin.DeleteEquipmentUnitHandler(serverCtx),
+				},
 			}...,
 		),
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
@@ -74,7 +116,32 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/products/check-inventory",
 				Handler: product.CheckInventoryAvailabilityHandler(serverCtx),
 			},
+			{
+				// 租赁报价
+				Method:  http.MethodPost,
+				Path:    "/products/quote",
+				Handler: product.QuoteLeaseHandler(serverCtx),
+			},
+		},
+		rest.WithPrefix("/api/v1/leaseproduct"),
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 获取当前用户满足准入条件的租赁产品列表
+				Method:  http.MethodGet,
+				Path:    "/eligible-products",
+				Handler: product.ListEligibleLeaseProductsHandler(serverCtx),
+			},
+			{
+				// 检查当前用户是否满足产品准入条件
+				Method:  http.MethodGet,
+				Path:    "/products/:productCode/eligibility",
+				Handler: product.CheckEligibilityHandler(serverCtx),
+			},
 		},
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
 		rest.WithPrefix("/api/v1/leaseproduct"),
 	)
 }
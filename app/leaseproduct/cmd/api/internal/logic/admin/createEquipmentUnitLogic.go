@@ -0,0 +1,51 @@
+package admin
+
+import (
+	"context"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/leaseproductservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateEquipmentUnitLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 登记设备
+func NewCreateEquipmentUnitLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateEquipmentUnitLogic {
+	return &CreateEquipmentUnitLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateEquipmentUnitLogic) CreateEquipmentUnit(req *types.CreateEquipmentUnitReq) (resp *types.CreateEquipmentUnitResp, err error) {
+	// 调用RPC服务 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "leaseproduct-rpc", func() (*leaseproductservice.CreateEquipmentUnitResp, error) {
+		return l.svcCtx.LeaseProductRpc.CreateEquipmentUnit(l.ctx, &leaseproductservice.CreateEquipmentUnitReq{
+			ProductCode:  req.ProductCode,
+			SerialNumber: req.SerialNumber,
+			PurchaseDate: req.PurchaseDate,
+			HoursUsed:    req.HoursUsed,
+			Location:     req.Location,
+			Condition:    req.Condition,
+			Remark:       req.Remark,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return nil, err
+	}
+
+	// 转换响应数据
+	return &types.CreateEquipmentUnitResp{
+		Data: *convertEquipmentUnit(rpcResp.Data),
+	}, nil
+}
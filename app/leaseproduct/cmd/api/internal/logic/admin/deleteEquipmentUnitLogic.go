@@ -0,0 +1,43 @@
+package admin
+
+import (
+	"context"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/leaseproductservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteEquipmentUnitLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 删除设备
+func NewDeleteEquipmentUnitLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteEquipmentUnitLogic {
+	return &DeleteEquipmentUnitLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteEquipmentUnitLogic) DeleteEquipmentUnit(req *types.DeleteEquipmentUnitReq) (resp *types.DeleteEquipmentUnitResp, err error) {
+	// 调用RPC服务 - 使用熔断器
+	_, err = breaker.DoWithBreakerResultAcceptable(l.ctx, "leaseproduct-rpc", func() (*leaseproductservice.DeleteEquipmentUnitResp, error) {
+		return l.svcCtx.LeaseProductRpc.DeleteEquipmentUnit(l.ctx, &leaseproductservice.DeleteEquipmentUnitReq{
+			Id: req.Id,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return nil, err
+	}
+
+	// 返回响应
+	return &types.DeleteEquipmentUnitResp{}, nil
+}
@@ -0,0 +1,45 @@
+package admin
+
+import (
+	"context"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/leaseproductservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetEquipmentUnitLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取设备详情
+func NewGetEquipmentUnitLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetEquipmentUnitLogic {
+	return &GetEquipmentUnitLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetEquipmentUnitLogic) GetEquipmentUnit(req *types.GetEquipmentUnitReq) (resp *types.GetEquipmentUnitResp, err error) {
+	// 调用RPC服务 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "leaseproduct-rpc", func() (*leaseproductservice.GetEquipmentUnitResp, error) {
+		return l.svcCtx.LeaseProductRpc.GetEquipmentUnit(l.ctx, &leaseproductservice.GetEquipmentUnitReq{
+			Id: req.Id,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return nil, err
+	}
+
+	// 转换响应数据
+	return &types.GetEquipmentUnitResp{
+		Data: *convertEquipmentUnit(rpcResp.Data),
+	}, nil
+}
@@ -53,23 +53,29 @@ func (l *ListAllLeaseProductsLogic) ListAllLeaseProducts(req *types.ListLeasePro
 	var products []types.LeaseProductInfo
 	for _, item := range rpcResp.List {
 		products = append(products, types.LeaseProductInfo{
-			Id:             item.Id,
-			ProductCode:    item.ProductCode,
-			Name:           item.Name,
-			Type:           item.Type,
-			Machinery:      item.Machinery,
-			Brand:          item.Brand,
-			Model:          item.Model,
-			DailyRate:      item.DailyRate,
-			Deposit:        item.Deposit,
-			MaxDuration:    item.MaxDuration,
-			MinDuration:    item.MinDuration,
-			Description:    item.Description,
-			InventoryCount: item.InventoryCount,
-			AvailableCount: item.AvailableCount,
-			Status:         item.Status,
-			CreatedAt:      item.CreatedAt,
-			UpdatedAt:      item.UpdatedAt,
+			Id:              item.Id,
+			ProductCode:     item.ProductCode,
+			Name:            item.Name,
+			Type:            item.Type,
+			Machinery:       item.Machinery,
+			Brand:           item.Brand,
+			Model:           item.Model,
+			DailyRate:       item.DailyRate,
+			Deposit:         item.Deposit,
+			MaxDuration:     item.MaxDuration,
+			MinDuration:     item.MinDuration,
+			Description:     item.Description,
+			ApprovalChain:   item.ApprovalChain,
+			InventoryCount:  item.InventoryCount,
+			AvailableCount:  item.AvailableCount,
+			Status:          item.Status,
+			CreatedAt:       item.CreatedAt,
+			UpdatedAt:       item.UpdatedAt,
+			Version:         item.Version,
+			LaunchAt:        item.LaunchAt,
+			DelistAt:        item.DelistAt,
+			EligibilityRule: item.EligibilityRule,
+			PricingRule:     item.PricingRule,
 		})
 	}
 
@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"context"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/leaseproductservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListEquipmentUnitsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取产品设备台账
+func NewListEquipmentUnitsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListEquipmentUnitsLogic {
+	return &ListEquipmentUnitsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListEquipmentUnitsLogic) ListEquipmentUnits(req *types.ListEquipmentUnitsReq) (resp *types.ListEquipmentUnitsResp, err error) {
+	// 调用RPC服务 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "leaseproduct-rpc", func() (*leaseproductservice.ListEquipmentUnitsResp, error) {
+		return l.svcCtx.LeaseProductRpc.ListEquipmentUnits(l.ctx, &leaseproductservice.ListEquipmentUnitsReq{
+			ProductCode: req.ProductCode,
+			Status:      req.Status,
+			Page:        req.Page,
+			Size:        req.Size,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]types.EquipmentUnitInfo, 0, len(rpcResp.List))
+	for _, unit := range rpcResp.List {
+		list = append(list, *convertEquipmentUnit(unit))
+	}
+
+	return &types.ListEquipmentUnitsResp{
+		List:  list,
+		Total: rpcResp.Total,
+	}, nil
+}
+
+// convertEquipmentUnit 转换设备台账
+func convertEquipmentUnit(unit *leaseproductservice.EquipmentUnitInfo) *types.EquipmentUnitInfo {
+	return &types.EquipmentUnitInfo{
+		Id:            unit.Id,
+		ProductId:     unit.ProductId,
+		ProductCode:   unit.ProductCode,
+		SerialNumber:  unit.SerialNumber,
+		PurchaseDate:  unit.PurchaseDate,
+		HoursUsed:     unit.HoursUsed,
+		Location:      unit.Location,
+		Condition:     unit.Condition,
+		Status:        unit.Status,
+		ApplicationId: unit.ApplicationId,
+		Remark:        unit.Remark,
+		CreatedAt:     unit.CreatedAt,
+		UpdatedAt:     unit.UpdatedAt,
+	}
+}
@@ -0,0 +1,74 @@
+package admin
+
+import (
+	"context"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListLeaseProductVersionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取租赁产品版本历史
+func NewListLeaseProductVersionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListLeaseProductVersionsLogic {
+	return &ListLeaseProductVersionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListLeaseProductVersionsLogic) ListLeaseProductVersions(req *types.ListLeaseProductVersionsReq) (resp *types.ListLeaseProductVersionsResp, err error) {
+	// 调用RPC服务 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "leaseproduct-rpc", func() (*leaseproduct.ListLeaseProductVersionsResp, error) {
+		return l.svcCtx.LeaseProductRpc.ListLeaseProductVersions(l.ctx, &leaseproduct.ListLeaseProductVersionsReq{
+			ProductCode: req.ProductCode,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]types.LeaseProductVersionInfo, 0, len(rpcResp.List))
+	for _, version := range rpcResp.List {
+		list = append(list, *convertProductVersion(version))
+	}
+
+	return &types.ListLeaseProductVersionsResp{
+		List: list,
+	}, nil
+}
+
+// convertProductVersion 转换产品条款版本
+func convertProductVersion(version *leaseproduct.LeaseProductVersionInfo) *types.LeaseProductVersionInfo {
+	changes := make([]types.ProductVersionChange, 0, len(version.Changes))
+	for _, change := range version.Changes {
+		changes = append(changes, types.ProductVersionChange{
+			Field: change.Field,
+			From:  change.From,
+			To:    change.To,
+		})
+	}
+
+	return &types.LeaseProductVersionInfo{
+		Id:            version.Id,
+		ProductId:     version.ProductId,
+		Version:       version.Version,
+		Status:        version.Status,
+		Changes:       changes,
+		EffectiveFrom: version.EffectiveFrom,
+		EffectiveTo:   version.EffectiveTo,
+		OperatorId:    version.OperatorId,
+		OperatorName:  version.OperatorName,
+		CreatedAt:     version.CreatedAt,
+	}
+}
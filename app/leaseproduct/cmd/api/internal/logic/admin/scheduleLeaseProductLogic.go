@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"context"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/leaseproductservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ScheduleLeaseProductLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 排期上下架租赁产品
+func NewScheduleLeaseProductLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ScheduleLeaseProductLogic {
+	return &ScheduleLeaseProductLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ScheduleLeaseProductLogic) ScheduleLeaseProduct(req *types.ScheduleLeaseProductReq) (resp *types.ScheduleLeaseProductResp, err error) {
+	// 调用RPC服务 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "leaseproduct-rpc", func() (*leaseproductservice.ScheduleLeaseProductResp, error) {
+		return l.svcCtx.LeaseProductRpc.ScheduleLeaseProduct(l.ctx, &leaseproductservice.ScheduleLeaseProductReq{
+			ProductCode: req.ProductCode,
+			LaunchAt:    req.LaunchAt,
+			DelistAt:    req.DelistAt,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return nil, err
+	}
+
+	// 转换响应数据
+	return &types.ScheduleLeaseProductResp{
+		Data: types.LeaseProductInfo{
+			Id:              rpcResp.Data.Id,
+			ProductCode:     rpcResp.Data.ProductCode,
+			Name:            rpcResp.Data.Name,
+			Type:            rpcResp.Data.Type,
+			Machinery:       rpcResp.Data.Machinery,
+			Brand:           rpcResp.Data.Brand,
+			Model:           rpcResp.Data.Model,
+			DailyRate:       rpcResp.Data.DailyRate,
+			Deposit:         rpcResp.Data.Deposit,
+			MaxDuration:     rpcResp.Data.MaxDuration,
+			MinDuration:     rpcResp.Data.MinDuration,
+			Description:     rpcResp.Data.Description,
+			ApprovalChain:   rpcResp.Data.ApprovalChain,
+			InventoryCount:  rpcResp.Data.InventoryCount,
+			AvailableCount:  rpcResp.Data.AvailableCount,
+			Status:          rpcResp.Data.Status,
+			CreatedAt:       rpcResp.Data.CreatedAt,
+			UpdatedAt:       rpcResp.Data.UpdatedAt,
+			Version:         rpcResp.Data.Version,
+			LaunchAt:        rpcResp.Data.LaunchAt,
+			DelistAt:        rpcResp.Data.DelistAt,
+			EligibilityRule: rpcResp.Data.EligibilityRule,
+			PricingRule:     rpcResp.Data.PricingRule,
+		},
+	}, nil
+}
@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"context"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/leaseproductservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateEquipmentUnitLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 更新设备
+func NewUpdateEquipmentUnitLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateEquipmentUnitLogic {
+	return &UpdateEquipmentUnitLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateEquipmentUnitLogic) UpdateEquipmentUnit(req *types.UpdateEquipmentUnitReq) (resp *types.UpdateEquipmentUnitResp, err error) {
+	// 调用RPC服务 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "leaseproduct-rpc", func() (*leaseproductservice.UpdateEquipmentUnitResp, error) {
+		return l.svcCtx.LeaseProductRpc.UpdateEquipmentUnit(l.ctx, &leaseproductservice.UpdateEquipmentUnitReq{
+			Id:           req.Id,
+			SerialNumber: req.SerialNumber,
+			PurchaseDate: req.PurchaseDate,
+			HoursUsed:    req.HoursUsed,
+			Location:     req.Location,
+			Condition:    req.Condition,
+			Status:       req.Status,
+			Remark:       req.Remark,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return nil, err
+	}
+
+	// 转换响应数据
+	return &types.UpdateEquipmentUnitResp{
+		Data: *convertEquipmentUnit(rpcResp.Data),
+	}, nil
+}
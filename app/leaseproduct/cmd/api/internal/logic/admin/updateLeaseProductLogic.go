@@ -2,10 +2,13 @@ package admin
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
 
+	"api/internal/breaker"
 	"api/internal/svc"
 	"api/internal/types"
-	"api/internal/breaker"
 	"rpc/leaseproduct"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -27,20 +30,35 @@ func NewUpdateLeaseProductLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 }
 
 func (l *UpdateLeaseProductLogic) UpdateLeaseProduct(req *types.UpdateLeaseProductReq) (resp *types.UpdateLeaseProductResp, err error) {
+	// 获取操作人信息 (从JWT中获取),用于记录产品版本的修改人
+	operatorId, err := l.getUserIdFromJWT()
+	if err != nil {
+		l.Errorf("获取操作人ID失败: %v", err)
+		return nil, err
+	}
+	operatorName := l.getUserNameFromJWT()
+
 	// 调用RPC服务 - 使用熔断器
 	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "leaseproduct-rpc", func() (*leaseproduct.UpdateLeaseProductResp, error) {
 		return l.svcCtx.LeaseProductRpc.UpdateLeaseProduct(l.ctx, &leaseproduct.UpdateLeaseProductReq{
-			ProductCode:    req.ProductCode,
-			Name:           req.Name,
-			Type:           req.Type,
-			Machinery:      req.Machinery,
-			Brand:          req.Brand,
-			Model:          req.Model,
-			DailyRate:      req.DailyRate,
-			Deposit:        req.Deposit,
-			MaxDuration:    req.MaxDuration,
-			MinDuration:    req.MinDuration,
-			Description:    req.Description,
+			ProductCode:     req.ProductCode,
+			Name:            req.Name,
+			Type:            req.Type,
+			Machinery:       req.Machinery,
+			Brand:           req.Brand,
+			Model:           req.Model,
+			DailyRate:       req.DailyRate,
+			Deposit:         req.Deposit,
+			MaxDuration:     req.MaxDuration,
+			MinDuration:     req.MinDuration,
+			Description:     req.Description,
+			ApprovalChain:   req.ApprovalChain,
+			EligibilityRule: req.EligibilityRule,
+			PricingRule:     req.PricingRule,
+			Status:          req.Status,
+			EffectiveFrom:   req.EffectiveFrom,
+			OperatorId:      operatorId,
+			OperatorName:    operatorName,
 		})
 	}, breaker.IsAcceptableError)
 	if err != nil {
@@ -48,26 +66,116 @@ func (l *UpdateLeaseProductLogic) UpdateLeaseProduct(req *types.UpdateLeaseProdu
 		return nil, err
 	}
 
+	// 本次修改产生的版本,条款无变化时为空
+	var version *types.LeaseProductVersionInfo
+	if rpcResp.Version != nil {
+		version = convertProductVersion(rpcResp.Version)
+	}
+
 	// 转换响应数据
 	return &types.UpdateLeaseProductResp{
 		Data: types.LeaseProductInfo{
-			Id:             rpcResp.Data.Id,
-			ProductCode:    rpcResp.Data.ProductCode,
-			Name:           rpcResp.Data.Name,
-			Type:           rpcResp.Data.Type,
-			Machinery:      rpcResp.Data.Machinery,
-			Brand:          rpcResp.Data.Brand,
-			Model:          rpcResp.Data.Model,
-			DailyRate:      rpcResp.Data.DailyRate,
-			Deposit:        rpcResp.Data.Deposit,
-			MaxDuration:    rpcResp.Data.MaxDuration,
-			MinDuration:    rpcResp.Data.MinDuration,
-			Description:    rpcResp.Data.Description,
-			InventoryCount: rpcResp.Data.InventoryCount,
-			AvailableCount: rpcResp.Data.AvailableCount,
-			Status:         rpcResp.Data.Status,
-			CreatedAt:      rpcResp.Data.CreatedAt,
-			UpdatedAt:      rpcResp.Data.UpdatedAt,
+			Id:              rpcResp.Data.Id,
+			ProductCode:     rpcResp.Data.ProductCode,
+			Name:            rpcResp.Data.Name,
+			Type:            rpcResp.Data.Type,
+			Machinery:       rpcResp.Data.Machinery,
+			Brand:           rpcResp.Data.Brand,
+			Model:           rpcResp.Data.Model,
+			DailyRate:       rpcResp.Data.DailyRate,
+			Deposit:         rpcResp.Data.Deposit,
+			MaxDuration:     rpcResp.Data.MaxDuration,
+			MinDuration:     rpcResp.Data.MinDuration,
+			Description:     rpcResp.Data.Description,
+			ApprovalChain:   rpcResp.Data.ApprovalChain,
+			InventoryCount:  rpcResp.Data.InventoryCount,
+			AvailableCount:  rpcResp.Data.AvailableCount,
+			Status:          rpcResp.Data.Status,
+			CreatedAt:       rpcResp.Data.CreatedAt,
+			UpdatedAt:       rpcResp.Data.UpdatedAt,
+			Version:         rpcResp.Data.Version,
+			LaunchAt:        rpcResp.Data.LaunchAt,
+			DelistAt:        rpcResp.Data.DelistAt,
+			EligibilityRule: rpcResp.Data.EligibilityRule,
+			PricingRule:     rpcResp.Data.PricingRule,
 		},
+		Version: version,
 	}, nil
 }
+
+// 从JWT中获取用户ID的辅助方法
+func (l *UpdateLeaseProductLogic) getUserIdFromJWT() (int64, error) {
+	// 方法1: go-zero标准方式 - 处理json.Number类型
+	if userIdVal := l.ctx.Value("user_id"); userIdVal != nil {
+		// go-zero将JWT中的数字转换为json.Number类型
+		if jsonUid, ok := userIdVal.(json.Number); ok {
+			if int64Uid, err := jsonUid.Int64(); err == nil {
+				return int64Uid, nil
+			} else {
+				logx.WithContext(l.ctx).Errorf("JWT user_id转换失败: %v", err)
+			}
+		}
+		// 备用：尝试其他类型
+		if userId, ok := userIdVal.(float64); ok {
+			return int64(userId), nil
+		}
+		if userId, ok := userIdVal.(int64); ok {
+			return userId, nil
+		}
+		if userIdStr, ok := userIdVal.(string); ok {
+			return strconv.ParseInt(userIdStr, 10, 64)
+		}
+	}
+
+	// 方法2: 尝试从context的其他可能字段获取
+	if userIdVal := l.ctx.Value("userId"); userIdVal != nil {
+		if jsonUid, ok := userIdVal.(json.Number); ok {
+			if int64Uid, err := jsonUid.Int64(); err == nil {
+				return int64Uid, nil
+			}
+		}
+		if userId, ok := userIdVal.(float64); ok {
+			return int64(userId), nil
+		}
+		if userId, ok := userIdVal.(int64); ok {
+			return userId, nil
+		}
+		if userIdStr, ok := userIdVal.(string); ok {
+			return strconv.ParseInt(userIdStr, 10, 64)
+		}
+	}
+
+	// 方法3: 尝试从JWT标准字段获取 (sub字段通常包含用户ID)
+	if subVal := l.ctx.Value("sub"); subVal != nil {
+		if jsonSub, ok := subVal.(json.Number); ok {
+			if int64Sub, err := jsonSub.Int64(); err == nil {
+				return int64Sub, nil
+			}
+		}
+		if subStr, ok := subVal.(string); ok {
+			return strconv.ParseInt(subStr, 10, 64)
+		}
+	}
+
+	return 0, fmt.Errorf("无法从JWT中获取用户ID")
+}
+
+// 从JWT中获取用户名的辅助方法
+func (l *UpdateLeaseProductLogic) getUserNameFromJWT() string {
+	if nameVal := l.ctx.Value("username"); nameVal != nil {
+		if name, ok := nameVal.(string); ok {
+			return name
+		}
+	}
+	if nameVal := l.ctx.Value("name"); nameVal != nil {
+		if name, ok := nameVal.(string); ok {
+			return name
+		}
+	}
+	if phoneVal := l.ctx.Value("phone"); phoneVal != nil {
+		if phone, ok := phoneVal.(string); ok {
+			return phone // 如果没有用户名，使用手机号
+		}
+	}
+	return ""
+}
@@ -0,0 +1,124 @@
+package product
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/leaseproductservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckEligibilityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 检查当前用户是否满足产品准入条件
+func NewCheckEligibilityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckEligibilityLogic {
+	return &CheckEligibilityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CheckEligibilityLogic) CheckEligibility(req *types.CheckEligibilityReq) (resp *types.CheckEligibilityResp, err error) {
+	// 获取当前用户ID (从JWT中获取)
+	userId, err := l.getUserIdFromJWT()
+	if err != nil {
+		l.Errorf("获取用户ID失败: %v", err)
+		return nil, err
+	}
+
+	// 调用RPC服务 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "leaseproduct-rpc", func() (*leaseproductservice.CheckEligibilityResp, error) {
+		return l.svcCtx.LeaseProductRpc.CheckEligibility(l.ctx, &leaseproductservice.CheckEligibilityReq{
+			ProductCode: req.ProductCode,
+			UserId:      userId,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return nil, err
+	}
+
+	// 转换响应数据
+	reasons := make([]types.EligibilityReason, 0, len(rpcResp.Reasons))
+	for _, reason := range rpcResp.Reasons {
+		reasons = append(reasons, types.EligibilityReason{
+			Code:    reason.Code,
+			Message: reason.Message,
+			Limit:   reason.Limit,
+			Actual:  reason.Actual,
+		})
+	}
+
+	return &types.CheckEligibilityResp{
+		Eligible: rpcResp.Eligible,
+		Reasons:  reasons,
+	}, nil
+}
+
+// 从JWT中获取用户ID的辅助方法
+func (l *CheckEligibilityLogic) getUserIdFromJWT() (int64, error) {
+	// 方法1: go-zero标准方式 - 处理json.Number类型
+	if userIdVal := l.ctx.Value("user_id"); userIdVal != nil {
+		// go-zero将JWT中的数字转换为json.Number类型
+		if jsonUid, ok := userIdVal.(json.Number); ok {
+			if int64Uid, err := jsonUid.Int64(); err == nil {
+				return int64Uid, nil
+			} else {
+				logx.WithContext(l.ctx).Errorf("JWT user_id转换失败: %v", err)
+			}
+		}
+		// 备用：尝试其他类型
+		if userId, ok := userIdVal.(float64); ok {
+			return int64(userId), nil
+		}
+		if userId, ok := userIdVal.(int64); ok {
+			return userId, nil
+		}
+		if userIdStr, ok := userIdVal.(string); ok {
+			return strconv.ParseInt(userIdStr, 10, 64)
+		}
+	}
+
+	// 方法2: 尝试从context的其他可能字段获取
+	if userIdVal := l.ctx.Value("userId"); userIdVal != nil {
+		if jsonUid, ok := userIdVal.(json.Number); ok {
+			if int64Uid, err := jsonUid.Int64(); err == nil {
+				return int64Uid, nil
+			}
+		}
+		if userId, ok := userIdVal.(float64); ok {
+			return int64(userId), nil
+		}
+		if userId, ok := userIdVal.(int64); ok {
+			return userId, nil
+		}
+		if userIdStr, ok := userIdVal.(string); ok {
+			return strconv.ParseInt(userIdStr, 10, 64)
+		}
+	}
+
+	// 方法3: 尝试从JWT标准字段获取 (sub字段通常包含用户ID)
+	if subVal := l.ctx.Value("sub"); subVal != nil {
+		if jsonSub, ok := subVal.(json.Number); ok {
+			if int64Sub, err := jsonSub.Int64(); err == nil {
+				return int64Sub, nil
+			}
+		}
+		if subStr, ok := subVal.(string); ok {
+			return strconv.ParseInt(subStr, 10, 64)
+		}
+	}
+
+	return 0, fmt.Errorf("无法从JWT中获取用户ID")
+}
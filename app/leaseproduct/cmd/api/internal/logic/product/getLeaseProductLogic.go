@@ -41,23 +41,29 @@ func (l *GetLeaseProductLogic) GetLeaseProduct(productCode string) (resp *types.
 	// 转换响应数据
 	return &types.GetLeaseProductResp{
 		Data: types.LeaseProductInfo{
-			Id:             rpcResp.Data.Id,
-			ProductCode:    rpcResp.Data.ProductCode,
-			Name:           rpcResp.Data.Name,
-			Type:           rpcResp.Data.Type,
-			Machinery:      rpcResp.Data.Machinery,
-			Brand:          rpcResp.Data.Brand,
-			Model:          rpcResp.Data.Model,
-			DailyRate:      rpcResp.Data.DailyRate,
-			Deposit:        rpcResp.Data.Deposit,
-			MaxDuration:    rpcResp.Data.MaxDuration,
-			MinDuration:    rpcResp.Data.MinDuration,
-			Description:    rpcResp.Data.Description,
-			InventoryCount: rpcResp.Data.InventoryCount,
-			AvailableCount: rpcResp.Data.AvailableCount,
-			Status:         rpcResp.Data.Status,
-			CreatedAt:      rpcResp.Data.CreatedAt,
-			UpdatedAt:      rpcResp.Data.UpdatedAt,
+			Id:              rpcResp.Data.Id,
+			ProductCode:     rpcResp.Data.ProductCode,
+			Name:            rpcResp.Data.Name,
+			Type:            rpcResp.Data.Type,
+			Machinery:       rpcResp.Data.Machinery,
+			Brand:           rpcResp.Data.Brand,
+			Model:           rpcResp.Data.Model,
+			DailyRate:       rpcResp.Data.DailyRate,
+			Deposit:         rpcResp.Data.Deposit,
+			MaxDuration:     rpcResp.Data.MaxDuration,
+			MinDuration:     rpcResp.Data.MinDuration,
+			Description:     rpcResp.Data.Description,
+			ApprovalChain:   rpcResp.Data.ApprovalChain,
+			InventoryCount:  rpcResp.Data.InventoryCount,
+			AvailableCount:  rpcResp.Data.AvailableCount,
+			Status:          rpcResp.Data.Status,
+			CreatedAt:       rpcResp.Data.CreatedAt,
+			UpdatedAt:       rpcResp.Data.UpdatedAt,
+			Version:         rpcResp.Data.Version,
+			LaunchAt:        rpcResp.Data.LaunchAt,
+			DelistAt:        rpcResp.Data.DelistAt,
+			EligibilityRule: rpcResp.Data.EligibilityRule,
+			PricingRule:     rpcResp.Data.PricingRule,
 		},
 	}, nil
 }
@@ -0,0 +1,155 @@
+package product
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListEligibleLeaseProductsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取当前用户满足准入条件的租赁产品列表
+func NewListEligibleLeaseProductsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListEligibleLeaseProductsLogic {
+	return &ListEligibleLeaseProductsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListEligibleLeaseProductsLogic) ListEligibleLeaseProducts(req *types.ListLeaseProductsReq) (resp *types.ListLeaseProductsResp, err error) {
+	// 获取当前用户ID (从JWT中获取)
+	userId, err := l.getUserIdFromJWT()
+	if err != nil {
+		l.Errorf("获取用户ID失败: %v", err)
+		return nil, err
+	}
+
+	// 设置默认值
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Size <= 0 {
+		req.Size = 10
+	}
+
+	// 调用RPC服务 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "leaseproduct-rpc", func() (*leaseproduct.ListLeaseProductsResp, error) {
+		return l.svcCtx.LeaseProductRpc.ListLeaseProducts(l.ctx, &leaseproduct.ListLeaseProductsReq{
+			Page:    req.Page,
+			Size:    req.Size,
+			Type:    req.Type,
+			Brand:   req.Brand,
+			Keyword: req.Keyword,
+			UserId:  userId, // 只返回该用户满足准入规则的上架产品
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return nil, err
+	}
+
+	// 转换产品列表数据
+	var products []types.LeaseProductInfo
+	for _, item := range rpcResp.List {
+		products = append(products, types.LeaseProductInfo{
+			Id:              item.Id,
+			ProductCode:     item.ProductCode,
+			Name:            item.Name,
+			Type:            item.Type,
+			Machinery:       item.Machinery,
+			Brand:           item.Brand,
+			Model:           item.Model,
+			DailyRate:       item.DailyRate,
+			Deposit:         item.Deposit,
+			MaxDuration:     item.MaxDuration,
+			MinDuration:     item.MinDuration,
+			Description:     item.Description,
+			ApprovalChain:   item.ApprovalChain,
+			InventoryCount:  item.InventoryCount,
+			AvailableCount:  item.AvailableCount,
+			Status:          item.Status,
+			CreatedAt:       item.CreatedAt,
+			UpdatedAt:       item.UpdatedAt,
+			Version:         item.Version,
+			LaunchAt:        item.LaunchAt,
+			DelistAt:        item.DelistAt,
+			EligibilityRule: item.EligibilityRule,
+			PricingRule:     item.PricingRule,
+		})
+	}
+
+	return &types.ListLeaseProductsResp{
+		List:  products,
+		Total: rpcResp.Total,
+	}, nil
+}
+
+// 从JWT中获取用户ID的辅助方法
+func (l *ListEligibleLeaseProductsLogic) getUserIdFromJWT() (int64, error) {
+	// 方法1: go-zero标准方式 - 处理json.Number类型
+	if userIdVal := l.ctx.Value("user_id"); userIdVal != nil {
+		// go-zero将JWT中的数字转换为json.Number类型
+		if jsonUid, ok := userIdVal.(json.Number); ok {
+			if int64Uid, err := jsonUid.Int64(); err == nil {
+				return int64Uid, nil
+			} else {
+				logx.WithContext(l.ctx).Errorf("JWT user_id转换失败: %v", err)
+			}
+		}
+		// 备用：尝试其他类型
+		if userId, ok := userIdVal.(float64); ok {
+			return int64(userId), nil
+		}
+		if userId, ok := userIdVal.(int64); ok {
+			return userId, nil
+		}
+		if userIdStr, ok := userIdVal.(string); ok {
+			return strconv.ParseInt(userIdStr, 10, 64)
+		}
+	}
+
+	// 方法2: 尝试从context的其他可能字段获取
+	if userIdVal := l.ctx.Value("userId"); userIdVal != nil {
+		if jsonUid, ok := userIdVal.(json.Number); ok {
+			if int64Uid, err := jsonUid.Int64(); err == nil {
+				return int64Uid, nil
+			}
+		}
+		if userId, ok := userIdVal.(float64); ok {
+			return int64(userId), nil
+		}
+		if userId, ok := userIdVal.(int64); ok {
+			return userId, nil
+		}
+		if userIdStr, ok := userIdVal.(string); ok {
+			return strconv.ParseInt(userIdStr, 10, 64)
+		}
+	}
+
+	// 方法3: 尝试从JWT标准字段获取 (sub字段通常包含用户ID)
+	if subVal := l.ctx.Value("sub"); subVal != nil {
+		if jsonSub, ok := subVal.(json.Number); ok {
+			if int64Sub, err := jsonSub.Int64(); err == nil {
+				return int64Sub, nil
+			}
+		}
+		if subStr, ok := subVal.(string); ok {
+			return strconv.ParseInt(subStr, 10, 64)
+		}
+	}
+
+	return 0, fmt.Errorf("无法从JWT中获取用户ID")
+}
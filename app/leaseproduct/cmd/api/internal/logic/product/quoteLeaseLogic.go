@@ -0,0 +1,51 @@
+package product
+
+import (
+	"context"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/leaseproductservice"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type QuoteLeaseLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 租赁报价
+func NewQuoteLeaseLogic(ctx context.Context, svcCtx *svc.ServiceContext) *QuoteLeaseLogic {
+	return &QuoteLeaseLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *QuoteLeaseLogic) QuoteLease(req *types.QuoteLeaseReq) (resp *types.QuoteLeaseResp, err error) {
+	// 调用RPC服务 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "leaseproduct-rpc", func() (*leaseproductservice.QuoteLeaseResp, error) {
+		return l.svcCtx.LeaseProductRpc.QuoteLease(l.ctx, &leaseproductservice.QuoteLeaseReq{
+			ProductCode: req.ProductCode,
+			StartDate:   req.StartDate,
+			EndDate:     req.EndDate,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return nil, err
+	}
+
+	// 转换响应数据
+	return &types.QuoteLeaseResp{
+		ProductCode: rpcResp.ProductCode,
+		Duration:    rpcResp.Duration,
+		DailyRate:   rpcResp.DailyRate,
+		TotalAmount: rpcResp.TotalAmount,
+		Deposit:     rpcResp.Deposit,
+	}, nil
+}
@@ -6,6 +6,15 @@ package types
 type BaseResp struct {
 }
 
+type CheckEligibilityReq struct {
+	ProductCode string `path:"productCode"`
+}
+
+type CheckEligibilityResp struct {
+	Eligible bool                `json:"eligible"` // 是否满足准入条件
+	Reasons  []EligibilityReason `json:"reasons"`  // 未满足的条件
+}
+
 type CheckInventoryReq struct {
 	ProductCode string `json:"product_code"`
 	Quantity    int32  `json:"quantity"`
@@ -18,25 +27,49 @@ type CheckInventoryResp struct {
 	AvailableCount int32 `json:"available_count"`
 }
 
+type CreateEquipmentUnitReq struct {
+	ProductCode  string  `path:"productCode"`
+	SerialNumber string  `json:"serial_number"`          // 设备序列号,全局唯一
+	PurchaseDate string  `json:"purchase_date,optional"` // 购置日期 YYYY-MM-DD
+	HoursUsed    float64 `json:"hours_used,optional"`    // 累计使用小时数
+	Location     string  `json:"location,optional"`      // 当前存放位置
+	Condition    string  `json:"condition,optional"`     // 设备状况,为空时为good
+	Remark       string  `json:"remark,optional"`        // 备注
+}
+
+type CreateEquipmentUnitResp struct {
+	Data EquipmentUnitInfo `json:"data"`
+}
+
 type CreateLeaseProductReq struct {
-	ProductCode    string  `json:"product_code"`
-	Name           string  `json:"name"`
-	Type           string  `json:"type"`
-	Machinery      string  `json:"machinery"`
-	Brand          string  `json:"brand"`
-	Model          string  `json:"model"`
-	DailyRate      float64 `json:"daily_rate"`
-	Deposit        float64 `json:"deposit"`
-	MaxDuration    int32   `json:"max_duration"`
-	MinDuration    int32   `json:"min_duration"`
-	Description    string  `json:"description"`
-	InventoryCount int32   `json:"inventory_count"`
+	ProductCode     string  `json:"product_code"`
+	Name            string  `json:"name"`
+	Type            string  `json:"type"`
+	Machinery       string  `json:"machinery"`
+	Brand           string  `json:"brand"`
+	Model           string  `json:"model"`
+	DailyRate       float64 `json:"daily_rate"`
+	Deposit         float64 `json:"deposit"`
+	MaxDuration     int32   `json:"max_duration"`
+	MinDuration     int32   `json:"min_duration"`
+	Description     string  `json:"description"`
+	ApprovalChain   string  `json:"approval_chain,optional"` // 审批链配置(JSON),为空表示单级审批
+	InventoryCount  int32   `json:"inventory_count"`
+	EligibilityRule string  `json:"eligibility_rule,optional"` // 准入规则配置(JSON),如{"occupations":["农","牧"],"min_age":18,"max_age":60,"min_income":3000},为空表示不限制
+	PricingRule     string  `json:"pricing_rule,optional"`     // 定价规则配置(JSON),如{"duration_tiers":[{"min_days":7,"discount":0.95}],"seasons":[{"name":"秋收旺季","start":"09-15","end":"10-15","multiplier":1.5}],"early_booking":[{"min_days_ahead":30,"discount":0.95}]},为空表示按日租金计价
 }
 
 type CreateLeaseProductResp struct {
 	Data LeaseProductInfo `json:"data"` // 添加数据字段
 }
 
+type DeleteEquipmentUnitReq struct {
+	Id int64 `path:"id"`
+}
+
+type DeleteEquipmentUnitResp struct {
+}
+
 type DeleteLeaseProductReq struct {
 	ProductCode string `path:"productCode"`
 }
@@ -44,6 +77,37 @@ type DeleteLeaseProductReq struct {
 type DeleteLeaseProductResp struct {
 }
 
+type EligibilityReason struct {
+	Code    string  `json:"code"`    // occupation:职业不符 age:年龄不符 income:收入不足 profile_gap:资料未填写
+	Message string  `json:"message"` // 原因说明
+	Limit   float64 `json:"limit"`   // 限额
+	Actual  float64 `json:"actual"`  // 实际值
+}
+
+type EquipmentUnitInfo struct {
+	Id            int64   `json:"id"`             // 设备ID
+	ProductId     int64   `json:"product_id"`     // 产品ID
+	ProductCode   string  `json:"product_code"`   // 产品编码
+	SerialNumber  string  `json:"serial_number"`  // 设备序列号
+	PurchaseDate  string  `json:"purchase_date"`  // 购置日期 YYYY-MM-DD,未登记时为空
+	HoursUsed     float64 `json:"hours_used"`     // 累计使用小时数
+	Location      string  `json:"location"`       // 当前存放位置
+	Condition     string  `json:"condition"`      // 设备状况 new:全新 good:良好 fair:一般 poor:较差
+	Status        string  `json:"status"`         // 状态 available:可用 leased:已租出(预占租期覆盖当天) maintenance:维修保养 retired:已退役
+	ApplicationId string  `json:"application_id"` // 当前租用的申请编号,租期覆盖当天的预占有值
+	Remark        string  `json:"remark"`         // 备注
+	CreatedAt     int64   `json:"created_at"`
+	UpdatedAt     int64   `json:"updated_at"`
+}
+
+type GetEquipmentUnitReq struct {
+	Id int64 `path:"id"`
+}
+
+type GetEquipmentUnitResp struct {
+	Data EquipmentUnitInfo `json:"data"`
+}
+
 type GetLeaseProductDetailReq struct {
 	ProductCode string `path:"productCode"`
 }
@@ -57,23 +121,62 @@ type GetLeaseProductResp struct {
 }
 
 type LeaseProductInfo struct {
-	Id             int64   `json:"id"`
-	ProductCode    string  `json:"product_code"`
-	Name           string  `json:"name"`
-	Type           string  `json:"type"`
-	Machinery      string  `json:"machinery"`
-	Brand          string  `json:"brand"`
-	Model          string  `json:"model"`
-	DailyRate      float64 `json:"daily_rate"`
-	Deposit        float64 `json:"deposit"`
-	MaxDuration    int32   `json:"max_duration"` // 修改为int32与RPC一致
-	MinDuration    int32   `json:"min_duration"` // 修改为int32与RPC一致
-	Description    string  `json:"description"`
-	InventoryCount int32   `json:"inventory_count"` // 修改为int32与RPC一致
-	AvailableCount int32   `json:"available_count"` // 修改为int32与RPC一致
-	Status         int32   `json:"status"`          // 修改为int32与RPC一致
-	CreatedAt      int64   `json:"created_at"`
-	UpdatedAt      int64   `json:"updated_at"`
+	Id              int64   `json:"id"`
+	ProductCode     string  `json:"product_code"`
+	Name            string  `json:"name"`
+	Type            string  `json:"type"`
+	Machinery       string  `json:"machinery"`
+	Brand           string  `json:"brand"`
+	Model           string  `json:"model"`
+	DailyRate       float64 `json:"daily_rate"`
+	Deposit         float64 `json:"deposit"`
+	MaxDuration     int32   `json:"max_duration"` // 修改为int32与RPC一致
+	MinDuration     int32   `json:"min_duration"` // 修改为int32与RPC一致
+	Description     string  `json:"description"`
+	ApprovalChain   string  `json:"approval_chain"`  // 审批链配置(JSON),为空表示单级审批
+	InventoryCount  int32   `json:"inventory_count"` // 修改为int32与RPC一致
+	AvailableCount  int32   `json:"available_count"` // 修改为int32与RPC一致
+	Status          int32   `json:"status"`          // 修改为int32与RPC一致
+	CreatedAt       int64   `json:"created_at"`
+	UpdatedAt       int64   `json:"updated_at"`
+	Version         int32   `json:"version"`          // 当前生效的条款版本号,0表示历史数据尚未建立版本
+	LaunchAt        int64   `json:"launch_at"`        // 计划上架时间,0表示未排期
+	DelistAt        int64   `json:"delist_at"`        // 计划下架时间,0表示长期有效
+	EligibilityRule string  `json:"eligibility_rule"` // 准入规则配置(JSON),为空表示不限制
+	PricingRule     string  `json:"pricing_rule"`     // 定价规则配置(JSON),租期折扣、季节调价与提前预订折扣,为空表示按日租金计价
+}
+
+type LeaseProductVersionInfo struct {
+	Id            int64                  `json:"id"`
+	ProductId     int64                  `json:"product_id"`
+	Version       int32                  `json:"version"`        // 版本号
+	Status        string                 `json:"status"`         // scheduled:待生效 active:生效中 superseded:已失效 cancelled:已取消
+	Changes       []ProductVersionChange `json:"changes"`        // 相对上一版本的变更
+	EffectiveFrom int64                  `json:"effective_from"` // 生效时间
+	EffectiveTo   int64                  `json:"effective_to"`   // 失效时间,生效中或待生效时为0
+	OperatorId    int64                  `json:"operator_id"`    // 操作人ID
+	OperatorName  string                 `json:"operator_name"`  // 操作人姓名
+	CreatedAt     int64                  `json:"created_at"`
+}
+
+type ListEquipmentUnitsReq struct {
+	ProductCode string `path:"productCode"`
+	Status      string `form:"status,optional"` // 状态 available/leased/maintenance/retired,为空表示全部
+	Page        int32  `form:"page,default=1"`
+	Size        int32  `form:"size,default=10"`
+}
+
+type ListEquipmentUnitsResp struct {
+	List  []EquipmentUnitInfo `json:"list"`
+	Total int64               `json:"total"`
+}
+
+type ListLeaseProductVersionsReq struct {
+	ProductCode string `path:"productCode"`
+}
+
+type ListLeaseProductVersionsResp struct {
+	List []LeaseProductVersionInfo `json:"list"` // 按版本号倒序
 }
 
 type ListLeaseProductsReq struct {
@@ -90,21 +193,76 @@ type ListLeaseProductsResp struct {
 	Total int64              `json:"total"`
 }
 
+type ProductVersionChange struct {
+	Field string `json:"field"` // 变更字段
+	From  string `json:"from"`  // 变更前取值(JSON)
+	To    string `json:"to"`    // 变更后取值(JSON)
+}
+
+type QuoteLeaseReq struct {
+	ProductCode string `json:"product_code"`
+	StartDate   string `json:"start_date"` // 开始日期 YYYY-MM-DD
+	EndDate     string `json:"end_date"`   // 结束日期 YYYY-MM-DD,两端均计入租期
+}
+
+type QuoteLeaseResp struct {
+	ProductCode          string  `json:"product_code"`
+	Duration             int32   `json:"duration"`               // 租期(天)
+	DailyRate            float64 `json:"daily_rate"`             // 日租金
+	TotalAmount          float64 `json:"total_amount"`           // 租金总额,按定价规则计算,提交租赁申请时须与报价一致
+	Deposit              float64 `json:"deposit"`                // 押金
+	BaseAmount           float64 `json:"base_amount"`            // 原价,即日租金乘以租期
+	SeasonalDays         int32   `json:"seasonal_days"`          // 落在季节调价窗口内的天数
+	SeasonalAmount       float64 `json:"seasonal_amount"`        // 按季节系数调整后的租金
+	DurationDiscount     float64 `json:"duration_discount"`      // 适用的租期折扣,1表示无折扣
+	EarlyBookingDiscount float64 `json:"early_booking_discount"` // 适用的提前预订折扣,1表示无折扣
+}
+
+type UpdateEquipmentUnitReq struct {
+	Id           int64   `path:"id"`
+	SerialNumber string  `json:"serial_number,optional"` // 设备序列号
+	PurchaseDate string  `json:"purchase_date,optional"` // 购置日期 YYYY-MM-DD
+	HoursUsed    float64 `json:"hours_used,optional"`    // 累计使用小时数,不能小于当前值
+	Location     string  `json:"location,optional"`      // 当前存放位置
+	Condition    string  `json:"condition,optional"`     // 设备状况
+	Status       string  `json:"status,optional"`        // 状态 available/maintenance/retired,有未结束租赁预占的设备不能设为维修保养或已退役
+	Remark       string  `json:"remark,optional"`        // 备注
+}
+
+type UpdateEquipmentUnitResp struct {
+	Data EquipmentUnitInfo `json:"data"`
+}
+
 type UpdateLeaseProductReq struct {
-	ProductCode string  `path:"productCode"`
-	Name        string  `json:"name"`
-	Type        string  `json:"type"`
-	Machinery   string  `json:"machinery"`
-	Brand       string  `json:"brand"`
-	Model       string  `json:"model"`
-	DailyRate   float64 `json:"daily_rate"`
-	Deposit     float64 `json:"deposit"`
-	MaxDuration int32   `json:"max_duration"`
-	MinDuration int32   `json:"min_duration"`
-	Description string  `json:"description"`
-	Status      int32   `json:"status"`
+	ProductCode     string  `path:"productCode"`
+	Name            string  `json:"name"`
+	Type            string  `json:"type"`
+	Machinery       string  `json:"machinery"`
+	Brand           string  `json:"brand"`
+	Model           string  `json:"model"`
+	DailyRate       float64 `json:"daily_rate"`
+	Deposit         float64 `json:"deposit"`
+	MaxDuration     int32   `json:"max_duration"`
+	MinDuration     int32   `json:"min_duration"`
+	Description     string  `json:"description"`
+	ApprovalChain   string  `json:"approval_chain,optional"`   // 审批链配置(JSON),为空表示单级审批
+	Status          int32   `json:"status"`                    // 状态,立即生效且不产生版本
+	EffectiveFrom   int64   `json:"effective_from,optional"`   // 生效时间(Unix秒),为空或早于当前时间时立即生效,否则到期后自动生效
+	EligibilityRule string  `json:"eligibility_rule,optional"` // 准入规则配置(JSON),如{"occupations":["农","牧"],"min_age":18,"max_age":60,"min_income":3000},为空表示不限制
+	PricingRule     string  `json:"pricing_rule,optional"`     // 定价规则配置(JSON),如{"duration_tiers":[{"min_days":7,"discount":0.95}],"seasons":[{"name":"秋收旺季","start":"09-15","end":"10-15","multiplier":1.5}],"early_booking":[{"min_days_ahead":30,"discount":0.95}]},为空表示按日租金计价
 }
 
 type UpdateLeaseProductResp struct {
-	Data LeaseProductInfo `json:"data"` // 添加数据字段
+	Data    LeaseProductInfo         `json:"data"`              // 添加数据字段
+	Version *LeaseProductVersionInfo `json:"version,omitempty"` // 本次修改产生的版本,条款无变化时为空
+}
+
+type ScheduleLeaseProductReq struct {
+	ProductCode string `path:"productCode"`
+	LaunchAt    int64  `json:"launch_at,optional"` // 计划上架时间(Unix秒),0表示不设置
+	DelistAt    int64  `json:"delist_at,optional"` // 计划下架时间(Unix秒),0表示不设置
+}
+
+type ScheduleLeaseProductResp struct {
+	Data LeaseProductInfo `json:"data"`
 }
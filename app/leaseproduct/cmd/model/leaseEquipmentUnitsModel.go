@@ -0,0 +1,166 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ LeaseEquipmentUnitsModel = (*customLeaseEquipmentUnitsModel)(nil)
+
+type (
+	// LeaseEquipmentUnitsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customLeaseEquipmentUnitsModel.
+	LeaseEquipmentUnitsModel interface {
+		leaseEquipmentUnitsModel
+		// 自定义方法
+		CountWithConditions(ctx context.Context, whereClause string, args []interface{}) (int64, error)
+		ListWithConditions(ctx context.Context, whereClause string, args []interface{}, limit, offset int32) ([]*LeaseEquipmentUnits, error)
+		UpdateIfStatus(ctx context.Context, data *LeaseEquipmentUnits, from string) (bool, error)
+		UpdateIfIdle(ctx context.Context, data *LeaseEquipmentUnits, from string, today time.Time) (bool, error)
+		DeleteUnlessReserved(ctx context.Context, data *LeaseEquipmentUnits) (bool, error)
+		CountAvailability(ctx context.Context, productId uint64, startDate, endDate time.Time) (*UnitAvailability, error)
+		// 事务方法: 在产品模型的 TransactCtx 中调用 *Session 方法
+		CountAvailabilityWithSession(ctx context.Context, session sqlx.Session, productId uint64, startDate, endDate time.Time) (*UnitAvailability, error)
+		FindAssignableForUpdateWithSession(ctx context.Context, session sqlx.Session, productId uint64, startDate, endDate time.Time) (*LeaseEquipmentUnits, error)
+		DelUnitCache(ctx context.Context, data *LeaseEquipmentUnits) error
+	}
+
+	customLeaseEquipmentUnitsModel struct {
+		*defaultLeaseEquipmentUnitsModel
+	}
+
+	// UnitAvailability 产品设备台账在租期内的可用情况
+	UnitAvailability struct {
+		Registered int64 `db:"registered"` // 登记的设备数量,为0表示产品未登记设备台账
+		Assignable int64 `db:"assignable"` // 租期内没有预占的可用设备数量
+	}
+)
+
+// NewLeaseEquipmentUnitsModel returns a model for the database table.
+func NewLeaseEquipmentUnitsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) LeaseEquipmentUnitsModel {
+	return &customLeaseEquipmentUnitsModel{
+		defaultLeaseEquipmentUnitsModel: newLeaseEquipmentUnitsModel(conn, c, opts...),
+	}
+}
+
+// CountWithConditions 根据条件统计设备数量
+func (m *customLeaseEquipmentUnitsModel) CountWithConditions(ctx context.Context, whereClause string, args []interface{}) (int64, error) {
+	var total int64
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s %s", m.table, whereClause)
+	err := m.QueryRowNoCacheCtx(ctx, &total, query, args...)
+	return total, err
+}
+
+// ListWithConditions 根据条件查询设备列表,按登记先后排序
+func (m *customLeaseEquipmentUnitsModel) ListWithConditions(ctx context.Context, whereClause string, args []interface{}, limit, offset int32) ([]*LeaseEquipmentUnits, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s %s ORDER BY `id` ASC LIMIT ? OFFSET ?", leaseEquipmentUnitsRows, m.table, whereClause)
+	queryArgs := append(args, limit, offset)
+
+	var units []*LeaseEquipmentUnits
+	err := m.QueryRowsNoCacheCtx(ctx, &units, query, queryArgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return units, nil
+}
+
+// UpdateIfStatus 仅当设备仍处于 from 状态时更新,期间被其他管理员修改时返回 false
+func (m *customLeaseEquipmentUnitsModel) UpdateIfStatus(ctx context.Context, data *LeaseEquipmentUnits, from string) (bool, error) {
+	return m.execIfMatch(ctx, data, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ? and `status` = ?", m.table, leaseEquipmentUnitsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, data.ProductId, data.SerialNumber, data.PurchaseDate, data.HoursUsed, data.Location, data.Condition, data.Status, data.Remark, data.Id, from)
+	})
+}
+
+// UpdateIfIdle 仅当设备仍处于 from 状态且没有在 today 及之后未结束的租赁预占时更新,用于停用设备
+func (m *customLeaseEquipmentUnitsModel) UpdateIfIdle(ctx context.Context, data *LeaseEquipmentUnits, from string, today time.Time) (bool, error) {
+	return m.execIfMatch(ctx, data, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set %s where `id` = ? and `status` = ? and not exists (select 1 from `lease_product_reservations` where `unit_id` = ? and `status` = 'reserved' and `end_date` >= ?)", m.table, leaseEquipmentUnitsRowsWithPlaceHolder)
+		return conn.ExecCtx(ctx, query, data.ProductId, data.SerialNumber, data.PurchaseDate, data.HoursUsed, data.Location, data.Condition, data.Status, data.Remark, data.Id, from, data.Id, today.Format("2006-01-02"))
+	})
+}
+
+// DeleteUnlessReserved 删除没有预占中租赁记录的设备,仍被预占时返回 false
+func (m *customLeaseEquipmentUnitsModel) DeleteUnlessReserved(ctx context.Context, data *LeaseEquipmentUnits) (bool, error) {
+	return m.execIfMatch(ctx, data, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("delete from %s where `id` = ? and not exists (select 1 from `lease_product_reservations` where `unit_id` = ? and `status` = 'reserved')", m.table)
+		return conn.ExecCtx(ctx, query, data.Id, data.Id)
+	})
+}
+
+// CountAvailability 统计产品登记的设备数量及租期内可分配的设备数量,租期两端均包含
+func (m *customLeaseEquipmentUnitsModel) CountAvailability(ctx context.Context, productId uint64, startDate, endDate time.Time) (*UnitAvailability, error) {
+	var availability UnitAvailability
+	err := m.QueryRowNoCacheCtx(ctx, &availability, m.availabilityQuery(), endDate, startDate, productId)
+	if err != nil {
+		return nil, err
+	}
+	return &availability, nil
+}
+
+// CountAvailabilityWithSession 在事务中统计产品登记的设备数量及租期内可分配的设备数量
+func (m *customLeaseEquipmentUnitsModel) CountAvailabilityWithSession(ctx context.Context, session sqlx.Session, productId uint64, startDate, endDate time.Time) (*UnitAvailability, error) {
+	var availability UnitAvailability
+	err := session.QueryRowCtx(ctx, &availability, m.availabilityQuery(), endDate, startDate, productId)
+	if err != nil {
+		return nil, err
+	}
+	return &availability, nil
+}
+
+// availabilityQuery 可用设备与 FindAssignableForUpdateWithSession 的分配条件一致
+func (m *customLeaseEquipmentUnitsModel) availabilityQuery() string {
+	return fmt.Sprintf("select count(*) as `registered`, coalesce(sum(`status` = 'available' and not exists "+
+		"(select 1 from `lease_product_reservations` where `unit_id` = %s.`id` and `status` = 'reserved' and `start_date` <= ? and `end_date` >= ?)), 0) as `assignable` "+
+		"from %s where `product_id` = ?", m.table, m.table)
+}
+
+// FindAssignableForUpdateWithSession 在事务中查询并锁定产品下租期内没有预占的可用设备,
+// 优先分配累计使用小时数最少的设备以均衡损耗,租期两端均包含
+func (m *customLeaseEquipmentUnitsModel) FindAssignableForUpdateWithSession(ctx context.Context, session sqlx.Session, productId uint64, startDate, endDate time.Time) (*LeaseEquipmentUnits, error) {
+	query := fmt.Sprintf("select %s from %s where `product_id` = ? and `status` = 'available' and not exists "+
+		"(select 1 from `lease_product_reservations` where `unit_id` = %s.`id` and `status` = 'reserved' and `start_date` <= ? and `end_date` >= ?) "+
+		"order by `hours_used` asc, `id` asc limit 1 for update", leaseEquipmentUnitsRows, m.table, m.table)
+
+	var unit LeaseEquipmentUnits
+	err := session.QueryRowCtx(ctx, &unit, query, productId, endDate, startDate)
+	switch err {
+	case nil:
+		return &unit, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// DelUnitCache 清理设备缓存
+func (m *customLeaseEquipmentUnitsModel) DelUnitCache(ctx context.Context, data *LeaseEquipmentUnits) error {
+	return m.DelCacheCtx(ctx, m.cacheKeys(data)...)
+}
+
+// execIfMatch 执行条件更新并清理缓存,返回是否命中
+func (m *customLeaseEquipmentUnitsModel) execIfMatch(ctx context.Context, data *LeaseEquipmentUnits, exec func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error)) (bool, error) {
+	result, err := m.ExecCtx(ctx, exec, m.cacheKeys(data)...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (m *customLeaseEquipmentUnitsModel) cacheKeys(data *LeaseEquipmentUnits) []string {
+	return []string{
+		fmt.Sprintf("%s%v", cacheLeaseEquipmentUnitsIdPrefix, data.Id),
+		fmt.Sprintf("%s%v", cacheLeaseEquipmentUnitsSerialNumberPrefix, data.SerialNumber),
+	}
+}
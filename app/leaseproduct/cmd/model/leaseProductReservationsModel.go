@@ -0,0 +1,153 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ LeaseProductReservationsModel = (*customLeaseProductReservationsModel)(nil)
+
+type (
+	// LeaseProductReservationsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customLeaseProductReservationsModel.
+	LeaseProductReservationsModel interface {
+		leaseProductReservationsModel
+		// 自定义方法
+		FindOverlapping(ctx context.Context, productId uint64, startDate, endDate time.Time) ([]*LeaseProductReservations, error)
+		ReleaseIfReserved(ctx context.Context, data *LeaseProductReservations, releasedAt time.Time) (bool, error)
+		FindCurrentByUnitIds(ctx context.Context, unitIds []uint64, date time.Time) ([]*LeaseProductReservations, error)
+		// 事务方法: 在产品模型的 TransactCtx 中调用 *Session 方法,提交后调用 DelReservationCache 清理缓存
+		FindOneByApplicationIdWithSession(ctx context.Context, session sqlx.Session, applicationId string) (*LeaseProductReservations, error)
+		FindOverlappingWithSession(ctx context.Context, session sqlx.Session, productId uint64, startDate, endDate time.Time) ([]*LeaseProductReservations, error)
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *LeaseProductReservations) (sql.Result, error)
+		ReserveAgainWithSession(ctx context.Context, session sqlx.Session, data *LeaseProductReservations) error
+		DelReservationCache(ctx context.Context, data *LeaseProductReservations) error
+	}
+
+	customLeaseProductReservationsModel struct {
+		*defaultLeaseProductReservationsModel
+	}
+)
+
+// NewLeaseProductReservationsModel returns a model for the database table.
+func NewLeaseProductReservationsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) LeaseProductReservationsModel {
+	return &customLeaseProductReservationsModel{
+		defaultLeaseProductReservationsModel: newLeaseProductReservationsModel(conn, c, opts...),
+	}
+}
+
+// overlappingQuery 查询与租期有交集的预占中记录,租期两端均包含
+func (m *customLeaseProductReservationsModel) overlappingQuery() string {
+	return fmt.Sprintf("select %s from %s where `product_id` = ? and `status` = 'reserved' and `start_date` <= ? and `end_date` >= ?", leaseProductReservationsRows, m.table)
+}
+
+// FindOverlapping 查询产品在租期内预占中的记录
+func (m *customLeaseProductReservationsModel) FindOverlapping(ctx context.Context, productId uint64, startDate, endDate time.Time) ([]*LeaseProductReservations, error) {
+	var reservations []*LeaseProductReservations
+	err := m.QueryRowsNoCacheCtx(ctx, &reservations, m.overlappingQuery(), productId, endDate, startDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+// FindOverlappingWithSession 在事务中查询产品在租期内预占中的记录
+func (m *customLeaseProductReservationsModel) FindOverlappingWithSession(ctx context.Context, session sqlx.Session, productId uint64, startDate, endDate time.Time) ([]*LeaseProductReservations, error) {
+	var reservations []*LeaseProductReservations
+	err := session.QueryRowsCtx(ctx, &reservations, m.overlappingQuery(), productId, endDate, startDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+// FindCurrentByUnitIds 查询设备在指定日期所处的预占中记录,用于展示设备当前租用的申请
+func (m *customLeaseProductReservationsModel) FindCurrentByUnitIds(ctx context.Context, unitIds []uint64, date time.Time) ([]*LeaseProductReservations, error) {
+	if len(unitIds) == 0 {
+		return nil, nil
+	}
+
+	placeholders := make([]string, 0, len(unitIds))
+	args := make([]interface{}, 0, len(unitIds)+2)
+	for _, id := range unitIds {
+		placeholders = append(placeholders, "?")
+		args = append(args, id)
+	}
+	day := date.Format("2006-01-02")
+	args = append(args, day, day)
+	query := fmt.Sprintf("select %s from %s where `unit_id` in (%s) and `status` = 'reserved' and `start_date` <= ? and `end_date` >= ?",
+		leaseProductReservationsRows, m.table, strings.Join(placeholders, ","))
+
+	var reservations []*LeaseProductReservations
+	err := m.QueryRowsNoCacheCtx(ctx, &reservations, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return reservations, nil
+}
+
+// FindOneByApplicationIdWithSession 在事务中按申请编号查询预占记录,不经过缓存
+func (m *customLeaseProductReservationsModel) FindOneByApplicationIdWithSession(ctx context.Context, session sqlx.Session, applicationId string) (*LeaseProductReservations, error) {
+	query := fmt.Sprintf("select %s from %s where `application_id` = ? limit 1", leaseProductReservationsRows, m.table)
+
+	var reservation LeaseProductReservations
+	err := session.QueryRowCtx(ctx, &reservation, query, applicationId)
+	switch err {
+	case nil:
+		return &reservation, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// InsertWithSession 在事务中写入预占记录
+func (m *customLeaseProductReservationsModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *LeaseProductReservations) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?)", m.table, leaseProductReservationsRowsExpectAutoSet)
+	return session.ExecCtx(ctx, query, data.ProductId, data.ApplicationId, data.Quantity, data.UnitId, data.StartDate, data.EndDate, data.Status, data.ReleasedAt)
+}
+
+// ReserveAgainWithSession 在事务中将已释放的预占记录按新的数量、租期、设备重新预占
+func (m *customLeaseProductReservationsModel) ReserveAgainWithSession(ctx context.Context, session sqlx.Session, data *LeaseProductReservations) error {
+	query := fmt.Sprintf("update %s set `quantity` = ?, `unit_id` = ?, `start_date` = ?, `end_date` = ?, `status` = 'reserved', `released_at` = NULL where `id` = ?", m.table)
+	_, err := session.ExecCtx(ctx, query, data.Quantity, data.UnitId, data.StartDate, data.EndDate, data.Id)
+	return err
+}
+
+// ReleaseIfReserved 仅当记录仍为预占状态时释放,已被释放时返回 false
+func (m *customLeaseProductReservationsModel) ReleaseIfReserved(ctx context.Context, data *LeaseProductReservations, releasedAt time.Time) (bool, error) {
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `status` = 'released', `released_at` = ? where `id` = ? and `status` = 'reserved'", m.table)
+		return conn.ExecCtx(ctx, query, releasedAt, data.Id)
+	}, m.cacheKeys(data)...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// DelReservationCache 清理预占记录缓存
+func (m *customLeaseProductReservationsModel) DelReservationCache(ctx context.Context, data *LeaseProductReservations) error {
+	return m.DelCacheCtx(ctx, m.cacheKeys(data)...)
+}
+
+func (m *customLeaseProductReservationsModel) cacheKeys(data *LeaseProductReservations) []string {
+	return []string{
+		fmt.Sprintf("%s%v", cacheLeaseProductReservationsIdPrefix, data.Id),
+		fmt.Sprintf("%s%v", cacheLeaseProductReservationsApplicationIdPrefix, data.ApplicationId),
+	}
+}
@@ -0,0 +1,165 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ LeaseProductVersionsModel = (*customLeaseProductVersionsModel)(nil)
+
+type (
+	// LeaseProductVersionsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customLeaseProductVersionsModel.
+	LeaseProductVersionsModel interface {
+		leaseProductVersionsModel
+		// 自定义方法
+		FindByProductId(ctx context.Context, productId uint64) ([]*LeaseProductVersions, error)
+		FindActiveByProductId(ctx context.Context, productId uint64) (*LeaseProductVersions, error)
+		FindDueScheduled(ctx context.Context, now time.Time, limit int) ([]*LeaseProductVersions, error)
+		MaxVersion(ctx context.Context, productId uint64) (uint64, error)
+		// 事务方法: 在 TransactCtx 中调用 *Session 方法,提交后调用 DelVersionCache 清理缓存
+		FindActiveByProductIdWithSession(ctx context.Context, session sqlx.Session, productId uint64) (*LeaseProductVersions, error)
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *LeaseProductVersions) (sql.Result, error)
+		SupersedeWithSession(ctx context.Context, session sqlx.Session, data *LeaseProductVersions, effectiveTo time.Time) error
+		ActivateWithSession(ctx context.Context, session sqlx.Session, data *LeaseProductVersions) (bool, error)
+		CancelScheduledWithSession(ctx context.Context, session sqlx.Session, productId uint64) ([]*LeaseProductVersions, error)
+		DelVersionCache(ctx context.Context, data ...*LeaseProductVersions) error
+	}
+
+	customLeaseProductVersionsModel struct {
+		*defaultLeaseProductVersionsModel
+	}
+)
+
+// NewLeaseProductVersionsModel returns a model for the database table.
+func NewLeaseProductVersionsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) LeaseProductVersionsModel {
+	return &customLeaseProductVersionsModel{
+		defaultLeaseProductVersionsModel: newLeaseProductVersionsModel(conn, c, opts...),
+	}
+}
+
+// FindByProductId 查询产品的全部版本,按版本号倒序
+func (m *customLeaseProductVersionsModel) FindByProductId(ctx context.Context, productId uint64) ([]*LeaseProductVersions, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `product_id` = ? ORDER BY `version` DESC", leaseProductVersionsRows, m.table)
+
+	var versions []*LeaseProductVersions
+	err := m.QueryRowsNoCacheCtx(ctx, &versions, query, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// FindActiveByProductId 查询产品当前生效的版本,历史产品可能没有版本记录,此时返回 ErrNotFound
+func (m *customLeaseProductVersionsModel) FindActiveByProductId(ctx context.Context, productId uint64) (*LeaseProductVersions, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `product_id` = ? AND `status` = 'active' ORDER BY `version` DESC LIMIT 1", leaseProductVersionsRows, m.table)
+
+	var version LeaseProductVersions
+	err := m.QueryRowNoCacheCtx(ctx, &version, query, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
+
+// FindActiveByProductIdWithSession 在事务中查询产品当前生效的版本,需先锁定产品以串行化版本切换
+func (m *customLeaseProductVersionsModel) FindActiveByProductIdWithSession(ctx context.Context, session sqlx.Session, productId uint64) (*LeaseProductVersions, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `product_id` = ? AND `status` = 'active' ORDER BY `version` DESC LIMIT 1", leaseProductVersionsRows, m.table)
+
+	var version LeaseProductVersions
+	err := session.QueryRowCtx(ctx, &version, query, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
+
+// FindDueScheduled 查询已到生效时间的待生效版本,按生效时间先后排序,已删除产品的版本不再生效
+func (m *customLeaseProductVersionsModel) FindDueScheduled(ctx context.Context, now time.Time, limit int) ([]*LeaseProductVersions, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `status` = 'scheduled' AND `effective_from` <= ? AND `product_id` IN (SELECT `id` FROM `lease_products` WHERE `deleted_at` IS NULL) ORDER BY `effective_from` ASC, `version` ASC LIMIT ?", leaseProductVersionsRows, m.table)
+
+	var versions []*LeaseProductVersions
+	err := m.QueryRowsNoCacheCtx(ctx, &versions, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return versions, nil
+}
+
+// MaxVersion 查询产品已分配的最大版本号,没有版本记录时返回 0
+func (m *customLeaseProductVersionsModel) MaxVersion(ctx context.Context, productId uint64) (uint64, error) {
+	var max uint64
+	query := fmt.Sprintf("SELECT COALESCE(MAX(`version`), 0) FROM %s WHERE `product_id` = ?", m.table)
+	err := m.QueryRowNoCacheCtx(ctx, &max, query, productId)
+	return max, err
+}
+
+// InsertWithSession 在事务中写入版本记录
+func (m *customLeaseProductVersionsModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *LeaseProductVersions) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, leaseProductVersionsRowsExpectAutoSet)
+	return session.ExecCtx(ctx, query, data.ProductId, data.Version, data.Terms, data.Changes, data.Status, data.EffectiveFrom, data.EffectiveTo, data.OperatorId, data.OperatorName)
+}
+
+// SupersedeWithSession 在事务中将生效版本标记为已失效
+func (m *customLeaseProductVersionsModel) SupersedeWithSession(ctx context.Context, session sqlx.Session, data *LeaseProductVersions, effectiveTo time.Time) error {
+	query := fmt.Sprintf("update %s set `status` = 'superseded', `effective_to` = ? where `id` = ? and `status` = 'active'", m.table)
+	_, err := session.ExecCtx(ctx, query, effectiveTo, data.Id)
+	return err
+}
+
+// ActivateWithSession 在事务中将待生效版本置为生效并写入最终条款,版本已被其他实例处理时返回 false
+func (m *customLeaseProductVersionsModel) ActivateWithSession(ctx context.Context, session sqlx.Session, data *LeaseProductVersions) (bool, error) {
+	query := fmt.Sprintf("update %s set `status` = 'active', `terms` = ? where `id` = ? and `status` = 'scheduled'", m.table)
+	result, err := session.ExecCtx(ctx, query, data.Terms, data.Id)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// CancelScheduledWithSession 在事务中取消产品全部待生效版本,返回被取消的版本用于清理缓存
+func (m *customLeaseProductVersionsModel) CancelScheduledWithSession(ctx context.Context, session sqlx.Session, productId uint64) ([]*LeaseProductVersions, error) {
+	query := fmt.Sprintf("select %s from %s where `product_id` = ? and `status` = 'scheduled' for update", leaseProductVersionsRows, m.table)
+
+	var versions []*LeaseProductVersions
+	if err := session.QueryRowsCtx(ctx, &versions, query, productId); err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, nil
+	}
+
+	query = fmt.Sprintf("update %s set `status` = 'cancelled' where `product_id` = ? and `status` = 'scheduled'", m.table)
+	if _, err := session.ExecCtx(ctx, query, productId); err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// DelVersionCache 清理版本记录缓存
+func (m *customLeaseProductVersionsModel) DelVersionCache(ctx context.Context, data ...*LeaseProductVersions) error {
+	keys := make([]string, 0, len(data)*2)
+	for _, item := range data {
+		keys = append(keys,
+			fmt.Sprintf("%s%v", cacheLeaseProductVersionsIdPrefix, item.Id),
+			fmt.Sprintf("%s%v:%v", cacheLeaseProductVersionsProductIdVersionPrefix, item.ProductId, item.Version),
+		)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return m.DelCacheCtx(ctx, keys...)
+}
@@ -2,7 +2,9 @@ package model
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
@@ -18,6 +20,19 @@ type (
 		// 自定义方法
 		CountWithConditions(ctx context.Context, whereClause string, args []interface{}) (int64, error)
 		ListWithConditions(ctx context.Context, whereClause string, args []interface{}, limit, offset int32) ([]*LeaseProducts, error)
+		// 事务方法: 在 TransactCtx 中调用 *Session 方法,提交后调用 DelProductCache 清理缓存
+		TransactCtx(ctx context.Context, fn func(context.Context, sqlx.Session) error) error
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *LeaseProducts) (sql.Result, error)
+		UpdateWithSession(ctx context.Context, session sqlx.Session, data *LeaseProducts) error
+		FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, id uint64) (*LeaseProducts, error)
+		DelProductCache(ctx context.Context, data *LeaseProducts) error
+		// 软删除: 已删除的产品按不存在处理
+		SoftDeleteWithSession(ctx context.Context, session sqlx.Session, data *LeaseProducts, deletedAt time.Time) error
+		FindOneIncludingDeleted(ctx context.Context, id uint64) (*LeaseProducts, error)
+		// 排期上下架
+		FindScheduleDue(ctx context.Context, now time.Time) ([]*LeaseProducts, error)
+		UpdateStatusIfMatch(ctx context.Context, data *LeaseProducts, from, to uint64) (bool, error)
+		UpdateScheduleIfMatch(ctx context.Context, data, from *LeaseProducts) (bool, error)
 	}
 
 	customLeaseProductsModel struct {
@@ -53,3 +68,122 @@ func (m *customLeaseProductsModel) ListWithConditions(ctx context.Context, where
 
 	return products, nil
 }
+
+// InsertWithSession 在事务中写入产品
+func (m *customLeaseProductsModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *LeaseProducts) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, leaseProductsRowsExpectAutoSet)
+	return session.ExecCtx(ctx, query, data.ProductCode, data.Name, data.Type, data.Machinery, data.Brand, data.Model, data.DailyRate, data.Deposit, data.MaxDuration, data.MinDuration, data.Description, data.ApprovalChain, data.EligibilityRule, data.PricingRule, data.InventoryCount, data.AvailableCount, data.Status, data.Version, data.LaunchAt, data.DelistAt, data.DeletedAt)
+}
+
+// UpdateWithSession 在事务中更新产品,事务提交后需调用 DelProductCache
+func (m *customLeaseProductsModel) UpdateWithSession(ctx context.Context, session sqlx.Session, data *LeaseProducts) error {
+	query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, leaseProductsRowsWithPlaceHolder)
+	_, err := session.ExecCtx(ctx, query, data.ProductCode, data.Name, data.Type, data.Machinery, data.Brand, data.Model, data.DailyRate, data.Deposit, data.MaxDuration, data.MinDuration, data.Description, data.ApprovalChain, data.EligibilityRule, data.PricingRule, data.InventoryCount, data.AvailableCount, data.Status, data.Version, data.LaunchAt, data.DelistAt, data.DeletedAt, data.Id)
+	return err
+}
+
+// FindOne 按ID查询产品,已删除的产品返回 ErrNotFound
+func (m *customLeaseProductsModel) FindOne(ctx context.Context, id uint64) (*LeaseProducts, error) {
+	product, err := m.defaultLeaseProductsModel.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if product.DeletedAt.Valid {
+		return nil, ErrNotFound
+	}
+	return product, nil
+}
+
+// FindOneIncludingDeleted 按ID查询产品,包括已删除的产品,用于展示设备台账等关联数据的产品编码
+func (m *customLeaseProductsModel) FindOneIncludingDeleted(ctx context.Context, id uint64) (*LeaseProducts, error) {
+	return m.defaultLeaseProductsModel.FindOne(ctx, id)
+}
+
+// FindOneByProductCode 按产品编码查询产品,已删除的产品返回 ErrNotFound
+func (m *customLeaseProductsModel) FindOneByProductCode(ctx context.Context, productCode string) (*LeaseProducts, error) {
+	product, err := m.defaultLeaseProductsModel.FindOneByProductCode(ctx, productCode)
+	if err != nil {
+		return nil, err
+	}
+	if product.DeletedAt.Valid {
+		return nil, ErrNotFound
+	}
+	return product, nil
+}
+
+// SoftDeleteWithSession 在事务中软删除产品: 记录删除时间并下架,同时清除排期,产品编码保留不可复用
+func (m *customLeaseProductsModel) SoftDeleteWithSession(ctx context.Context, session sqlx.Session, data *LeaseProducts, deletedAt time.Time) error {
+	query := fmt.Sprintf("update %s set `deleted_at` = ?, `status` = 2, `launch_at` = NULL where `id` = ? and `deleted_at` is null", m.table)
+	_, err := session.ExecCtx(ctx, query, deletedAt, data.Id)
+	return err
+}
+
+// FindOneForUpdateWithSession 在事务中查询并锁定产品,用于串行化同一产品的库存预占
+func (m *customLeaseProductsModel) FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, id uint64) (*LeaseProducts, error) {
+	query := fmt.Sprintf("select %s from %s where `id` = ? and `deleted_at` is null limit 1 for update", leaseProductsRows, m.table)
+
+	var product LeaseProducts
+	err := session.QueryRowCtx(ctx, &product, query, id)
+	switch err {
+	case nil:
+		return &product, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// DelProductCache 清理产品缓存
+func (m *customLeaseProductsModel) DelProductCache(ctx context.Context, data *LeaseProducts) error {
+	return m.DelCacheCtx(ctx,
+		fmt.Sprintf("%s%v", cacheLeaseProductsIdPrefix, data.Id),
+		fmt.Sprintf("%s%v", cacheLeaseProductsProductCodePrefix, data.ProductCode),
+	)
+}
+
+// FindScheduleDue 查询排期已到需要切换状态的产品: 已到上架时间仍未上架,或已到下架时间仍在上架
+func (m *customLeaseProductsModel) FindScheduleDue(ctx context.Context, now time.Time) ([]*LeaseProducts, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `deleted_at` IS NULL AND ((`status` = 2 AND `launch_at` <= ? AND (`delist_at` IS NULL OR `delist_at` > ?)) OR (`status` = 1 AND `delist_at` <= ?))", leaseProductsRows, m.table)
+
+	var products []*LeaseProducts
+	err := m.QueryRowsNoCacheCtx(ctx, &products, query, now, now, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// UpdateStatusIfMatch 仅当产品仍处于 from 状态时切换为 to,已被其他实例或人工处理时返回 false
+func (m *customLeaseProductsModel) UpdateStatusIfMatch(ctx context.Context, data *LeaseProducts, from, to uint64) (bool, error) {
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `status` = ? where `id` = ? and `status` = ?", m.table)
+		return conn.ExecCtx(ctx, query, to, data.Id, from)
+	}, fmt.Sprintf("%s%v", cacheLeaseProductsIdPrefix, data.Id), fmt.Sprintf("%s%v", cacheLeaseProductsProductCodePrefix, data.ProductCode))
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// UpdateScheduleIfMatch 仅更新上下架状态与排期,不覆盖并发修改的条款等字段
+// 产品状态与计划下架时间仍与读取时(from)一致且未删除时才更新,否则返回 false
+func (m *customLeaseProductsModel) UpdateScheduleIfMatch(ctx context.Context, data, from *LeaseProducts) (bool, error) {
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `status` = ?, `launch_at` = ?, `delist_at` = ? where `id` = ? and `status` = ? and `delist_at` <=> ? and `deleted_at` is null", m.table)
+		return conn.ExecCtx(ctx, query, data.Status, data.LaunchAt, data.DelistAt, data.Id, from.Status, from.DelistAt)
+	}, fmt.Sprintf("%s%v", cacheLeaseProductsIdPrefix, data.Id), fmt.Sprintf("%s%v", cacheLeaseProductsProductCodePrefix, data.ProductCode))
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
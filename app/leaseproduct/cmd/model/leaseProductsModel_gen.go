@@ -43,23 +43,30 @@ type (
 	}
 
 	LeaseProducts struct {
-		Id             uint64    `db:"id"`              // 产品ID
-		ProductCode    string    `db:"product_code"`    // 产品编码
-		Name           string    `db:"name"`            // 产品名称
-		Type           string    `db:"type"`            // 租赁类型
-		Machinery      string    `db:"machinery"`       // 设备名称
-		Brand          string    `db:"brand"`           // 品牌
-		Model          string    `db:"model"`           // 型号
-		DailyRate      float64   `db:"daily_rate"`      // 日租金
-		Deposit        float64   `db:"deposit"`         // 押金
-		MaxDuration    uint64    `db:"max_duration"`    // 最大租期(天)
-		MinDuration    uint64    `db:"min_duration"`    // 最小租期(天)
-		Description    string    `db:"description"`     // 产品描述
-		InventoryCount uint64    `db:"inventory_count"` // 库存数量
-		AvailableCount uint64    `db:"available_count"` // 可用数量
-		Status         uint64    `db:"status"`          // 状态 1:上架 2:下架
-		CreatedAt      time.Time `db:"created_at"`      // 创建时间
-		UpdatedAt      time.Time `db:"updated_at"`      // 更新时间
+		Id              uint64       `db:"id"`               // 产品ID
+		ProductCode     string       `db:"product_code"`     // 产品编码
+		Name            string       `db:"name"`             // 产品名称
+		Type            string       `db:"type"`             // 租赁类型
+		Machinery       string       `db:"machinery"`        // 设备名称
+		Brand           string       `db:"brand"`            // 品牌
+		Model           string       `db:"model"`            // 型号
+		DailyRate       float64      `db:"daily_rate"`       // 日租金
+		Deposit         float64      `db:"deposit"`          // 押金
+		MaxDuration     uint64       `db:"max_duration"`     // 最大租期(天)
+		MinDuration     uint64       `db:"min_duration"`     // 最小租期(天)
+		Description     string       `db:"description"`      // 产品描述
+		ApprovalChain   string       `db:"approval_chain"`   // 审批链配置(JSON),如[{"name":"初审","role":"operator"},{"name":"终审","role":"admin"}],为空表示单级审批
+		EligibilityRule string       `db:"eligibility_rule"` // 准入规则配置(JSON),如{"occupations":["农","牧"],"min_age":18,"max_age":60,"min_income":3000},为空表示不限制
+		PricingRule     string       `db:"pricing_rule"`     // 定价规则配置(JSON),如{"duration_tiers":[{"min_days":7,"discount":0.95}],"seasons":[{"name":"秋收旺季","start":"09-15","end":"10-15","multiplier":1.5}],"early_booking":[{"min_days_ahead":30,"discount":0.95}]},为空表示按日租金计价
+		InventoryCount  uint64       `db:"inventory_count"`  // 库存数量
+		AvailableCount  uint64       `db:"available_count"`  // 可用数量
+		Status          uint64       `db:"status"`           // 状态 1:上架 2:下架
+		Version         uint64       `db:"version"`          // 当前生效的条款版本号
+		LaunchAt        sql.NullTime `db:"launch_at"`        // 计划上架时间,到期后自动上架,为空表示不排期
+		DelistAt        sql.NullTime `db:"delist_at"`        // 计划下架时间,到期后自动下架且不再出现在产品列表,为空表示长期有效
+		DeletedAt       sql.NullTime `db:"deleted_at"`       // 删除时间,不为空表示产品已归档,不再出现在查询中
+		CreatedAt       time.Time    `db:"created_at"`       // 创建时间
+		UpdatedAt       time.Time    `db:"updated_at"`       // 更新时间
 	}
 )
 
@@ -126,8 +133,8 @@ func (m *defaultLeaseProductsModel) Insert(ctx context.Context, data *LeaseProdu
 	leaseProductsIdKey := fmt.Sprintf("%s%v", cacheLeaseProductsIdPrefix, data.Id)
 	leaseProductsProductCodeKey := fmt.Sprintf("%s%v", cacheLeaseProductsProductCodePrefix, data.ProductCode)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, leaseProductsRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.ProductCode, data.Name, data.Type, data.Machinery, data.Brand, data.Model, data.DailyRate, data.Deposit, data.MaxDuration, data.MinDuration, data.Description, data.InventoryCount, data.AvailableCount, data.Status)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, leaseProductsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.ProductCode, data.Name, data.Type, data.Machinery, data.Brand, data.Model, data.DailyRate, data.Deposit, data.MaxDuration, data.MinDuration, data.Description, data.ApprovalChain, data.EligibilityRule, data.PricingRule, data.InventoryCount, data.AvailableCount, data.Status, data.Version, data.LaunchAt, data.DelistAt, data.DeletedAt)
 	}, leaseProductsIdKey, leaseProductsProductCodeKey)
 	return ret, err
 }
@@ -142,7 +149,7 @@ func (m *defaultLeaseProductsModel) Update(ctx context.Context, newData *LeasePr
 	leaseProductsProductCodeKey := fmt.Sprintf("%s%v", cacheLeaseProductsProductCodePrefix, data.ProductCode)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, leaseProductsRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.ProductCode, newData.Name, newData.Type, newData.Machinery, newData.Brand, newData.Model, newData.DailyRate, newData.Deposit, newData.MaxDuration, newData.MinDuration, newData.Description, newData.InventoryCount, newData.AvailableCount, newData.Status, newData.Id)
+		return conn.ExecCtx(ctx, query, newData.ProductCode, newData.Name, newData.Type, newData.Machinery, newData.Brand, newData.Model, newData.DailyRate, newData.Deposit, newData.MaxDuration, newData.MinDuration, newData.Description, newData.ApprovalChain, newData.EligibilityRule, newData.PricingRule, newData.InventoryCount, newData.AvailableCount, newData.Status, newData.Version, newData.LaunchAt, newData.DelistAt, newData.DeletedAt, newData.Id)
 	}, leaseProductsIdKey, leaseProductsProductCodeKey)
 	return err
 }
@@ -152,6 +152,8 @@ func IsAcceptableError(err error) bool {
 		"重复提交",
 		"余额不足",
 		"库存不足",
+		"额度不足",
+		"不符合申请条件",
 		"密码错误",
 		"用户已存在",
 		"手机号已注册",
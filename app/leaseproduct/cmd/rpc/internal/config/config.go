@@ -19,4 +19,20 @@ type Config struct {
 
 	// Redis 缓存配置
 	CacheConf cache.CacheConf
+
+	// 产品版本生效任务配置 - 默认每60秒扫描一次到期的待生效版本
+	VersionJob struct {
+		Enabled  bool `json:",default=true"`
+		Interval int  `json:",default=60,range=[1:3600]"`
+	}
+
+	// 产品排期上下架任务配置 - 默认每60秒扫描一次到期的排期
+	ScheduleJob struct {
+		Enabled  bool `json:",default=true"`
+		Interval int  `json:",default=60,range=[1:3600]"`
+	}
+
+	// 其他RPC服务配置 - 删除产品前通过租赁服务检查产品引用,准入校验时通过用户服务获取用户资料
+	LeaseRpc   zrpc.RpcClientConf
+	AppUserRpc zrpc.RpcClientConf
 }
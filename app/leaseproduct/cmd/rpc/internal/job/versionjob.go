@@ -0,0 +1,81 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"rpc/internal/logic"
+	"rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// versionBatchSize 每次扫描处理的待生效版本数量上限
+const versionBatchSize = 100
+
+// VersionJob 产品版本生效任务
+// 定时扫描已到生效时间的待生效版本,按生效时间先后依次应用到产品,多实例部署时同一版本只会生效一次
+type VersionJob struct {
+	svcCtx *svc.ServiceContext
+	done   chan struct{}
+}
+
+func NewVersionJob(svcCtx *svc.ServiceContext) *VersionJob {
+	return &VersionJob{
+		svcCtx: svcCtx,
+		done:   make(chan struct{}),
+	}
+}
+
+// Start 启动任务: 启动时执行一次,之后按配置的间隔执行
+func (j *VersionJob) Start() {
+	if !j.svcCtx.Config.VersionJob.Enabled {
+		logx.Info("产品版本生效任务未启用")
+		return
+	}
+
+	j.Run(context.Background())
+	ticker := time.NewTicker(time.Duration(j.svcCtx.Config.VersionJob.Interval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			j.Run(context.Background())
+		case <-j.done:
+			return
+		}
+	}
+}
+
+// Stop 停止任务
+func (j *VersionJob) Stop() {
+	close(j.done)
+}
+
+// Run 执行一次版本生效扫描
+func (j *VersionJob) Run(ctx context.Context) {
+	logger := logx.WithContext(ctx)
+	now := time.Now()
+
+	versions, err := j.svcCtx.LeaseProductVersionsModel.FindDueScheduled(ctx, now, versionBatchSize)
+	if err != nil {
+		logger.Errorf("查询待生效产品版本失败: %v", err)
+		return
+	}
+
+	activated := 0
+	for _, version := range versions {
+		ok, err := logic.ActivateScheduledVersion(ctx, j.svcCtx, version, now)
+		if err != nil {
+			logger.Errorf("产品版本生效失败, 产品ID: %d, 版本: %d, 错误: %v", version.ProductId, version.Version, err)
+			continue
+		}
+		if ok {
+			activated++
+		}
+	}
+
+	if activated > 0 {
+		logger.Infof("产品版本生效任务完成, 生效版本数: %d", activated)
+	}
+}
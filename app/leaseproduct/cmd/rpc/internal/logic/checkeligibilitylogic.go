@@ -0,0 +1,70 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"rpc/internal/svc"
+	"rpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckEligibilityLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckEligibilityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckEligibilityLogic {
+	return &CheckEligibilityLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 准入校验
+func (l *CheckEligibilityLogic) CheckEligibility(in *leaseproduct.CheckEligibilityReq) (*leaseproduct.CheckEligibilityResp, error) {
+	// 参数验证
+	if in.ProductCode == "" {
+		return nil, fmt.Errorf("产品编码不能为空")
+	}
+	if in.UserId <= 0 {
+		return nil, fmt.Errorf("用户ID不能为空")
+	}
+
+	product, err := l.svcCtx.LeaseProductModel.FindOneByProductCode(l.ctx, in.ProductCode)
+	if err != nil {
+		l.Errorf("查询产品失败: %v", err)
+		return nil, fmt.Errorf("产品不存在")
+	}
+
+	// 未配置准入规则的产品不限制申请人,无需查询用户资料
+	if product.EligibilityRule == "" {
+		return &leaseproduct.CheckEligibilityResp{
+			Eligible: true,
+			Reasons:  []*leaseproduct.EligibilityReason{},
+		}, nil
+	}
+
+	applicant, err := loadApplicant(l.ctx, l.svcCtx, in.UserId)
+	if err != nil {
+		l.Errorf("获取用户资料失败: userId=%d, err=%v", in.UserId, err)
+		return nil, fmt.Errorf("用户信息验证失败，请稍后重试")
+	}
+
+	reasons, err := checkProductEligibility(product.EligibilityRule, applicant)
+	if err != nil {
+		l.Errorf("解析产品准入规则失败: productCode=%s, err=%v", product.ProductCode, err)
+		return nil, fmt.Errorf("准入校验失败")
+	}
+	if reasons == nil {
+		reasons = make([]*leaseproduct.EligibilityReason, 0)
+	}
+
+	return &leaseproduct.CheckEligibilityResp{
+		Eligible: len(reasons) == 0,
+		Reasons:  reasons,
+	}, nil
+}
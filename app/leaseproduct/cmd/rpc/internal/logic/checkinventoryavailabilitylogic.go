@@ -37,18 +37,9 @@ func (l *CheckInventoryAvailabilityLogic) CheckInventoryAvailability(in *leasepr
 	}
 
 	// 验证日期格式和合理性
-	startDate, err := time.Parse("2006-01-02", in.StartDate)
+	startDate, endDate, err := parseLeasePeriod(in.StartDate, in.EndDate)
 	if err != nil {
-		return nil, fmt.Errorf("开始日期格式错误，应为YYYY-MM-DD")
-	}
-
-	endDate, err := time.Parse("2006-01-02", in.EndDate)
-	if err != nil {
-		return nil, fmt.Errorf("结束日期格式错误，应为YYYY-MM-DD")
-	}
-
-	if startDate.After(endDate) {
-		return nil, fmt.Errorf("开始日期不能晚于结束日期")
+		return nil, err
 	}
 
 	if startDate.Before(time.Now().Truncate(24 * time.Hour)) {
@@ -70,22 +61,29 @@ func (l *CheckInventoryAvailabilityLogic) CheckInventoryAvailability(in *leasepr
 		}, nil
 	}
 
-	// 计算租期天数
-	duration := int(endDate.Sub(startDate).Hours()/24) + 1
-
 	// 检查租期是否在允许范围内
-	if int32(duration) < int32(product.MinDuration) {
-		return nil, fmt.Errorf("租期不能少于最小租期")
+	if err := checkLeaseDuration(product, leaseDuration(startDate, endDate)); err != nil {
+		return nil, err
 	}
 
-	if int32(duration) > int32(product.MaxDuration) {
-		return nil, fmt.Errorf("租期不能超过最大租期")
+	// 登记了设备台账的产品按租期内可分配的设备计算,否则按租期内已预占的数量计算实际可用库存
+	availability, err := l.svcCtx.LeaseEquipmentUnitsModel.CountAvailability(l.ctx, product.Id, startDate, endDate)
+	if err != nil {
+		l.Errorf("查询设备台账失败: %v", err)
+		return nil, fmt.Errorf("库存检查失败")
+	}
+	availableCount, hasUnits, err := unitInventory(availability, in.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	if !hasUnits {
+		reservations, err := l.svcCtx.LeaseProductReservationsModel.FindOverlapping(l.ctx, product.Id, startDate, endDate)
+		if err != nil {
+			l.Errorf("查询库存预占失败: %v", err)
+			return nil, fmt.Errorf("库存检查失败")
+		}
+		availableCount = availableInventory(product, reservations, startDate, endDate)
 	}
-
-	// TODO: 这里应该根据已有的租赁记录计算实际可用库存
-	// 需要查询在指定时间段内已被预订的数量
-	// 简化处理：直接使用当前可用库存
-	availableCount := int32(product.AvailableCount)
 	available := availableCount >= in.Quantity
 
 	return &leaseproduct.CheckInventoryAvailabilityResp{
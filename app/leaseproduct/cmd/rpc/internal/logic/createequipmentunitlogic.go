@@ -0,0 +1,101 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"model"
+	"rpc/internal/svc"
+	"rpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateEquipmentUnitLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateEquipmentUnitLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateEquipmentUnitLogic {
+	return &CreateEquipmentUnitLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CreateEquipmentUnitLogic) CreateEquipmentUnit(in *leaseproduct.CreateEquipmentUnitReq) (*leaseproduct.CreateEquipmentUnitResp, error) {
+	// 参数验证
+	if in.ProductCode == "" {
+		return nil, fmt.Errorf("产品编码不能为空")
+	}
+	serialNumber := strings.TrimSpace(in.SerialNumber)
+	if serialNumber == "" {
+		return nil, fmt.Errorf("参数错误，设备序列号不能为空")
+	}
+	if in.HoursUsed < 0 {
+		return nil, fmt.Errorf("参数错误，累计使用小时数不能小于0")
+	}
+	condition := in.Condition
+	if condition == "" {
+		condition = unitConditionGood
+	}
+	if !validUnitCondition(condition) {
+		return nil, fmt.Errorf("参数错误，设备状况只能为new、good、fair或poor")
+	}
+	purchaseDate, err := parsePurchaseDate(in.PurchaseDate)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := l.svcCtx.LeaseProductModel.FindOneByProductCode(l.ctx, in.ProductCode)
+	if err == model.ErrNotFound {
+		return nil, fmt.Errorf("产品不存在")
+	}
+	if err != nil {
+		l.Errorf("查询产品失败: %v", err)
+		return nil, fmt.Errorf("登记设备失败")
+	}
+
+	// 检查序列号是否已登记
+	if _, err := l.svcCtx.LeaseEquipmentUnitsModel.FindOneBySerialNumber(l.ctx, serialNumber); err == nil {
+		return nil, fmt.Errorf("参数错误，设备序列号已登记")
+	} else if err != model.ErrNotFound {
+		l.Errorf("查询设备失败: %v", err)
+		return nil, fmt.Errorf("登记设备失败")
+	}
+
+	unit := &model.LeaseEquipmentUnits{
+		ProductId:    product.Id,
+		SerialNumber: serialNumber,
+		PurchaseDate: purchaseDate,
+		HoursUsed:    in.HoursUsed,
+		Location:     strings.TrimSpace(in.Location),
+		Condition:    condition,
+		Status:       unitStatusAvailable,
+		Remark:       in.Remark,
+	}
+	result, err := l.svcCtx.LeaseEquipmentUnitsModel.Insert(l.ctx, unit)
+	if err != nil {
+		l.Errorf("登记设备失败: %v", err)
+		return nil, fmt.Errorf("登记设备失败")
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		l.Errorf("获取设备ID失败: %v", err)
+		return nil, fmt.Errorf("登记设备失败")
+	}
+
+	created, err := l.svcCtx.LeaseEquipmentUnitsModel.FindOne(l.ctx, uint64(id))
+	if err != nil {
+		l.Errorf("查询设备失败: %v", err)
+		return nil, fmt.Errorf("登记设备失败")
+	}
+
+	l.Infof("登记设备: productCode=%s, serialNumber=%s, unitId=%d", product.ProductCode, created.SerialNumber, created.Id)
+	return &leaseproduct.CreateEquipmentUnitResp{
+		Data: toEquipmentUnitInfo(created, product.ProductCode, ""),
+	}, nil
+}
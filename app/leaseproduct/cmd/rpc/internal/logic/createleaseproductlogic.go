@@ -3,12 +3,16 @@ package logic
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"common/productschedule"
+	"common/productversion"
 	"model"
 	"rpc/internal/svc"
 	"rpc/leaseproduct"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
 type CreateLeaseProductLogic struct {
@@ -32,6 +36,24 @@ func (l *CreateLeaseProductLogic) CreateLeaseProduct(in *leaseproduct.CreateLeas
 		return nil, err
 	}
 
+	// 校验审批链
+	approvalChain, err := normalizeApprovalChain(in.ApprovalChain)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验准入规则
+	eligibilityRule, err := normalizeEligibilityRule(in.EligibilityRule)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验定价规则
+	pricingRule, err := normalizePricingRule(in.PricingRule)
+	if err != nil {
+		return nil, err
+	}
+
 	// 检查产品编码是否已存在
 	existingProduct, err := l.svcCtx.LeaseProductModel.FindOneByProductCode(l.ctx, in.ProductCode)
 	if err == nil && existingProduct != nil {
@@ -40,34 +62,50 @@ func (l *CreateLeaseProductLogic) CreateLeaseProduct(in *leaseproduct.CreateLeas
 
 	// 创建产品记录
 	product := &model.LeaseProducts{
-		ProductCode:    in.ProductCode,
-		Name:           in.Name,
-		Type:           in.Type,
-		Machinery:      in.Machinery,
-		Brand:          in.Brand,
-		Model:          in.Model,
-		DailyRate:      in.DailyRate,
-		Deposit:        in.Deposit,
-		MaxDuration:    uint64(in.MaxDuration),
-		MinDuration:    uint64(in.MinDuration),
-		Description:    in.Description,
-		InventoryCount: uint64(in.InventoryCount),
-		AvailableCount: uint64(in.InventoryCount), // 初始可用数量等于库存数量
-		Status:         1,                         // 默认上架状态
-	}
-
-	result, err := l.svcCtx.LeaseProductModel.Insert(l.ctx, product)
-	if err != nil {
-		l.Errorf("创建产品失败: %v", err)
-		return nil, fmt.Errorf("创建产品失败")
+		ProductCode:     in.ProductCode,
+		Name:            in.Name,
+		Type:            in.Type,
+		Machinery:       in.Machinery,
+		Brand:           in.Brand,
+		Model:           in.Model,
+		DailyRate:       in.DailyRate,
+		Deposit:         in.Deposit,
+		MaxDuration:     uint64(in.MaxDuration),
+		MinDuration:     uint64(in.MinDuration),
+		Description:     in.Description,
+		ApprovalChain:   approvalChain,
+		EligibilityRule: eligibilityRule,
+		PricingRule:     pricingRule,
+		InventoryCount:  uint64(in.InventoryCount),
+		AvailableCount:  uint64(in.InventoryCount), // 初始可用数量等于库存数量
+		Status:          1,                         // 默认上架状态
+		Version:         1,
 	}
 
-	// 获取新创建的产品ID
-	productId, err := result.LastInsertId()
+	// 产品与首个条款版本在同一事务中写入
+	var productId int64
+	err = l.svcCtx.LeaseProductModel.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
+		result, err := l.svcCtx.LeaseProductModel.InsertWithSession(ctx, session, product)
+		if err != nil {
+			return err
+		}
+		if productId, err = result.LastInsertId(); err != nil {
+			return err
+		}
+
+		version, err := newProductVersion(uint64(productId), product.Version, termsOf(product), []productversion.Change{}, productversion.StatusActive, time.Now(), in.OperatorId, in.OperatorName)
+		if err != nil {
+			return err
+		}
+		_, err = l.svcCtx.LeaseProductVersionsModel.InsertWithSession(ctx, session, version)
+		return err
+	})
 	if err != nil {
-		l.Errorf("获取新产品ID失败: %v", err)
-		return nil, fmt.Errorf("创建成功但查询失败")
+		l.Errorf("创建产品失败: %v", err)
+		return nil, fmt.Errorf("创建产品失败")
 	}
+	product.Id = uint64(productId)
+	_ = l.svcCtx.LeaseProductModel.DelProductCache(l.ctx, product)
 
 	// 查询完整的产品信息
 	createdProduct, err := l.svcCtx.LeaseProductModel.FindOne(l.ctx, uint64(productId))
@@ -78,23 +116,29 @@ func (l *CreateLeaseProductLogic) CreateLeaseProduct(in *leaseproduct.CreateLeas
 
 	return &leaseproduct.CreateLeaseProductResp{
 		Data: &leaseproduct.LeaseProductInfo{
-			Id:             int64(createdProduct.Id),
-			ProductCode:    createdProduct.ProductCode,
-			Name:           createdProduct.Name,
-			Type:           createdProduct.Type,
-			Machinery:      createdProduct.Machinery,
-			Brand:          createdProduct.Brand,
-			Model:          createdProduct.Model,
-			DailyRate:      createdProduct.DailyRate,
-			Deposit:        createdProduct.Deposit,
-			MaxDuration:    int32(createdProduct.MaxDuration),
-			MinDuration:    int32(createdProduct.MinDuration),
-			Description:    createdProduct.Description,
-			InventoryCount: int32(createdProduct.InventoryCount),
-			AvailableCount: int32(createdProduct.AvailableCount),
-			Status:         int32(createdProduct.Status),
-			CreatedAt:      createdProduct.CreatedAt.Unix(),
-			UpdatedAt:      createdProduct.UpdatedAt.Unix(),
+			Id:              int64(createdProduct.Id),
+			ProductCode:     createdProduct.ProductCode,
+			Name:            createdProduct.Name,
+			Type:            createdProduct.Type,
+			Machinery:       createdProduct.Machinery,
+			Brand:           createdProduct.Brand,
+			Model:           createdProduct.Model,
+			DailyRate:       createdProduct.DailyRate,
+			Deposit:         createdProduct.Deposit,
+			MaxDuration:     int32(createdProduct.MaxDuration),
+			MinDuration:     int32(createdProduct.MinDuration),
+			Description:     createdProduct.Description,
+			ApprovalChain:   createdProduct.ApprovalChain,
+			InventoryCount:  int32(createdProduct.InventoryCount),
+			AvailableCount:  int32(createdProduct.AvailableCount),
+			Status:          int32(createdProduct.Status),
+			CreatedAt:       createdProduct.CreatedAt.Unix(),
+			UpdatedAt:       createdProduct.UpdatedAt.Unix(),
+			Version:         int32(createdProduct.Version),
+			LaunchAt:        productschedule.ToUnix(createdProduct.LaunchAt),
+			DelistAt:        productschedule.ToUnix(createdProduct.DelistAt),
+			EligibilityRule: createdProduct.EligibilityRule,
+			PricingRule:     createdProduct.PricingRule,
 		},
 	}, nil
 }
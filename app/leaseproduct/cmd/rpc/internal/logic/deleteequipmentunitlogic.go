@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"model"
+	"rpc/internal/svc"
+	"rpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteEquipmentUnitLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteEquipmentUnitLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteEquipmentUnitLogic {
+	return &DeleteEquipmentUnitLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *DeleteEquipmentUnitLogic) DeleteEquipmentUnit(in *leaseproduct.DeleteEquipmentUnitReq) (*leaseproduct.DeleteEquipmentUnitResp, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, fmt.Errorf("设备ID不能为空")
+	}
+
+	unit, err := l.svcCtx.LeaseEquipmentUnitsModel.FindOne(l.ctx, uint64(in.Id))
+	if err == model.ErrNotFound {
+		return nil, fmt.Errorf("设备不存在")
+	}
+	if err != nil {
+		l.Errorf("查询设备失败: %v", err)
+		return nil, fmt.Errorf("删除设备失败")
+	}
+
+	// 仍被租赁预占的设备不能删除,预占释放后才可删除
+	ok, err := l.svcCtx.LeaseEquipmentUnitsModel.DeleteUnlessReserved(l.ctx, unit)
+	if err != nil {
+		l.Errorf("删除设备失败: %v", err)
+		return nil, fmt.Errorf("删除设备失败")
+	}
+	if !ok {
+		return nil, fmt.Errorf("状态错误，设备有未释放的租赁预占，不能删除")
+	}
+
+	l.Infof("删除设备: unitId=%d, serialNumber=%s", unit.Id, unit.SerialNumber)
+	return &leaseproduct.DeleteEquipmentUnitResp{}, nil
+}
@@ -3,11 +3,16 @@ package logic
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"leaserpc/leaseclient"
+	"model"
+	"rpc/internal/breaker"
 	"rpc/internal/svc"
 	"rpc/leaseproduct"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
 type DeleteLeaseProductLogic struct {
@@ -37,16 +42,45 @@ func (l *DeleteLeaseProductLogic) DeleteLeaseProduct(in *leaseproduct.DeleteLeas
 		return nil, fmt.Errorf("产品不存在")
 	}
 
-	// TODO: 检查是否有正在进行的租赁申请
-	// 这里应该调用lease.rpc检查是否有未完成的租赁申请
-	// 如果有正在进行的租赁，应该禁止删除
+	// 通过租赁服务检查产品引用,无法确认时拒绝删除; 远程调用不在事务内进行,避免长时间持有产品行锁
+	usage, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "lease-rpc", func() (*leaseclient.CountProductApplicationsResp, error) {
+		return l.svcCtx.LeaseClient.CountProductApplications(l.ctx, &leaseclient.CountProductApplicationsReq{
+			ProductId: int64(product.Id),
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("查询产品引用失败: productId=%d, err=%v", product.Id, err)
+		return nil, fmt.Errorf("删除产品失败，无法确认产品引用情况，请稍后重试")
+	}
+	if usage.Active > 0 {
+		return nil, fmt.Errorf("状态错误，产品仍有%d笔进行中的租赁申请，不能删除", usage.Active)
+	}
 
-	// 删除产品
-	err = l.svcCtx.LeaseProductModel.Delete(l.ctx, product.Id)
+	// 锁定产品后软删除并取消待生效版本,预占库存的请求在删除提交前等待,删除后按产品不存在处理
+	var cancelled []*model.LeaseProductVersions
+	err = l.svcCtx.LeaseProductModel.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
+		if _, err := l.svcCtx.LeaseProductModel.FindOneForUpdateWithSession(ctx, session, product.Id); err != nil {
+			return err
+		}
+
+		// 软删除产品,历史申请仍可追溯产品信息
+		if err := l.svcCtx.LeaseProductModel.SoftDeleteWithSession(ctx, session, product, time.Now()); err != nil {
+			return err
+		}
+		versions, err := l.svcCtx.LeaseProductVersionsModel.CancelScheduledWithSession(ctx, session, product.Id)
+		cancelled = versions
+		return err
+	})
+	if err == model.ErrNotFound {
+		return nil, fmt.Errorf("产品不存在")
+	}
 	if err != nil {
 		l.Errorf("删除产品失败: %v", err)
 		return nil, fmt.Errorf("删除产品失败")
 	}
+	_ = l.svcCtx.LeaseProductModel.DelProductCache(l.ctx, product)
+	_ = l.svcCtx.LeaseProductVersionsModel.DelVersionCache(l.ctx, cancelled...)
 
+	l.Infof("产品已归档: productId=%d, productCode=%s, 历史申请数=%d", product.Id, product.ProductCode, usage.Total)
 	return &leaseproduct.DeleteLeaseProductResp{}, nil
 }
@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"appuserrpc/appuserclient"
+	"common/eligibility"
+	"rpc/internal/breaker"
+	"rpc/internal/svc"
+	"rpc/leaseproduct"
+)
+
+// normalizeEligibilityRule 校验产品准入规则配置,返回规范化后的配置,为空表示不限制
+func normalizeEligibilityRule(config string) (string, error) {
+	rule, err := eligibility.Parse(config)
+	if err != nil {
+		return "", err
+	}
+	return rule.String(), nil
+}
+
+// loadApplicant 通过用户服务获取准入校验所需的申请人资料
+func loadApplicant(ctx context.Context, svcCtx *svc.ServiceContext, userId int64) (eligibility.Applicant, error) {
+	userResp, err := breaker.DoWithBreakerResultAcceptable(ctx, "appuser-rpc", func() (*appuserclient.GetUserInfoResp, error) {
+		return svcCtx.AppUserClient.GetUserById(ctx, &appuserclient.GetUserByIdReq{
+			UserId: userId,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		return eligibility.Applicant{}, err
+	}
+	if userResp.UserInfo == nil {
+		return eligibility.Applicant{}, fmt.Errorf("用户信息不存在")
+	}
+
+	return eligibility.Applicant{
+		Age:        int(userResp.UserInfo.Age),
+		Occupation: userResp.UserInfo.Occupation,
+		Income:     userResp.UserInfo.Income,
+	}, nil
+}
+
+// checkProductEligibility 按产品准入规则校验申请人,返回全部未满足的条件,全部满足时返回空
+func checkProductEligibility(config string, applicant eligibility.Applicant) ([]*leaseproduct.EligibilityReason, error) {
+	rule, err := eligibility.Parse(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var reasons []*leaseproduct.EligibilityReason
+	for _, reason := range rule.Check(applicant) {
+		reasons = append(reasons, &leaseproduct.EligibilityReason{
+			Code:    reason.Code,
+			Message: reason.Message,
+			Limit:   reason.Limit,
+			Actual:  reason.Actual,
+		})
+	}
+	return reasons, nil
+}
@@ -0,0 +1,122 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"model"
+	"rpc/internal/svc"
+	"rpc/leaseproduct"
+)
+
+// 设备状态,租用情况按预占记录的租期判断,不占用设备状态
+// available <--> maintenance, available/maintenance --> retired
+const (
+	unitStatusAvailable   = "available"
+	unitStatusMaintenance = "maintenance"
+	unitStatusRetired     = "retired"
+)
+
+// unitStatusLeased 已租出,由租期覆盖当天的预占推导,仅用于展示和列表筛选,不落库
+const unitStatusLeased = "leased"
+
+// 设备状况
+const (
+	unitConditionNew  = "new"
+	unitConditionGood = "good"
+	unitConditionFair = "fair"
+	unitConditionPoor = "poor"
+)
+
+// errNoAssignableUnit 产品登记了设备台账,但租期内没有可分配的设备
+var errNoAssignableUnit = errors.New("库存不足，租期内暂无可分配的设备")
+
+// validUnitCondition 校验设备状况
+func validUnitCondition(condition string) bool {
+	switch condition {
+	case unitConditionNew, unitConditionGood, unitConditionFair, unitConditionPoor:
+		return true
+	}
+	return false
+}
+
+// validUnitStatus 校验设备状态
+func validUnitStatus(status string) bool {
+	switch status {
+	case unitStatusAvailable, unitStatusMaintenance, unitStatusRetired:
+		return true
+	}
+	return false
+}
+
+// parsePurchaseDate 解析购置日期(YYYY-MM-DD),为空表示未登记,不能晚于今天
+func parsePurchaseDate(value string) (sql.NullTime, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return sql.NullTime{}, nil
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return sql.NullTime{}, fmt.Errorf("参数错误，购置日期格式错误，应为YYYY-MM-DD")
+	}
+	if date.After(time.Now()) {
+		return sql.NullTime{}, fmt.Errorf("参数错误，购置日期不能晚于今天")
+	}
+	return sql.NullTime{Time: date, Valid: true}, nil
+}
+
+// unitProductCode 查询设备所属产品的编码,产品已删除时仍返回原编码,查询失败时返回空
+func unitProductCode(ctx context.Context, svcCtx *svc.ServiceContext, productId uint64) string {
+	product, err := svcCtx.LeaseProductModel.FindOneIncludingDeleted(ctx, productId)
+	if err != nil {
+		return ""
+	}
+	return product.ProductCode
+}
+
+// currentApplications 查询设备当天所处预占对应的申请编号,查询失败时返回空
+func currentApplications(ctx context.Context, svcCtx *svc.ServiceContext, units ...*model.LeaseEquipmentUnits) map[uint64]string {
+	unitIds := make([]uint64, 0, len(units))
+	for _, unit := range units {
+		unitIds = append(unitIds, unit.Id)
+	}
+	reservations, err := svcCtx.LeaseProductReservationsModel.FindCurrentByUnitIds(ctx, unitIds, time.Now())
+	if err != nil {
+		return nil
+	}
+
+	applications := make(map[uint64]string, len(reservations))
+	for _, reservation := range reservations {
+		applications[reservation.UnitId] = reservation.ApplicationId
+	}
+	return applications
+}
+
+// toEquipmentUnitInfo 转换为设备台账响应
+func toEquipmentUnitInfo(unit *model.LeaseEquipmentUnits, productCode, applicationId string) *leaseproduct.EquipmentUnitInfo {
+	info := &leaseproduct.EquipmentUnitInfo{
+		Id:            int64(unit.Id),
+		ProductId:     int64(unit.ProductId),
+		ProductCode:   productCode,
+		SerialNumber:  unit.SerialNumber,
+		HoursUsed:     unit.HoursUsed,
+		Location:      unit.Location,
+		Condition:     unit.Condition,
+		Status:        unit.Status,
+		ApplicationId: applicationId,
+		Remark:        unit.Remark,
+		CreatedAt:     unit.CreatedAt.Unix(),
+		UpdatedAt:     unit.UpdatedAt.Unix(),
+	}
+	if applicationId != "" && unit.Status == unitStatusAvailable {
+		info.Status = unitStatusLeased
+	}
+	if unit.PurchaseDate.Valid {
+		info.PurchaseDate = unit.PurchaseDate.Time.Format("2006-01-02")
+	}
+	return info
+}
@@ -0,0 +1,46 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"model"
+	"rpc/internal/svc"
+	"rpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetEquipmentUnitLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetEquipmentUnitLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetEquipmentUnitLogic {
+	return &GetEquipmentUnitLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetEquipmentUnitLogic) GetEquipmentUnit(in *leaseproduct.GetEquipmentUnitReq) (*leaseproduct.GetEquipmentUnitResp, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, fmt.Errorf("设备ID不能为空")
+	}
+
+	unit, err := l.svcCtx.LeaseEquipmentUnitsModel.FindOne(l.ctx, uint64(in.Id))
+	if err == model.ErrNotFound {
+		return nil, fmt.Errorf("设备不存在")
+	}
+	if err != nil {
+		l.Errorf("查询设备失败: %v", err)
+		return nil, fmt.Errorf("查询设备失败")
+	}
+
+	return &leaseproduct.GetEquipmentUnitResp{
+		Data: toEquipmentUnitInfo(unit, unitProductCode(l.ctx, l.svcCtx, unit.ProductId), currentApplications(l.ctx, l.svcCtx, unit)[unit.Id]),
+	}, nil
+}
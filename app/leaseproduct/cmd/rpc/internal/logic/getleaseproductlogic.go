@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"common/productschedule"
 	"rpc/internal/svc"
 	"rpc/leaseproduct"
 
@@ -40,23 +41,29 @@ func (l *GetLeaseProductLogic) GetLeaseProduct(in *leaseproduct.GetLeaseProductR
 
 	return &leaseproduct.GetLeaseProductResp{
 		Data: &leaseproduct.LeaseProductInfo{
-			Id:             int64(product.Id),
-			ProductCode:    product.ProductCode,
-			Name:           product.Name,
-			Type:           product.Type,
-			Machinery:      product.Machinery,
-			Brand:          product.Brand,
-			Model:          product.Model,
-			DailyRate:      product.DailyRate,
-			Deposit:        product.Deposit,
-			MaxDuration:    int32(product.MaxDuration),
-			MinDuration:    int32(product.MinDuration),
-			Description:    product.Description,
-			InventoryCount: int32(product.InventoryCount),
-			AvailableCount: int32(product.AvailableCount),
-			Status:         int32(product.Status),
-			CreatedAt:      product.CreatedAt.Unix(),
-			UpdatedAt:      product.UpdatedAt.Unix(),
+			Id:              int64(product.Id),
+			ProductCode:     product.ProductCode,
+			Name:            product.Name,
+			Type:            product.Type,
+			Machinery:       product.Machinery,
+			Brand:           product.Brand,
+			Model:           product.Model,
+			DailyRate:       product.DailyRate,
+			Deposit:         product.Deposit,
+			MaxDuration:     int32(product.MaxDuration),
+			MinDuration:     int32(product.MinDuration),
+			Description:     product.Description,
+			ApprovalChain:   product.ApprovalChain,
+			InventoryCount:  int32(product.InventoryCount),
+			AvailableCount:  int32(product.AvailableCount),
+			Status:          int32(product.Status),
+			CreatedAt:       product.CreatedAt.Unix(),
+			UpdatedAt:       product.UpdatedAt.Unix(),
+			Version:         int32(product.Version),
+			LaunchAt:        productschedule.ToUnix(product.LaunchAt),
+			DelistAt:        productschedule.ToUnix(product.DelistAt),
+			EligibilityRule: product.EligibilityRule,
+			PricingRule:     product.PricingRule,
 		},
 	}, nil
 }
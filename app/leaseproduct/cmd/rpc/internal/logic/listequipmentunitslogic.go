@@ -0,0 +1,100 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"model"
+	"rpc/internal/svc"
+	"rpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListEquipmentUnitsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListEquipmentUnitsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListEquipmentUnitsLogic {
+	return &ListEquipmentUnitsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 设备台账
+func (l *ListEquipmentUnitsLogic) ListEquipmentUnits(in *leaseproduct.ListEquipmentUnitsReq) (*leaseproduct.ListEquipmentUnitsResp, error) {
+	// 参数验证
+	if in.ProductCode == "" {
+		return nil, fmt.Errorf("产品编码不能为空")
+	}
+	if in.Status != "" && in.Status != unitStatusLeased && !validUnitStatus(in.Status) {
+		return nil, fmt.Errorf("参数错误，设备状态只能为available、leased、maintenance或retired")
+	}
+	if in.Page <= 0 {
+		in.Page = 1
+	}
+	if in.Size <= 0 {
+		in.Size = 10
+	}
+	if in.Size > 100 {
+		in.Size = 100 // 限制最大页面大小
+	}
+
+	product, err := l.svcCtx.LeaseProductModel.FindOneByProductCode(l.ctx, in.ProductCode)
+	if err == model.ErrNotFound {
+		return nil, fmt.Errorf("产品不存在")
+	}
+	if err != nil {
+		l.Errorf("查询产品失败: %v", err)
+		return nil, fmt.Errorf("查询设备失败")
+	}
+
+	// 构建查询条件,已租出与可用均为 available 设备,按是否有租期覆盖当天的预占区分
+	conditions := []string{"product_id = ?"}
+	args := []interface{}{product.Id}
+	today := time.Now().Format("2006-01-02")
+	leasedCondition := "exists (select 1 from `lease_product_reservations` r where r.unit_id = `lease_equipment_units`.id and r.status = 'reserved' and r.start_date <= ? and r.end_date >= ?)"
+	switch in.Status {
+	case "":
+	case unitStatusLeased:
+		conditions = append(conditions, "status = ?", leasedCondition)
+		args = append(args, unitStatusAvailable, today, today)
+	case unitStatusAvailable:
+		conditions = append(conditions, "status = ?", "not "+leasedCondition)
+		args = append(args, unitStatusAvailable, today, today)
+	default:
+		conditions = append(conditions, "status = ?")
+		args = append(args, in.Status)
+	}
+	whereClause := "WHERE " + strings.Join(conditions, " AND ")
+
+	total, err := l.svcCtx.LeaseEquipmentUnitsModel.CountWithConditions(l.ctx, whereClause, args)
+	if err != nil {
+		l.Errorf("查询设备总数失败: %v", err)
+		return nil, fmt.Errorf("查询设备失败")
+	}
+
+	rows, err := l.svcCtx.LeaseEquipmentUnitsModel.ListWithConditions(l.ctx, whereClause, args, in.Size, (in.Page-1)*in.Size)
+	if err != nil && err != sql.ErrNoRows {
+		l.Errorf("查询设备列表失败: %v", err)
+		return nil, fmt.Errorf("查询设备列表失败")
+	}
+
+	applications := currentApplications(l.ctx, l.svcCtx, rows...)
+	units := make([]*leaseproduct.EquipmentUnitInfo, 0, len(rows))
+	for _, row := range rows {
+		units = append(units, toEquipmentUnitInfo(row, product.ProductCode, applications[row.Id]))
+	}
+
+	return &leaseproduct.ListEquipmentUnitsResp{
+		List:  units,
+		Total: total,
+	}, nil
+}
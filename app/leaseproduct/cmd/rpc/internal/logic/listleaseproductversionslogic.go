@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"rpc/internal/svc"
+	"rpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListLeaseProductVersionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListLeaseProductVersionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListLeaseProductVersionsLogic {
+	return &ListLeaseProductVersionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListLeaseProductVersionsLogic) ListLeaseProductVersions(in *leaseproduct.ListLeaseProductVersionsReq) (*leaseproduct.ListLeaseProductVersionsResp, error) {
+	if in.ProductCode == "" {
+		return nil, fmt.Errorf("产品编码不能为空")
+	}
+
+	// 查询产品是否存在
+	product, err := l.svcCtx.LeaseProductModel.FindOneByProductCode(l.ctx, in.ProductCode)
+	if err != nil {
+		l.Errorf("查询产品失败: %v", err)
+		return nil, fmt.Errorf("产品不存在")
+	}
+
+	versions, err := l.svcCtx.LeaseProductVersionsModel.FindByProductId(l.ctx, product.Id)
+	if err != nil {
+		l.Errorf("查询产品版本失败: %v", err)
+		return nil, fmt.Errorf("查询产品版本失败")
+	}
+
+	list := make([]*leaseproduct.LeaseProductVersionInfo, 0, len(versions))
+	for _, version := range versions {
+		list = append(list, convertProductVersion(version))
+	}
+
+	return &leaseproduct.ListLeaseProductVersionsResp{
+		List: list,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"fmt"
+	"time"
+
+	"common/leasepricing"
+	"common/productschedule"
+	"model"
+	"rpc/leaseproduct"
+)
+
+// leaseDuration 租期天数,起止日期两端均包含
+func leaseDuration(start, end time.Time) int32 {
+	return int32(dateOf(end).Sub(dateOf(start)).Hours()/24) + 1
+}
+
+// checkLeaseDuration 校验租期是否在产品允许范围内
+func checkLeaseDuration(product *model.LeaseProducts, duration int32) error {
+	if duration < int32(product.MinDuration) {
+		return fmt.Errorf("租期不能少于最小租期")
+	}
+	if duration > int32(product.MaxDuration) {
+		return fmt.Errorf("租期不能超过最大租期")
+	}
+	return nil
+}
+
+// normalizePricingRule 校验产品定价规则配置,返回规范化后的配置,为空表示按日租金计价
+func normalizePricingRule(config string) (string, error) {
+	rule, err := leasepricing.Parse(config)
+	if err != nil {
+		return "", err
+	}
+	return rule.String(), nil
+}
+
+// quoteLease 按产品日租金与定价规则计算租期报价,today 为报价日
+func quoteLease(product *model.LeaseProducts, start, end, today time.Time) (*leaseproduct.QuoteLeaseResp, error) {
+	if product.Status != productschedule.StatusOnSale {
+		return nil, fmt.Errorf("产品状态错误，产品未上架")
+	}
+
+	duration := leaseDuration(start, end)
+	if err := checkLeaseDuration(product, duration); err != nil {
+		return nil, err
+	}
+
+	rule, err := leasepricing.Parse(product.PricingRule)
+	if err != nil {
+		return nil, err
+	}
+	quote := rule.Quote(product.DailyRate, start, end, today)
+
+	return &leaseproduct.QuoteLeaseResp{
+		ProductCode:          product.ProductCode,
+		Duration:             duration,
+		DailyRate:            product.DailyRate,
+		TotalAmount:          quote.TotalAmount,
+		Deposit:              product.Deposit,
+		BaseAmount:           quote.BaseAmount,
+		SeasonalDays:         int32(quote.SeasonalDays),
+		SeasonalAmount:       quote.SeasonalAmount,
+		DurationDiscount:     quote.DurationDiscount,
+		EarlyBookingDiscount: quote.EarlyBookingDiscount,
+	}, nil
+}
@@ -0,0 +1,222 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"common/productversion"
+	"model"
+	"rpc/internal/svc"
+	"rpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// errVersionProcessed 待生效版本已被其他实例处理
+var errVersionProcessed = errors.New("版本已被处理")
+
+// productTerms 纳入版本管理的产品条款,json 标签即版本变更记录中的字段名
+// 产品编码、库存与上下架状态不属于条款,修改后立即生效且不产生版本
+type productTerms struct {
+	Name            string  `json:"name"`
+	Type            string  `json:"type"`
+	Machinery       string  `json:"machinery"`
+	Brand           string  `json:"brand"`
+	Model           string  `json:"model"`
+	DailyRate       float64 `json:"daily_rate"`
+	Deposit         float64 `json:"deposit"`
+	MaxDuration     uint64  `json:"max_duration"`
+	MinDuration     uint64  `json:"min_duration"`
+	Description     string  `json:"description"`
+	ApprovalChain   string  `json:"approval_chain"`
+	EligibilityRule string  `json:"eligibility_rule"`
+	PricingRule     string  `json:"pricing_rule"`
+}
+
+// termsOf 提取产品当前条款
+func termsOf(product *model.LeaseProducts) productTerms {
+	return productTerms{
+		Name:            product.Name,
+		Type:            product.Type,
+		Machinery:       product.Machinery,
+		Brand:           product.Brand,
+		Model:           product.Model,
+		DailyRate:       product.DailyRate,
+		Deposit:         product.Deposit,
+		MaxDuration:     product.MaxDuration,
+		MinDuration:     product.MinDuration,
+		Description:     product.Description,
+		ApprovalChain:   product.ApprovalChain,
+		EligibilityRule: product.EligibilityRule,
+		PricingRule:     product.PricingRule,
+	}
+}
+
+// applyTo 将条款写回产品
+func (t productTerms) applyTo(product *model.LeaseProducts) {
+	product.Name = t.Name
+	product.Type = t.Type
+	product.Machinery = t.Machinery
+	product.Brand = t.Brand
+	product.Model = t.Model
+	product.DailyRate = t.DailyRate
+	product.Deposit = t.Deposit
+	product.MaxDuration = t.MaxDuration
+	product.MinDuration = t.MinDuration
+	product.Description = t.Description
+	product.ApprovalChain = t.ApprovalChain
+	product.EligibilityRule = t.EligibilityRule
+	product.PricingRule = t.PricingRule
+}
+
+// newProductVersion 构造版本记录,terms 为版本生效后的完整条款
+func newProductVersion(productId, version uint64, terms productTerms, changes []productversion.Change, status string, effectiveFrom time.Time, operatorId int64, operatorName string) (*model.LeaseProductVersions, error) {
+	termsJSON, err := json.Marshal(terms)
+	if err != nil {
+		return nil, err
+	}
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.LeaseProductVersions{
+		ProductId:     productId,
+		Version:       version,
+		Terms:         string(termsJSON),
+		Changes:       string(changesJSON),
+		Status:        status,
+		EffectiveFrom: effectiveFrom,
+		OperatorId:    uint64(operatorId),
+		OperatorName:  operatorName,
+	}, nil
+}
+
+// switchProductVersion 在事务中更新产品条款并切换生效版本: 原生效版本失效,新版本写入或由待生效转为生效
+// 先锁定产品,apply 在锁定后读取的最新记录上修改条款,不会覆盖并发修改的其他字段或恢复已删除的产品
+// 产品已删除时返回 ErrNotFound; 待生效版本已被其他实例处理时返回 false,事务回滚
+func switchProductVersion(ctx context.Context, svcCtx *svc.ServiceContext, productId uint64, next *model.LeaseProductVersions, now time.Time,
+	apply func(product *model.LeaseProducts) error) (bool, error) {
+	var product *model.LeaseProducts
+	var current *model.LeaseProductVersions
+	err := svcCtx.LeaseProductModel.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		locked, err := svcCtx.LeaseProductModel.FindOneForUpdateWithSession(ctx, session, productId)
+		if err != nil {
+			return err
+		}
+		current, err = svcCtx.LeaseProductVersionsModel.FindActiveByProductIdWithSession(ctx, session, productId)
+		if err != nil && err != model.ErrNotFound {
+			return err
+		}
+
+		if err := apply(locked); err != nil {
+			return err
+		}
+		locked.Version = next.Version
+		product = locked
+		if err := svcCtx.LeaseProductModel.UpdateWithSession(ctx, session, locked); err != nil {
+			return err
+		}
+		if current != nil {
+			if err := svcCtx.LeaseProductVersionsModel.SupersedeWithSession(ctx, session, current, now); err != nil {
+				return err
+			}
+		}
+		if next.Id == 0 {
+			result, err := svcCtx.LeaseProductVersionsModel.InsertWithSession(ctx, session, next)
+			if err != nil {
+				return err
+			}
+			id, err := result.LastInsertId()
+			if err != nil {
+				return err
+			}
+			next.Id = uint64(id)
+			return nil
+		}
+
+		ok, err := svcCtx.LeaseProductVersionsModel.ActivateWithSession(ctx, session, next)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errVersionProcessed
+		}
+		return nil
+	})
+	if errors.Is(err, errVersionProcessed) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	_ = svcCtx.LeaseProductModel.DelProductCache(ctx, product)
+	if current != nil {
+		_ = svcCtx.LeaseProductVersionsModel.DelVersionCache(ctx, current, next)
+	} else {
+		_ = svcCtx.LeaseProductVersionsModel.DelVersionCache(ctx, next)
+	}
+	return true, nil
+}
+
+// ActivateScheduledVersion 使到期的待生效版本生效
+// 只应用该版本自身变更的字段,排期之后对其他字段的修改保持不变
+func ActivateScheduledVersion(ctx context.Context, svcCtx *svc.ServiceContext, version *model.LeaseProductVersions, now time.Time) (bool, error) {
+	var changes []productversion.Change
+	if err := json.Unmarshal([]byte(version.Changes), &changes); err != nil {
+		return false, err
+	}
+
+	return switchProductVersion(ctx, svcCtx, version.ProductId, version, now, func(product *model.LeaseProducts) error {
+		terms := termsOf(product)
+		if err := productversion.Apply(&terms, changes); err != nil {
+			return err
+		}
+		termsJSON, err := json.Marshal(terms)
+		if err != nil {
+			return err
+		}
+
+		terms.applyTo(product)
+		version.Terms = string(termsJSON)
+		return nil
+	})
+}
+
+// convertProductVersion 转换版本记录
+func convertProductVersion(version *model.LeaseProductVersions) *leaseproduct.LeaseProductVersionInfo {
+	var changes []productversion.Change
+	_ = json.Unmarshal([]byte(version.Changes), &changes)
+
+	items := make([]*leaseproduct.ProductVersionChange, 0, len(changes))
+	for _, change := range changes {
+		from, _ := json.Marshal(change.From)
+		to, _ := json.Marshal(change.To)
+		items = append(items, &leaseproduct.ProductVersionChange{
+			Field: change.Field,
+			From:  string(from),
+			To:    string(to),
+		})
+	}
+
+	var effectiveTo int64
+	if version.EffectiveTo.Valid {
+		effectiveTo = version.EffectiveTo.Time.Unix()
+	}
+
+	return &leaseproduct.LeaseProductVersionInfo{
+		Id:            int64(version.Id),
+		ProductId:     int64(version.ProductId),
+		Version:       int32(version.Version),
+		Status:        version.Status,
+		Changes:       items,
+		EffectiveFrom: version.EffectiveFrom.Unix(),
+		EffectiveTo:   effectiveTo,
+		OperatorId:    int64(version.OperatorId),
+		OperatorName:  version.OperatorName,
+		CreatedAt:     version.CreatedAt.Unix(),
+	}
+}
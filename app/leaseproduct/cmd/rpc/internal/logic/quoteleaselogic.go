@@ -0,0 +1,47 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"rpc/internal/svc"
+	"rpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type QuoteLeaseLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewQuoteLeaseLogic(ctx context.Context, svcCtx *svc.ServiceContext) *QuoteLeaseLogic {
+	return &QuoteLeaseLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 租赁报价
+func (l *QuoteLeaseLogic) QuoteLease(in *leaseproduct.QuoteLeaseReq) (*leaseproduct.QuoteLeaseResp, error) {
+	// 参数验证
+	if in.ProductCode == "" {
+		return nil, fmt.Errorf("产品编码不能为空")
+	}
+
+	startDate, endDate, err := parseLeasePeriod(in.StartDate, in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := l.svcCtx.LeaseProductModel.FindOneByProductCode(l.ctx, in.ProductCode)
+	if err != nil {
+		l.Errorf("查询产品失败: %v", err)
+		return nil, fmt.Errorf("产品不存在")
+	}
+
+	return quoteLease(product, startDate, endDate, time.Now())
+}
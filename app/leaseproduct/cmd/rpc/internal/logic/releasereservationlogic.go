@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"model"
+	"rpc/internal/svc"
+	"rpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReleaseReservationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReleaseReservationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReleaseReservationLogic {
+	return &ReleaseReservationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ReleaseReservationLogic) ReleaseReservation(in *leaseproduct.ReleaseReservationReq) (*leaseproduct.ReleaseReservationResp, error) {
+	// 参数验证
+	if in.ApplicationId == "" {
+		return nil, fmt.Errorf("申请编号不能为空")
+	}
+
+	// 申请未预占库存时无需释放,撤销或拒绝未审批通过的申请均走此分支
+	reservation, err := l.svcCtx.LeaseProductReservationsModel.FindOneByApplicationId(l.ctx, in.ApplicationId)
+	if err == model.ErrNotFound {
+		return &leaseproduct.ReleaseReservationResp{}, nil
+	}
+	if err != nil {
+		l.Errorf("查询库存预占失败: %v", err)
+		return nil, fmt.Errorf("释放库存失败")
+	}
+
+	// 按预占状态条件释放,重复释放时直接返回
+	released, err := l.svcCtx.LeaseProductReservationsModel.ReleaseIfReserved(l.ctx, reservation, time.Now())
+	if err != nil {
+		l.Errorf("释放库存失败: %v", err)
+		return nil, fmt.Errorf("释放库存失败")
+	}
+	if released {
+		// 分配的设备随预占释放,租期内可再分配给其他申请
+		l.Infof("释放库存预占, 申请编号: %s, 产品ID: %d, 数量: %d, 设备ID: %d", reservation.ApplicationId, reservation.ProductId, reservation.Quantity, reservation.UnitId)
+	}
+
+	return &leaseproduct.ReleaseReservationResp{
+		Released: released,
+	}, nil
+}
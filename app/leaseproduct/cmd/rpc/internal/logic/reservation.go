@@ -0,0 +1,84 @@
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"model"
+)
+
+// 库存预占状态
+const (
+	reservationStatusReserved = "reserved"
+	reservationStatusReleased = "released"
+)
+
+// errInventoryShortage 租期内可用库存不足
+var errInventoryShortage = errors.New("库存不足")
+
+// errUnitQuantity 登记了设备台账的产品每条预占记录对应一台设备
+var errUnitQuantity = errors.New("参数错误，登记设备台账的产品每次只能预占1台设备")
+
+// parseLeasePeriod 解析租期起止日期(YYYY-MM-DD),两端均包含
+func parseLeasePeriod(startDate, endDate string) (time.Time, time.Time, error) {
+	start, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("开始日期格式错误，应为YYYY-MM-DD")
+	}
+
+	end, err := time.Parse("2006-01-02", endDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("结束日期格式错误，应为YYYY-MM-DD")
+	}
+
+	if start.After(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("开始日期不能晚于结束日期")
+	}
+
+	return start, end, nil
+}
+
+// dateOf 取日期部分,数据库 date 字段按连接时区解析,统一后再逐日比较
+func dateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// reservedPeak 统计租期内每天预占数量的峰值
+// 租期内互不重叠的预占可以先后使用同一台设备,按天取峰值而不是简单累加
+func reservedPeak(reservations []*model.LeaseProductReservations, start, end time.Time) int64 {
+	var peak int64
+	for day := dateOf(start); !day.After(dateOf(end)); day = day.AddDate(0, 0, 1) {
+		var reserved int64
+		for _, reservation := range reservations {
+			if !dateOf(reservation.StartDate).After(day) && !dateOf(reservation.EndDate).Before(day) {
+				reserved += int64(reservation.Quantity)
+			}
+		}
+		if reserved > peak {
+			peak = reserved
+		}
+	}
+	return peak
+}
+
+// unitInventory 登记设备台账的产品按设备计算可用数量: 租期内没有预占的可用设备数,
+// 未登记设备台账时返回 false,按库存数量计算
+func unitInventory(availability *model.UnitAvailability, quantity int32) (int32, bool, error) {
+	if availability.Registered == 0 {
+		return 0, false, nil
+	}
+	if quantity > 1 {
+		return 0, true, errUnitQuantity
+	}
+	return int32(availability.Assignable), true, nil
+}
+
+// availableInventory 租期内可用数量: 库存数量减去与租期重叠的预占峰值
+func availableInventory(product *model.LeaseProducts, reservations []*model.LeaseProductReservations, start, end time.Time) int32 {
+	available := int64(product.InventoryCount) - reservedPeak(reservations, start, end)
+	if available < 0 {
+		return 0
+	}
+	return int32(available)
+}
@@ -0,0 +1,168 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"model"
+	"rpc/internal/svc"
+	"rpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type ReserveInventoryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReserveInventoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReserveInventoryLogic {
+	return &ReserveInventoryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ReserveInventoryLogic) ReserveInventory(in *leaseproduct.ReserveInventoryReq) (*leaseproduct.ReserveInventoryResp, error) {
+	// 参数验证
+	if in.ProductCode == "" {
+		return nil, fmt.Errorf("产品编码不能为空")
+	}
+	if in.ApplicationId == "" {
+		return nil, fmt.Errorf("申请编号不能为空")
+	}
+	if in.Quantity <= 0 {
+		return nil, fmt.Errorf("数量必须大于0")
+	}
+	startDate, endDate, err := parseLeasePeriod(in.StartDate, in.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := l.svcCtx.LeaseProductModel.FindOneByProductCode(l.ctx, in.ProductCode)
+	if err == model.ErrNotFound {
+		return nil, fmt.Errorf("产品不存在")
+	}
+	if err != nil {
+		l.Errorf("查询产品失败: %v", err)
+		return nil, fmt.Errorf("预占库存失败")
+	}
+
+	// 锁定产品后统计重叠预占并写入预占记录,同一产品的并发审批按顺序预占库存
+	reservation := &model.LeaseProductReservations{
+		ProductId:     product.Id,
+		ApplicationId: in.ApplicationId,
+		Quantity:      uint64(in.Quantity),
+		StartDate:     startDate,
+		EndDate:       endDate,
+		Status:        reservationStatusReserved,
+	}
+	var availableCount int32
+	err = l.svcCtx.LeaseProductModel.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
+		locked, err := l.svcCtx.LeaseProductModel.FindOneForUpdateWithSession(ctx, session, product.Id)
+		if err != nil {
+			return err
+		}
+
+		// 同一申请重复预占时直接返回,保证审批重试幂等
+		// 已释放的预占(审批补偿或撤销后)再次审批时按新的租期重新预占,预占与释放的先后顺序由租赁服务的 Saga 保证
+		existing, err := l.svcCtx.LeaseProductReservationsModel.FindOneByApplicationIdWithSession(ctx, session, in.ApplicationId)
+		if err != nil && err != model.ErrNotFound {
+			return err
+		}
+		if existing != nil && existing.Status == reservationStatusReleased {
+			reservation.Id = existing.Id
+			existing = nil
+		}
+
+		// 登记了设备台账的产品按租期内可分配的设备计算可用数量,否则按库存数量减去重叠预占
+		availability, err := l.svcCtx.LeaseEquipmentUnitsModel.CountAvailabilityWithSession(ctx, session, locked.Id, startDate, endDate)
+		if err != nil {
+			return err
+		}
+		var hasUnits bool
+		availableCount, hasUnits, err = unitInventory(availability, in.Quantity)
+		if err != nil {
+			return err
+		}
+		if !hasUnits {
+			reservations, err := l.svcCtx.LeaseProductReservationsModel.FindOverlappingWithSession(ctx, session, locked.Id, startDate, endDate)
+			if err != nil {
+				return err
+			}
+			availableCount = availableInventory(locked, reservations, startDate, endDate)
+		}
+		if existing != nil {
+			reservation.UnitId = existing.UnitId
+			return nil
+		}
+
+		if availableCount < in.Quantity {
+			return errInventoryShortage
+		}
+		if hasUnits {
+			reservation.UnitId, err = l.assignUnit(ctx, session, locked.Id, startDate, endDate)
+			if err != nil {
+				return err
+			}
+		}
+		if reservation.Id > 0 {
+			err = l.svcCtx.LeaseProductReservationsModel.ReserveAgainWithSession(ctx, session, reservation)
+		} else {
+			_, err = l.svcCtx.LeaseProductReservationsModel.InsertWithSession(ctx, session, reservation)
+		}
+		if err != nil {
+			return err
+		}
+		availableCount -= in.Quantity
+		return nil
+	})
+	switch {
+	case err == nil:
+	case err == model.ErrNotFound:
+		return nil, fmt.Errorf("产品不存在")
+	case errors.Is(err, errInventoryShortage):
+		return nil, fmt.Errorf("库存不足，产品在%s至%s期间可用数量为%d", in.StartDate, in.EndDate, availableCount)
+	case errors.Is(err, errNoAssignableUnit):
+		return nil, errNoAssignableUnit
+	case errors.Is(err, errUnitQuantity):
+		return nil, errUnitQuantity
+	default:
+		l.Errorf("预占库存失败: %v", err)
+		return nil, fmt.Errorf("预占库存失败")
+	}
+
+	_ = l.svcCtx.LeaseProductReservationsModel.DelReservationCache(l.ctx, reservation)
+
+	resp := &leaseproduct.ReserveInventoryResp{
+		AvailableCount: availableCount,
+	}
+	if reservation.UnitId > 0 {
+		unit, err := l.svcCtx.LeaseEquipmentUnitsModel.FindOne(l.ctx, reservation.UnitId)
+		if err != nil {
+			l.Errorf("查询分配设备失败: %v", err)
+			return nil, fmt.Errorf("预占库存失败")
+		}
+		resp.UnitId = int64(unit.Id)
+		resp.SerialNumber = unit.SerialNumber
+	}
+	return resp, nil
+}
+
+// assignUnit 为租期分配一台没有重叠预占的可用设备,返回设备ID
+// 在锁定产品的事务中调用,同一产品的并发审批不会把同一台设备分配给重叠的租期
+func (l *ReserveInventoryLogic) assignUnit(ctx context.Context, session sqlx.Session, productId uint64, startDate, endDate time.Time) (uint64, error) {
+	unit, err := l.svcCtx.LeaseEquipmentUnitsModel.FindAssignableForUpdateWithSession(ctx, session, productId, startDate, endDate)
+	if err == model.ErrNotFound {
+		return 0, errNoAssignableUnit
+	}
+	if err != nil {
+		return 0, err
+	}
+	return unit.Id, nil
+}
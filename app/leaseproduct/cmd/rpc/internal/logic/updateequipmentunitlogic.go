@@ -0,0 +1,128 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"model"
+	"rpc/internal/svc"
+	"rpc/leaseproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateEquipmentUnitLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateEquipmentUnitLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateEquipmentUnitLogic {
+	return &UpdateEquipmentUnitLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *UpdateEquipmentUnitLogic) UpdateEquipmentUnit(in *leaseproduct.UpdateEquipmentUnitReq) (*leaseproduct.UpdateEquipmentUnitResp, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, fmt.Errorf("设备ID不能为空")
+	}
+	if in.HoursUsed < 0 {
+		return nil, fmt.Errorf("参数错误，累计使用小时数不能小于0")
+	}
+	if in.Condition != "" && !validUnitCondition(in.Condition) {
+		return nil, fmt.Errorf("参数错误，设备状况只能为new、good、fair或poor")
+	}
+
+	unit, err := l.svcCtx.LeaseEquipmentUnitsModel.FindOne(l.ctx, uint64(in.Id))
+	if err == model.ErrNotFound {
+		return nil, fmt.Errorf("设备不存在")
+	}
+	if err != nil {
+		l.Errorf("查询设备失败: %v", err)
+		return nil, fmt.Errorf("更新设备失败")
+	}
+	from := unit.Status
+
+	// 状态变更: 已退役的设备不再启用
+	if in.Status != "" && in.Status != unit.Status {
+		if !validUnitStatus(in.Status) {
+			return nil, fmt.Errorf("参数错误，设备状态只能设为available、maintenance或retired")
+		}
+		if unit.Status == unitStatusRetired {
+			return nil, fmt.Errorf("状态错误，设备已退役，不能变更状态")
+		}
+		unit.Status = in.Status
+	}
+	deactivate := unit.Status != from && unit.Status != unitStatusAvailable
+
+	if serialNumber := strings.TrimSpace(in.SerialNumber); serialNumber != "" && serialNumber != unit.SerialNumber {
+		if _, err := l.svcCtx.LeaseEquipmentUnitsModel.FindOneBySerialNumber(l.ctx, serialNumber); err == nil {
+			return nil, fmt.Errorf("参数错误，设备序列号已登记")
+		} else if err != model.ErrNotFound {
+			l.Errorf("查询设备失败: %v", err)
+			return nil, fmt.Errorf("更新设备失败")
+		}
+		// 修改序列号后需同时清理原序列号的缓存
+		if err := l.svcCtx.LeaseEquipmentUnitsModel.DelUnitCache(l.ctx, unit); err != nil {
+			l.Errorf("清理设备缓存失败: %v", err)
+		}
+		unit.SerialNumber = serialNumber
+	}
+	if in.PurchaseDate != "" {
+		purchaseDate, err := parsePurchaseDate(in.PurchaseDate)
+		if err != nil {
+			return nil, err
+		}
+		unit.PurchaseDate = purchaseDate
+	}
+	if in.HoursUsed > 0 {
+		if in.HoursUsed < unit.HoursUsed {
+			return nil, fmt.Errorf("参数错误，累计使用小时数不能小于当前值%.1f", unit.HoursUsed)
+		}
+		unit.HoursUsed = in.HoursUsed
+	}
+	if location := strings.TrimSpace(in.Location); location != "" {
+		unit.Location = location
+	}
+	if in.Condition != "" {
+		unit.Condition = in.Condition
+	}
+	if in.Remark != "" {
+		unit.Remark = in.Remark
+	}
+
+	// 按原状态条件更新,避免覆盖其他管理员的修改;
+	// 停用设备(维修保养或退役)时要求没有未结束的租赁预占,与审批分配设备互斥
+	var ok bool
+	if deactivate {
+		ok, err = l.svcCtx.LeaseEquipmentUnitsModel.UpdateIfIdle(l.ctx, unit, from, time.Now())
+	} else {
+		ok, err = l.svcCtx.LeaseEquipmentUnitsModel.UpdateIfStatus(l.ctx, unit, from)
+	}
+	if err != nil {
+		l.Errorf("更新设备失败: %v", err)
+		return nil, fmt.Errorf("更新设备失败")
+	}
+	if !ok && deactivate {
+		return nil, fmt.Errorf("状态错误，设备状态已变化或有未结束的租赁预占，不能设为%s", unit.Status)
+	}
+	if !ok {
+		return nil, fmt.Errorf("状态错误，设备状态已变化，请刷新后重试")
+	}
+
+	updated, err := l.svcCtx.LeaseEquipmentUnitsModel.FindOne(l.ctx, unit.Id)
+	if err != nil {
+		l.Errorf("查询设备失败: %v", err)
+		return nil, fmt.Errorf("更新设备失败")
+	}
+
+	return &leaseproduct.UpdateEquipmentUnitResp{
+		Data: toEquipmentUnitInfo(updated, unitProductCode(l.ctx, l.svcCtx, updated.ProductId), currentApplications(l.ctx, l.svcCtx, updated)[updated.Id]),
+	}, nil
+}
@@ -2,9 +2,13 @@ package logic
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
+	"common/productschedule"
+	"common/productversion"
+	"model"
 	"rpc/internal/svc"
 	"rpc/leaseproduct"
 
@@ -43,24 +47,99 @@ func (l *UpdateLeaseProductLogic) UpdateLeaseProduct(in *leaseproduct.UpdateLeas
 		return nil, err
 	}
 
-	// 更新产品信息
-	product.Name = in.Name
-	product.Type = in.Type
-	product.Machinery = in.Machinery
-	product.Brand = in.Brand
-	product.Model = in.Model
-	product.DailyRate = in.DailyRate
-	product.Deposit = in.Deposit
-	product.MaxDuration = uint64(in.MaxDuration)
-	product.MinDuration = uint64(in.MinDuration)
-	product.Description = in.Description
-	product.Status = uint64(in.Status)
-	product.UpdatedAt = time.Now()
-
-	err = l.svcCtx.LeaseProductModel.Update(l.ctx, product)
+	// 校验审批链
+	approvalChain, err := normalizeApprovalChain(in.ApprovalChain)
 	if err != nil {
-		l.Errorf("更新产品失败: %v", err)
-		return nil, fmt.Errorf("更新产品失败")
+		return nil, err
+	}
+
+	// 校验准入规则
+	eligibilityRule, err := normalizeEligibilityRule(in.EligibilityRule)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验定价规则
+	pricingRule, err := normalizePricingRule(in.PricingRule)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按请求组装修改后的条款,与当前条款比较得出变更字段
+	now := time.Now()
+	current := termsOf(product)
+	next := current
+	next.Name = in.Name
+	next.Type = in.Type
+	next.Machinery = in.Machinery
+	next.Brand = in.Brand
+	next.Model = in.Model
+	next.DailyRate = in.DailyRate
+	next.Deposit = in.Deposit
+	next.MaxDuration = uint64(in.MaxDuration)
+	next.MinDuration = uint64(in.MinDuration)
+	next.Description = in.Description
+	next.ApprovalChain = approvalChain
+	next.EligibilityRule = eligibilityRule
+	next.PricingRule = pricingRule
+
+	// 上下架状态不属于条款,随本次修改立即生效; 人工调整状态会覆盖计划上架时间
+	original := *product
+	statusChanged := uint64(in.Status) != product.Status
+	if statusChanged {
+		if uint64(in.Status) == productschedule.StatusOnSale && productschedule.Expired(product.DelistAt, now) {
+			return nil, fmt.Errorf("状态错误，产品已过计划下架时间，请先调整排期")
+		}
+		product.Status = uint64(in.Status)
+		product.LaunchAt = sql.NullTime{}
+	}
+
+	// 条款有变化时生成新版本: 指定未来生效时间的版本待到期后由版本任务生效,否则立即生效
+	var version *model.LeaseProductVersions
+	statusSaved := !statusChanged
+	if changes := productversion.Diff(current, next); len(changes) > 0 {
+		maxVersion, err := l.svcCtx.LeaseProductVersionsModel.MaxVersion(l.ctx, product.Id)
+		if err != nil {
+			l.Errorf("查询产品版本失败: %v", err)
+			return nil, fmt.Errorf("更新产品失败")
+		}
+		number := max(maxVersion, product.Version) + 1
+
+		if productversion.IsScheduled(in.EffectiveFrom, now) {
+			version, err = newProductVersion(product.Id, number, next, changes, productversion.StatusScheduled, time.Unix(in.EffectiveFrom, 0), in.OperatorId, in.OperatorName)
+			if err == nil {
+				_, err = l.svcCtx.LeaseProductVersionsModel.Insert(l.ctx, version)
+			}
+		} else {
+			version, err = newProductVersion(product.Id, number, next, changes, productversion.StatusActive, now, in.OperatorId, in.OperatorName)
+			if err == nil {
+				// 上下架状态随条款一并保存
+				_, err = switchProductVersion(l.ctx, l.svcCtx, product.Id, version, now, func(locked *model.LeaseProducts) error {
+					next.applyTo(locked)
+					if statusChanged {
+						locked.Status = product.Status
+						locked.LaunchAt = product.LaunchAt
+					}
+					return nil
+				})
+				statusSaved = true
+			}
+		}
+		if err != nil {
+			l.Errorf("更新产品失败: %v", err)
+			return nil, fmt.Errorf("更新产品失败")
+		}
+
+		if saved, err := l.svcCtx.LeaseProductVersionsModel.FindOneByProductIdVersion(l.ctx, product.Id, number); err == nil {
+			version = saved
+		}
+	}
+
+	// 条款未变化或版本待到期生效时,上下架状态立即单独保存
+	if !statusSaved {
+		if err := l.saveStatus(product, &original); err != nil {
+			return nil, err
+		}
 	}
 
 	// 查询更新后的产品信息
@@ -70,29 +149,54 @@ func (l *UpdateLeaseProductLogic) UpdateLeaseProduct(in *leaseproduct.UpdateLeas
 		return nil, fmt.Errorf("更新成功但查询失败")
 	}
 
+	var versionInfo *leaseproduct.LeaseProductVersionInfo
+	if version != nil {
+		versionInfo = convertProductVersion(version)
+	}
+
 	return &leaseproduct.UpdateLeaseProductResp{
 		Data: &leaseproduct.LeaseProductInfo{
-			Id:             int64(updatedProduct.Id),
-			ProductCode:    updatedProduct.ProductCode,
-			Name:           updatedProduct.Name,
-			Type:           updatedProduct.Type,
-			Machinery:      updatedProduct.Machinery,
-			Brand:          updatedProduct.Brand,
-			Model:          updatedProduct.Model,
-			DailyRate:      updatedProduct.DailyRate,
-			Deposit:        updatedProduct.Deposit,
-			MaxDuration:    int32(updatedProduct.MaxDuration),
-			MinDuration:    int32(updatedProduct.MinDuration),
-			Description:    updatedProduct.Description,
-			InventoryCount: int32(updatedProduct.InventoryCount),
-			AvailableCount: int32(updatedProduct.AvailableCount),
-			Status:         int32(updatedProduct.Status),
-			CreatedAt:      updatedProduct.CreatedAt.Unix(),
-			UpdatedAt:      updatedProduct.UpdatedAt.Unix(),
+			Id:              int64(updatedProduct.Id),
+			ProductCode:     updatedProduct.ProductCode,
+			Name:            updatedProduct.Name,
+			Type:            updatedProduct.Type,
+			Machinery:       updatedProduct.Machinery,
+			Brand:           updatedProduct.Brand,
+			Model:           updatedProduct.Model,
+			DailyRate:       updatedProduct.DailyRate,
+			Deposit:         updatedProduct.Deposit,
+			MaxDuration:     int32(updatedProduct.MaxDuration),
+			MinDuration:     int32(updatedProduct.MinDuration),
+			Description:     updatedProduct.Description,
+			ApprovalChain:   updatedProduct.ApprovalChain,
+			InventoryCount:  int32(updatedProduct.InventoryCount),
+			AvailableCount:  int32(updatedProduct.AvailableCount),
+			Status:          int32(updatedProduct.Status),
+			CreatedAt:       updatedProduct.CreatedAt.Unix(),
+			UpdatedAt:       updatedProduct.UpdatedAt.Unix(),
+			Version:         int32(updatedProduct.Version),
+			LaunchAt:        productschedule.ToUnix(updatedProduct.LaunchAt),
+			DelistAt:        productschedule.ToUnix(updatedProduct.DelistAt),
+			EligibilityRule: updatedProduct.EligibilityRule,
+			PricingRule:     updatedProduct.PricingRule,
 		},
+		Version: versionInfo,
 	}, nil
 }
 
+// saveStatus 仅保存上下架状态与排期字段,期间状态或排期被修改、产品被删除时返回状态错误
+func (l *UpdateLeaseProductLogic) saveStatus(product, original *model.LeaseProducts) error {
+	ok, err := l.svcCtx.LeaseProductModel.UpdateScheduleIfMatch(l.ctx, product, original)
+	if err != nil {
+		l.Errorf("更新产品状态失败: %v", err)
+		return fmt.Errorf("更新产品失败")
+	}
+	if !ok {
+		return fmt.Errorf("状态错误，产品状态或排期已变化，请刷新后重试")
+	}
+	return nil
+}
+
 // validateUpdateRequest 验证更新请求参数
 func (l *UpdateLeaseProductLogic) validateUpdateRequest(in *leaseproduct.UpdateLeaseProductReq) error {
 	if in.Name == "" {
@@ -34,6 +34,16 @@ func (s *LeaseProductServiceServer) ListLeaseProducts(ctx context.Context, in *l
 	return l.ListLeaseProducts(in)
 }
 
+func (s *LeaseProductServiceServer) CheckEligibility(ctx context.Context, in *leaseproduct.CheckEligibilityReq) (*leaseproduct.CheckEligibilityResp, error) {
+	l := logic.NewCheckEligibilityLogic(ctx, s.svcCtx)
+	return l.CheckEligibility(in)
+}
+
+func (s *LeaseProductServiceServer) QuoteLease(ctx context.Context, in *leaseproduct.QuoteLeaseReq) (*leaseproduct.QuoteLeaseResp, error) {
+	l := logic.NewQuoteLeaseLogic(ctx, s.svcCtx)
+	return l.QuoteLease(in)
+}
+
 // 产品管理
 func (s *LeaseProductServiceServer) CreateLeaseProduct(ctx context.Context, in *leaseproduct.CreateLeaseProductReq) (*leaseproduct.CreateLeaseProductResp, error) {
 	l := logic.NewCreateLeaseProductLogic(ctx, s.svcCtx)
@@ -50,8 +60,54 @@ func (s *LeaseProductServiceServer) DeleteLeaseProduct(ctx context.Context, in *
 	return l.DeleteLeaseProduct(in)
 }
 
+func (s *LeaseProductServiceServer) ListLeaseProductVersions(ctx context.Context, in *leaseproduct.ListLeaseProductVersionsReq) (*leaseproduct.ListLeaseProductVersionsResp, error) {
+	l := logic.NewListLeaseProductVersionsLogic(ctx, s.svcCtx)
+	return l.ListLeaseProductVersions(in)
+}
+
+func (s *LeaseProductServiceServer) ScheduleLeaseProduct(ctx context.Context, in *leaseproduct.ScheduleLeaseProductReq) (*leaseproduct.ScheduleLeaseProductResp, error) {
+	l := logic.NewScheduleLeaseProductLogic(ctx, s.svcCtx)
+	return l.ScheduleLeaseProduct(in)
+}
+
 // 库存检查
 func (s *LeaseProductServiceServer) CheckInventoryAvailability(ctx context.Context, in *leaseproduct.CheckInventoryAvailabilityReq) (*leaseproduct.CheckInventoryAvailabilityResp, error) {
 	l := logic.NewCheckInventoryAvailabilityLogic(ctx, s.svcCtx)
 	return l.CheckInventoryAvailability(in)
 }
+
+func (s *LeaseProductServiceServer) ReserveInventory(ctx context.Context, in *leaseproduct.ReserveInventoryReq) (*leaseproduct.ReserveInventoryResp, error) {
+	l := logic.NewReserveInventoryLogic(ctx, s.svcCtx)
+	return l.ReserveInventory(in)
+}
+
+func (s *LeaseProductServiceServer) ReleaseReservation(ctx context.Context, in *leaseproduct.ReleaseReservationReq) (*leaseproduct.ReleaseReservationResp, error) {
+	l := logic.NewReleaseReservationLogic(ctx, s.svcCtx)
+	return l.ReleaseReservation(in)
+}
+
+// 设备台账
+func (s *LeaseProductServiceServer) ListEquipmentUnits(ctx context.Context, in *leaseproduct.ListEquipmentUnitsReq) (*leaseproduct.ListEquipmentUnitsResp, error) {
+	l := logic.NewListEquipmentUnitsLogic(ctx, s.svcCtx)
+	return l.ListEquipmentUnits(in)
+}
+
+func (s *LeaseProductServiceServer) GetEquipmentUnit(ctx context.Context, in *leaseproduct.GetEquipmentUnitReq) (*leaseproduct.GetEquipmentUnitResp, error) {
+	l := logic.NewGetEquipmentUnitLogic(ctx, s.svcCtx)
+	return l.GetEquipmentUnit(in)
+}
+
+func (s *LeaseProductServiceServer) CreateEquipmentUnit(ctx context.Context, in *leaseproduct.CreateEquipmentUnitReq) (*leaseproduct.CreateEquipmentUnitResp, error) {
+	l := logic.NewCreateEquipmentUnitLogic(ctx, s.svcCtx)
+	return l.CreateEquipmentUnit(in)
+}
+
+func (s *LeaseProductServiceServer) UpdateEquipmentUnit(ctx context.Context, in *leaseproduct.UpdateEquipmentUnitReq) (*leaseproduct.UpdateEquipmentUnitResp, error) {
+	l := logic.NewUpdateEquipmentUnitLogic(ctx, s.svcCtx)
+	return l.UpdateEquipmentUnit(in)
+}
+
+func (s *LeaseProductServiceServer) DeleteEquipmentUnit(ctx context.Context, in *leaseproduct.DeleteEquipmentUnitReq) (*leaseproduct.DeleteEquipmentUnitResp, error) {
+	l := logic.NewDeleteEquipmentUnitLogic(ctx, s.svcCtx)
+	return l.DeleteEquipmentUnit(in)
+}
@@ -1,21 +1,38 @@
 package svc
 
 import (
+	"appuserrpc/appuserclient"
+	"leaserpc/leaseclient"
 	"model"
 	"rpc/internal/config"
 
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
-	Config            config.Config
-	LeaseProductModel model.LeaseProductsModel
+	Config                        config.Config
+	LeaseProductModel             model.LeaseProductsModel
+	LeaseProductVersionsModel     model.LeaseProductVersionsModel
+	LeaseProductReservationsModel model.LeaseProductReservationsModel
+	LeaseEquipmentUnitsModel      model.LeaseEquipmentUnitsModel
+
+	// RPC 客户端 - 删除产品前检查租赁申请引用,准入校验时获取用户资料
+	LeaseClient   leaseclient.Lease
+	AppUserClient appuserclient.AppUser
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	conn := sqlx.NewMysql(c.MySQL.DataSource)
 	return &ServiceContext{
-		Config:            c,
-		LeaseProductModel: model.NewLeaseProductsModel(conn, c.CacheConf),
+		Config:                        c,
+		LeaseProductModel:             model.NewLeaseProductsModel(conn, c.CacheConf),
+		LeaseProductVersionsModel:     model.NewLeaseProductVersionsModel(conn, c.CacheConf),
+		LeaseProductReservationsModel: model.NewLeaseProductReservationsModel(conn, c.CacheConf),
+		LeaseEquipmentUnitsModel:      model.NewLeaseEquipmentUnitsModel(conn, c.CacheConf),
+
+		// 通过consul服务发现初始化RPC客户端
+		LeaseClient:   leaseclient.NewLease(zrpc.MustNewClient(c.LeaseRpc)),
+		AppUserClient: appuserclient.NewAppUser(zrpc.MustNewClient(c.AppUserRpc)),
 	}
 }
@@ -23,26 +23,32 @@ const (
 
 // 租赁产品信息
 type LeaseProductInfo struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                          // 产品ID
-	ProductCode    string                 `protobuf:"bytes,2,opt,name=productCode,proto3" json:"productCode,omitempty"`         // 产品编码
-	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                       // 产品名称
-	Type           string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                       // 租赁类型
-	Machinery      string                 `protobuf:"bytes,5,opt,name=machinery,proto3" json:"machinery,omitempty"`             // 设备名称
-	Brand          string                 `protobuf:"bytes,6,opt,name=brand,proto3" json:"brand,omitempty"`                     // 品牌
-	Model          string                 `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`                     // 型号
-	DailyRate      float64                `protobuf:"fixed64,8,opt,name=dailyRate,proto3" json:"dailyRate,omitempty"`           // 日租金
-	Deposit        float64                `protobuf:"fixed64,9,opt,name=deposit,proto3" json:"deposit,omitempty"`               // 押金
-	MaxDuration    int32                  `protobuf:"varint,10,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`       // 最大租期(天)
-	MinDuration    int32                  `protobuf:"varint,11,opt,name=minDuration,proto3" json:"minDuration,omitempty"`       // 最小租期(天)
-	Description    string                 `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`        // 产品描述
-	InventoryCount int32                  `protobuf:"varint,13,opt,name=inventoryCount,proto3" json:"inventoryCount,omitempty"` // 库存数量
-	AvailableCount int32                  `protobuf:"varint,14,opt,name=availableCount,proto3" json:"availableCount,omitempty"` // 可用数量
-	Status         int32                  `protobuf:"varint,15,opt,name=status,proto3" json:"status,omitempty"`                 // 状态 1:上架 2:下架
-	CreatedAt      int64                  `protobuf:"varint,16,opt,name=createdAt,proto3" json:"createdAt,omitempty"`           // 创建时间
-	UpdatedAt      int64                  `protobuf:"varint,17,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`           // 更新时间
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                           // 产品ID
+	ProductCode     string                 `protobuf:"bytes,2,opt,name=productCode,proto3" json:"productCode,omitempty"`          // 产品编码
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                        // 产品名称
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                        // 租赁类型
+	Machinery       string                 `protobuf:"bytes,5,opt,name=machinery,proto3" json:"machinery,omitempty"`              // 设备名称
+	Brand           string                 `protobuf:"bytes,6,opt,name=brand,proto3" json:"brand,omitempty"`                      // 品牌
+	Model           string                 `protobuf:"bytes,7,opt,name=model,proto3" json:"model,omitempty"`                      // 型号
+	DailyRate       float64                `protobuf:"fixed64,8,opt,name=dailyRate,proto3" json:"dailyRate,omitempty"`            // 日租金
+	Deposit         float64                `protobuf:"fixed64,9,opt,name=deposit,proto3" json:"deposit,omitempty"`                // 押金
+	MaxDuration     int32                  `protobuf:"varint,10,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`        // 最大租期(天)
+	MinDuration     int32                  `protobuf:"varint,11,opt,name=minDuration,proto3" json:"minDuration,omitempty"`        // 最小租期(天)
+	Description     string                 `protobuf:"bytes,12,opt,name=description,proto3" json:"description,omitempty"`         // 产品描述
+	InventoryCount  int32                  `protobuf:"varint,13,opt,name=inventoryCount,proto3" json:"inventoryCount,omitempty"`  // 库存数量
+	AvailableCount  int32                  `protobuf:"varint,14,opt,name=availableCount,proto3" json:"availableCount,omitempty"`  // 可用数量
+	Status          int32                  `protobuf:"varint,15,opt,name=status,proto3" json:"status,omitempty"`                  // 状态 1:上架 2:下架
+	CreatedAt       int64                  `protobuf:"varint,16,opt,name=createdAt,proto3" json:"createdAt,omitempty"`            // 创建时间
+	UpdatedAt       int64                  `protobuf:"varint,17,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`            // 更新时间
+	ApprovalChain   string                 `protobuf:"bytes,18,opt,name=approvalChain,proto3" json:"approvalChain,omitempty"`     // 审批链配置(JSON),为空表示单级审批
+	Version         int32                  `protobuf:"varint,19,opt,name=version,proto3" json:"version,omitempty"`                // 当前生效的条款版本号,0表示历史数据尚未建立版本
+	LaunchAt        int64                  `protobuf:"varint,20,opt,name=launchAt,proto3" json:"launchAt,omitempty"`              // 计划上架时间,0表示未排期
+	DelistAt        int64                  `protobuf:"varint,21,opt,name=delistAt,proto3" json:"delistAt,omitempty"`              // 计划下架时间,0表示长期有效
+	EligibilityRule string                 `protobuf:"bytes,22,opt,name=eligibilityRule,proto3" json:"eligibilityRule,omitempty"` // 准入规则配置(JSON),为空表示不限制
+	PricingRule     string                 `protobuf:"bytes,23,opt,name=pricingRule,proto3" json:"pricingRule,omitempty"`         // 定价规则配置(JSON),租期折扣、季节调价与提前预订折扣,为空表示按日租金计价
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *LeaseProductInfo) Reset() {
@@ -194,6 +200,48 @@ func (x *LeaseProductInfo) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *LeaseProductInfo) GetApprovalChain() string {
+	if x != nil {
+		return x.ApprovalChain
+	}
+	return ""
+}
+
+func (x *LeaseProductInfo) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LeaseProductInfo) GetLaunchAt() int64 {
+	if x != nil {
+		return x.LaunchAt
+	}
+	return 0
+}
+
+func (x *LeaseProductInfo) GetDelistAt() int64 {
+	if x != nil {
+		return x.DelistAt
+	}
+	return 0
+}
+
+func (x *LeaseProductInfo) GetEligibilityRule() string {
+	if x != nil {
+		return x.EligibilityRule
+	}
+	return ""
+}
+
+func (x *LeaseProductInfo) GetPricingRule() string {
+	if x != nil {
+		return x.PricingRule
+	}
+	return ""
+}
+
 // 添加删除操作响应
 type DeleteLeaseProductResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -321,8 +369,9 @@ func (x *CreateLeaseProductResp) GetData() *LeaseProductInfo {
 }
 
 type UpdateLeaseProductResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *LeaseProductInfo      `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Data          *LeaseProductInfo        `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Version       *LeaseProductVersionInfo `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // 本次修改产生的版本,条款无变化时为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -364,6 +413,13 @@ func (x *UpdateLeaseProductResp) GetData() *LeaseProductInfo {
 	return nil
 }
 
+func (x *UpdateLeaseProductResp) GetVersion() *LeaseProductVersionInfo {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
 // 获取租赁产品请求
 type GetLeaseProductReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -418,6 +474,7 @@ type ListLeaseProductsReq struct {
 	Brand         string                 `protobuf:"bytes,4,opt,name=brand,proto3" json:"brand,omitempty"`     // 品牌
 	Status        int32                  `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`  // 状态
 	Keyword       string                 `protobuf:"bytes,6,opt,name=keyword,proto3" json:"keyword,omitempty"` // 关键词
+	UserId        int64                  `protobuf:"varint,7,opt,name=userId,proto3" json:"userId,omitempty"`  // 用户ID,大于0时只返回该用户满足准入规则的产品
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -494,6 +551,13 @@ func (x *ListLeaseProductsReq) GetKeyword() string {
 	return ""
 }
 
+func (x *ListLeaseProductsReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
 type ListLeaseProductsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	List          []*LeaseProductInfo    `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`    // 产品列表
@@ -548,21 +612,26 @@ func (x *ListLeaseProductsResp) GetTotal() int64 {
 
 // 创建租赁产品请求
 type CreateLeaseProductReq struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ProductCode    string                 `protobuf:"bytes,1,opt,name=productCode,proto3" json:"productCode,omitempty"`         // 产品编码
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                       // 产品名称
-	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                       // 产品类型
-	Machinery      string                 `protobuf:"bytes,4,opt,name=machinery,proto3" json:"machinery,omitempty"`             // 设备名称
-	Brand          string                 `protobuf:"bytes,5,opt,name=brand,proto3" json:"brand,omitempty"`                     // 品牌
-	Model          string                 `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`                     // 型号
-	DailyRate      float64                `protobuf:"fixed64,7,opt,name=dailyRate,proto3" json:"dailyRate,omitempty"`           // 日租金
-	Deposit        float64                `protobuf:"fixed64,8,opt,name=deposit,proto3" json:"deposit,omitempty"`               // 押金
-	MaxDuration    int32                  `protobuf:"varint,9,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`        // 最大租期(天)
-	MinDuration    int32                  `protobuf:"varint,10,opt,name=minDuration,proto3" json:"minDuration,omitempty"`       // 最小租期(天)
-	Description    string                 `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`        // 产品描述
-	InventoryCount int32                  `protobuf:"varint,12,opt,name=inventoryCount,proto3" json:"inventoryCount,omitempty"` // 库存数量
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode     string                 `protobuf:"bytes,1,opt,name=productCode,proto3" json:"productCode,omitempty"`          // 产品编码
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                        // 产品名称
+	Type            string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                        // 产品类型
+	Machinery       string                 `protobuf:"bytes,4,opt,name=machinery,proto3" json:"machinery,omitempty"`              // 设备名称
+	Brand           string                 `protobuf:"bytes,5,opt,name=brand,proto3" json:"brand,omitempty"`                      // 品牌
+	Model           string                 `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`                      // 型号
+	DailyRate       float64                `protobuf:"fixed64,7,opt,name=dailyRate,proto3" json:"dailyRate,omitempty"`            // 日租金
+	Deposit         float64                `protobuf:"fixed64,8,opt,name=deposit,proto3" json:"deposit,omitempty"`                // 押金
+	MaxDuration     int32                  `protobuf:"varint,9,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`         // 最大租期(天)
+	MinDuration     int32                  `protobuf:"varint,10,opt,name=minDuration,proto3" json:"minDuration,omitempty"`        // 最小租期(天)
+	Description     string                 `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`         // 产品描述
+	InventoryCount  int32                  `protobuf:"varint,12,opt,name=inventoryCount,proto3" json:"inventoryCount,omitempty"`  // 库存数量
+	ApprovalChain   string                 `protobuf:"bytes,13,opt,name=approvalChain,proto3" json:"approvalChain,omitempty"`     // 审批链配置(JSON),为空表示单级审批
+	OperatorId      int64                  `protobuf:"varint,14,opt,name=operatorId,proto3" json:"operatorId,omitempty"`          // 操作人ID
+	OperatorName    string                 `protobuf:"bytes,15,opt,name=operatorName,proto3" json:"operatorName,omitempty"`       // 操作人姓名
+	EligibilityRule string                 `protobuf:"bytes,16,opt,name=eligibilityRule,proto3" json:"eligibilityRule,omitempty"` // 准入规则配置(JSON),为空表示不限制
+	PricingRule     string                 `protobuf:"bytes,17,opt,name=pricingRule,proto3" json:"pricingRule,omitempty"`         // 定价规则配置(JSON),为空表示按日租金计价
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *CreateLeaseProductReq) Reset() {
@@ -679,23 +748,64 @@ func (x *CreateLeaseProductReq) GetInventoryCount() int32 {
 	return 0
 }
 
+func (x *CreateLeaseProductReq) GetApprovalChain() string {
+	if x != nil {
+		return x.ApprovalChain
+	}
+	return ""
+}
+
+func (x *CreateLeaseProductReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *CreateLeaseProductReq) GetOperatorName() string {
+	if x != nil {
+		return x.OperatorName
+	}
+	return ""
+}
+
+func (x *CreateLeaseProductReq) GetEligibilityRule() string {
+	if x != nil {
+		return x.EligibilityRule
+	}
+	return ""
+}
+
+func (x *CreateLeaseProductReq) GetPricingRule() string {
+	if x != nil {
+		return x.PricingRule
+	}
+	return ""
+}
+
 // 更新租赁产品请求
 type UpdateLeaseProductReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductCode   string                 `protobuf:"bytes,1,opt,name=productCode,proto3" json:"productCode,omitempty"`   // 产品编码
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                 // 产品名称
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                 // 产品类型
-	Machinery     string                 `protobuf:"bytes,4,opt,name=machinery,proto3" json:"machinery,omitempty"`       // 设备名称
-	Brand         string                 `protobuf:"bytes,5,opt,name=brand,proto3" json:"brand,omitempty"`               // 品牌
-	Model         string                 `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`               // 型号
-	DailyRate     float64                `protobuf:"fixed64,7,opt,name=dailyRate,proto3" json:"dailyRate,omitempty"`     // 日租金
-	Deposit       float64                `protobuf:"fixed64,8,opt,name=deposit,proto3" json:"deposit,omitempty"`         // 押金
-	MaxDuration   int32                  `protobuf:"varint,9,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`  // 最大租期(天)
-	MinDuration   int32                  `protobuf:"varint,10,opt,name=minDuration,proto3" json:"minDuration,omitempty"` // 最小租期(天)
-	Description   string                 `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`  // 产品描述
-	Status        int32                  `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`           // 状态
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode     string                 `protobuf:"bytes,1,opt,name=productCode,proto3" json:"productCode,omitempty"`          // 产品编码
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                        // 产品名称
+	Type            string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                        // 产品类型
+	Machinery       string                 `protobuf:"bytes,4,opt,name=machinery,proto3" json:"machinery,omitempty"`              // 设备名称
+	Brand           string                 `protobuf:"bytes,5,opt,name=brand,proto3" json:"brand,omitempty"`                      // 品牌
+	Model           string                 `protobuf:"bytes,6,opt,name=model,proto3" json:"model,omitempty"`                      // 型号
+	DailyRate       float64                `protobuf:"fixed64,7,opt,name=dailyRate,proto3" json:"dailyRate,omitempty"`            // 日租金
+	Deposit         float64                `protobuf:"fixed64,8,opt,name=deposit,proto3" json:"deposit,omitempty"`                // 押金
+	MaxDuration     int32                  `protobuf:"varint,9,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`         // 最大租期(天)
+	MinDuration     int32                  `protobuf:"varint,10,opt,name=minDuration,proto3" json:"minDuration,omitempty"`        // 最小租期(天)
+	Description     string                 `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`         // 产品描述
+	Status          int32                  `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`                  // 状态,立即生效且不产生版本
+	ApprovalChain   string                 `protobuf:"bytes,13,opt,name=approvalChain,proto3" json:"approvalChain,omitempty"`     // 审批链配置(JSON),为空表示单级审批
+	EffectiveFrom   int64                  `protobuf:"varint,14,opt,name=effectiveFrom,proto3" json:"effectiveFrom,omitempty"`    // 生效时间(Unix秒),为0或早于当前时间时立即生效,否则到期后自动生效
+	OperatorId      int64                  `protobuf:"varint,15,opt,name=operatorId,proto3" json:"operatorId,omitempty"`          // 操作人ID
+	OperatorName    string                 `protobuf:"bytes,16,opt,name=operatorName,proto3" json:"operatorName,omitempty"`       // 操作人姓名
+	EligibilityRule string                 `protobuf:"bytes,17,opt,name=eligibilityRule,proto3" json:"eligibilityRule,omitempty"` // 准入规则配置(JSON),为空表示不限制
+	PricingRule     string                 `protobuf:"bytes,18,opt,name=pricingRule,proto3" json:"pricingRule,omitempty"`         // 定价规则配置(JSON),为空表示按日租金计价
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateLeaseProductReq) Reset() {
@@ -812,6 +922,48 @@ func (x *UpdateLeaseProductReq) GetStatus() int32 {
 	return 0
 }
 
+func (x *UpdateLeaseProductReq) GetApprovalChain() string {
+	if x != nil {
+		return x.ApprovalChain
+	}
+	return ""
+}
+
+func (x *UpdateLeaseProductReq) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+func (x *UpdateLeaseProductReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *UpdateLeaseProductReq) GetOperatorName() string {
+	if x != nil {
+		return x.OperatorName
+	}
+	return ""
+}
+
+func (x *UpdateLeaseProductReq) GetEligibilityRule() string {
+	if x != nil {
+		return x.EligibilityRule
+	}
+	return ""
+}
+
+func (x *UpdateLeaseProductReq) GetPricingRule() string {
+	if x != nil {
+		return x.PricingRule
+	}
+	return ""
+}
+
 // 删除租赁产品请求
 type DeleteLeaseProductReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -857,31 +1009,29 @@ func (x *DeleteLeaseProductReq) GetProductCode() string {
 	return ""
 }
 
-// 库存检查请求
-type CheckInventoryAvailabilityReq struct {
+// 准入校验请求 - 按产品准入规则校验用户资料(职业/年龄/月收入)
+type CheckEligibilityReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductCode   string                 `protobuf:"bytes,1,opt,name=productCode,proto3" json:"productCode,omitempty"` // 产品编码
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`      // 数量
-	StartDate     string                 `protobuf:"bytes,3,opt,name=startDate,proto3" json:"startDate,omitempty"`     // 开始日期
-	EndDate       string                 `protobuf:"bytes,4,opt,name=endDate,proto3" json:"endDate,omitempty"`         // 结束日期
+	UserId        int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`          // 用户ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckInventoryAvailabilityReq) Reset() {
-	*x = CheckInventoryAvailabilityReq{}
+func (x *CheckEligibilityReq) Reset() {
+	*x = CheckEligibilityReq{}
 	mi := &file_leaseproduct_rpc_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckInventoryAvailabilityReq) String() string {
+func (x *CheckEligibilityReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckInventoryAvailabilityReq) ProtoMessage() {}
+func (*CheckEligibilityReq) ProtoMessage() {}
 
-func (x *CheckInventoryAvailabilityReq) ProtoReflect() protoreflect.Message {
+func (x *CheckEligibilityReq) ProtoReflect() protoreflect.Message {
 	mi := &file_leaseproduct_rpc_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -893,62 +1043,116 @@ func (x *CheckInventoryAvailabilityReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckInventoryAvailabilityReq.ProtoReflect.Descriptor instead.
-func (*CheckInventoryAvailabilityReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckEligibilityReq.ProtoReflect.Descriptor instead.
+func (*CheckEligibilityReq) Descriptor() ([]byte, []int) {
 	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CheckInventoryAvailabilityReq) GetProductCode() string {
+func (x *CheckEligibilityReq) GetProductCode() string {
 	if x != nil {
 		return x.ProductCode
 	}
 	return ""
 }
 
-func (x *CheckInventoryAvailabilityReq) GetQuantity() int32 {
+func (x *CheckEligibilityReq) GetUserId() int64 {
 	if x != nil {
-		return x.Quantity
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *CheckInventoryAvailabilityReq) GetStartDate() string {
+type EligibilityReason struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`       // occupation:职业不符 age:年龄不符 income:收入不足 profile_gap:资料未填写
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 原因说明
+	Limit         float64                `protobuf:"fixed64,3,opt,name=limit,proto3" json:"limit,omitempty"`   // 限额
+	Actual        float64                `protobuf:"fixed64,4,opt,name=actual,proto3" json:"actual,omitempty"` // 实际值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EligibilityReason) Reset() {
+	*x = EligibilityReason{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EligibilityReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EligibilityReason) ProtoMessage() {}
+
+func (x *EligibilityReason) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[12]
 	if x != nil {
-		return x.StartDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EligibilityReason.ProtoReflect.Descriptor instead.
+func (*EligibilityReason) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EligibilityReason) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
 	return ""
 }
 
-func (x *CheckInventoryAvailabilityReq) GetEndDate() string {
+func (x *EligibilityReason) GetMessage() string {
 	if x != nil {
-		return x.EndDate
+		return x.Message
 	}
 	return ""
 }
 
-type CheckInventoryAvailabilityResp struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Available      bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`           // 是否可用
-	AvailableCount int32                  `protobuf:"varint,2,opt,name=availableCount,proto3" json:"availableCount,omitempty"` // 可用数量
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *EligibilityReason) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
 }
 
-func (x *CheckInventoryAvailabilityResp) Reset() {
-	*x = CheckInventoryAvailabilityResp{}
-	mi := &file_leaseproduct_rpc_proto_msgTypes[12]
+func (x *EligibilityReason) GetActual() float64 {
+	if x != nil {
+		return x.Actual
+	}
+	return 0
+}
+
+type CheckEligibilityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Eligible      bool                   `protobuf:"varint,1,opt,name=eligible,proto3" json:"eligible,omitempty"` // 是否满足准入规则
+	Reasons       []*EligibilityReason   `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`    // 未满足的条件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckEligibilityResp) Reset() {
+	*x = CheckEligibilityResp{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckInventoryAvailabilityResp) String() string {
+func (x *CheckEligibilityResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckInventoryAvailabilityResp) ProtoMessage() {}
+func (*CheckEligibilityResp) ProtoMessage() {}
 
-func (x *CheckInventoryAvailabilityResp) ProtoReflect() protoreflect.Message {
-	mi := &file_leaseproduct_rpc_proto_msgTypes[12]
+func (x *CheckEligibilityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,98 +1163,1762 @@ func (x *CheckInventoryAvailabilityResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckInventoryAvailabilityResp.ProtoReflect.Descriptor instead.
-func (*CheckInventoryAvailabilityResp) Descriptor() ([]byte, []int) {
-	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CheckEligibilityResp.ProtoReflect.Descriptor instead.
+func (*CheckEligibilityResp) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CheckInventoryAvailabilityResp) GetAvailable() bool {
+func (x *CheckEligibilityResp) GetEligible() bool {
 	if x != nil {
-		return x.Available
+		return x.Eligible
 	}
 	return false
 }
 
-func (x *CheckInventoryAvailabilityResp) GetAvailableCount() int32 {
+func (x *CheckEligibilityResp) GetReasons() []*EligibilityReason {
 	if x != nil {
-		return x.AvailableCount
+		return x.Reasons
 	}
-	return 0
+	return nil
 }
 
-var File_leaseproduct_rpc_proto protoreflect.FileDescriptor
+// 租赁报价 - 按起止日期计算租期天数(两端均包含),按产品日租金与定价规则计算租金总额并收取产品押金
+// 租金总额 = 按季节系数调整后的租金 × 租期折扣 × 提前预订折扣
+type QuoteLeaseReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode   string                 `protobuf:"bytes,1,opt,name=productCode,proto3" json:"productCode,omitempty"` // 产品编码
+	StartDate     string                 `protobuf:"bytes,2,opt,name=startDate,proto3" json:"startDate,omitempty"`     // 开始日期
+	EndDate       string                 `protobuf:"bytes,3,opt,name=endDate,proto3" json:"endDate,omitempty"`         // 结束日期
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_leaseproduct_rpc_proto_rawDesc = "" +
-	"\n" +
-	"\x16leaseproduct-rpc.proto\x12\fleaseproduct\"\xf8\x03\n" +
-	"\x10LeaseProductInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12 \n" +
-	"\vproductCode\x18\x02 \x01(\tR\vproductCode\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1c\n" +
-	"\tmachinery\x18\x05 \x01(\tR\tmachinery\x12\x14\n" +
-	"\x05brand\x18\x06 \x01(\tR\x05brand\x12\x14\n" +
-	"\x05model\x18\a \x01(\tR\x05model\x12\x1c\n" +
-	"\tdailyRate\x18\b \x01(\x01R\tdailyRate\x12\x18\n" +
-	"\adeposit\x18\t \x01(\x01R\adeposit\x12 \n" +
-	"\vmaxDuration\x18\n" +
-	" \x01(\x05R\vmaxDuration\x12 \n" +
-	"\vminDuration\x18\v \x01(\x05R\vminDuration\x12 \n" +
-	"\vdescription\x18\f \x01(\tR\vdescription\x12&\n" +
-	"\x0einventoryCount\x18\r \x01(\x05R\x0einventoryCount\x12&\n" +
-	"\x0eavailableCount\x18\x0e \x01(\x05R\x0eavailableCount\x12\x16\n" +
-	"\x06status\x18\x0f \x01(\x05R\x06status\x12\x1c\n" +
-	"\tcreatedAt\x18\x10 \x01(\x03R\tcreatedAt\x12\x1c\n" +
-	"\tupdatedAt\x18\x11 \x01(\x03R\tupdatedAt\"\x18\n" +
-	"\x16DeleteLeaseProductResp\"I\n" +
-	"\x13GetLeaseProductResp\x122\n" +
-	"\x04data\x18\x01 \x01(\v2\x1e.leaseproduct.LeaseProductInfoR\x04data\"L\n" +
-	"\x16CreateLeaseProductResp\x122\n" +
-	"\x04data\x18\x01 \x01(\v2\x1e.leaseproduct.LeaseProductInfoR\x04data\"L\n" +
-	"\x16UpdateLeaseProductResp\x122\n" +
-	"\x04data\x18\x01 \x01(\v2\x1e.leaseproduct.LeaseProductInfoR\x04data\"6\n" +
-	"\x12GetLeaseProductReq\x12 \n" +
-	"\vproductCode\x18\x01 \x01(\tR\vproductCode\"\x9a\x01\n" +
-	"\x14ListLeaseProductsReq\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
-	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
-	"\x05brand\x18\x04 \x01(\tR\x05brand\x12\x16\n" +
-	"\x06status\x18\x05 \x01(\x05R\x06status\x12\x18\n" +
-	"\akeyword\x18\x06 \x01(\tR\akeyword\"a\n" +
-	"\x15ListLeaseProductsResp\x122\n" +
-	"\x04list\x18\x01 \x03(\v2\x1e.leaseproduct.LeaseProductInfoR\x04list\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"\xf1\x02\n" +
-	"\x15CreateLeaseProductReq\x12 \n" +
-	"\vproductCode\x18\x01 \x01(\tR\vproductCode\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1c\n" +
-	"\tmachinery\x18\x04 \x01(\tR\tmachinery\x12\x14\n" +
-	"\x05brand\x18\x05 \x01(\tR\x05brand\x12\x14\n" +
-	"\x05model\x18\x06 \x01(\tR\x05model\x12\x1c\n" +
-	"\tdailyRate\x18\a \x01(\x01R\tdailyRate\x12\x18\n" +
-	"\adeposit\x18\b \x01(\x01R\adeposit\x12 \n" +
-	"\vmaxDuration\x18\t \x01(\x05R\vmaxDuration\x12 \n" +
-	"\vminDuration\x18\n" +
-	" \x01(\x05R\vminDuration\x12 \n" +
-	"\vdescription\x18\v \x01(\tR\vdescription\x12&\n" +
-	"\x0einventoryCount\x18\f \x01(\x05R\x0einventoryCount\"\xe1\x02\n" +
-	"\x15UpdateLeaseProductReq\x12 \n" +
-	"\vproductCode\x18\x01 \x01(\tR\vproductCode\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1c\n" +
-	"\tmachinery\x18\x04 \x01(\tR\tmachinery\x12\x14\n" +
-	"\x05brand\x18\x05 \x01(\tR\x05brand\x12\x14\n" +
-	"\x05model\x18\x06 \x01(\tR\x05model\x12\x1c\n" +
-	"\tdailyRate\x18\a \x01(\x01R\tdailyRate\x12\x18\n" +
-	"\adeposit\x18\b \x01(\x01R\adeposit\x12 \n" +
-	"\vmaxDuration\x18\t \x01(\x05R\vmaxDuration\x12 \n" +
-	"\vminDuration\x18\n" +
-	" \x01(\x05R\vminDuration\x12 \n" +
-	"\vdescription\x18\v \x01(\tR\vdescription\x12\x16\n" +
-	"\x06status\x18\f \x01(\x05R\x06status\"9\n" +
-	"\x15DeleteLeaseProductReq\x12 \n" +
-	"\vproductCode\x18\x01 \x01(\tR\vproductCode\"\x95\x01\n" +
+func (x *QuoteLeaseReq) Reset() {
+	*x = QuoteLeaseReq{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteLeaseReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteLeaseReq) ProtoMessage() {}
+
+func (x *QuoteLeaseReq) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteLeaseReq.ProtoReflect.Descriptor instead.
+func (*QuoteLeaseReq) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QuoteLeaseReq) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *QuoteLeaseReq) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *QuoteLeaseReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type QuoteLeaseResp struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode          string                 `protobuf:"bytes,1,opt,name=productCode,proto3" json:"productCode,omitempty"`                      // 产品编码
+	Duration             int32                  `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`                           // 租期(天)
+	DailyRate            float64                `protobuf:"fixed64,3,opt,name=dailyRate,proto3" json:"dailyRate,omitempty"`                        // 日租金
+	TotalAmount          float64                `protobuf:"fixed64,4,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"`                    // 租金总额,按定价规则计算
+	Deposit              float64                `protobuf:"fixed64,5,opt,name=deposit,proto3" json:"deposit,omitempty"`                            // 押金
+	BaseAmount           float64                `protobuf:"fixed64,6,opt,name=baseAmount,proto3" json:"baseAmount,omitempty"`                      // 原价,即日租金乘以租期
+	SeasonalDays         int32                  `protobuf:"varint,7,opt,name=seasonalDays,proto3" json:"seasonalDays,omitempty"`                   // 落在季节调价窗口内的天数
+	SeasonalAmount       float64                `protobuf:"fixed64,8,opt,name=seasonalAmount,proto3" json:"seasonalAmount,omitempty"`              // 按季节系数调整后的租金
+	DurationDiscount     float64                `protobuf:"fixed64,9,opt,name=durationDiscount,proto3" json:"durationDiscount,omitempty"`          // 适用的租期折扣,1表示无折扣
+	EarlyBookingDiscount float64                `protobuf:"fixed64,10,opt,name=earlyBookingDiscount,proto3" json:"earlyBookingDiscount,omitempty"` // 适用的提前预订折扣,1表示无折扣
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *QuoteLeaseResp) Reset() {
+	*x = QuoteLeaseResp{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteLeaseResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteLeaseResp) ProtoMessage() {}
+
+func (x *QuoteLeaseResp) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteLeaseResp.ProtoReflect.Descriptor instead.
+func (*QuoteLeaseResp) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *QuoteLeaseResp) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *QuoteLeaseResp) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *QuoteLeaseResp) GetDailyRate() float64 {
+	if x != nil {
+		return x.DailyRate
+	}
+	return 0
+}
+
+func (x *QuoteLeaseResp) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *QuoteLeaseResp) GetDeposit() float64 {
+	if x != nil {
+		return x.Deposit
+	}
+	return 0
+}
+
+func (x *QuoteLeaseResp) GetBaseAmount() float64 {
+	if x != nil {
+		return x.BaseAmount
+	}
+	return 0
+}
+
+func (x *QuoteLeaseResp) GetSeasonalDays() int32 {
+	if x != nil {
+		return x.SeasonalDays
+	}
+	return 0
+}
+
+func (x *QuoteLeaseResp) GetSeasonalAmount() float64 {
+	if x != nil {
+		return x.SeasonalAmount
+	}
+	return 0
+}
+
+func (x *QuoteLeaseResp) GetDurationDiscount() float64 {
+	if x != nil {
+		return x.DurationDiscount
+	}
+	return 0
+}
+
+func (x *QuoteLeaseResp) GetEarlyBookingDiscount() float64 {
+	if x != nil {
+		return x.EarlyBookingDiscount
+	}
+	return 0
+}
+
+// 库存检查请求
+type CheckInventoryAvailabilityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode   string                 `protobuf:"bytes,1,opt,name=productCode,proto3" json:"productCode,omitempty"` // 产品编码
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`      // 数量
+	StartDate     string                 `protobuf:"bytes,3,opt,name=startDate,proto3" json:"startDate,omitempty"`     // 开始日期
+	EndDate       string                 `protobuf:"bytes,4,opt,name=endDate,proto3" json:"endDate,omitempty"`         // 结束日期
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInventoryAvailabilityReq) Reset() {
+	*x = CheckInventoryAvailabilityReq{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInventoryAvailabilityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInventoryAvailabilityReq) ProtoMessage() {}
+
+func (x *CheckInventoryAvailabilityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInventoryAvailabilityReq.ProtoReflect.Descriptor instead.
+func (*CheckInventoryAvailabilityReq) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CheckInventoryAvailabilityReq) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *CheckInventoryAvailabilityReq) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CheckInventoryAvailabilityReq) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CheckInventoryAvailabilityReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type CheckInventoryAvailabilityResp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Available      bool                   `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`           // 是否可用
+	AvailableCount int32                  `protobuf:"varint,2,opt,name=availableCount,proto3" json:"availableCount,omitempty"` // 可用数量,即租期内库存数量减去重叠预占数量后的最小值;登记设备台账的产品为租期内没有预占的可用设备数
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CheckInventoryAvailabilityResp) Reset() {
+	*x = CheckInventoryAvailabilityResp{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInventoryAvailabilityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInventoryAvailabilityResp) ProtoMessage() {}
+
+func (x *CheckInventoryAvailabilityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInventoryAvailabilityResp.ProtoReflect.Descriptor instead.
+func (*CheckInventoryAvailabilityResp) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CheckInventoryAvailabilityResp) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *CheckInventoryAvailabilityResp) GetAvailableCount() int32 {
+	if x != nil {
+		return x.AvailableCount
+	}
+	return 0
+}
+
+// 预占库存 - 租赁审批通过时调用,同一申请重复预占时直接返回,已释放的预占再次审批时按新的租期重新预占
+// 产品登记了设备台账时同时分配一台可用设备,没有可用设备时按库存不足处理
+type ReserveInventoryReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode   string                 `protobuf:"bytes,1,opt,name=productCode,proto3" json:"productCode,omitempty"`     // 产品编码
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=applicationId,proto3" json:"applicationId,omitempty"` // 租赁申请编号
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`          // 预占数量
+	StartDate     string                 `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`         // 开始日期
+	EndDate       string                 `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`             // 结束日期
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveInventoryReq) Reset() {
+	*x = ReserveInventoryReq{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveInventoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveInventoryReq) ProtoMessage() {}
+
+func (x *ReserveInventoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveInventoryReq.ProtoReflect.Descriptor instead.
+func (*ReserveInventoryReq) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReserveInventoryReq) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *ReserveInventoryReq) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ReserveInventoryReq) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReserveInventoryReq) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ReserveInventoryReq) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type ReserveInventoryResp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AvailableCount int32                  `protobuf:"varint,1,opt,name=availableCount,proto3" json:"availableCount,omitempty"` // 预占后租期内的可用数量
+	UnitId         int64                  `protobuf:"varint,2,opt,name=unitId,proto3" json:"unitId,omitempty"`                 // 分配的设备ID,产品未登记设备台账时为0
+	SerialNumber   string                 `protobuf:"bytes,3,opt,name=serialNumber,proto3" json:"serialNumber,omitempty"`      // 分配的设备序列号
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReserveInventoryResp) Reset() {
+	*x = ReserveInventoryResp{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveInventoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveInventoryResp) ProtoMessage() {}
+
+func (x *ReserveInventoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveInventoryResp.ProtoReflect.Descriptor instead.
+func (*ReserveInventoryResp) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReserveInventoryResp) GetAvailableCount() int32 {
+	if x != nil {
+		return x.AvailableCount
+	}
+	return 0
+}
+
+func (x *ReserveInventoryResp) GetUnitId() int64 {
+	if x != nil {
+		return x.UnitId
+	}
+	return 0
+}
+
+func (x *ReserveInventoryResp) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+// 释放预占库存 - 租赁申请撤销或拒绝时调用,未预占或已释放时直接返回,分配的设备同时归还为可用
+type ReleaseReservationReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=applicationId,proto3" json:"applicationId,omitempty"` // 租赁申请编号
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationReq) Reset() {
+	*x = ReleaseReservationReq{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationReq) ProtoMessage() {}
+
+func (x *ReleaseReservationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationReq.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationReq) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReleaseReservationReq) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type ReleaseReservationResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Released      bool                   `protobuf:"varint,1,opt,name=released,proto3" json:"released,omitempty"` // 本次是否释放了库存
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationResp) Reset() {
+	*x = ReleaseReservationResp{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationResp) ProtoMessage() {}
+
+func (x *ReleaseReservationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationResp.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationResp) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReleaseReservationResp) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+// 产品条款版本
+type ProductVersionChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // 变更字段
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`   // 变更前取值(JSON)
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`       // 变更后取值(JSON)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductVersionChange) Reset() {
+	*x = ProductVersionChange{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductVersionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductVersionChange) ProtoMessage() {}
+
+func (x *ProductVersionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductVersionChange.ProtoReflect.Descriptor instead.
+func (*ProductVersionChange) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ProductVersionChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ProductVersionChange) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ProductVersionChange) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type LeaseProductVersionInfo struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int64                   `protobuf:"varint,2,opt,name=productId,proto3" json:"productId,omitempty"`
+	Version       int32                   `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`             // 版本号
+	Status        string                  `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                // scheduled:待生效 active:生效中 superseded:已失效 cancelled:已取消
+	Changes       []*ProductVersionChange `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`              // 相对上一版本的变更
+	EffectiveFrom int64                   `protobuf:"varint,6,opt,name=effectiveFrom,proto3" json:"effectiveFrom,omitempty"` // 生效时间
+	EffectiveTo   int64                   `protobuf:"varint,7,opt,name=effectiveTo,proto3" json:"effectiveTo,omitempty"`     // 失效时间,生效中或待生效时为0
+	OperatorId    int64                   `protobuf:"varint,8,opt,name=operatorId,proto3" json:"operatorId,omitempty"`       // 操作人ID
+	OperatorName  string                  `protobuf:"bytes,9,opt,name=operatorName,proto3" json:"operatorName,omitempty"`    // 操作人姓名
+	CreatedAt     int64                   `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaseProductVersionInfo) Reset() {
+	*x = LeaseProductVersionInfo{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaseProductVersionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseProductVersionInfo) ProtoMessage() {}
+
+func (x *LeaseProductVersionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseProductVersionInfo.ProtoReflect.Descriptor instead.
+func (*LeaseProductVersionInfo) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LeaseProductVersionInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LeaseProductVersionInfo) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *LeaseProductVersionInfo) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LeaseProductVersionInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LeaseProductVersionInfo) GetChanges() []*ProductVersionChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *LeaseProductVersionInfo) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+func (x *LeaseProductVersionInfo) GetEffectiveTo() int64 {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return 0
+}
+
+func (x *LeaseProductVersionInfo) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *LeaseProductVersionInfo) GetOperatorName() string {
+	if x != nil {
+		return x.OperatorName
+	}
+	return ""
+}
+
+func (x *LeaseProductVersionInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 产品版本历史请求
+type ListLeaseProductVersionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode   string                 `protobuf:"bytes,1,opt,name=productCode,proto3" json:"productCode,omitempty"` // 产品编码
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeaseProductVersionsReq) Reset() {
+	*x = ListLeaseProductVersionsReq{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeaseProductVersionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeaseProductVersionsReq) ProtoMessage() {}
+
+func (x *ListLeaseProductVersionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeaseProductVersionsReq.ProtoReflect.Descriptor instead.
+func (*ListLeaseProductVersionsReq) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListLeaseProductVersionsReq) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+type ListLeaseProductVersionsResp struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	List          []*LeaseProductVersionInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"` // 按版本号倒序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeaseProductVersionsResp) Reset() {
+	*x = ListLeaseProductVersionsResp{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeaseProductVersionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeaseProductVersionsResp) ProtoMessage() {}
+
+func (x *ListLeaseProductVersionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeaseProductVersionsResp.ProtoReflect.Descriptor instead.
+func (*ListLeaseProductVersionsResp) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListLeaseProductVersionsResp) GetList() []*LeaseProductVersionInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 排期上下架 - 到期由后台任务自动切换状态,时间传0表示清除排期
+type ScheduleLeaseProductReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode   string                 `protobuf:"bytes,1,opt,name=productCode,proto3" json:"productCode,omitempty"` // 产品编码
+	LaunchAt      int64                  `protobuf:"varint,2,opt,name=launchAt,proto3" json:"launchAt,omitempty"`      // 计划上架时间(Unix秒)
+	DelistAt      int64                  `protobuf:"varint,3,opt,name=delistAt,proto3" json:"delistAt,omitempty"`      // 计划下架时间(Unix秒)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleLeaseProductReq) Reset() {
+	*x = ScheduleLeaseProductReq{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleLeaseProductReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleLeaseProductReq) ProtoMessage() {}
+
+func (x *ScheduleLeaseProductReq) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleLeaseProductReq.ProtoReflect.Descriptor instead.
+func (*ScheduleLeaseProductReq) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ScheduleLeaseProductReq) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *ScheduleLeaseProductReq) GetLaunchAt() int64 {
+	if x != nil {
+		return x.LaunchAt
+	}
+	return 0
+}
+
+func (x *ScheduleLeaseProductReq) GetDelistAt() int64 {
+	if x != nil {
+		return x.DelistAt
+	}
+	return 0
+}
+
+type ScheduleLeaseProductResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *LeaseProductInfo      `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleLeaseProductResp) Reset() {
+	*x = ScheduleLeaseProductResp{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleLeaseProductResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleLeaseProductResp) ProtoMessage() {}
+
+func (x *ScheduleLeaseProductResp) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleLeaseProductResp.ProtoReflect.Descriptor instead.
+func (*ScheduleLeaseProductResp) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ScheduleLeaseProductResp) GetData() *LeaseProductInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 设备台账 - 产品下的单台设备,审批通过时分配给租赁申请
+type EquipmentUnitInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                       // 设备ID
+	ProductId     int64                  `protobuf:"varint,2,opt,name=productId,proto3" json:"productId,omitempty"`         // 产品ID
+	ProductCode   string                 `protobuf:"bytes,3,opt,name=productCode,proto3" json:"productCode,omitempty"`      // 产品编码
+	SerialNumber  string                 `protobuf:"bytes,4,opt,name=serialNumber,proto3" json:"serialNumber,omitempty"`    // 设备序列号
+	PurchaseDate  string                 `protobuf:"bytes,5,opt,name=purchaseDate,proto3" json:"purchaseDate,omitempty"`    // 购置日期 YYYY-MM-DD,未登记时为空
+	HoursUsed     float64                `protobuf:"fixed64,6,opt,name=hoursUsed,proto3" json:"hoursUsed,omitempty"`        // 累计使用小时数
+	Location      string                 `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`            // 当前存放位置
+	Condition     string                 `protobuf:"bytes,8,opt,name=condition,proto3" json:"condition,omitempty"`          // 设备状况 new:全新 good:良好 fair:一般 poor:较差
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`                // 状态 available:可用 leased:已租出(预占租期覆盖当天) maintenance:维修保养 retired:已退役
+	ApplicationId string                 `protobuf:"bytes,10,opt,name=applicationId,proto3" json:"applicationId,omitempty"` // 当前租用的申请编号,租期覆盖当天的预占有值
+	Remark        string                 `protobuf:"bytes,11,opt,name=remark,proto3" json:"remark,omitempty"`               // 备注
+	CreatedAt     int64                  `protobuf:"varint,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`        // 创建时间
+	UpdatedAt     int64                  `protobuf:"varint,13,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`        // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EquipmentUnitInfo) Reset() {
+	*x = EquipmentUnitInfo{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EquipmentUnitInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EquipmentUnitInfo) ProtoMessage() {}
+
+func (x *EquipmentUnitInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EquipmentUnitInfo.ProtoReflect.Descriptor instead.
+func (*EquipmentUnitInfo) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *EquipmentUnitInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EquipmentUnitInfo) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *EquipmentUnitInfo) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *EquipmentUnitInfo) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *EquipmentUnitInfo) GetPurchaseDate() string {
+	if x != nil {
+		return x.PurchaseDate
+	}
+	return ""
+}
+
+func (x *EquipmentUnitInfo) GetHoursUsed() float64 {
+	if x != nil {
+		return x.HoursUsed
+	}
+	return 0
+}
+
+func (x *EquipmentUnitInfo) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *EquipmentUnitInfo) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *EquipmentUnitInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EquipmentUnitInfo) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *EquipmentUnitInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *EquipmentUnitInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *EquipmentUnitInfo) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// 设备列表请求
+type ListEquipmentUnitsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode   string                 `protobuf:"bytes,1,opt,name=productCode,proto3" json:"productCode,omitempty"` // 产品编码
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`           // 状态 available/leased/maintenance/retired,为空表示全部
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`              // 页码
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`              // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEquipmentUnitsReq) Reset() {
+	*x = ListEquipmentUnitsReq{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEquipmentUnitsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEquipmentUnitsReq) ProtoMessage() {}
+
+func (x *ListEquipmentUnitsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEquipmentUnitsReq.ProtoReflect.Descriptor instead.
+func (*ListEquipmentUnitsReq) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListEquipmentUnitsReq) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *ListEquipmentUnitsReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListEquipmentUnitsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListEquipmentUnitsReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ListEquipmentUnitsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*EquipmentUnitInfo   `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`    // 设备列表
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // 总数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEquipmentUnitsResp) Reset() {
+	*x = ListEquipmentUnitsResp{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEquipmentUnitsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEquipmentUnitsResp) ProtoMessage() {}
+
+func (x *ListEquipmentUnitsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEquipmentUnitsResp.ProtoReflect.Descriptor instead.
+func (*ListEquipmentUnitsResp) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListEquipmentUnitsResp) GetList() []*EquipmentUnitInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListEquipmentUnitsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 设备详情请求
+type GetEquipmentUnitReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 设备ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEquipmentUnitReq) Reset() {
+	*x = GetEquipmentUnitReq{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEquipmentUnitReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEquipmentUnitReq) ProtoMessage() {}
+
+func (x *GetEquipmentUnitReq) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEquipmentUnitReq.ProtoReflect.Descriptor instead.
+func (*GetEquipmentUnitReq) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetEquipmentUnitReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetEquipmentUnitResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *EquipmentUnitInfo     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEquipmentUnitResp) Reset() {
+	*x = GetEquipmentUnitResp{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEquipmentUnitResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEquipmentUnitResp) ProtoMessage() {}
+
+func (x *GetEquipmentUnitResp) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEquipmentUnitResp.ProtoReflect.Descriptor instead.
+func (*GetEquipmentUnitResp) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetEquipmentUnitResp) GetData() *EquipmentUnitInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 登记设备请求 - 新设备状态为可用
+type CreateEquipmentUnitReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode   string                 `protobuf:"bytes,1,opt,name=productCode,proto3" json:"productCode,omitempty"`   // 产品编码
+	SerialNumber  string                 `protobuf:"bytes,2,opt,name=serialNumber,proto3" json:"serialNumber,omitempty"` // 设备序列号,全局唯一
+	PurchaseDate  string                 `protobuf:"bytes,3,opt,name=purchaseDate,proto3" json:"purchaseDate,omitempty"` // 购置日期 YYYY-MM-DD
+	HoursUsed     float64                `protobuf:"fixed64,4,opt,name=hoursUsed,proto3" json:"hoursUsed,omitempty"`     // 累计使用小时数
+	Location      string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`         // 当前存放位置
+	Condition     string                 `protobuf:"bytes,6,opt,name=condition,proto3" json:"condition,omitempty"`       // 设备状况,为空时为 good
+	Remark        string                 `protobuf:"bytes,7,opt,name=remark,proto3" json:"remark,omitempty"`             // 备注
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEquipmentUnitReq) Reset() {
+	*x = CreateEquipmentUnitReq{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEquipmentUnitReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEquipmentUnitReq) ProtoMessage() {}
+
+func (x *CreateEquipmentUnitReq) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEquipmentUnitReq.ProtoReflect.Descriptor instead.
+func (*CreateEquipmentUnitReq) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateEquipmentUnitReq) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *CreateEquipmentUnitReq) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *CreateEquipmentUnitReq) GetPurchaseDate() string {
+	if x != nil {
+		return x.PurchaseDate
+	}
+	return ""
+}
+
+func (x *CreateEquipmentUnitReq) GetHoursUsed() float64 {
+	if x != nil {
+		return x.HoursUsed
+	}
+	return 0
+}
+
+func (x *CreateEquipmentUnitReq) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateEquipmentUnitReq) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *CreateEquipmentUnitReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type CreateEquipmentUnitResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *EquipmentUnitInfo     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEquipmentUnitResp) Reset() {
+	*x = CreateEquipmentUnitResp{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEquipmentUnitResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEquipmentUnitResp) ProtoMessage() {}
+
+func (x *CreateEquipmentUnitResp) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEquipmentUnitResp.ProtoReflect.Descriptor instead.
+func (*CreateEquipmentUnitResp) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateEquipmentUnitResp) GetData() *EquipmentUnitInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 更新设备请求 - 字段为空或0时不修改
+// 状态只能设为可用、维修保养或已退役,有未结束租赁预占的设备不能设为维修保养或已退役
+type UpdateEquipmentUnitReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                    // 设备ID
+	SerialNumber  string                 `protobuf:"bytes,2,opt,name=serialNumber,proto3" json:"serialNumber,omitempty"` // 设备序列号
+	PurchaseDate  string                 `protobuf:"bytes,3,opt,name=purchaseDate,proto3" json:"purchaseDate,omitempty"` // 购置日期 YYYY-MM-DD
+	HoursUsed     float64                `protobuf:"fixed64,4,opt,name=hoursUsed,proto3" json:"hoursUsed,omitempty"`     // 累计使用小时数,不能小于当前值
+	Location      string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`         // 当前存放位置
+	Condition     string                 `protobuf:"bytes,6,opt,name=condition,proto3" json:"condition,omitempty"`       // 设备状况
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`             // 状态
+	Remark        string                 `protobuf:"bytes,8,opt,name=remark,proto3" json:"remark,omitempty"`             // 备注
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEquipmentUnitReq) Reset() {
+	*x = UpdateEquipmentUnitReq{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEquipmentUnitReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEquipmentUnitReq) ProtoMessage() {}
+
+func (x *UpdateEquipmentUnitReq) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEquipmentUnitReq.ProtoReflect.Descriptor instead.
+func (*UpdateEquipmentUnitReq) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateEquipmentUnitReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateEquipmentUnitReq) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *UpdateEquipmentUnitReq) GetPurchaseDate() string {
+	if x != nil {
+		return x.PurchaseDate
+	}
+	return ""
+}
+
+func (x *UpdateEquipmentUnitReq) GetHoursUsed() float64 {
+	if x != nil {
+		return x.HoursUsed
+	}
+	return 0
+}
+
+func (x *UpdateEquipmentUnitReq) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UpdateEquipmentUnitReq) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *UpdateEquipmentUnitReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateEquipmentUnitReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type UpdateEquipmentUnitResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *EquipmentUnitInfo     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEquipmentUnitResp) Reset() {
+	*x = UpdateEquipmentUnitResp{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEquipmentUnitResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEquipmentUnitResp) ProtoMessage() {}
+
+func (x *UpdateEquipmentUnitResp) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEquipmentUnitResp.ProtoReflect.Descriptor instead.
+func (*UpdateEquipmentUnitResp) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateEquipmentUnitResp) GetData() *EquipmentUnitInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 删除设备请求 - 有未释放租赁预占的设备不能删除,停用设备应改为已退役
+type DeleteEquipmentUnitReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 设备ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEquipmentUnitReq) Reset() {
+	*x = DeleteEquipmentUnitReq{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEquipmentUnitReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEquipmentUnitReq) ProtoMessage() {}
+
+func (x *DeleteEquipmentUnitReq) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEquipmentUnitReq.ProtoReflect.Descriptor instead.
+func (*DeleteEquipmentUnitReq) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteEquipmentUnitReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteEquipmentUnitResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEquipmentUnitResp) Reset() {
+	*x = DeleteEquipmentUnitResp{}
+	mi := &file_leaseproduct_rpc_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEquipmentUnitResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEquipmentUnitResp) ProtoMessage() {}
+
+func (x *DeleteEquipmentUnitResp) ProtoReflect() protoreflect.Message {
+	mi := &file_leaseproduct_rpc_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEquipmentUnitResp.ProtoReflect.Descriptor instead.
+func (*DeleteEquipmentUnitResp) Descriptor() ([]byte, []int) {
+	return file_leaseproduct_rpc_proto_rawDescGZIP(), []int{38}
+}
+
+var File_leaseproduct_rpc_proto protoreflect.FileDescriptor
+
+const file_leaseproduct_rpc_proto_rawDesc = "" +
+	"\n" +
+	"\x16leaseproduct-rpc.proto\x12\fleaseproduct\"\xbc\x05\n" +
+	"\x10LeaseProductInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12 \n" +
+	"\vproductCode\x18\x02 \x01(\tR\vproductCode\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1c\n" +
+	"\tmachinery\x18\x05 \x01(\tR\tmachinery\x12\x14\n" +
+	"\x05brand\x18\x06 \x01(\tR\x05brand\x12\x14\n" +
+	"\x05model\x18\a \x01(\tR\x05model\x12\x1c\n" +
+	"\tdailyRate\x18\b \x01(\x01R\tdailyRate\x12\x18\n" +
+	"\adeposit\x18\t \x01(\x01R\adeposit\x12 \n" +
+	"\vmaxDuration\x18\n" +
+	" \x01(\x05R\vmaxDuration\x12 \n" +
+	"\vminDuration\x18\v \x01(\x05R\vminDuration\x12 \n" +
+	"\vdescription\x18\f \x01(\tR\vdescription\x12&\n" +
+	"\x0einventoryCount\x18\r \x01(\x05R\x0einventoryCount\x12&\n" +
+	"\x0eavailableCount\x18\x0e \x01(\x05R\x0eavailableCount\x12\x16\n" +
+	"\x06status\x18\x0f \x01(\x05R\x06status\x12\x1c\n" +
+	"\tcreatedAt\x18\x10 \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\x11 \x01(\x03R\tupdatedAt\x12$\n" +
+	"\rapprovalChain\x18\x12 \x01(\tR\rapprovalChain\x12\x18\n" +
+	"\aversion\x18\x13 \x01(\x05R\aversion\x12\x1a\n" +
+	"\blaunchAt\x18\x14 \x01(\x03R\blaunchAt\x12\x1a\n" +
+	"\bdelistAt\x18\x15 \x01(\x03R\bdelistAt\x12(\n" +
+	"\x0feligibilityRule\x18\x16 \x01(\tR\x0feligibilityRule\x12 \n" +
+	"\vpricingRule\x18\x17 \x01(\tR\vpricingRule\"\x18\n" +
+	"\x16DeleteLeaseProductResp\"I\n" +
+	"\x13GetLeaseProductResp\x122\n" +
+	"\x04data\x18\x01 \x01(\v2\x1e.leaseproduct.LeaseProductInfoR\x04data\"L\n" +
+	"\x16CreateLeaseProductResp\x122\n" +
+	"\x04data\x18\x01 \x01(\v2\x1e.leaseproduct.LeaseProductInfoR\x04data\"\x8d\x01\n" +
+	"\x16UpdateLeaseProductResp\x122\n" +
+	"\x04data\x18\x01 \x01(\v2\x1e.leaseproduct.LeaseProductInfoR\x04data\x12?\n" +
+	"\aversion\x18\x02 \x01(\v2%.leaseproduct.LeaseProductVersionInfoR\aversion\"6\n" +
+	"\x12GetLeaseProductReq\x12 \n" +
+	"\vproductCode\x18\x01 \x01(\tR\vproductCode\"\xb2\x01\n" +
+	"\x14ListLeaseProductsReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x14\n" +
+	"\x05brand\x18\x04 \x01(\tR\x05brand\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\x12\x18\n" +
+	"\akeyword\x18\x06 \x01(\tR\akeyword\x12\x16\n" +
+	"\x06userId\x18\a \x01(\x03R\x06userId\"a\n" +
+	"\x15ListLeaseProductsResp\x122\n" +
+	"\x04list\x18\x01 \x03(\v2\x1e.leaseproduct.LeaseProductInfoR\x04list\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xa7\x04\n" +
+	"\x15CreateLeaseProductReq\x12 \n" +
+	"\vproductCode\x18\x01 \x01(\tR\vproductCode\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1c\n" +
+	"\tmachinery\x18\x04 \x01(\tR\tmachinery\x12\x14\n" +
+	"\x05brand\x18\x05 \x01(\tR\x05brand\x12\x14\n" +
+	"\x05model\x18\x06 \x01(\tR\x05model\x12\x1c\n" +
+	"\tdailyRate\x18\a \x01(\x01R\tdailyRate\x12\x18\n" +
+	"\adeposit\x18\b \x01(\x01R\adeposit\x12 \n" +
+	"\vmaxDuration\x18\t \x01(\x05R\vmaxDuration\x12 \n" +
+	"\vminDuration\x18\n" +
+	" \x01(\x05R\vminDuration\x12 \n" +
+	"\vdescription\x18\v \x01(\tR\vdescription\x12&\n" +
+	"\x0einventoryCount\x18\f \x01(\x05R\x0einventoryCount\x12$\n" +
+	"\rapprovalChain\x18\r \x01(\tR\rapprovalChain\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\x0e \x01(\x03R\n" +
+	"operatorId\x12\"\n" +
+	"\foperatorName\x18\x0f \x01(\tR\foperatorName\x12(\n" +
+	"\x0feligibilityRule\x18\x10 \x01(\tR\x0feligibilityRule\x12 \n" +
+	"\vpricingRule\x18\x11 \x01(\tR\vpricingRule\"\xbd\x04\n" +
+	"\x15UpdateLeaseProductReq\x12 \n" +
+	"\vproductCode\x18\x01 \x01(\tR\vproductCode\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1c\n" +
+	"\tmachinery\x18\x04 \x01(\tR\tmachinery\x12\x14\n" +
+	"\x05brand\x18\x05 \x01(\tR\x05brand\x12\x14\n" +
+	"\x05model\x18\x06 \x01(\tR\x05model\x12\x1c\n" +
+	"\tdailyRate\x18\a \x01(\x01R\tdailyRate\x12\x18\n" +
+	"\adeposit\x18\b \x01(\x01R\adeposit\x12 \n" +
+	"\vmaxDuration\x18\t \x01(\x05R\vmaxDuration\x12 \n" +
+	"\vminDuration\x18\n" +
+	" \x01(\x05R\vminDuration\x12 \n" +
+	"\vdescription\x18\v \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\f \x01(\x05R\x06status\x12$\n" +
+	"\rapprovalChain\x18\r \x01(\tR\rapprovalChain\x12$\n" +
+	"\reffectiveFrom\x18\x0e \x01(\x03R\reffectiveFrom\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\x0f \x01(\x03R\n" +
+	"operatorId\x12\"\n" +
+	"\foperatorName\x18\x10 \x01(\tR\foperatorName\x12(\n" +
+	"\x0feligibilityRule\x18\x11 \x01(\tR\x0feligibilityRule\x12 \n" +
+	"\vpricingRule\x18\x12 \x01(\tR\vpricingRule\"9\n" +
+	"\x15DeleteLeaseProductReq\x12 \n" +
+	"\vproductCode\x18\x01 \x01(\tR\vproductCode\"O\n" +
+	"\x13CheckEligibilityReq\x12 \n" +
+	"\vproductCode\x18\x01 \x01(\tR\vproductCode\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x03R\x06userId\"o\n" +
+	"\x11EligibilityReason\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x01R\x05limit\x12\x16\n" +
+	"\x06actual\x18\x04 \x01(\x01R\x06actual\"m\n" +
+	"\x14CheckEligibilityResp\x12\x1a\n" +
+	"\beligible\x18\x01 \x01(\bR\beligible\x129\n" +
+	"\areasons\x18\x02 \x03(\v2\x1f.leaseproduct.EligibilityReasonR\areasons\"i\n" +
+	"\rQuoteLeaseReq\x12 \n" +
+	"\vproductCode\x18\x01 \x01(\tR\vproductCode\x12\x1c\n" +
+	"\tstartDate\x18\x02 \x01(\tR\tstartDate\x12\x18\n" +
+	"\aendDate\x18\x03 \x01(\tR\aendDate\"\xf4\x02\n" +
+	"\x0eQuoteLeaseResp\x12 \n" +
+	"\vproductCode\x18\x01 \x01(\tR\vproductCode\x12\x1a\n" +
+	"\bduration\x18\x02 \x01(\x05R\bduration\x12\x1c\n" +
+	"\tdailyRate\x18\x03 \x01(\x01R\tdailyRate\x12 \n" +
+	"\vtotalAmount\x18\x04 \x01(\x01R\vtotalAmount\x12\x18\n" +
+	"\adeposit\x18\x05 \x01(\x01R\adeposit\x12\x1e\n" +
+	"\n" +
+	"baseAmount\x18\x06 \x01(\x01R\n" +
+	"baseAmount\x12\"\n" +
+	"\fseasonalDays\x18\a \x01(\x05R\fseasonalDays\x12&\n" +
+	"\x0eseasonalAmount\x18\b \x01(\x01R\x0eseasonalAmount\x12*\n" +
+	"\x10durationDiscount\x18\t \x01(\x01R\x10durationDiscount\x122\n" +
+	"\x14earlyBookingDiscount\x18\n" +
+	" \x01(\x01R\x14earlyBookingDiscount\"\x95\x01\n" +
 	"\x1dCheckInventoryAvailabilityReq\x12 \n" +
 	"\vproductCode\x18\x01 \x01(\tR\vproductCode\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1c\n" +
@@ -1058,14 +2926,119 @@ const file_leaseproduct_rpc_proto_rawDesc = "" +
 	"\aendDate\x18\x04 \x01(\tR\aendDate\"f\n" +
 	"\x1eCheckInventoryAvailabilityResp\x12\x1c\n" +
 	"\tavailable\x18\x01 \x01(\bR\tavailable\x12&\n" +
-	"\x0eavailableCount\x18\x02 \x01(\x05R\x0eavailableCount2\xe7\x04\n" +
+	"\x0eavailableCount\x18\x02 \x01(\x05R\x0eavailableCount\"\xb1\x01\n" +
+	"\x13ReserveInventoryReq\x12 \n" +
+	"\vproductCode\x18\x01 \x01(\tR\vproductCode\x12$\n" +
+	"\rapplicationId\x18\x02 \x01(\tR\rapplicationId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1c\n" +
+	"\tstartDate\x18\x04 \x01(\tR\tstartDate\x12\x18\n" +
+	"\aendDate\x18\x05 \x01(\tR\aendDate\"z\n" +
+	"\x14ReserveInventoryResp\x12&\n" +
+	"\x0eavailableCount\x18\x01 \x01(\x05R\x0eavailableCount\x12\x16\n" +
+	"\x06unitId\x18\x02 \x01(\x03R\x06unitId\x12\"\n" +
+	"\fserialNumber\x18\x03 \x01(\tR\fserialNumber\"=\n" +
+	"\x15ReleaseReservationReq\x12$\n" +
+	"\rapplicationId\x18\x01 \x01(\tR\rapplicationId\"4\n" +
+	"\x16ReleaseReservationResp\x12\x1a\n" +
+	"\breleased\x18\x01 \x01(\bR\breleased\"P\n" +
+	"\x14ProductVersionChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\xe1\x02\n" +
+	"\x17LeaseProductVersionInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
+	"\tproductId\x18\x02 \x01(\x03R\tproductId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12<\n" +
+	"\achanges\x18\x05 \x03(\v2\".leaseproduct.ProductVersionChangeR\achanges\x12$\n" +
+	"\reffectiveFrom\x18\x06 \x01(\x03R\reffectiveFrom\x12 \n" +
+	"\veffectiveTo\x18\a \x01(\x03R\veffectiveTo\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\b \x01(\x03R\n" +
+	"operatorId\x12\"\n" +
+	"\foperatorName\x18\t \x01(\tR\foperatorName\x12\x1c\n" +
+	"\tcreatedAt\x18\n" +
+	" \x01(\x03R\tcreatedAt\"?\n" +
+	"\x1bListLeaseProductVersionsReq\x12 \n" +
+	"\vproductCode\x18\x01 \x01(\tR\vproductCode\"Y\n" +
+	"\x1cListLeaseProductVersionsResp\x129\n" +
+	"\x04list\x18\x01 \x03(\v2%.leaseproduct.LeaseProductVersionInfoR\x04list\"s\n" +
+	"\x17ScheduleLeaseProductReq\x12 \n" +
+	"\vproductCode\x18\x01 \x01(\tR\vproductCode\x12\x1a\n" +
+	"\blaunchAt\x18\x02 \x01(\x03R\blaunchAt\x12\x1a\n" +
+	"\bdelistAt\x18\x03 \x01(\x03R\bdelistAt\"N\n" +
+	"\x18ScheduleLeaseProductResp\x122\n" +
+	"\x04data\x18\x01 \x01(\v2\x1e.leaseproduct.LeaseProductInfoR\x04data\"\x95\x03\n" +
+	"\x11EquipmentUnitInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
+	"\tproductId\x18\x02 \x01(\x03R\tproductId\x12 \n" +
+	"\vproductCode\x18\x03 \x01(\tR\vproductCode\x12\"\n" +
+	"\fserialNumber\x18\x04 \x01(\tR\fserialNumber\x12\"\n" +
+	"\fpurchaseDate\x18\x05 \x01(\tR\fpurchaseDate\x12\x1c\n" +
+	"\thoursUsed\x18\x06 \x01(\x01R\thoursUsed\x12\x1a\n" +
+	"\blocation\x18\a \x01(\tR\blocation\x12\x1c\n" +
+	"\tcondition\x18\b \x01(\tR\tcondition\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12$\n" +
+	"\rapplicationId\x18\n" +
+	" \x01(\tR\rapplicationId\x12\x16\n" +
+	"\x06remark\x18\v \x01(\tR\x06remark\x12\x1c\n" +
+	"\tcreatedAt\x18\f \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\r \x01(\x03R\tupdatedAt\"y\n" +
+	"\x15ListEquipmentUnitsReq\x12 \n" +
+	"\vproductCode\x18\x01 \x01(\tR\vproductCode\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\"c\n" +
+	"\x16ListEquipmentUnitsResp\x123\n" +
+	"\x04list\x18\x01 \x03(\v2\x1f.leaseproduct.EquipmentUnitInfoR\x04list\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"%\n" +
+	"\x13GetEquipmentUnitReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"K\n" +
+	"\x14GetEquipmentUnitResp\x123\n" +
+	"\x04data\x18\x01 \x01(\v2\x1f.leaseproduct.EquipmentUnitInfoR\x04data\"\xf2\x01\n" +
+	"\x16CreateEquipmentUnitReq\x12 \n" +
+	"\vproductCode\x18\x01 \x01(\tR\vproductCode\x12\"\n" +
+	"\fserialNumber\x18\x02 \x01(\tR\fserialNumber\x12\"\n" +
+	"\fpurchaseDate\x18\x03 \x01(\tR\fpurchaseDate\x12\x1c\n" +
+	"\thoursUsed\x18\x04 \x01(\x01R\thoursUsed\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12\x1c\n" +
+	"\tcondition\x18\x06 \x01(\tR\tcondition\x12\x16\n" +
+	"\x06remark\x18\a \x01(\tR\x06remark\"N\n" +
+	"\x17CreateEquipmentUnitResp\x123\n" +
+	"\x04data\x18\x01 \x01(\v2\x1f.leaseproduct.EquipmentUnitInfoR\x04data\"\xf8\x01\n" +
+	"\x16UpdateEquipmentUnitReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\"\n" +
+	"\fserialNumber\x18\x02 \x01(\tR\fserialNumber\x12\"\n" +
+	"\fpurchaseDate\x18\x03 \x01(\tR\fpurchaseDate\x12\x1c\n" +
+	"\thoursUsed\x18\x04 \x01(\x01R\thoursUsed\x12\x1a\n" +
+	"\blocation\x18\x05 \x01(\tR\blocation\x12\x1c\n" +
+	"\tcondition\x18\x06 \x01(\tR\tcondition\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x16\n" +
+	"\x06remark\x18\b \x01(\tR\x06remark\"N\n" +
+	"\x17UpdateEquipmentUnitResp\x123\n" +
+	"\x04data\x18\x01 \x01(\v2\x1f.leaseproduct.EquipmentUnitInfoR\x04data\"(\n" +
+	"\x16DeleteEquipmentUnitReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x19\n" +
+	"\x17DeleteEquipmentUnitResp2\x89\r\n" +
 	"\x13LeaseProductService\x12V\n" +
 	"\x0fGetLeaseProduct\x12 .leaseproduct.GetLeaseProductReq\x1a!.leaseproduct.GetLeaseProductResp\x12\\\n" +
-	"\x11ListLeaseProducts\x12\".leaseproduct.ListLeaseProductsReq\x1a#.leaseproduct.ListLeaseProductsResp\x12_\n" +
+	"\x11ListLeaseProducts\x12\".leaseproduct.ListLeaseProductsReq\x1a#.leaseproduct.ListLeaseProductsResp\x12Y\n" +
+	"\x10CheckEligibility\x12!.leaseproduct.CheckEligibilityReq\x1a\".leaseproduct.CheckEligibilityResp\x12G\n" +
+	"\n" +
+	"QuoteLease\x12\x1b.leaseproduct.QuoteLeaseReq\x1a\x1c.leaseproduct.QuoteLeaseResp\x12_\n" +
 	"\x12CreateLeaseProduct\x12#.leaseproduct.CreateLeaseProductReq\x1a$.leaseproduct.CreateLeaseProductResp\x12_\n" +
 	"\x12UpdateLeaseProduct\x12#.leaseproduct.UpdateLeaseProductReq\x1a$.leaseproduct.UpdateLeaseProductResp\x12_\n" +
-	"\x12DeleteLeaseProduct\x12#.leaseproduct.DeleteLeaseProductReq\x1a$.leaseproduct.DeleteLeaseProductResp\x12w\n" +
-	"\x1aCheckInventoryAvailability\x12+.leaseproduct.CheckInventoryAvailabilityReq\x1a,.leaseproduct.CheckInventoryAvailabilityRespB\x10Z\x0e./leaseproductb\x06proto3"
+	"\x12DeleteLeaseProduct\x12#.leaseproduct.DeleteLeaseProductReq\x1a$.leaseproduct.DeleteLeaseProductResp\x12q\n" +
+	"\x18ListLeaseProductVersions\x12).leaseproduct.ListLeaseProductVersionsReq\x1a*.leaseproduct.ListLeaseProductVersionsResp\x12e\n" +
+	"\x14ScheduleLeaseProduct\x12%.leaseproduct.ScheduleLeaseProductReq\x1a&.leaseproduct.ScheduleLeaseProductResp\x12w\n" +
+	"\x1aCheckInventoryAvailability\x12+.leaseproduct.CheckInventoryAvailabilityReq\x1a,.leaseproduct.CheckInventoryAvailabilityResp\x12Y\n" +
+	"\x10ReserveInventory\x12!.leaseproduct.ReserveInventoryReq\x1a\".leaseproduct.ReserveInventoryResp\x12_\n" +
+	"\x12ReleaseReservation\x12#.leaseproduct.ReleaseReservationReq\x1a$.leaseproduct.ReleaseReservationResp\x12_\n" +
+	"\x12ListEquipmentUnits\x12#.leaseproduct.ListEquipmentUnitsReq\x1a$.leaseproduct.ListEquipmentUnitsResp\x12Y\n" +
+	"\x10GetEquipmentUnit\x12!.leaseproduct.GetEquipmentUnitReq\x1a\".leaseproduct.GetEquipmentUnitResp\x12b\n" +
+	"\x13CreateEquipmentUnit\x12$.leaseproduct.CreateEquipmentUnitReq\x1a%.leaseproduct.CreateEquipmentUnitResp\x12b\n" +
+	"\x13UpdateEquipmentUnit\x12$.leaseproduct.UpdateEquipmentUnitReq\x1a%.leaseproduct.UpdateEquipmentUnitResp\x12b\n" +
+	"\x13DeleteEquipmentUnit\x12$.leaseproduct.DeleteEquipmentUnitReq\x1a%.leaseproduct.DeleteEquipmentUnitRespB\x10Z\x0e./leaseproductb\x06proto3"
 
 var (
 	file_leaseproduct_rpc_proto_rawDescOnce sync.Once
@@ -1079,7 +3052,7 @@ func file_leaseproduct_rpc_proto_rawDescGZIP() []byte {
 	return file_leaseproduct_rpc_proto_rawDescData
 }
 
-var file_leaseproduct_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_leaseproduct_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_leaseproduct_rpc_proto_goTypes = []any{
 	(*LeaseProductInfo)(nil),               // 0: leaseproduct.LeaseProductInfo
 	(*DeleteLeaseProductResp)(nil),         // 1: leaseproduct.DeleteLeaseProductResp
@@ -1092,31 +3065,88 @@ var file_leaseproduct_rpc_proto_goTypes = []any{
 	(*CreateLeaseProductReq)(nil),          // 8: leaseproduct.CreateLeaseProductReq
 	(*UpdateLeaseProductReq)(nil),          // 9: leaseproduct.UpdateLeaseProductReq
 	(*DeleteLeaseProductReq)(nil),          // 10: leaseproduct.DeleteLeaseProductReq
-	(*CheckInventoryAvailabilityReq)(nil),  // 11: leaseproduct.CheckInventoryAvailabilityReq
-	(*CheckInventoryAvailabilityResp)(nil), // 12: leaseproduct.CheckInventoryAvailabilityResp
+	(*CheckEligibilityReq)(nil),            // 11: leaseproduct.CheckEligibilityReq
+	(*EligibilityReason)(nil),              // 12: leaseproduct.EligibilityReason
+	(*CheckEligibilityResp)(nil),           // 13: leaseproduct.CheckEligibilityResp
+	(*QuoteLeaseReq)(nil),                  // 14: leaseproduct.QuoteLeaseReq
+	(*QuoteLeaseResp)(nil),                 // 15: leaseproduct.QuoteLeaseResp
+	(*CheckInventoryAvailabilityReq)(nil),  // 16: leaseproduct.CheckInventoryAvailabilityReq
+	(*CheckInventoryAvailabilityResp)(nil), // 17: leaseproduct.CheckInventoryAvailabilityResp
+	(*ReserveInventoryReq)(nil),            // 18: leaseproduct.ReserveInventoryReq
+	(*ReserveInventoryResp)(nil),           // 19: leaseproduct.ReserveInventoryResp
+	(*ReleaseReservationReq)(nil),          // 20: leaseproduct.ReleaseReservationReq
+	(*ReleaseReservationResp)(nil),         // 21: leaseproduct.ReleaseReservationResp
+	(*ProductVersionChange)(nil),           // 22: leaseproduct.ProductVersionChange
+	(*LeaseProductVersionInfo)(nil),        // 23: leaseproduct.LeaseProductVersionInfo
+	(*ListLeaseProductVersionsReq)(nil),    // 24: leaseproduct.ListLeaseProductVersionsReq
+	(*ListLeaseProductVersionsResp)(nil),   // 25: leaseproduct.ListLeaseProductVersionsResp
+	(*ScheduleLeaseProductReq)(nil),        // 26: leaseproduct.ScheduleLeaseProductReq
+	(*ScheduleLeaseProductResp)(nil),       // 27: leaseproduct.ScheduleLeaseProductResp
+	(*EquipmentUnitInfo)(nil),              // 28: leaseproduct.EquipmentUnitInfo
+	(*ListEquipmentUnitsReq)(nil),          // 29: leaseproduct.ListEquipmentUnitsReq
+	(*ListEquipmentUnitsResp)(nil),         // 30: leaseproduct.ListEquipmentUnitsResp
+	(*GetEquipmentUnitReq)(nil),            // 31: leaseproduct.GetEquipmentUnitReq
+	(*GetEquipmentUnitResp)(nil),           // 32: leaseproduct.GetEquipmentUnitResp
+	(*CreateEquipmentUnitReq)(nil),         // 33: leaseproduct.CreateEquipmentUnitReq
+	(*CreateEquipmentUnitResp)(nil),        // 34: leaseproduct.CreateEquipmentUnitResp
+	(*UpdateEquipmentUnitReq)(nil),         // 35: leaseproduct.UpdateEquipmentUnitReq
+	(*UpdateEquipmentUnitResp)(nil),        // 36: leaseproduct.UpdateEquipmentUnitResp
+	(*DeleteEquipmentUnitReq)(nil),         // 37: leaseproduct.DeleteEquipmentUnitReq
+	(*DeleteEquipmentUnitResp)(nil),        // 38: leaseproduct.DeleteEquipmentUnitResp
 }
 var file_leaseproduct_rpc_proto_depIdxs = []int32{
 	0,  // 0: leaseproduct.GetLeaseProductResp.data:type_name -> leaseproduct.LeaseProductInfo
 	0,  // 1: leaseproduct.CreateLeaseProductResp.data:type_name -> leaseproduct.LeaseProductInfo
 	0,  // 2: leaseproduct.UpdateLeaseProductResp.data:type_name -> leaseproduct.LeaseProductInfo
-	0,  // 3: leaseproduct.ListLeaseProductsResp.list:type_name -> leaseproduct.LeaseProductInfo
-	5,  // 4: leaseproduct.LeaseProductService.GetLeaseProduct:input_type -> leaseproduct.GetLeaseProductReq
-	6,  // 5: leaseproduct.LeaseProductService.ListLeaseProducts:input_type -> leaseproduct.ListLeaseProductsReq
-	8,  // 6: leaseproduct.LeaseProductService.CreateLeaseProduct:input_type -> leaseproduct.CreateLeaseProductReq
-	9,  // 7: leaseproduct.LeaseProductService.UpdateLeaseProduct:input_type -> leaseproduct.UpdateLeaseProductReq
-	10, // 8: leaseproduct.LeaseProductService.DeleteLeaseProduct:input_type -> leaseproduct.DeleteLeaseProductReq
-	11, // 9: leaseproduct.LeaseProductService.CheckInventoryAvailability:input_type -> leaseproduct.CheckInventoryAvailabilityReq
-	2,  // 10: leaseproduct.LeaseProductService.GetLeaseProduct:output_type -> leaseproduct.GetLeaseProductResp
-	7,  // 11: leaseproduct.LeaseProductService.ListLeaseProducts:output_type -> leaseproduct.ListLeaseProductsResp
-	3,  // 12: leaseproduct.LeaseProductService.CreateLeaseProduct:output_type -> leaseproduct.CreateLeaseProductResp
-	4,  // 13: leaseproduct.LeaseProductService.UpdateLeaseProduct:output_type -> leaseproduct.UpdateLeaseProductResp
-	1,  // 14: leaseproduct.LeaseProductService.DeleteLeaseProduct:output_type -> leaseproduct.DeleteLeaseProductResp
-	12, // 15: leaseproduct.LeaseProductService.CheckInventoryAvailability:output_type -> leaseproduct.CheckInventoryAvailabilityResp
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	23, // 3: leaseproduct.UpdateLeaseProductResp.version:type_name -> leaseproduct.LeaseProductVersionInfo
+	0,  // 4: leaseproduct.ListLeaseProductsResp.list:type_name -> leaseproduct.LeaseProductInfo
+	12, // 5: leaseproduct.CheckEligibilityResp.reasons:type_name -> leaseproduct.EligibilityReason
+	22, // 6: leaseproduct.LeaseProductVersionInfo.changes:type_name -> leaseproduct.ProductVersionChange
+	23, // 7: leaseproduct.ListLeaseProductVersionsResp.list:type_name -> leaseproduct.LeaseProductVersionInfo
+	0,  // 8: leaseproduct.ScheduleLeaseProductResp.data:type_name -> leaseproduct.LeaseProductInfo
+	28, // 9: leaseproduct.ListEquipmentUnitsResp.list:type_name -> leaseproduct.EquipmentUnitInfo
+	28, // 10: leaseproduct.GetEquipmentUnitResp.data:type_name -> leaseproduct.EquipmentUnitInfo
+	28, // 11: leaseproduct.CreateEquipmentUnitResp.data:type_name -> leaseproduct.EquipmentUnitInfo
+	28, // 12: leaseproduct.UpdateEquipmentUnitResp.data:type_name -> leaseproduct.EquipmentUnitInfo
+	5,  // 13: leaseproduct.LeaseProductService.GetLeaseProduct:input_type -> leaseproduct.GetLeaseProductReq
+	6,  // 14: leaseproduct.LeaseProductService.ListLeaseProducts:input_type -> leaseproduct.ListLeaseProductsReq
+	11, // 15: leaseproduct.LeaseProductService.CheckEligibility:input_type -> leaseproduct.CheckEligibilityReq
+	14, // 16: leaseproduct.LeaseProductService.QuoteLease:input_type -> leaseproduct.QuoteLeaseReq
+	8,  // 17: leaseproduct.LeaseProductService.CreateLeaseProduct:input_type -> leaseproduct.CreateLeaseProductReq
+	9,  // 18: leaseproduct.LeaseProductService.UpdateLeaseProduct:input_type -> leaseproduct.UpdateLeaseProductReq
+	10, // 19: leaseproduct.LeaseProductService.DeleteLeaseProduct:input_type -> leaseproduct.DeleteLeaseProductReq
+	24, // 20: leaseproduct.LeaseProductService.ListLeaseProductVersions:input_type -> leaseproduct.ListLeaseProductVersionsReq
+	26, // 21: leaseproduct.LeaseProductService.ScheduleLeaseProduct:input_type -> leaseproduct.ScheduleLeaseProductReq
+	16, // 22: leaseproduct.LeaseProductService.CheckInventoryAvailability:input_type -> leaseproduct.CheckInventoryAvailabilityReq
+	18, // 23: leaseproduct.LeaseProductService.ReserveInventory:input_type -> leaseproduct.ReserveInventoryReq
+	20, // 24: leaseproduct.LeaseProductService.ReleaseReservation:input_type -> leaseproduct.ReleaseReservationReq
+	29, // 25: leaseproduct.LeaseProductService.ListEquipmentUnits:input_type -> leaseproduct.ListEquipmentUnitsReq
+	31, // 26: leaseproduct.LeaseProductService.GetEquipmentUnit:input_type -> leaseproduct.GetEquipmentUnitReq
+	33, // 27: leaseproduct.LeaseProductService.CreateEquipmentUnit:input_type -> leaseproduct.CreateEquipmentUnitReq
+	35, // 28: leaseproduct.LeaseProductService.UpdateEquipmentUnit:input_type -> leaseproduct.UpdateEquipmentUnitReq
+	37, // 29: leaseproduct.LeaseProductService.DeleteEquipmentUnit:input_type -> leaseproduct.DeleteEquipmentUnitReq
+	2,  // 30: leaseproduct.LeaseProductService.GetLeaseProduct:output_type -> leaseproduct.GetLeaseProductResp
+	7,  // 31: leaseproduct.LeaseProductService.ListLeaseProducts:output_type -> leaseproduct.ListLeaseProductsResp
+	13, // 32: leaseproduct.LeaseProductService.CheckEligibility:output_type -> leaseproduct.CheckEligibilityResp
+	15, // 33: leaseproduct.LeaseProductService.QuoteLease:output_type -> leaseproduct.QuoteLeaseResp
+	3,  // 34: leaseproduct.LeaseProductService.CreateLeaseProduct:output_type -> leaseproduct.CreateLeaseProductResp
+	4,  // 35: leaseproduct.LeaseProductService.UpdateLeaseProduct:output_type -> leaseproduct.UpdateLeaseProductResp
+	1,  // 36: leaseproduct.LeaseProductService.DeleteLeaseProduct:output_type -> leaseproduct.DeleteLeaseProductResp
+	25, // 37: leaseproduct.LeaseProductService.ListLeaseProductVersions:output_type -> leaseproduct.ListLeaseProductVersionsResp
+	27, // 38: leaseproduct.LeaseProductService.ScheduleLeaseProduct:output_type -> leaseproduct.ScheduleLeaseProductResp
+	17, // 39: leaseproduct.LeaseProductService.CheckInventoryAvailability:output_type -> leaseproduct.CheckInventoryAvailabilityResp
+	19, // 40: leaseproduct.LeaseProductService.ReserveInventory:output_type -> leaseproduct.ReserveInventoryResp
+	21, // 41: leaseproduct.LeaseProductService.ReleaseReservation:output_type -> leaseproduct.ReleaseReservationResp
+	30, // 42: leaseproduct.LeaseProductService.ListEquipmentUnits:output_type -> leaseproduct.ListEquipmentUnitsResp
+	32, // 43: leaseproduct.LeaseProductService.GetEquipmentUnit:output_type -> leaseproduct.GetEquipmentUnitResp
+	34, // 44: leaseproduct.LeaseProductService.CreateEquipmentUnit:output_type -> leaseproduct.CreateEquipmentUnitResp
+	36, // 45: leaseproduct.LeaseProductService.UpdateEquipmentUnit:output_type -> leaseproduct.UpdateEquipmentUnitResp
+	38, // 46: leaseproduct.LeaseProductService.DeleteEquipmentUnit:output_type -> leaseproduct.DeleteEquipmentUnitResp
+	30, // [30:47] is the sub-list for method output_type
+	13, // [13:30] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_leaseproduct_rpc_proto_init() }
@@ -1130,7 +3160,7 @@ func file_leaseproduct_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_leaseproduct_rpc_proto_rawDesc), len(file_leaseproduct_rpc_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,10 +21,21 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	LeaseProductService_GetLeaseProduct_FullMethodName            = "/leaseproduct.LeaseProductService/GetLeaseProduct"
 	LeaseProductService_ListLeaseProducts_FullMethodName          = "/leaseproduct.LeaseProductService/ListLeaseProducts"
+	LeaseProductService_CheckEligibility_FullMethodName           = "/leaseproduct.LeaseProductService/CheckEligibility"
+	LeaseProductService_QuoteLease_FullMethodName                 = "/leaseproduct.LeaseProductService/QuoteLease"
 	LeaseProductService_CreateLeaseProduct_FullMethodName         = "/leaseproduct.LeaseProductService/CreateLeaseProduct"
 	LeaseProductService_UpdateLeaseProduct_FullMethodName         = "/leaseproduct.LeaseProductService/UpdateLeaseProduct"
 	LeaseProductService_DeleteLeaseProduct_FullMethodName         = "/leaseproduct.LeaseProductService/DeleteLeaseProduct"
+	LeaseProductService_ListLeaseProductVersions_FullMethodName   = "/leaseproduct.LeaseProductService/ListLeaseProductVersions"
+	LeaseProductService_ScheduleLeaseProduct_FullMethodName       = "/leaseproduct.LeaseProductService/ScheduleLeaseProduct"
 	LeaseProductService_CheckInventoryAvailability_FullMethodName = "/leaseproduct.LeaseProductService/CheckInventoryAvailability"
+	LeaseProductService_ReserveInventory_FullMethodName           = "/leaseproduct.LeaseProductService/ReserveInventory"
+	LeaseProductService_ReleaseReservation_FullMethodName         = "/leaseproduct.LeaseProductService/ReleaseReservation"
+	LeaseProductService_ListEquipmentUnits_FullMethodName         = "/leaseproduct.LeaseProductService/ListEquipmentUnits"
+	LeaseProductService_GetEquipmentUnit_FullMethodName           = "/leaseproduct.LeaseProductService/GetEquipmentUnit"
+	LeaseProductService_CreateEquipmentUnit_FullMethodName        = "/leaseproduct.LeaseProductService/CreateEquipmentUnit"
+	LeaseProductService_UpdateEquipmentUnit_FullMethodName        = "/leaseproduct.LeaseProductService/UpdateEquipmentUnit"
+	LeaseProductService_DeleteEquipmentUnit_FullMethodName        = "/leaseproduct.LeaseProductService/DeleteEquipmentUnit"
 )
 
 // LeaseProductServiceClient is the client API for LeaseProductService service.
@@ -34,12 +45,24 @@ type LeaseProductServiceClient interface {
 	// 产品查询
 	GetLeaseProduct(ctx context.Context, in *GetLeaseProductReq, opts ...grpc.CallOption) (*GetLeaseProductResp, error)
 	ListLeaseProducts(ctx context.Context, in *ListLeaseProductsReq, opts ...grpc.CallOption) (*ListLeaseProductsResp, error)
+	CheckEligibility(ctx context.Context, in *CheckEligibilityReq, opts ...grpc.CallOption) (*CheckEligibilityResp, error)
+	QuoteLease(ctx context.Context, in *QuoteLeaseReq, opts ...grpc.CallOption) (*QuoteLeaseResp, error)
 	// 产品管理
 	CreateLeaseProduct(ctx context.Context, in *CreateLeaseProductReq, opts ...grpc.CallOption) (*CreateLeaseProductResp, error)
 	UpdateLeaseProduct(ctx context.Context, in *UpdateLeaseProductReq, opts ...grpc.CallOption) (*UpdateLeaseProductResp, error)
 	DeleteLeaseProduct(ctx context.Context, in *DeleteLeaseProductReq, opts ...grpc.CallOption) (*DeleteLeaseProductResp, error)
+	ListLeaseProductVersions(ctx context.Context, in *ListLeaseProductVersionsReq, opts ...grpc.CallOption) (*ListLeaseProductVersionsResp, error)
+	ScheduleLeaseProduct(ctx context.Context, in *ScheduleLeaseProductReq, opts ...grpc.CallOption) (*ScheduleLeaseProductResp, error)
 	// 库存检查
 	CheckInventoryAvailability(ctx context.Context, in *CheckInventoryAvailabilityReq, opts ...grpc.CallOption) (*CheckInventoryAvailabilityResp, error)
+	ReserveInventory(ctx context.Context, in *ReserveInventoryReq, opts ...grpc.CallOption) (*ReserveInventoryResp, error)
+	ReleaseReservation(ctx context.Context, in *ReleaseReservationReq, opts ...grpc.CallOption) (*ReleaseReservationResp, error)
+	// 设备台账
+	ListEquipmentUnits(ctx context.Context, in *ListEquipmentUnitsReq, opts ...grpc.CallOption) (*ListEquipmentUnitsResp, error)
+	GetEquipmentUnit(ctx context.Context, in *GetEquipmentUnitReq, opts ...grpc.CallOption) (*GetEquipmentUnitResp, error)
+	CreateEquipmentUnit(ctx context.Context, in *CreateEquipmentUnitReq, opts ...grpc.CallOption) (*CreateEquipmentUnitResp, error)
+	UpdateEquipmentUnit(ctx context.Context, in *UpdateEquipmentUnitReq, opts ...grpc.CallOption) (*UpdateEquipmentUnitResp, error)
+	DeleteEquipmentUnit(ctx context.Context, in *DeleteEquipmentUnitReq, opts ...grpc.CallOption) (*DeleteEquipmentUnitResp, error)
 }
 
 type leaseProductServiceClient struct {
@@ -70,6 +93,26 @@ func (c *leaseProductServiceClient) ListLeaseProducts(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *leaseProductServiceClient) CheckEligibility(ctx context.Context, in *CheckEligibilityReq, opts ...grpc.CallOption) (*CheckEligibilityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckEligibilityResp)
+	err := c.cc.Invoke(ctx, LeaseProductService_CheckEligibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaseProductServiceClient) QuoteLease(ctx context.Context, in *QuoteLeaseReq, opts ...grpc.CallOption) (*QuoteLeaseResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteLeaseResp)
+	err := c.cc.Invoke(ctx, LeaseProductService_QuoteLease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *leaseProductServiceClient) CreateLeaseProduct(ctx context.Context, in *CreateLeaseProductReq, opts ...grpc.CallOption) (*CreateLeaseProductResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateLeaseProductResp)
@@ -100,6 +143,26 @@ func (c *leaseProductServiceClient) DeleteLeaseProduct(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *leaseProductServiceClient) ListLeaseProductVersions(ctx context.Context, in *ListLeaseProductVersionsReq, opts ...grpc.CallOption) (*ListLeaseProductVersionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLeaseProductVersionsResp)
+	err := c.cc.Invoke(ctx, LeaseProductService_ListLeaseProductVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaseProductServiceClient) ScheduleLeaseProduct(ctx context.Context, in *ScheduleLeaseProductReq, opts ...grpc.CallOption) (*ScheduleLeaseProductResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleLeaseProductResp)
+	err := c.cc.Invoke(ctx, LeaseProductService_ScheduleLeaseProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *leaseProductServiceClient) CheckInventoryAvailability(ctx context.Context, in *CheckInventoryAvailabilityReq, opts ...grpc.CallOption) (*CheckInventoryAvailabilityResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CheckInventoryAvailabilityResp)
@@ -110,6 +173,76 @@ func (c *leaseProductServiceClient) CheckInventoryAvailability(ctx context.Conte
 	return out, nil
 }
 
+func (c *leaseProductServiceClient) ReserveInventory(ctx context.Context, in *ReserveInventoryReq, opts ...grpc.CallOption) (*ReserveInventoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveInventoryResp)
+	err := c.cc.Invoke(ctx, LeaseProductService_ReserveInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaseProductServiceClient) ReleaseReservation(ctx context.Context, in *ReleaseReservationReq, opts ...grpc.CallOption) (*ReleaseReservationResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseReservationResp)
+	err := c.cc.Invoke(ctx, LeaseProductService_ReleaseReservation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaseProductServiceClient) ListEquipmentUnits(ctx context.Context, in *ListEquipmentUnitsReq, opts ...grpc.CallOption) (*ListEquipmentUnitsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEquipmentUnitsResp)
+	err := c.cc.Invoke(ctx, LeaseProductService_ListEquipmentUnits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaseProductServiceClient) GetEquipmentUnit(ctx context.Context, in *GetEquipmentUnitReq, opts ...grpc.CallOption) (*GetEquipmentUnitResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEquipmentUnitResp)
+	err := c.cc.Invoke(ctx, LeaseProductService_GetEquipmentUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaseProductServiceClient) CreateEquipmentUnit(ctx context.Context, in *CreateEquipmentUnitReq, opts ...grpc.CallOption) (*CreateEquipmentUnitResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEquipmentUnitResp)
+	err := c.cc.Invoke(ctx, LeaseProductService_CreateEquipmentUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaseProductServiceClient) UpdateEquipmentUnit(ctx context.Context, in *UpdateEquipmentUnitReq, opts ...grpc.CallOption) (*UpdateEquipmentUnitResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEquipmentUnitResp)
+	err := c.cc.Invoke(ctx, LeaseProductService_UpdateEquipmentUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *leaseProductServiceClient) DeleteEquipmentUnit(ctx context.Context, in *DeleteEquipmentUnitReq, opts ...grpc.CallOption) (*DeleteEquipmentUnitResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEquipmentUnitResp)
+	err := c.cc.Invoke(ctx, LeaseProductService_DeleteEquipmentUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LeaseProductServiceServer is the server API for LeaseProductService service.
 // All implementations must embed UnimplementedLeaseProductServiceServer
 // for forward compatibility.
@@ -117,12 +250,24 @@ type LeaseProductServiceServer interface {
 	// 产品查询
 	GetLeaseProduct(context.Context, *GetLeaseProductReq) (*GetLeaseProductResp, error)
 	ListLeaseProducts(context.Context, *ListLeaseProductsReq) (*ListLeaseProductsResp, error)
+	CheckEligibility(context.Context, *CheckEligibilityReq) (*CheckEligibilityResp, error)
+	QuoteLease(context.Context, *QuoteLeaseReq) (*QuoteLeaseResp, error)
 	// 产品管理
 	CreateLeaseProduct(context.Context, *CreateLeaseProductReq) (*CreateLeaseProductResp, error)
 	UpdateLeaseProduct(context.Context, *UpdateLeaseProductReq) (*UpdateLeaseProductResp, error)
 	DeleteLeaseProduct(context.Context, *DeleteLeaseProductReq) (*DeleteLeaseProductResp, error)
+	ListLeaseProductVersions(context.Context, *ListLeaseProductVersionsReq) (*ListLeaseProductVersionsResp, error)
+	ScheduleLeaseProduct(context.Context, *ScheduleLeaseProductReq) (*ScheduleLeaseProductResp, error)
 	// 库存检查
 	CheckInventoryAvailability(context.Context, *CheckInventoryAvailabilityReq) (*CheckInventoryAvailabilityResp, error)
+	ReserveInventory(context.Context, *ReserveInventoryReq) (*ReserveInventoryResp, error)
+	ReleaseReservation(context.Context, *ReleaseReservationReq) (*ReleaseReservationResp, error)
+	// 设备台账
+	ListEquipmentUnits(context.Context, *ListEquipmentUnitsReq) (*ListEquipmentUnitsResp, error)
+	GetEquipmentUnit(context.Context, *GetEquipmentUnitReq) (*GetEquipmentUnitResp, error)
+	CreateEquipmentUnit(context.Context, *CreateEquipmentUnitReq) (*CreateEquipmentUnitResp, error)
+	UpdateEquipmentUnit(context.Context, *UpdateEquipmentUnitReq) (*UpdateEquipmentUnitResp, error)
+	DeleteEquipmentUnit(context.Context, *DeleteEquipmentUnitReq) (*DeleteEquipmentUnitResp, error)
 	mustEmbedUnimplementedLeaseProductServiceServer()
 }
 
@@ -139,6 +284,12 @@ func (UnimplementedLeaseProductServiceServer) GetLeaseProduct(context.Context, *
 func (UnimplementedLeaseProductServiceServer) ListLeaseProducts(context.Context, *ListLeaseProductsReq) (*ListLeaseProductsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLeaseProducts not implemented")
 }
+func (UnimplementedLeaseProductServiceServer) CheckEligibility(context.Context, *CheckEligibilityReq) (*CheckEligibilityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckEligibility not implemented")
+}
+func (UnimplementedLeaseProductServiceServer) QuoteLease(context.Context, *QuoteLeaseReq) (*QuoteLeaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteLease not implemented")
+}
 func (UnimplementedLeaseProductServiceServer) CreateLeaseProduct(context.Context, *CreateLeaseProductReq) (*CreateLeaseProductResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLeaseProduct not implemented")
 }
@@ -148,9 +299,36 @@ func (UnimplementedLeaseProductServiceServer) UpdateLeaseProduct(context.Context
 func (UnimplementedLeaseProductServiceServer) DeleteLeaseProduct(context.Context, *DeleteLeaseProductReq) (*DeleteLeaseProductResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLeaseProduct not implemented")
 }
+func (UnimplementedLeaseProductServiceServer) ListLeaseProductVersions(context.Context, *ListLeaseProductVersionsReq) (*ListLeaseProductVersionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeaseProductVersions not implemented")
+}
+func (UnimplementedLeaseProductServiceServer) ScheduleLeaseProduct(context.Context, *ScheduleLeaseProductReq) (*ScheduleLeaseProductResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleLeaseProduct not implemented")
+}
 func (UnimplementedLeaseProductServiceServer) CheckInventoryAvailability(context.Context, *CheckInventoryAvailabilityReq) (*CheckInventoryAvailabilityResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckInventoryAvailability not implemented")
 }
+func (UnimplementedLeaseProductServiceServer) ReserveInventory(context.Context, *ReserveInventoryReq) (*ReserveInventoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveInventory not implemented")
+}
+func (UnimplementedLeaseProductServiceServer) ReleaseReservation(context.Context, *ReleaseReservationReq) (*ReleaseReservationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseReservation not implemented")
+}
+func (UnimplementedLeaseProductServiceServer) ListEquipmentUnits(context.Context, *ListEquipmentUnitsReq) (*ListEquipmentUnitsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEquipmentUnits not implemented")
+}
+func (UnimplementedLeaseProductServiceServer) GetEquipmentUnit(context.Context, *GetEquipmentUnitReq) (*GetEquipmentUnitResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEquipmentUnit not implemented")
+}
+func (UnimplementedLeaseProductServiceServer) CreateEquipmentUnit(context.Context, *CreateEquipmentUnitReq) (*CreateEquipmentUnitResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEquipmentUnit not implemented")
+}
+func (UnimplementedLeaseProductServiceServer) UpdateEquipmentUnit(context.Context, *UpdateEquipmentUnitReq) (*UpdateEquipmentUnitResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEquipmentUnit not implemented")
+}
+func (UnimplementedLeaseProductServiceServer) DeleteEquipmentUnit(context.Context, *DeleteEquipmentUnitReq) (*DeleteEquipmentUnitResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEquipmentUnit not implemented")
+}
 func (UnimplementedLeaseProductServiceServer) mustEmbedUnimplementedLeaseProductServiceServer() {}
 func (UnimplementedLeaseProductServiceServer) testEmbeddedByValue()                             {}
 
@@ -208,6 +386,42 @@ func _LeaseProductService_ListLeaseProducts_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeaseProductService_CheckEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckEligibilityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseProductServiceServer).CheckEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaseProductService_CheckEligibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseProductServiceServer).CheckEligibility(ctx, req.(*CheckEligibilityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaseProductService_QuoteLease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteLeaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseProductServiceServer).QuoteLease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaseProductService_QuoteLease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseProductServiceServer).QuoteLease(ctx, req.(*QuoteLeaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LeaseProductService_CreateLeaseProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateLeaseProductReq)
 	if err := dec(in); err != nil {
@@ -262,6 +476,42 @@ func _LeaseProductService_DeleteLeaseProduct_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeaseProductService_ListLeaseProductVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeaseProductVersionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseProductServiceServer).ListLeaseProductVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaseProductService_ListLeaseProductVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseProductServiceServer).ListLeaseProductVersions(ctx, req.(*ListLeaseProductVersionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaseProductService_ScheduleLeaseProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleLeaseProductReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseProductServiceServer).ScheduleLeaseProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaseProductService_ScheduleLeaseProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseProductServiceServer).ScheduleLeaseProduct(ctx, req.(*ScheduleLeaseProductReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LeaseProductService_CheckInventoryAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckInventoryAvailabilityReq)
 	if err := dec(in); err != nil {
@@ -280,6 +530,132 @@ func _LeaseProductService_CheckInventoryAvailability_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LeaseProductService_ReserveInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveInventoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseProductServiceServer).ReserveInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaseProductService_ReserveInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseProductServiceServer).ReserveInventory(ctx, req.(*ReserveInventoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaseProductService_ReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseReservationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseProductServiceServer).ReleaseReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaseProductService_ReleaseReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseProductServiceServer).ReleaseReservation(ctx, req.(*ReleaseReservationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaseProductService_ListEquipmentUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEquipmentUnitsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseProductServiceServer).ListEquipmentUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaseProductService_ListEquipmentUnits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseProductServiceServer).ListEquipmentUnits(ctx, req.(*ListEquipmentUnitsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaseProductService_GetEquipmentUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEquipmentUnitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseProductServiceServer).GetEquipmentUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaseProductService_GetEquipmentUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseProductServiceServer).GetEquipmentUnit(ctx, req.(*GetEquipmentUnitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaseProductService_CreateEquipmentUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEquipmentUnitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseProductServiceServer).CreateEquipmentUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaseProductService_CreateEquipmentUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseProductServiceServer).CreateEquipmentUnit(ctx, req.(*CreateEquipmentUnitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaseProductService_UpdateEquipmentUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEquipmentUnitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseProductServiceServer).UpdateEquipmentUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaseProductService_UpdateEquipmentUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseProductServiceServer).UpdateEquipmentUnit(ctx, req.(*UpdateEquipmentUnitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LeaseProductService_DeleteEquipmentUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEquipmentUnitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LeaseProductServiceServer).DeleteEquipmentUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LeaseProductService_DeleteEquipmentUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LeaseProductServiceServer).DeleteEquipmentUnit(ctx, req.(*DeleteEquipmentUnitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LeaseProductService_ServiceDesc is the grpc.ServiceDesc for LeaseProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -295,6 +671,14 @@ var LeaseProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLeaseProducts",
 			Handler:    _LeaseProductService_ListLeaseProducts_Handler,
 		},
+		{
+			MethodName: "CheckEligibility",
+			Handler:    _LeaseProductService_CheckEligibility_Handler,
+		},
+		{
+			MethodName: "QuoteLease",
+			Handler:    _LeaseProductService_QuoteLease_Handler,
+		},
 		{
 			MethodName: "CreateLeaseProduct",
 			Handler:    _LeaseProductService_CreateLeaseProduct_Handler,
@@ -307,10 +691,46 @@ var LeaseProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteLeaseProduct",
 			Handler:    _LeaseProductService_DeleteLeaseProduct_Handler,
 		},
+		{
+			MethodName: "ListLeaseProductVersions",
+			Handler:    _LeaseProductService_ListLeaseProductVersions_Handler,
+		},
+		{
+			MethodName: "ScheduleLeaseProduct",
+			Handler:    _LeaseProductService_ScheduleLeaseProduct_Handler,
+		},
 		{
 			MethodName: "CheckInventoryAvailability",
 			Handler:    _LeaseProductService_CheckInventoryAvailability_Handler,
 		},
+		{
+			MethodName: "ReserveInventory",
+			Handler:    _LeaseProductService_ReserveInventory_Handler,
+		},
+		{
+			MethodName: "ReleaseReservation",
+			Handler:    _LeaseProductService_ReleaseReservation_Handler,
+		},
+		{
+			MethodName: "ListEquipmentUnits",
+			Handler:    _LeaseProductService_ListEquipmentUnits_Handler,
+		},
+		{
+			MethodName: "GetEquipmentUnit",
+			Handler:    _LeaseProductService_GetEquipmentUnit_Handler,
+		},
+		{
+			MethodName: "CreateEquipmentUnit",
+			Handler:    _LeaseProductService_CreateEquipmentUnit_Handler,
+		},
+		{
+			MethodName: "UpdateEquipmentUnit",
+			Handler:    _LeaseProductService_UpdateEquipmentUnit_Handler,
+		},
+		{
+			MethodName: "DeleteEquipmentUnit",
+			Handler:    _LeaseProductService_DeleteEquipmentUnit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "leaseproduct-rpc.proto",
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"rpc/internal/config"
+	"rpc/internal/job"
 	"rpc/internal/server"
 	"rpc/internal/svc"
 	"rpc/leaseproduct"
@@ -41,8 +42,13 @@ func main() {
 		logx.Errorf("consul register service %s", err)
 	}
 
-	defer s.Stop()
+	// rpc 服务与后台版本生效、排期上下架任务统一管理
+	group := service.NewServiceGroup()
+	defer group.Stop()
+	group.Add(s)
+	group.Add(job.NewVersionJob(ctx))
+	group.Add(job.NewScheduleJob(ctx))
 
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
-	s.Start()
+	group.Start()
 }
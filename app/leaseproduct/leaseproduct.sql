@@ -27,9 +27,16 @@ CREATE TABLE `lease_products` (
   `max_duration` int UNSIGNED DEFAULT 365 COMMENT '最大租期(天)',
   `min_duration` int UNSIGNED DEFAULT 1 COMMENT '最小租期(天)',
   `description` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '产品描述',
+  `approval_chain` varchar(1000) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '审批链配置(JSON),如[{"name":"初审","role":"operator"},{"name":"终审","role":"admin"}],为空表示单级审批',
+  `eligibility_rule` varchar(1000) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '准入规则配置(JSON),如{"occupations":["农","牧"],"min_age":18,"max_age":60,"min_income":3000},为空表示不限制',
+  `pricing_rule` varchar(2000) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '定价规则配置(JSON),如{"duration_tiers":[{"min_days":7,"discount":0.95}],"seasons":[{"name":"秋收旺季","start":"09-15","end":"10-15","multiplier":1.5}],"early_booking":[{"min_days_ahead":30,"discount":0.95}]},为空表示按日租金计价',
   `inventory_count` int UNSIGNED DEFAULT 0 COMMENT '库存数量',
   `available_count` int UNSIGNED DEFAULT 0 COMMENT '可用数量',
   `status` tinyint UNSIGNED DEFAULT 1 COMMENT '状态 1:上架 2:下架',
+  `version` int UNSIGNED NOT NULL DEFAULT 0 COMMENT '当前生效的条款版本号',
+  `launch_at` timestamp NULL DEFAULT NULL COMMENT '计划上架时间,到期后自动上架,为空表示不排期',
+  `delist_at` timestamp NULL DEFAULT NULL COMMENT '计划下架时间,到期后自动下架且不再出现在产品列表,为空表示长期有效',
+  `deleted_at` timestamp NULL DEFAULT NULL COMMENT '删除时间,不为空表示产品已归档,不再出现在查询中',
   `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
   PRIMARY KEY (`id`),
@@ -39,6 +46,71 @@ CREATE TABLE `lease_products` (
   KEY `idx_status` (`status`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='租赁产品表';
 
+-- ----------------------------
+-- 租赁产品条款版本表
+-- ----------------------------
+DROP TABLE IF EXISTS `lease_product_versions`;
+CREATE TABLE `lease_product_versions` (
+  `id` bigint UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '版本记录ID',
+  `product_id` bigint UNSIGNED NOT NULL COMMENT '产品ID',
+  `version` int UNSIGNED NOT NULL COMMENT '版本号',
+  `terms` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '版本生效后的产品条款(JSON)',
+  `changes` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '相对上一版本的变更字段(JSON),如[{"field":"daily_rate","from":300,"to":280}]',
+  `status` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'scheduled' COMMENT '状态 scheduled:待生效 active:生效中 superseded:已失效 cancelled:已取消',
+  `effective_from` timestamp NOT NULL COMMENT '生效时间',
+  `effective_to` timestamp NULL DEFAULT NULL COMMENT '失效时间,生效中或待生效的版本为空',
+  `operator_id` bigint UNSIGNED DEFAULT 0 COMMENT '操作人ID',
+  `operator_name` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '操作人姓名',
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_product_version` (`product_id`, `version`),
+  KEY `idx_status_effective_from` (`status`, `effective_from`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='租赁产品条款版本表';
+
+-- ----------------------------
+-- 租赁产品库存预占表
+-- ----------------------------
+DROP TABLE IF EXISTS `lease_product_reservations`;
+CREATE TABLE `lease_product_reservations` (
+  `id` bigint UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '预占记录ID',
+  `product_id` bigint UNSIGNED NOT NULL COMMENT '产品ID',
+  `application_id` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '租赁申请编号',
+  `quantity` int UNSIGNED NOT NULL DEFAULT 1 COMMENT '预占数量',
+  `unit_id` bigint UNSIGNED NOT NULL DEFAULT 0 COMMENT '分配的设备ID,0表示未分配(产品未登记设备台账)',
+  `start_date` date NOT NULL COMMENT '租期开始日期,含',
+  `end_date` date NOT NULL COMMENT '租期结束日期,含',
+  `status` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'reserved' COMMENT '状态 reserved:已预占 released:已释放',
+  `released_at` timestamp NULL DEFAULT NULL COMMENT '释放时间',
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '预占时间',
+  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_application_id` (`application_id`),
+  KEY `idx_product_status_dates` (`product_id`, `status`, `start_date`, `end_date`),
+  KEY `idx_unit_status_dates` (`unit_id`, `status`, `start_date`, `end_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='租赁产品库存预占表';
+
+-- ----------------------------
+-- 租赁设备台账表
+-- ----------------------------
+DROP TABLE IF EXISTS `lease_equipment_units`;
+CREATE TABLE `lease_equipment_units` (
+  `id` bigint UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '设备ID',
+  `product_id` bigint UNSIGNED NOT NULL COMMENT '产品ID',
+  `serial_number` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '设备序列号',
+  `purchase_date` date NULL DEFAULT NULL COMMENT '购置日期',
+  `hours_used` decimal(10,1) NOT NULL DEFAULT 0.0 COMMENT '累计使用小时数',
+  `location` varchar(200) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '当前存放位置',
+  `condition` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'good' COMMENT '设备状况 new:全新 good:良好 fair:一般 poor:较差',
+  `status` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'available' COMMENT '状态 available:可用 maintenance:维修保养 retired:已退役',
+  `remark` varchar(500) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '备注',
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_serial_number` (`serial_number`),
+  KEY `idx_product_status` (`product_id`, `status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='租赁设备台账表';
+
 -- ----------------------------
 -- 初始化数据
 -- ----------------------------
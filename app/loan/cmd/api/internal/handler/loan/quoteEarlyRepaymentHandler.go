@@ -0,0 +1,28 @@
+package loan
+
+import (
+	"net/http"
+
+	"api/internal/logic/loan"
+	"api/internal/svc"
+	"api/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func QuoteEarlyRepaymentHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.QuoteEarlyRepaymentReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := loan.NewQuoteEarlyRepaymentLogic(r.Context(), svcCtx)
+		resp, err := l.QuoteEarlyRepayment(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
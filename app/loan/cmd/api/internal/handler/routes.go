@@ -38,6 +38,26 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/applications/:id/approve",
 					Handler: admin.ApproveLoanApplicationHandler(serverCtx),
 				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/applications/:id/disburse",
+					Handler: admin.DisburseLoanHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/applications/:id/repayments",
+					Handler: admin.ListRepaymentsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/applications/:id/schedule",
+					Handler: admin.GetRepaymentScheduleHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/applications/:id/schedule",
+					Handler: admin.GenerateRepaymentScheduleHandler(serverCtx),
+				},
 			}...,
 		),
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
@@ -71,6 +91,31 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/applications/:id/cancel",
 				Handler: loan.CancelMyLoanApplicationHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/applications/:id/prepay",
+				Handler: loan.QuoteEarlyRepaymentHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/applications/:id/prepay",
+				Handler: loan.SettleEarlyHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/applications/:id/repayments",
+				Handler: loan.RecordMyRepaymentHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/applications/:id/repayments",
+				Handler: loan.ListMyRepaymentsHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/applications/:id/schedule",
+				Handler: loan.GetMyRepaymentScheduleHandler(serverCtx),
+			},
 		},
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
 		rest.WithPrefix("/api/v1/loan"),
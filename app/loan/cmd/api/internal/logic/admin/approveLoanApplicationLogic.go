@@ -42,17 +42,22 @@ func (l *ApproveLoanApplicationLogic) ApproveLoanApplication(req *types.ApproveL
 		auditorName = "系统管理员"
 	}
 
+	// 获取审核员角色,用于校验审批链环节权限
+	auditorRole := l.getUserRoleFromJWT()
+
 	// 调用 Loan RPC 审批申请 - 使用熔断器
-	_, err = breaker.DoWithBreakerResultAcceptable(l.ctx, "loan-rpc", func() (*loanclient.ApproveLoanApplicationResp, error) {
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "loan-rpc", func() (*loanclient.ApproveLoanApplicationResp, error) {
 		return l.svcCtx.LoanRpc.ApproveLoanApplication(l.ctx, &loanclient.ApproveLoanApplicationReq{
 			ApplicationId:    req.ApplicationId,
 			AuditorId:        auditorId,
 			AuditorName:      auditorName,
+			AuditorRole:      auditorRole,
 			Action:           req.Action,
 			Suggestions:      req.Suggestions,
 			ApprovedAmount:   req.ApprovedAmount,
 			ApprovedDuration: req.ApprovedDuration,
 			InterestRate:     req.InterestRate,
+			RepaymentMethod:  req.RepaymentMethod,
 		})
 	}, breaker.IsAcceptableError)
 	if err != nil {
@@ -61,7 +66,13 @@ func (l *ApproveLoanApplicationLogic) ApproveLoanApplication(req *types.ApproveL
 	}
 
 	// 转换 RPC 响应为 API 响应
-	return &types.ApproveLoanApplicationResp{}, nil
+	return &types.ApproveLoanApplicationResp{
+		Stage:      rpcResp.Stage,
+		StageName:  rpcResp.StageName,
+		TotalStage: rpcResp.TotalStage,
+		Finished:   rpcResp.Finished,
+		Status:     rpcResp.Status,
+	}, nil
 }
 
 // 从JWT中获取用户ID的辅助方法
@@ -140,3 +151,13 @@ func (l *ApproveLoanApplicationLogic) getUserNameFromJWT() string {
 	}
 	return ""
 }
+
+// 从JWT中获取用户角色的辅助方法 (admin/operator)
+func (l *ApproveLoanApplicationLogic) getUserRoleFromJWT() string {
+	if roleVal := l.ctx.Value("role"); roleVal != nil {
+		if role, ok := roleVal.(string); ok {
+			return role
+		}
+	}
+	return ""
+}
@@ -0,0 +1,159 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/loanclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DisburseLoanLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDisburseLoanLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DisburseLoanLogic {
+	return &DisburseLoanLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DisburseLoanLogic) DisburseLoan(req *types.DisburseLoanReq) (resp *types.DisburseLoanResp, err error) {
+	// 获取当前操作员ID (从JWT中获取)
+	operatorId, err := l.getUserIdFromJWT()
+	if err != nil {
+		logx.WithContext(l.ctx).Errorf("获取操作员ID失败: %v", err)
+		return nil, err
+	}
+
+	// 获取操作员姓名 (从JWT中获取或设置默认值)
+	operatorName := l.getUserNameFromJWT()
+	if operatorName == "" {
+		operatorName = "系统管理员"
+	}
+
+	// 调用 Loan RPC 放款 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "loan-rpc", func() (*loanclient.DisburseLoanResp, error) {
+		return l.svcCtx.LoanRpc.DisburseLoan(l.ctx, &loanclient.DisburseLoanReq{
+			ApplicationId: req.ApplicationId,
+			OperatorId:    operatorId,
+			OperatorName:  operatorName,
+			AccountName:   req.AccountName,
+			AccountNo:     req.AccountNo,
+			BankName:      req.BankName,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		logx.WithContext(l.ctx).Errorf("调用Loan RPC失败: %v", err)
+		return nil, err
+	}
+
+	// 转换放款记录
+	info := rpcResp.DisbursementInfo
+	return &types.DisburseLoanResp{
+		DisbursementInfo: types.LoanDisbursementInfo{
+			Id:             info.Id,
+			DisbursementNo: info.DisbursementNo,
+			ApplicationId:  info.ApplicationId,
+			Amount:         info.Amount,
+			AccountName:    info.AccountName,
+			AccountNo:      info.AccountNo,
+			BankName:       info.BankName,
+			Channel:        info.Channel,
+			BankSerialNo:   info.BankSerialNo,
+			Status:         info.Status,
+			FailReason:     info.FailReason,
+			OperatorId:     info.OperatorId,
+			OperatorName:   info.OperatorName,
+			DisbursedAt:    info.DisbursedAt,
+			CreatedAt:      info.CreatedAt,
+		},
+	}, nil
+}
+
+// 从JWT中获取用户ID的辅助方法
+func (l *DisburseLoanLogic) getUserIdFromJWT() (int64, error) {
+	// 方法1: go-zero标准方式 - 处理json.Number类型
+	if userIdVal := l.ctx.Value("user_id"); userIdVal != nil {
+		// go-zero将JWT中的数字转换为json.Number类型
+		if jsonUid, ok := userIdVal.(json.Number); ok {
+			if int64Uid, err := jsonUid.Int64(); err == nil {
+				return int64Uid, nil
+			} else {
+				logx.WithContext(l.ctx).Errorf("JWT user_id转换失败: %v", err)
+			}
+		}
+		// 备用：尝试其他类型
+		if userId, ok := userIdVal.(float64); ok {
+			return int64(userId), nil
+		}
+		if userId, ok := userIdVal.(int64); ok {
+			return userId, nil
+		}
+		if userIdStr, ok := userIdVal.(string); ok {
+			return strconv.ParseInt(userIdStr, 10, 64)
+		}
+	}
+
+	// 方法2: 尝试从context的其他可能字段获取
+	if userIdVal := l.ctx.Value("userId"); userIdVal != nil {
+		if jsonUid, ok := userIdVal.(json.Number); ok {
+			if int64Uid, err := jsonUid.Int64(); err == nil {
+				return int64Uid, nil
+			}
+		}
+		if userId, ok := userIdVal.(float64); ok {
+			return int64(userId), nil
+		}
+		if userId, ok := userIdVal.(int64); ok {
+			return userId, nil
+		}
+		if userIdStr, ok := userIdVal.(string); ok {
+			return strconv.ParseInt(userIdStr, 10, 64)
+		}
+	}
+
+	// 方法3: 尝试从JWT标准字段获取 (sub字段通常包含用户ID)
+	if subVal := l.ctx.Value("sub"); subVal != nil {
+		if jsonSub, ok := subVal.(json.Number); ok {
+			if int64Sub, err := jsonSub.Int64(); err == nil {
+				return int64Sub, nil
+			}
+		}
+		if subStr, ok := subVal.(string); ok {
+			return strconv.ParseInt(subStr, 10, 64)
+		}
+	}
+
+	return 0, fmt.Errorf("无法从JWT中获取用户ID")
+}
+
+// 从JWT中获取用户名的辅助方法
+func (l *DisburseLoanLogic) getUserNameFromJWT() string {
+	if nameVal := l.ctx.Value("username"); nameVal != nil {
+		if name, ok := nameVal.(string); ok {
+			return name
+		}
+	}
+	if nameVal := l.ctx.Value("name"); nameVal != nil {
+		if name, ok := nameVal.(string); ok {
+			return name
+		}
+	}
+	if phoneVal := l.ctx.Value("phone"); phoneVal != nil {
+		if phone, ok := phoneVal.(string); ok {
+			return phone // 如果没有用户名，使用手机号
+		}
+	}
+	return ""
+}
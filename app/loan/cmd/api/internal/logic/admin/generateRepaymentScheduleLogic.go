@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"context"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/loanclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GenerateRepaymentScheduleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGenerateRepaymentScheduleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GenerateRepaymentScheduleLogic {
+	return &GenerateRepaymentScheduleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GenerateRepaymentScheduleLogic) GenerateRepaymentSchedule(req *types.GenerateRepaymentScheduleReq) (resp *types.GenerateRepaymentScheduleResp, err error) {
+	// 调用 Loan RPC 生成还款计划 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "loan-rpc", func() (*loanclient.GenerateRepaymentScheduleResp, error) {
+		return l.svcCtx.LoanRpc.GenerateRepaymentSchedule(l.ctx, &loanclient.GenerateRepaymentScheduleReq{
+			ApplicationId:   req.ApplicationId,
+			RepaymentMethod: req.RepaymentMethod,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		logx.WithContext(l.ctx).Errorf("调用Loan RPC失败: %v", err)
+		return nil, err
+	}
+
+	// 转换还款计划
+	list := make([]types.RepaymentPlanInfo, 0, len(rpcResp.List))
+	for _, plan := range rpcResp.List {
+		list = append(list, types.RepaymentPlanInfo{
+			Id:                 plan.Id,
+			ApplicationId:      plan.ApplicationId,
+			InstallmentNo:      plan.InstallmentNo,
+			DueDate:            plan.DueDate,
+			Principal:          plan.Principal,
+			Interest:           plan.Interest,
+			TotalAmount:        plan.TotalAmount,
+			RemainingPrincipal: plan.RemainingPrincipal,
+			RepaymentMethod:    plan.RepaymentMethod,
+			Status:             plan.Status,
+			CreatedAt:          plan.CreatedAt,
+			UpdatedAt:          plan.UpdatedAt,
+			PaidPrincipal:      plan.PaidPrincipal,
+			PaidInterest:       plan.PaidInterest,
+			Penalty:            plan.Penalty,
+			PaidPenalty:        plan.PaidPenalty,
+			OverdueDays:        plan.OverdueDays,
+			PaidAt:             plan.PaidAt,
+			ServiceFee:         plan.ServiceFee,
+			PaidServiceFee:     plan.PaidServiceFee,
+		})
+	}
+
+	return &types.GenerateRepaymentScheduleResp{
+		List: list,
+	}, nil
+}
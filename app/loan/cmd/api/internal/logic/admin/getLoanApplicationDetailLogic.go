@@ -40,19 +40,82 @@ func (l *GetLoanApplicationDetailLogic) GetLoanApplicationDetail(applicationId s
 	// 转换申请信息
 	return &types.GetLoanApplicationResp{
 		ApplicationInfo: types.LoanApplicationInfo{
-			Id:            rpcResp.ApplicationInfo.Id,
-			ApplicationId: rpcResp.ApplicationInfo.ApplicationId,
-			UserId:        rpcResp.ApplicationInfo.UserId,
-			ApplicantName: rpcResp.ApplicationInfo.ApplicantName,
-			ProductId:     rpcResp.ApplicationInfo.ProductId,
-			Name:          rpcResp.ApplicationInfo.Name,
-			Type:          rpcResp.ApplicationInfo.Type,
-			Amount:        rpcResp.ApplicationInfo.Amount,
-			Duration:      rpcResp.ApplicationInfo.Duration,
-			Purpose:       rpcResp.ApplicationInfo.Purpose,
-			Status:        rpcResp.ApplicationInfo.Status,
-			CreatedAt:     rpcResp.ApplicationInfo.CreatedAt,
-			UpdatedAt:     rpcResp.ApplicationInfo.UpdatedAt,
+			Id:                 rpcResp.ApplicationInfo.Id,
+			ApplicationId:      rpcResp.ApplicationInfo.ApplicationId,
+			UserId:             rpcResp.ApplicationInfo.UserId,
+			ApplicantName:      rpcResp.ApplicationInfo.ApplicantName,
+			ProductId:          rpcResp.ApplicationInfo.ProductId,
+			Name:               rpcResp.ApplicationInfo.Name,
+			Type:               rpcResp.ApplicationInfo.Type,
+			Amount:             rpcResp.ApplicationInfo.Amount,
+			Duration:           rpcResp.ApplicationInfo.Duration,
+			Purpose:            rpcResp.ApplicationInfo.Purpose,
+			Status:             rpcResp.ApplicationInfo.Status,
+			CreatedAt:          rpcResp.ApplicationInfo.CreatedAt,
+			UpdatedAt:          rpcResp.ApplicationInfo.UpdatedAt,
+			OriginationFeeRate: rpcResp.ApplicationInfo.OriginationFeeRate,
+			ServiceFeeRate:     rpcResp.ApplicationInfo.ServiceFeeRate,
+			GuaranteeFeeRate:   rpcResp.ApplicationInfo.GuaranteeFeeRate,
+			LateFee:            rpcResp.ApplicationInfo.LateFee,
+			Apr:                rpcResp.ApplicationInfo.Apr,
 		},
+		CreditScore:     convertCreditScore(rpcResp.CreditScore),
+		ProductSnapshot: convertProductSnapshot(rpcResp.ProductSnapshot),
 	}, nil
 }
+
+// convertCreditScore 转换信用评分,未评分时返回空
+func convertCreditScore(score *loanclient.CreditScoreInfo) *types.CreditScoreInfo {
+	if score == nil {
+		return nil
+	}
+
+	items := make([]types.CreditScoreItem, 0, len(score.Items))
+	for _, item := range score.Items {
+		items = append(items, types.CreditScoreItem{
+			Rule:   item.Rule,
+			Factor: item.Factor,
+			Value:  item.Value,
+			Points: item.Points,
+			Reason: item.Reason,
+		})
+	}
+
+	return &types.CreditScoreInfo{
+		Score:          score.Score,
+		Grade:          score.Grade,
+		Recommendation: score.Recommendation,
+		Items:          items,
+		RuleVersion:    score.RuleVersion,
+		ScoredAt:       score.ScoredAt,
+	}
+}
+
+// convertProductSnapshot 转换申请时的产品条款快照,无快照时返回空
+func convertProductSnapshot(snapshot *loanclient.LoanProductSnapshot) *types.LoanProductSnapshot {
+	if snapshot == nil {
+		return nil
+	}
+
+	return &types.LoanProductSnapshot{
+		ProductCode:        snapshot.ProductCode,
+		Name:               snapshot.Name,
+		Type:               snapshot.Type,
+		InterestRate:       snapshot.InterestRate,
+		MinAmount:          snapshot.MinAmount,
+		MaxAmount:          snapshot.MaxAmount,
+		MinDuration:        snapshot.MinDuration,
+		MaxDuration:        snapshot.MaxDuration,
+		RepaymentProfile:   snapshot.RepaymentProfile,
+		GraceMonths:        snapshot.GraceMonths,
+		HarvestMonths:      snapshot.HarvestMonths,
+		PenaltyRate:        snapshot.PenaltyRate,
+		PrepaymentFeeRate:  snapshot.PrepaymentFeeRate,
+		OriginationFeeRate: snapshot.OriginationFeeRate,
+		ServiceFeeRate:     snapshot.ServiceFeeRate,
+		GuaranteeFeeRate:   snapshot.GuaranteeFeeRate,
+		LateFee:            snapshot.LateFee,
+		ProductUpdatedAt:   snapshot.ProductUpdatedAt,
+		SnapshotAt:         snapshot.SnapshotAt,
+	}
+}
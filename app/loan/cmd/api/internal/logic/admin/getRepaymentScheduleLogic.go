@@ -0,0 +1,84 @@
+package admin
+
+import (
+	"context"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/loanclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRepaymentScheduleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetRepaymentScheduleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRepaymentScheduleLogic {
+	return &GetRepaymentScheduleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetRepaymentScheduleLogic) GetRepaymentSchedule(req *types.GetRepaymentScheduleReq) (resp *types.GetRepaymentScheduleResp, err error) {
+	// 调用 Loan RPC 获取还款计划 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "loan-rpc", func() (*loanclient.GetRepaymentScheduleResp, error) {
+		return l.svcCtx.LoanRpc.GetRepaymentSchedule(l.ctx, &loanclient.GetRepaymentScheduleReq{
+			ApplicationId: req.ApplicationId,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		logx.WithContext(l.ctx).Errorf("调用Loan RPC失败: %v", err)
+		return nil, err
+	}
+
+	// 转换还款计划
+	var list []types.RepaymentPlanInfo
+	for _, plan := range rpcResp.List {
+		list = append(list, types.RepaymentPlanInfo{
+			Id:                 plan.Id,
+			ApplicationId:      plan.ApplicationId,
+			InstallmentNo:      plan.InstallmentNo,
+			DueDate:            plan.DueDate,
+			Principal:          plan.Principal,
+			Interest:           plan.Interest,
+			TotalAmount:        plan.TotalAmount,
+			RemainingPrincipal: plan.RemainingPrincipal,
+			RepaymentMethod:    plan.RepaymentMethod,
+			Status:             plan.Status,
+			CreatedAt:          plan.CreatedAt,
+			UpdatedAt:          plan.UpdatedAt,
+			PaidPrincipal:      plan.PaidPrincipal,
+			PaidInterest:       plan.PaidInterest,
+			Penalty:            plan.Penalty,
+			PaidPenalty:        plan.PaidPenalty,
+			OverdueDays:        plan.OverdueDays,
+			PaidAt:             plan.PaidAt,
+			ServiceFee:         plan.ServiceFee,
+			PaidServiceFee:     plan.PaidServiceFee,
+		})
+	}
+
+	// 如果没有数据，返回空列表
+	if list == nil {
+		list = make([]types.RepaymentPlanInfo, 0)
+	}
+
+	return &types.GetRepaymentScheduleResp{
+		ApplicationId:     rpcResp.ApplicationId,
+		RepaymentMethod:   rpcResp.RepaymentMethod,
+		TotalPrincipal:    rpcResp.TotalPrincipal,
+		TotalInterest:     rpcResp.TotalInterest,
+		TotalAmount:       rpcResp.TotalAmount,
+		TotalPenalty:      rpcResp.TotalPenalty,
+		PaidAmount:        rpcResp.PaidAmount,
+		OutstandingAmount: rpcResp.OutstandingAmount,
+		TotalServiceFee:   rpcResp.TotalServiceFee,
+		List:              list,
+	}, nil
+}
@@ -44,19 +44,24 @@ func (l *ListAllLoanApplicationsLogic) ListAllLoanApplications(req *types.ListLo
 	var applications []types.LoanApplicationInfo
 	for _, item := range rpcResp.List {
 		applications = append(applications, types.LoanApplicationInfo{
-			Id:            item.Id,
-			ApplicationId: item.ApplicationId,
-			UserId:        item.UserId,
-			ApplicantName: item.ApplicantName,
-			ProductId:     item.ProductId,
-			Name:          item.Name,
-			Type:          item.Type,
-			Amount:        item.Amount,
-			Duration:      item.Duration,
-			Purpose:       item.Purpose,
-			Status:        item.Status,
-			CreatedAt:     item.CreatedAt,
-			UpdatedAt:     item.UpdatedAt,
+			Id:                 item.Id,
+			ApplicationId:      item.ApplicationId,
+			UserId:             item.UserId,
+			ApplicantName:      item.ApplicantName,
+			ProductId:          item.ProductId,
+			Name:               item.Name,
+			Type:               item.Type,
+			Amount:             item.Amount,
+			Duration:           item.Duration,
+			Purpose:            item.Purpose,
+			Status:             item.Status,
+			CreatedAt:          item.CreatedAt,
+			UpdatedAt:          item.UpdatedAt,
+			OriginationFeeRate: item.OriginationFeeRate,
+			ServiceFeeRate:     item.ServiceFeeRate,
+			GuaranteeFeeRate:   item.GuaranteeFeeRate,
+			LateFee:            item.LateFee,
+			Apr:                item.Apr,
 		})
 	}
 
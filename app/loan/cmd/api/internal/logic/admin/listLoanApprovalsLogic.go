@@ -51,6 +51,9 @@ func (l *ListLoanApprovalsLogic) ListLoanApprovals(req *types.ListLoanApprovalsR
 			ApprovedDuration: item.ApprovedDuration,
 			InterestRate:     item.InterestRate,
 			CreatedAt:        item.CreatedAt,
+			Stage:            item.Stage,
+			StageName:        item.StageName,
+			AuditorRole:      item.AuditorRole,
 		})
 	}
 
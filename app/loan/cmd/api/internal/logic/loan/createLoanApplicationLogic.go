@@ -39,13 +39,14 @@ func (l *CreateLoanApplicationLogic) CreateLoanApplication(req *types.CreateLoan
 	// 使用熔断器调用 Loan RPC 创建申请
 	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "loan-rpc", func() (*loanclient.CreateLoanApplicationResp, error) {
 		return l.svcCtx.LoanRpc.CreateLoanApplication(l.ctx, &loanclient.CreateLoanApplicationReq{
-			UserId:    userId,
-			ProductId: req.ProductId,
-			Name:      req.Name,
-			Type:      req.Type,
-			Amount:    req.Amount,
-			Duration:  req.Duration,
-			Purpose:   req.Purpose,
+			UserId:         userId,
+			ProductId:      req.ProductId,
+			Name:           req.Name,
+			Type:           req.Type,
+			Amount:         req.Amount,
+			Duration:       req.Duration,
+			Purpose:        req.Purpose,
+			IdempotencyKey: req.IdempotencyKey,
 		})
 	}, breaker.IsAcceptableError)
 
@@ -55,8 +56,20 @@ func (l *CreateLoanApplicationLogic) CreateLoanApplication(req *types.CreateLoan
 	}
 
 	// 转换 RPC 响应为 API 响应
+	rejectReasons := make([]types.EligibilityReason, 0, len(rpcResp.RejectReasons))
+	for _, reason := range rpcResp.RejectReasons {
+		rejectReasons = append(rejectReasons, types.EligibilityReason{
+			Code:    reason.Code,
+			Message: reason.Message,
+			Limit:   reason.Limit,
+			Actual:  reason.Actual,
+		})
+	}
+
 	return &types.CreateLoanApplicationResp{
 		ApplicationId: rpcResp.ApplicationId,
+		Rejected:      rpcResp.Rejected,
+		RejectReasons: rejectReasons,
 	}, nil
 }
 
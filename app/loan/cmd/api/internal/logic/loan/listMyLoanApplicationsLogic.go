@@ -54,19 +54,24 @@ func (l *ListMyLoanApplicationsLogic) ListMyLoanApplications(req *types.ListLoan
 	applications := make([]types.LoanApplicationInfo, 0, len(rpcResp.List))
 	for _, app := range rpcResp.List {
 		applications = append(applications, types.LoanApplicationInfo{
-			Id:            app.Id,
-			ApplicationId: app.ApplicationId,
-			UserId:        app.UserId,
-			ApplicantName: app.ApplicantName,
-			ProductId:     app.ProductId,
-			Name:          app.Name,
-			Type:          app.Type,
-			Amount:        app.Amount,
-			Duration:      app.Duration,
-			Purpose:       app.Purpose,
-			Status:        app.Status,
-			CreatedAt:     app.CreatedAt,
-			UpdatedAt:     app.UpdatedAt,
+			Id:                 app.Id,
+			ApplicationId:      app.ApplicationId,
+			UserId:             app.UserId,
+			ApplicantName:      app.ApplicantName,
+			ProductId:          app.ProductId,
+			Name:               app.Name,
+			Type:               app.Type,
+			Amount:             app.Amount,
+			Duration:           app.Duration,
+			Purpose:            app.Purpose,
+			Status:             app.Status,
+			CreatedAt:          app.CreatedAt,
+			UpdatedAt:          app.UpdatedAt,
+			OriginationFeeRate: app.OriginationFeeRate,
+			ServiceFeeRate:     app.ServiceFeeRate,
+			GuaranteeFeeRate:   app.GuaranteeFeeRate,
+			LateFee:            app.LateFee,
+			Apr:                app.Apr,
 		})
 	}
 
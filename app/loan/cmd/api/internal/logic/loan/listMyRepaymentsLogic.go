@@ -0,0 +1,69 @@
+package loan
+
+import (
+	"context"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/loanclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListMyRepaymentsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListMyRepaymentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListMyRepaymentsLogic {
+	return &ListMyRepaymentsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListMyRepaymentsLogic) ListMyRepayments(req *types.ListRepaymentsReq) (resp *types.ListRepaymentsResp, err error) {
+	// 调用 Loan RPC 获取还款记录 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "loan-rpc", func() (*loanclient.ListRepaymentsResp, error) {
+		return l.svcCtx.LoanRpc.ListRepayments(l.ctx, &loanclient.ListRepaymentsReq{
+			ApplicationId: req.ApplicationId,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		logx.WithContext(l.ctx).Errorf("调用Loan RPC失败: %v", err)
+		return nil, err
+	}
+
+	// 转换还款记录
+	var list []types.LoanRepaymentInfo
+	for _, item := range rpcResp.List {
+		list = append(list, types.LoanRepaymentInfo{
+			Id:              item.Id,
+			RepaymentNo:     item.RepaymentNo,
+			ApplicationId:   item.ApplicationId,
+			UserId:          item.UserId,
+			Amount:          item.Amount,
+			PrincipalAmount: item.PrincipalAmount,
+			InterestAmount:  item.InterestAmount,
+			PenaltyAmount:   item.PenaltyAmount,
+			InstallmentNos:  item.InstallmentNos,
+			Channel:         item.Channel,
+			Remark:          item.Remark,
+			CreatedAt:       item.CreatedAt,
+			FeeAmount:       item.FeeAmount,
+			RepaymentType:   item.RepaymentType,
+		})
+	}
+
+	// 如果没有数据，返回空列表
+	if list == nil {
+		list = make([]types.LoanRepaymentInfo, 0)
+	}
+
+	return &types.ListRepaymentsResp{
+		List: list,
+	}, nil
+}
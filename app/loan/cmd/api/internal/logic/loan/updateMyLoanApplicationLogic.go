@@ -43,19 +43,24 @@ func (l *UpdateMyLoanApplicationLogic) UpdateMyLoanApplication(req *types.Update
 	// 转换申请信息
 	return &types.UpdateLoanApplicationResp{
 		ApplicationInfo: types.LoanApplicationInfo{
-			Id:            rpcResp.ApplicationInfo.Id,
-			ApplicationId: rpcResp.ApplicationInfo.ApplicationId,
-			UserId:        rpcResp.ApplicationInfo.UserId,
-			ApplicantName: rpcResp.ApplicationInfo.ApplicantName,
-			ProductId:     rpcResp.ApplicationInfo.ProductId,
-			Name:          rpcResp.ApplicationInfo.Name,
-			Type:          rpcResp.ApplicationInfo.Type,
-			Amount:        rpcResp.ApplicationInfo.Amount,
-			Duration:      rpcResp.ApplicationInfo.Duration,
-			Purpose:       rpcResp.ApplicationInfo.Purpose,
-			Status:        rpcResp.ApplicationInfo.Status,
-			CreatedAt:     rpcResp.ApplicationInfo.CreatedAt,
-			UpdatedAt:     rpcResp.ApplicationInfo.UpdatedAt,
+			Id:                 rpcResp.ApplicationInfo.Id,
+			ApplicationId:      rpcResp.ApplicationInfo.ApplicationId,
+			UserId:             rpcResp.ApplicationInfo.UserId,
+			ApplicantName:      rpcResp.ApplicationInfo.ApplicantName,
+			ProductId:          rpcResp.ApplicationInfo.ProductId,
+			Name:               rpcResp.ApplicationInfo.Name,
+			Type:               rpcResp.ApplicationInfo.Type,
+			Amount:             rpcResp.ApplicationInfo.Amount,
+			Duration:           rpcResp.ApplicationInfo.Duration,
+			Purpose:            rpcResp.ApplicationInfo.Purpose,
+			Status:             rpcResp.ApplicationInfo.Status,
+			CreatedAt:          rpcResp.ApplicationInfo.CreatedAt,
+			UpdatedAt:          rpcResp.ApplicationInfo.UpdatedAt,
+			OriginationFeeRate: rpcResp.ApplicationInfo.OriginationFeeRate,
+			ServiceFeeRate:     rpcResp.ApplicationInfo.ServiceFeeRate,
+			GuaranteeFeeRate:   rpcResp.ApplicationInfo.GuaranteeFeeRate,
+			LateFee:            rpcResp.ApplicationInfo.LateFee,
+			Apr:                rpcResp.ApplicationInfo.Apr,
 		},
 	}, nil
 }
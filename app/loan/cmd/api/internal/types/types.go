@@ -10,9 +10,15 @@ type ApproveLoanApplicationReq struct {
 	ApprovedAmount   float64 `json:"approved_amount"`
 	ApprovedDuration int32   `json:"approved_duration"`
 	InterestRate     float64 `json:"interest_rate"`
+	RepaymentMethod  string  `json:"repayment_method,optional"` // equal_installment/equal_principal/interest_only
 }
 
 type ApproveLoanApplicationResp struct {
+	Stage      int32  `json:"stage"`       // 本次处理的审批环节序号
+	StageName  string `json:"stage_name"`  // 本次处理的审批环节名称
+	TotalStage int32  `json:"total_stage"` // 该申请需要经过的审批环节总数
+	Finished   bool   `json:"finished"`    // 审批链是否已结束
+	Status     string `json:"status"`      // 审批后申请状态
 }
 
 type CancelLoanApplicationReq struct {
@@ -24,16 +30,63 @@ type CancelLoanApplicationResp struct {
 }
 
 type CreateLoanApplicationReq struct {
-	ProductId int64   `json:"product_id"`
-	Name      string  `json:"name"`
-	Type      string  `json:"type"`
-	Amount    float64 `json:"amount"`
-	Duration  int32   `json:"duration"`
-	Purpose   string  `json:"purpose"`
+	ProductId      int64   `json:"product_id"`
+	Name           string  `json:"name"`
+	Type           string  `json:"type"`
+	Amount         float64 `json:"amount"`
+	Duration       int32   `json:"duration"`
+	Purpose        string  `json:"purpose"`
+	IdempotencyKey string  `header:"Idempotency-Key,optional"`
 }
 
 type CreateLoanApplicationResp struct {
-	ApplicationId string `json:"application_id"`
+	ApplicationId string              `json:"application_id"` // 未通过准入校验时为空
+	Rejected      bool                `json:"rejected"`
+	RejectReasons []EligibilityReason `json:"reject_reasons,omitempty"`
+}
+
+type CreditScoreInfo struct {
+	Score          int32             `json:"score"`
+	Grade          string            `json:"grade"`
+	Recommendation string            `json:"recommendation"` // approve/review/reject
+	Items          []CreditScoreItem `json:"items"`
+	RuleVersion    string            `json:"rule_version"`
+	ScoredAt       int64             `json:"scored_at"`
+}
+
+type CreditScoreItem struct {
+	Rule   string  `json:"rule"`   // 规则名称
+	Factor string  `json:"factor"` // 评分因子
+	Value  string  `json:"value"`  // 因子取值
+	Points float64 `json:"points"` // 得分
+	Reason string  `json:"reason"` // 评分说明
+}
+
+type DisburseLoanReq struct {
+	ApplicationId string `path:"id"`
+	AccountName   string `json:"account_name"`
+	AccountNo     string `json:"account_no"`
+	BankName      string `json:"bank_name"`
+}
+
+type DisburseLoanResp struct {
+	DisbursementInfo LoanDisbursementInfo `json:"disbursement_info"`
+}
+
+type EligibilityReason struct {
+	Code    string  `json:"code"` // income_missing/debt_to_income/active_per_user/active_per_product,产品准入规则: occupation/age/income/profile_gap
+	Message string  `json:"message"`
+	Limit   float64 `json:"limit"`
+	Actual  float64 `json:"actual"`
+}
+
+type GenerateRepaymentScheduleReq struct {
+	ApplicationId   string `path:"id"`
+	RepaymentMethod string `json:"repayment_method,optional"` // equal_installment/equal_principal/interest_only
+}
+
+type GenerateRepaymentScheduleResp struct {
+	List []RepaymentPlanInfo `json:"list"`
 }
 
 type GetLoanApplicationReq struct {
@@ -41,7 +94,26 @@ type GetLoanApplicationReq struct {
 }
 
 type GetLoanApplicationResp struct {
-	ApplicationInfo LoanApplicationInfo `json:"application_info"`
+	ApplicationInfo LoanApplicationInfo  `json:"application_info"`
+	CreditScore     *CreditScoreInfo     `json:"credit_score,omitempty"`
+	ProductSnapshot *LoanProductSnapshot `json:"product_snapshot,omitempty"`
+}
+
+type GetRepaymentScheduleReq struct {
+	ApplicationId string `path:"id"`
+}
+
+type GetRepaymentScheduleResp struct {
+	ApplicationId     string              `json:"application_id"`
+	RepaymentMethod   string              `json:"repayment_method"`
+	TotalPrincipal    float64             `json:"total_principal"`
+	TotalInterest     float64             `json:"total_interest"`
+	TotalAmount       float64             `json:"total_amount"`
+	TotalPenalty      float64             `json:"total_penalty"`
+	PaidAmount        float64             `json:"paid_amount"`
+	OutstandingAmount float64             `json:"outstanding_amount"`
+	TotalServiceFee   float64             `json:"total_service_fee"`
+	List              []RepaymentPlanInfo `json:"list"`
 }
 
 type ListLoanApplicationsReq struct {
@@ -64,20 +136,33 @@ type ListLoanApprovalsResp struct {
 	List []LoanApprovalInfo `json:"list"`
 }
 
+type ListRepaymentsReq struct {
+	ApplicationId string `path:"id"`
+}
+
+type ListRepaymentsResp struct {
+	List []LoanRepaymentInfo `json:"list"`
+}
+
 type LoanApplicationInfo struct {
-	Id            int64   `json:"id"`
-	ApplicationId string  `json:"application_id"`
-	UserId        int64   `json:"user_id"`
-	ApplicantName string  `json:"applicant_name"`
-	ProductId     int64   `json:"product_id"`
-	Name          string  `json:"name"`
-	Type          string  `json:"type"`
-	Amount        float64 `json:"amount"`
-	Duration      int32   `json:"duration"`
-	Purpose       string  `json:"purpose"`
-	Status        string  `json:"status"`
-	CreatedAt     int64   `json:"created_at"`
-	UpdatedAt     int64   `json:"updated_at"`
+	Id                 int64   `json:"id"`
+	ApplicationId      string  `json:"application_id"`
+	UserId             int64   `json:"user_id"`
+	ApplicantName      string  `json:"applicant_name"`
+	ProductId          int64   `json:"product_id"`
+	Name               string  `json:"name"`
+	Type               string  `json:"type"`
+	Amount             float64 `json:"amount"`
+	Duration           int32   `json:"duration"`
+	Purpose            string  `json:"purpose"`
+	Status             string  `json:"status"` // pending/approved/rejected/cancelled/disbursed/settled
+	CreatedAt          int64   `json:"created_at"`
+	UpdatedAt          int64   `json:"updated_at"`
+	OriginationFeeRate float64 `json:"origination_fee_rate"` // 手续费率(%)
+	ServiceFeeRate     float64 `json:"service_fee_rate"`     // 服务费月费率(%)
+	GuaranteeFeeRate   float64 `json:"guarantee_fee_rate"`   // 担保费率(%)
+	LateFee            float64 `json:"late_fee"`             // 逾期滞纳金(元/期)
+	Apr                float64 `json:"apr"`                  // 综合年化利率(IRR,%)
 }
 
 type LoanApprovalInfo struct {
@@ -91,6 +176,130 @@ type LoanApprovalInfo struct {
 	ApprovedDuration int32   `json:"approved_duration"`
 	InterestRate     float64 `json:"interest_rate"`
 	CreatedAt        int64   `json:"created_at"`
+	Stage            int32   `json:"stage"`
+	StageName        string  `json:"stage_name"`
+	AuditorRole      string  `json:"auditor_role"` // admin/operator
+}
+
+type LoanDisbursementInfo struct {
+	Id             int64   `json:"id"`
+	DisbursementNo string  `json:"disbursement_no"`
+	ApplicationId  int64   `json:"application_id"`
+	Amount         float64 `json:"amount"`
+	AccountName    string  `json:"account_name"`
+	AccountNo      string  `json:"account_no"`
+	BankName       string  `json:"bank_name"`
+	Channel        string  `json:"channel"`
+	BankSerialNo   string  `json:"bank_serial_no"`
+	Status         string  `json:"status"` // pending/unknown/success/failed
+	FailReason     string  `json:"fail_reason"`
+	OperatorId     int64   `json:"operator_id"`
+	OperatorName   string  `json:"operator_name"`
+	DisbursedAt    int64   `json:"disbursed_at"`
+	CreatedAt      int64   `json:"created_at"`
+}
+
+type LoanProductSnapshot struct {
+	ProductCode        string  `json:"product_code"`
+	Name               string  `json:"name"`
+	Type               string  `json:"type"`
+	InterestRate       float64 `json:"interest_rate"` // 年利率(%)
+	MinAmount          float64 `json:"min_amount"`
+	MaxAmount          float64 `json:"max_amount"`
+	MinDuration        int32   `json:"min_duration"`      // 最小期限(月)
+	MaxDuration        int32   `json:"max_duration"`      // 最大期限(月)
+	RepaymentProfile   string  `json:"repayment_profile"` // standard/seasonal
+	GraceMonths        int32   `json:"grace_months"`
+	HarvestMonths      string  `json:"harvest_months"`
+	PenaltyRate        float64 `json:"penalty_rate"`         // 罚息日利率(%)
+	PrepaymentFeeRate  float64 `json:"prepayment_fee_rate"`  // 提前还款手续费率(%)
+	OriginationFeeRate float64 `json:"origination_fee_rate"` // 手续费率(%)
+	ServiceFeeRate     float64 `json:"service_fee_rate"`     // 服务费月费率(%)
+	GuaranteeFeeRate   float64 `json:"guarantee_fee_rate"`   // 担保费率(%)
+	LateFee            float64 `json:"late_fee"`             // 逾期滞纳金(元/期)
+	ProductUpdatedAt   int64   `json:"product_updated_at"`
+	SnapshotAt         int64   `json:"snapshot_at"`
+}
+
+type LoanRepaymentInfo struct {
+	Id              int64   `json:"id"`
+	RepaymentNo     string  `json:"repayment_no"`
+	ApplicationId   int64   `json:"application_id"`
+	UserId          int64   `json:"user_id"`
+	Amount          float64 `json:"amount"`
+	PrincipalAmount float64 `json:"principal_amount"`
+	InterestAmount  float64 `json:"interest_amount"`
+	PenaltyAmount   float64 `json:"penalty_amount"`
+	InstallmentNos  string  `json:"installment_nos"`
+	Channel         string  `json:"channel"` // online/bank_transfer/cash
+	Remark          string  `json:"remark"`
+	CreatedAt       int64   `json:"created_at"`
+	FeeAmount       float64 `json:"fee_amount"`
+	RepaymentType   string  `json:"repayment_type"` // regular/prepay
+}
+
+type QuoteEarlyRepaymentReq struct {
+	ApplicationId string `path:"id"`
+}
+
+type QuoteEarlyRepaymentResp struct {
+	ApplicationId        string  `json:"application_id"`
+	QuoteDate            string  `json:"quote_date"`
+	OutstandingPrincipal float64 `json:"outstanding_principal"`
+	AccruedInterest      float64 `json:"accrued_interest"`
+	Penalty              float64 `json:"penalty"`
+	PrepaymentFeeRate    float64 `json:"prepayment_fee_rate"`
+	PrepaymentFee        float64 `json:"prepayment_fee"`
+	TotalAmount          float64 `json:"total_amount"`
+	WaivedInterest       float64 `json:"waived_interest"`
+	ServiceFee           float64 `json:"service_fee"`
+}
+
+type RecordRepaymentReq struct {
+	ApplicationId string  `path:"id"`
+	Amount        float64 `json:"amount"`
+	Channel       string  `json:"channel,optional"` // online/bank_transfer/cash
+	Remark        string  `json:"remark,optional"`
+}
+
+type RecordRepaymentResp struct {
+	RepaymentInfo     LoanRepaymentInfo `json:"repayment_info"`
+	ApplicationStatus string            `json:"application_status"`
+}
+
+type RepaymentPlanInfo struct {
+	Id                 int64   `json:"id"`
+	ApplicationId      int64   `json:"application_id"`
+	InstallmentNo      int32   `json:"installment_no"`
+	DueDate            string  `json:"due_date"`
+	Principal          float64 `json:"principal"`
+	Interest           float64 `json:"interest"`
+	TotalAmount        float64 `json:"total_amount"`
+	RemainingPrincipal float64 `json:"remaining_principal"`
+	RepaymentMethod    string  `json:"repayment_method"`
+	Status             string  `json:"status"`
+	CreatedAt          int64   `json:"created_at"`
+	UpdatedAt          int64   `json:"updated_at"`
+	PaidPrincipal      float64 `json:"paid_principal"`
+	PaidInterest       float64 `json:"paid_interest"`
+	Penalty            float64 `json:"penalty"`
+	PaidPenalty        float64 `json:"paid_penalty"`
+	OverdueDays        int32   `json:"overdue_days"`
+	PaidAt             int64   `json:"paid_at"`
+	ServiceFee         float64 `json:"service_fee"`
+	PaidServiceFee     float64 `json:"paid_service_fee"`
+}
+
+type SettleEarlyReq struct {
+	ApplicationId string  `path:"id"`
+	Amount        float64 `json:"amount"`           // 须与当日试算总额一致
+	Channel       string  `json:"channel,optional"` // online/bank_transfer/cash
+	Remark        string  `json:"remark,optional"`
+}
+
+type SettleEarlyResp struct {
+	RepaymentInfo     LoanRepaymentInfo `json:"repayment_info"`
+	ApplicationStatus string            `json:"application_status"`
 }
 
 type UpdateLoanApplicationReq struct {
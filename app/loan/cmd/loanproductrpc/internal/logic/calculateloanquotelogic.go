@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"loanproductrpc/internal/svc"
+	"loanproductrpc/loanproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CalculateLoanQuoteLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCalculateLoanQuoteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CalculateLoanQuoteLogic {
+	return &CalculateLoanQuoteLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CalculateLoanQuoteLogic) CalculateLoanQuote(in *loanproduct.CalculateLoanQuoteReq) (*loanproduct.CalculateLoanQuoteResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loanproduct.CalculateLoanQuoteResp{}, nil
+}
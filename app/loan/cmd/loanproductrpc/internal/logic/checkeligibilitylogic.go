@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"loanproductrpc/internal/svc"
+	"loanproductrpc/loanproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckEligibilityLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckEligibilityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckEligibilityLogic {
+	return &CheckEligibilityLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CheckEligibilityLogic) CheckEligibility(in *loanproduct.CheckEligibilityReq) (*loanproduct.CheckEligibilityResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loanproduct.CheckEligibilityResp{}, nil
+}
@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"loanproductrpc/internal/svc"
+	"loanproductrpc/loanproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListLoanProductVersionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListLoanProductVersionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListLoanProductVersionsLogic {
+	return &ListLoanProductVersionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListLoanProductVersionsLogic) ListLoanProductVersions(in *loanproduct.ListLoanProductVersionsReq) (*loanproduct.ListLoanProductVersionsResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loanproduct.ListLoanProductVersionsResp{}, nil
+}
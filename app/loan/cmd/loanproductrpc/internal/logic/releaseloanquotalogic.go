@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"loanproductrpc/internal/svc"
+	"loanproductrpc/loanproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReleaseLoanQuotaLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReleaseLoanQuotaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReleaseLoanQuotaLogic {
+	return &ReleaseLoanQuotaLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ReleaseLoanQuotaLogic) ReleaseLoanQuota(in *loanproduct.ReleaseLoanQuotaReq) (*loanproduct.ReleaseLoanQuotaResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loanproduct.ReleaseLoanQuotaResp{}, nil
+}
@@ -0,0 +1,31 @@
+package logic
+
+import (
+	"context"
+
+	"loanproductrpc/internal/svc"
+	"loanproductrpc/loanproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReserveLoanQuotaLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReserveLoanQuotaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReserveLoanQuotaLogic {
+	return &ReserveLoanQuotaLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 放贷额度
+func (l *ReserveLoanQuotaLogic) ReserveLoanQuota(in *loanproduct.ReserveLoanQuotaReq) (*loanproduct.ReserveLoanQuotaResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loanproduct.ReserveLoanQuotaResp{}, nil
+}
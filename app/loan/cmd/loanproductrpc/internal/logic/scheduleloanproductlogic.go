@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"loanproductrpc/internal/svc"
+	"loanproductrpc/loanproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ScheduleLoanProductLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewScheduleLoanProductLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ScheduleLoanProductLogic {
+	return &ScheduleLoanProductLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ScheduleLoanProductLogic) ScheduleLoanProduct(in *loanproduct.ScheduleLoanProductReq) (*loanproduct.ScheduleLoanProductResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loanproduct.ScheduleLoanProductResp{}, nil
+}
@@ -14,29 +14,54 @@ import (
 )
 
 type (
-	CreateLoanProductReq    = loanproduct.CreateLoanProductReq
-	CreateLoanProductResp   = loanproduct.CreateLoanProductResp
-	DeleteLoanProductReq    = loanproduct.DeleteLoanProductReq
-	DeleteLoanProductResp   = loanproduct.DeleteLoanProductResp
-	GetLoanProductReq       = loanproduct.GetLoanProductReq
-	GetLoanProductResp      = loanproduct.GetLoanProductResp
-	ListLoanProductsReq     = loanproduct.ListLoanProductsReq
-	ListLoanProductsResp    = loanproduct.ListLoanProductsResp
-	LoanProductInfo         = loanproduct.LoanProductInfo
-	UpdateLoanProductReq    = loanproduct.UpdateLoanProductReq
-	UpdateLoanProductResp   = loanproduct.UpdateLoanProductResp
-	UpdateProductStatusReq  = loanproduct.UpdateProductStatusReq
-	UpdateProductStatusResp = loanproduct.UpdateProductStatusResp
+	CalculateLoanQuoteReq       = loanproduct.CalculateLoanQuoteReq
+	CalculateLoanQuoteResp      = loanproduct.CalculateLoanQuoteResp
+	CheckEligibilityReq         = loanproduct.CheckEligibilityReq
+	CheckEligibilityResp        = loanproduct.CheckEligibilityResp
+	CreateLoanProductReq        = loanproduct.CreateLoanProductReq
+	CreateLoanProductResp       = loanproduct.CreateLoanProductResp
+	DeleteLoanProductReq        = loanproduct.DeleteLoanProductReq
+	DeleteLoanProductResp       = loanproduct.DeleteLoanProductResp
+	EligibilityReason           = loanproduct.EligibilityReason
+	GetLoanProductReq           = loanproduct.GetLoanProductReq
+	GetLoanProductResp          = loanproduct.GetLoanProductResp
+	ListLoanProductVersionsReq  = loanproduct.ListLoanProductVersionsReq
+	ListLoanProductVersionsResp = loanproduct.ListLoanProductVersionsResp
+	ListLoanProductsReq         = loanproduct.ListLoanProductsReq
+	ListLoanProductsResp        = loanproduct.ListLoanProductsResp
+	LoanProductInfo             = loanproduct.LoanProductInfo
+	LoanProductVersionInfo      = loanproduct.LoanProductVersionInfo
+	LoanQuote                   = loanproduct.LoanQuote
+	LoanRateTier                = loanproduct.LoanRateTier
+	ProductVersionChange        = loanproduct.ProductVersionChange
+	QuoteInstallment            = loanproduct.QuoteInstallment
+	ReleaseLoanQuotaReq         = loanproduct.ReleaseLoanQuotaReq
+	ReleaseLoanQuotaResp        = loanproduct.ReleaseLoanQuotaResp
+	ReserveLoanQuotaReq         = loanproduct.ReserveLoanQuotaReq
+	ReserveLoanQuotaResp        = loanproduct.ReserveLoanQuotaResp
+	ScheduleLoanProductReq      = loanproduct.ScheduleLoanProductReq
+	ScheduleLoanProductResp     = loanproduct.ScheduleLoanProductResp
+	UpdateLoanProductReq        = loanproduct.UpdateLoanProductReq
+	UpdateLoanProductResp       = loanproduct.UpdateLoanProductResp
+	UpdateProductStatusReq      = loanproduct.UpdateProductStatusReq
+	UpdateProductStatusResp     = loanproduct.UpdateProductStatusResp
 
 	LoanProductService interface {
 		// 产品查询
 		GetLoanProduct(ctx context.Context, in *GetLoanProductReq, opts ...grpc.CallOption) (*GetLoanProductResp, error)
 		ListLoanProducts(ctx context.Context, in *ListLoanProductsReq, opts ...grpc.CallOption) (*ListLoanProductsResp, error)
+		CalculateLoanQuote(ctx context.Context, in *CalculateLoanQuoteReq, opts ...grpc.CallOption) (*CalculateLoanQuoteResp, error)
+		CheckEligibility(ctx context.Context, in *CheckEligibilityReq, opts ...grpc.CallOption) (*CheckEligibilityResp, error)
 		// 产品管理
 		CreateLoanProduct(ctx context.Context, in *CreateLoanProductReq, opts ...grpc.CallOption) (*CreateLoanProductResp, error)
 		UpdateLoanProduct(ctx context.Context, in *UpdateLoanProductReq, opts ...grpc.CallOption) (*UpdateLoanProductResp, error)
 		DeleteLoanProduct(ctx context.Context, in *DeleteLoanProductReq, opts ...grpc.CallOption) (*DeleteLoanProductResp, error)
 		UpdateProductStatus(ctx context.Context, in *UpdateProductStatusReq, opts ...grpc.CallOption) (*UpdateProductStatusResp, error)
+		ScheduleLoanProduct(ctx context.Context, in *ScheduleLoanProductReq, opts ...grpc.CallOption) (*ScheduleLoanProductResp, error)
+		ListLoanProductVersions(ctx context.Context, in *ListLoanProductVersionsReq, opts ...grpc.CallOption) (*ListLoanProductVersionsResp, error)
+		// 放贷额度
+		ReserveLoanQuota(ctx context.Context, in *ReserveLoanQuotaReq, opts ...grpc.CallOption) (*ReserveLoanQuotaResp, error)
+		ReleaseLoanQuota(ctx context.Context, in *ReleaseLoanQuotaReq, opts ...grpc.CallOption) (*ReleaseLoanQuotaResp, error)
 	}
 
 	defaultLoanProductService struct {
@@ -61,6 +86,16 @@ func (m *defaultLoanProductService) ListLoanProducts(ctx context.Context, in *Li
 	return client.ListLoanProducts(ctx, in, opts...)
 }
 
+func (m *defaultLoanProductService) CalculateLoanQuote(ctx context.Context, in *CalculateLoanQuoteReq, opts ...grpc.CallOption) (*CalculateLoanQuoteResp, error) {
+	client := loanproduct.NewLoanProductServiceClient(m.cli.Conn())
+	return client.CalculateLoanQuote(ctx, in, opts...)
+}
+
+func (m *defaultLoanProductService) CheckEligibility(ctx context.Context, in *CheckEligibilityReq, opts ...grpc.CallOption) (*CheckEligibilityResp, error) {
+	client := loanproduct.NewLoanProductServiceClient(m.cli.Conn())
+	return client.CheckEligibility(ctx, in, opts...)
+}
+
 // 产品管理
 func (m *defaultLoanProductService) CreateLoanProduct(ctx context.Context, in *CreateLoanProductReq, opts ...grpc.CallOption) (*CreateLoanProductResp, error) {
 	client := loanproduct.NewLoanProductServiceClient(m.cli.Conn())
@@ -81,3 +116,24 @@ func (m *defaultLoanProductService) UpdateProductStatus(ctx context.Context, in
 	client := loanproduct.NewLoanProductServiceClient(m.cli.Conn())
 	return client.UpdateProductStatus(ctx, in, opts...)
 }
+
+func (m *defaultLoanProductService) ScheduleLoanProduct(ctx context.Context, in *ScheduleLoanProductReq, opts ...grpc.CallOption) (*ScheduleLoanProductResp, error) {
+	client := loanproduct.NewLoanProductServiceClient(m.cli.Conn())
+	return client.ScheduleLoanProduct(ctx, in, opts...)
+}
+
+func (m *defaultLoanProductService) ListLoanProductVersions(ctx context.Context, in *ListLoanProductVersionsReq, opts ...grpc.CallOption) (*ListLoanProductVersionsResp, error) {
+	client := loanproduct.NewLoanProductServiceClient(m.cli.Conn())
+	return client.ListLoanProductVersions(ctx, in, opts...)
+}
+
+// 放贷额度
+func (m *defaultLoanProductService) ReserveLoanQuota(ctx context.Context, in *ReserveLoanQuotaReq, opts ...grpc.CallOption) (*ReserveLoanQuotaResp, error) {
+	client := loanproduct.NewLoanProductServiceClient(m.cli.Conn())
+	return client.ReserveLoanQuota(ctx, in, opts...)
+}
+
+func (m *defaultLoanProductService) ReleaseLoanQuota(ctx context.Context, in *ReleaseLoanQuotaReq, opts ...grpc.CallOption) (*ReleaseLoanQuotaResp, error) {
+	client := loanproduct.NewLoanProductServiceClient(m.cli.Conn())
+	return client.ReleaseLoanQuota(ctx, in, opts...)
+}
@@ -2,12 +2,18 @@ package model
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
 )
 
+// loanApplicationsRowsWithVersion 按版本号更新时的字段,version 由数据库自增
+var loanApplicationsRowsWithVersion = strings.Join(stringx.Remove(loanApplicationsFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`", "`version`"), "=?,") + "=?"
+
 var _ LoanApplicationsModel = (*customLoanApplicationsModel)(nil)
 
 type (
@@ -18,6 +24,12 @@ type (
 		// 自定义方法
 		CountWithConditions(ctx context.Context, whereClause string, args []interface{}) (int64, error)
 		ListWithConditions(ctx context.Context, whereClause string, args []interface{}, limit, offset int32) ([]*LoanApplications, error)
+		UpdateWithVersion(ctx context.Context, data *LoanApplications) error
+		// 事务方法: 在 TransactCtx 中调用 *Session 方法,提交后调用 DelApplicationCache 清理缓存
+		TransactCtx(ctx context.Context, fn func(context.Context, sqlx.Session) error) error
+		UpdateWithVersionSession(ctx context.Context, session sqlx.Session, data *LoanApplications) error
+		FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, id uint64) (*LoanApplications, error)
+		DelApplicationCache(ctx context.Context, data *LoanApplications) error
 	}
 
 	customLoanApplicationsModel struct {
@@ -53,3 +65,67 @@ func (m *customLoanApplicationsModel) ListWithConditions(ctx context.Context, wh
 	
 	return applications, nil
 }
+
+// UpdateWithVersion 按版本号更新申请(乐观锁),版本号不一致时返回 ErrVersionConflict
+func (m *customLoanApplicationsModel) UpdateWithVersion(ctx context.Context, data *LoanApplications) error {
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		return nil, updateLoanApplicationWithVersion(ctx, conn, data)
+	}, loanApplicationCacheKeys(data)...)
+	return err
+}
+
+// UpdateWithVersionSession 在事务中按版本号更新申请,事务提交后需调用 DelApplicationCache
+func (m *customLoanApplicationsModel) UpdateWithVersionSession(ctx context.Context, session sqlx.Session, data *LoanApplications) error {
+	return updateLoanApplicationWithVersion(ctx, session, data)
+}
+
+// FindOneForUpdateWithSession 在事务中查询并锁定申请,用于串行化放款占用与撤销
+func (m *customLoanApplicationsModel) FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, id uint64) (*LoanApplications, error) {
+	query := fmt.Sprintf("select %s from %s where `id` = ? limit 1 for update", loanApplicationsRows, m.table)
+
+	var application LoanApplications
+	err := session.QueryRowCtx(ctx, &application, query, id)
+	switch err {
+	case nil:
+		return &application, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// DelApplicationCache 清理申请缓存
+func (m *customLoanApplicationsModel) DelApplicationCache(ctx context.Context, data *LoanApplications) error {
+	return m.DelCacheCtx(ctx, loanApplicationCacheKeys(data)...)
+}
+
+// updateLoanApplicationWithVersion 按版本号更新申请,成功后版本号加1
+func updateLoanApplicationWithVersion(ctx context.Context, session sqlx.Session, data *LoanApplications) error {
+	query := fmt.Sprintf("update `loan_applications` set %s, `version` = `version` + 1 where `id` = ? and `version` = ?", loanApplicationsRowsWithVersion)
+	result, err := session.ExecCtx(ctx, query, data.ApplicationId, data.UserId, data.ApplicantName, data.ProductId, data.Name, data.Type,
+		data.Amount, data.Duration, data.Purpose, data.OriginationFeeRate, data.ServiceFeeRate, data.GuaranteeFeeRate, data.LateFee, data.Apr, data.ProductSnapshot,
+		data.Status, data.IdempotencyKey, data.Id, data.Version)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrVersionConflict
+	}
+
+	data.Version++
+	return nil
+}
+
+// loanApplicationCacheKeys 申请缓存键
+func loanApplicationCacheKeys(data *LoanApplications) []string {
+	return []string{
+		fmt.Sprintf("%s%v", cacheLoanApplicationsIdPrefix, data.Id),
+		fmt.Sprintf("%s%v", cacheLoanApplicationsApplicationIdPrefix, data.ApplicationId),
+		fmt.Sprintf("%s%v:%v", cacheLoanApplicationsUserIdIdempotencyKeyPrefix, data.UserId, data.IdempotencyKey),
+	}
+}
@@ -24,8 +24,9 @@ var (
 	loanApplicationsRowsExpectAutoSet   = strings.Join(stringx.Remove(loanApplicationsFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), ",")
 	loanApplicationsRowsWithPlaceHolder = strings.Join(stringx.Remove(loanApplicationsFieldNames, "`id`", "`create_at`", "`create_time`", "`created_at`", "`update_at`", "`update_time`", "`updated_at`"), "=?,") + "=?"
 
-	cacheLoanApplicationsIdPrefix            = "cache:loanApplications:id:"
-	cacheLoanApplicationsApplicationIdPrefix = "cache:loanApplications:applicationId:"
+	cacheLoanApplicationsIdPrefix                   = "cache:loanApplications:id:"
+	cacheLoanApplicationsApplicationIdPrefix        = "cache:loanApplications:applicationId:"
+	cacheLoanApplicationsUserIdIdempotencyKeyPrefix = "cache:loanApplications:userId:idempotencyKey:"
 )
 
 type (
@@ -33,6 +34,7 @@ type (
 		Insert(ctx context.Context, data *LoanApplications) (sql.Result, error)
 		FindOne(ctx context.Context, id uint64) (*LoanApplications, error)
 		FindOneByApplicationId(ctx context.Context, applicationId string) (*LoanApplications, error)
+		FindOneByUserIdIdempotencyKey(ctx context.Context, userId uint64, idempotencyKey sql.NullString) (*LoanApplications, error)
 		Update(ctx context.Context, data *LoanApplications) error
 		Delete(ctx context.Context, id uint64) error
 	}
@@ -43,19 +45,27 @@ type (
 	}
 
 	LoanApplications struct {
-		Id            uint64         `db:"id"`             // 申请ID
-		ApplicationId string         `db:"application_id"` // 申请编号
-		UserId        uint64         `db:"user_id"`        // 用户ID
-		ApplicantName string         `db:"applicant_name"` // 申请人姓名
-		ProductId     uint64         `db:"product_id"`     // 贷款产品ID
-		Name          string         `db:"name"`           // 申请名称
-		Type          string         `db:"type"`           // 贷款类型
-		Amount        float64        `db:"amount"`         // 申请金额
-		Duration      uint64         `db:"duration"`       // 贷款期限(月)
-		Purpose       sql.NullString `db:"purpose"`        // 贷款用途
-		Status        string         `db:"status"`         // 状态 pending/approved/rejected/cancelled
-		CreatedAt     time.Time      `db:"created_at"`     // 创建时间
-		UpdatedAt     time.Time      `db:"updated_at"`     // 更新时间
+		Id                 uint64         `db:"id"`                   // 申请ID
+		ApplicationId      string         `db:"application_id"`       // 申请编号
+		UserId             uint64         `db:"user_id"`              // 用户ID
+		ApplicantName      string         `db:"applicant_name"`       // 申请人姓名
+		ProductId          uint64         `db:"product_id"`           // 贷款产品ID
+		Name               string         `db:"name"`                 // 申请名称
+		Type               string         `db:"type"`                 // 贷款类型
+		Amount             float64        `db:"amount"`               // 申请金额
+		Duration           uint64         `db:"duration"`             // 贷款期限(月)
+		Purpose            sql.NullString `db:"purpose"`              // 贷款用途
+		OriginationFeeRate float64        `db:"origination_fee_rate"` // 手续费率(%),创建申请时按产品配置冻结
+		ServiceFeeRate     float64        `db:"service_fee_rate"`     // 服务费月费率(%),创建申请时按产品配置冻结
+		GuaranteeFeeRate   float64        `db:"guarantee_fee_rate"`   // 担保费率(%),创建申请时按产品配置冻结
+		LateFee            float64        `db:"late_fee"`             // 逾期滞纳金(元/期),创建申请时按产品配置冻结
+		Apr                float64        `db:"apr"`                  // 综合年化利率(IRR,%),按申请金额和期限以等额本息试算
+		ProductSnapshot    sql.NullString `db:"product_snapshot"`     // 产品条款快照(JSON),创建申请时记录利率、额度、期限、费用等
+		Status             string         `db:"status"`               // 状态 pending/approved/rejected/cancelled/disbursed/settled
+		Version            uint64         `db:"version"`              // 乐观锁版本号
+		IdempotencyKey     sql.NullString `db:"idempotency_key"`      // 幂等键(客户端Idempotency-Key)
+		CreatedAt          time.Time      `db:"created_at"`           // 创建时间
+		UpdatedAt          time.Time      `db:"updated_at"`           // 更新时间
 	}
 )
 
@@ -74,10 +84,11 @@ func (m *defaultLoanApplicationsModel) Delete(ctx context.Context, id uint64) er
 
 	loanApplicationsApplicationIdKey := fmt.Sprintf("%s%v", cacheLoanApplicationsApplicationIdPrefix, data.ApplicationId)
 	loanApplicationsIdKey := fmt.Sprintf("%s%v", cacheLoanApplicationsIdPrefix, id)
+	loanApplicationsUserIdIdempotencyKeyKey := fmt.Sprintf("%s%v:%v", cacheLoanApplicationsUserIdIdempotencyKeyPrefix, data.UserId, data.IdempotencyKey)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("delete from %s where `id` = ?", m.table)
 		return conn.ExecCtx(ctx, query, id)
-	}, loanApplicationsApplicationIdKey, loanApplicationsIdKey)
+	}, loanApplicationsApplicationIdKey, loanApplicationsIdKey, loanApplicationsUserIdIdempotencyKeyKey)
 	return err
 }
 
@@ -118,13 +129,34 @@ func (m *defaultLoanApplicationsModel) FindOneByApplicationId(ctx context.Contex
 	}
 }
 
+func (m *defaultLoanApplicationsModel) FindOneByUserIdIdempotencyKey(ctx context.Context, userId uint64, idempotencyKey sql.NullString) (*LoanApplications, error) {
+	loanApplicationsUserIdIdempotencyKeyKey := fmt.Sprintf("%s%v:%v", cacheLoanApplicationsUserIdIdempotencyKeyPrefix, userId, idempotencyKey)
+	var resp LoanApplications
+	err := m.QueryRowIndexCtx(ctx, &resp, loanApplicationsUserIdIdempotencyKeyKey, m.formatPrimary, func(ctx context.Context, conn sqlx.SqlConn, v any) (i any, e error) {
+		query := fmt.Sprintf("select %s from %s where `user_id` = ? and `idempotency_key` = ? limit 1", loanApplicationsRows, m.table)
+		if err := conn.QueryRowCtx(ctx, &resp, query, userId, idempotencyKey); err != nil {
+			return nil, err
+		}
+		return resp.Id, nil
+	}, m.queryPrimary)
+	switch err {
+	case nil:
+		return &resp, nil
+	case sqlc.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
 func (m *defaultLoanApplicationsModel) Insert(ctx context.Context, data *LoanApplications) (sql.Result, error) {
 	loanApplicationsApplicationIdKey := fmt.Sprintf("%s%v", cacheLoanApplicationsApplicationIdPrefix, data.ApplicationId)
 	loanApplicationsIdKey := fmt.Sprintf("%s%v", cacheLoanApplicationsIdPrefix, data.Id)
+	loanApplicationsUserIdIdempotencyKeyKey := fmt.Sprintf("%s%v:%v", cacheLoanApplicationsUserIdIdempotencyKeyPrefix, data.UserId, data.IdempotencyKey)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, loanApplicationsRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.ApplicationId, data.UserId, data.ApplicantName, data.ProductId, data.Name, data.Type, data.Amount, data.Duration, data.Purpose, data.Status)
-	}, loanApplicationsApplicationIdKey, loanApplicationsIdKey)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, loanApplicationsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.ApplicationId, data.UserId, data.ApplicantName, data.ProductId, data.Name, data.Type, data.Amount, data.Duration, data.Purpose, data.OriginationFeeRate, data.ServiceFeeRate, data.GuaranteeFeeRate, data.LateFee, data.Apr, data.ProductSnapshot, data.Status, data.Version, data.IdempotencyKey)
+	}, loanApplicationsApplicationIdKey, loanApplicationsIdKey, loanApplicationsUserIdIdempotencyKeyKey)
 	return ret, err
 }
 
@@ -136,10 +168,11 @@ func (m *defaultLoanApplicationsModel) Update(ctx context.Context, newData *Loan
 
 	loanApplicationsApplicationIdKey := fmt.Sprintf("%s%v", cacheLoanApplicationsApplicationIdPrefix, data.ApplicationId)
 	loanApplicationsIdKey := fmt.Sprintf("%s%v", cacheLoanApplicationsIdPrefix, data.Id)
+	loanApplicationsUserIdIdempotencyKeyKey := fmt.Sprintf("%s%v:%v", cacheLoanApplicationsUserIdIdempotencyKeyPrefix, data.UserId, data.IdempotencyKey)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, loanApplicationsRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.ApplicationId, newData.UserId, newData.ApplicantName, newData.ProductId, newData.Name, newData.Type, newData.Amount, newData.Duration, newData.Purpose, newData.Status, newData.Id)
-	}, loanApplicationsApplicationIdKey, loanApplicationsIdKey)
+		return conn.ExecCtx(ctx, query, newData.ApplicationId, newData.UserId, newData.ApplicantName, newData.ProductId, newData.Name, newData.Type, newData.Amount, newData.Duration, newData.Purpose, newData.OriginationFeeRate, newData.ServiceFeeRate, newData.GuaranteeFeeRate, newData.LateFee, newData.Apr, newData.ProductSnapshot, newData.Status, newData.Version, newData.IdempotencyKey, newData.Id)
+	}, loanApplicationsApplicationIdKey, loanApplicationsIdKey, loanApplicationsUserIdIdempotencyKeyKey)
 	return err
 }
 
@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
@@ -17,6 +18,7 @@ type (
 		loanApprovalsModel
 		// 自定义方法
 		FindByApplicationId(ctx context.Context, applicationId int64) ([]*LoanApprovals, error)
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *LoanApprovals) (sql.Result, error)
 	}
 
 	customLoanApprovalsModel struct {
@@ -43,3 +45,9 @@ func (m *customLoanApprovalsModel) FindByApplicationId(ctx context.Context, appl
 
 	return approvals, nil
 }
+
+// InsertWithSession 在事务中写入审批记录
+func (m *customLoanApprovalsModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *LoanApprovals) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, loanApprovalsRowsExpectAutoSet)
+	return session.ExecCtx(ctx, query, data.ApplicationId, data.AuditorId, data.AuditorName, data.Action, data.Stage, data.StageName, data.AuditorRole, data.Suggestions, data.ApprovedAmount, data.ApprovedDuration, data.InterestRate)
+}
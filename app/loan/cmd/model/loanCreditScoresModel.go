@@ -0,0 +1,46 @@
+package model
+
+import (
+	"context"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ LoanCreditScoresModel = (*customLoanCreditScoresModel)(nil)
+
+type (
+	// LoanCreditScoresModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customLoanCreditScoresModel.
+	LoanCreditScoresModel interface {
+		loanCreditScoresModel
+		// 自定义方法
+		Save(ctx context.Context, data *LoanCreditScores) error
+	}
+
+	customLoanCreditScoresModel struct {
+		*defaultLoanCreditScoresModel
+	}
+)
+
+// NewLoanCreditScoresModel returns a model for the database table.
+func NewLoanCreditScoresModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) LoanCreditScoresModel {
+	return &customLoanCreditScoresModel{
+		defaultLoanCreditScoresModel: newLoanCreditScoresModel(conn, c, opts...),
+	}
+}
+
+// Save 保存申请评分,每个申请仅保留最新一次评分
+func (m *customLoanCreditScoresModel) Save(ctx context.Context, data *LoanCreditScores) error {
+	existing, err := m.FindOneByApplicationId(ctx, data.ApplicationId)
+	switch err {
+	case nil:
+		data.Id = existing.Id
+		return m.Update(ctx, data)
+	case ErrNotFound:
+		_, err = m.Insert(ctx, data)
+		return err
+	default:
+		return err
+	}
+}
@@ -0,0 +1,125 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ LoanDisbursementsModel = (*customLoanDisbursementsModel)(nil)
+
+type (
+	// LoanDisbursementsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customLoanDisbursementsModel.
+	LoanDisbursementsModel interface {
+		loanDisbursementsModel
+		// 自定义方法
+		FindByApplicationId(ctx context.Context, applicationId uint64) ([]*LoanDisbursements, error)
+		FindSuccessByApplicationId(ctx context.Context, applicationId uint64) (*LoanDisbursements, error)
+		FindActiveByApplicationId(ctx context.Context, applicationId uint64) (*LoanDisbursements, error)
+		UpdateIfStatus(ctx context.Context, data *LoanDisbursements, from string) (bool, error)
+		// 事务方法: 在 TransactCtx 中调用 *Session 方法
+		TransactCtx(ctx context.Context, fn func(context.Context, sqlx.Session) error) error
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *LoanDisbursements) (sql.Result, error)
+		FindActiveByApplicationIdWithSession(ctx context.Context, session sqlx.Session, applicationId uint64) (*LoanDisbursements, error)
+	}
+
+	customLoanDisbursementsModel struct {
+		*defaultLoanDisbursementsModel
+	}
+)
+
+// NewLoanDisbursementsModel returns a model for the database table.
+func NewLoanDisbursementsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) LoanDisbursementsModel {
+	return &customLoanDisbursementsModel{
+		defaultLoanDisbursementsModel: newLoanDisbursementsModel(conn, c, opts...),
+	}
+}
+
+// FindByApplicationId 根据申请ID查询放款记录
+func (m *customLoanDisbursementsModel) FindByApplicationId(ctx context.Context, applicationId uint64) ([]*LoanDisbursements, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `application_id` = ? ORDER BY created_at ASC", loanDisbursementsRows, m.table)
+
+	var disbursements []*LoanDisbursements
+	err := m.QueryRowsNoCacheCtx(ctx, &disbursements, query, applicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return disbursements, nil
+}
+
+// FindSuccessByApplicationId 查询申请的放款成功记录
+func (m *customLoanDisbursementsModel) FindSuccessByApplicationId(ctx context.Context, applicationId uint64) (*LoanDisbursements, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `application_id` = ? AND `status` = 'success' LIMIT 1", loanDisbursementsRows, m.table)
+
+	var disbursement LoanDisbursements
+	err := m.QueryRowNoCacheCtx(ctx, &disbursement, query, applicationId)
+	switch err {
+	case nil:
+		return &disbursement, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindActiveByApplicationId 查询申请占用中的放款记录(在途、结果未知或已成功)
+func (m *customLoanDisbursementsModel) FindActiveByApplicationId(ctx context.Context, applicationId uint64) (*LoanDisbursements, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `active_application_id` = ? LIMIT 1", loanDisbursementsRows, m.table)
+
+	var disbursement LoanDisbursements
+	err := m.QueryRowNoCacheCtx(ctx, &disbursement, query, applicationId)
+	switch err {
+	case nil:
+		return &disbursement, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindActiveByApplicationIdWithSession 在事务中加锁查询申请占用中的放款记录,读取已提交的最新放款
+func (m *customLoanDisbursementsModel) FindActiveByApplicationIdWithSession(ctx context.Context, session sqlx.Session, applicationId uint64) (*LoanDisbursements, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `active_application_id` = ? LIMIT 1 FOR UPDATE", loanDisbursementsRows, m.table)
+
+	var disbursement LoanDisbursements
+	err := session.QueryRowCtx(ctx, &disbursement, query, applicationId)
+	switch err {
+	case nil:
+		return &disbursement, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// InsertWithSession 在事务中写入放款记录
+func (m *customLoanDisbursementsModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *LoanDisbursements) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, loanDisbursementsRowsExpectAutoSet)
+	return session.ExecCtx(ctx, query, data.DisbursementNo, data.ApplicationId, data.ActiveApplicationId, data.Amount, data.AccountName, data.AccountNo, data.BankName, data.Channel, data.BankSerialNo, data.Status, data.FailReason, data.OperatorId, data.OperatorName, data.DisbursedAt)
+}
+
+// UpdateIfStatus 仅当放款记录仍处于 from 状态时更新处理结果,状态已被其他请求变更时返回 false
+func (m *customLoanDisbursementsModel) UpdateIfStatus(ctx context.Context, data *LoanDisbursements, from string) (bool, error) {
+	loanDisbursementsDisbursementNoKey := fmt.Sprintf("%s%v", cacheLoanDisbursementsDisbursementNoPrefix, data.DisbursementNo)
+	loanDisbursementsIdKey := fmt.Sprintf("%s%v", cacheLoanDisbursementsIdPrefix, data.Id)
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `active_application_id` = ?, `bank_serial_no` = ?, `status` = ?, `fail_reason` = ?, `disbursed_at` = ? where `id` = ? and `status` = ?", m.table)
+		return conn.ExecCtx(ctx, query, data.ActiveApplicationId, data.BankSerialNo, data.Status, data.FailReason, data.DisbursedAt, data.Id, from)
+	}, loanDisbursementsDisbursementNoKey, loanDisbursementsIdKey)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
@@ -0,0 +1,187 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ LoanRepaymentPlansModel = (*customLoanRepaymentPlansModel)(nil)
+
+type (
+	// LoanRepaymentPlansModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customLoanRepaymentPlansModel.
+	LoanRepaymentPlansModel interface {
+		loanRepaymentPlansModel
+		// 自定义方法
+		FindByApplicationId(ctx context.Context, applicationId uint64) ([]*LoanRepaymentPlans, error)
+		ReplaceByApplicationId(ctx context.Context, applicationId uint64, plans []*LoanRepaymentPlans) error
+		FindUnpaidDueBefore(ctx context.Context, date time.Time) ([]*LoanRepaymentPlans, error)
+		FindUnpaidByUserId(ctx context.Context, userId uint64) ([]*LoanRepaymentPlans, error)
+		MarkOverdue(ctx context.Context, data *LoanRepaymentPlans) (bool, error)
+		// 事务方法: 在 TransactCtx 中调用 *Session 方法,提交后调用 DelPlansCache 清理缓存
+		DeleteByApplicationIdWithSession(ctx context.Context, session sqlx.Session, applicationId uint64) ([]*LoanRepaymentPlans, error)
+		DelPlansCache(ctx context.Context, plans ...*LoanRepaymentPlans) error
+	}
+
+	customLoanRepaymentPlansModel struct {
+		*defaultLoanRepaymentPlansModel
+	}
+)
+
+// NewLoanRepaymentPlansModel returns a model for the database table.
+func NewLoanRepaymentPlansModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) LoanRepaymentPlansModel {
+	return &customLoanRepaymentPlansModel{
+		defaultLoanRepaymentPlansModel: newLoanRepaymentPlansModel(conn, c, opts...),
+	}
+}
+
+// FindByApplicationId 根据申请ID查询还款计划(按期数升序)
+func (m *customLoanRepaymentPlansModel) FindByApplicationId(ctx context.Context, applicationId uint64) ([]*LoanRepaymentPlans, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `application_id` = ? ORDER BY installment_no ASC", loanRepaymentPlansRows, m.table)
+
+	var plans []*LoanRepaymentPlans
+	err := m.QueryRowsNoCacheCtx(ctx, &plans, query, applicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return plans, nil
+}
+
+// FindUnpaidDueBefore 查询应还日期早于指定日期且未结清的还款计划
+func (m *customLoanRepaymentPlansModel) FindUnpaidDueBefore(ctx context.Context, date time.Time) ([]*LoanRepaymentPlans, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `status` IN ('pending', 'overdue') AND `due_date` < ? ORDER BY application_id ASC, installment_no ASC", loanRepaymentPlansRows, m.table)
+
+	var plans []*LoanRepaymentPlans
+	err := m.QueryRowsNoCacheCtx(ctx, &plans, query, date.Format("2006-01-02"))
+	if err != nil {
+		return nil, err
+	}
+
+	return plans, nil
+}
+
+// FindUnpaidByUserId 查询用户已批准或已放款贷款中未结清的还款计划
+func (m *customLoanRepaymentPlansModel) FindUnpaidByUserId(ctx context.Context, userId uint64) ([]*LoanRepaymentPlans, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `status` IN ('pending', 'overdue') AND `application_id` IN (SELECT `id` FROM `loan_applications` WHERE `user_id` = ? AND `status` IN ('approved', 'disbursed')) ORDER BY application_id ASC, installment_no ASC", loanRepaymentPlansRows, m.table)
+
+	var plans []*LoanRepaymentPlans
+	err := m.QueryRowsNoCacheCtx(ctx, &plans, query, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return plans, nil
+}
+
+// MarkOverdue 标记逾期并更新罚息,仅更新逾期相关字段;
+// 计算罚息后已还本息发生变化或计划已结清时返回 false,由下次执行按最新已还金额重新计提
+func (m *customLoanRepaymentPlansModel) MarkOverdue(ctx context.Context, data *LoanRepaymentPlans) (bool, error) {
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("UPDATE %s SET `penalty` = ?, `overdue_days` = ?, `status` = 'overdue' WHERE `id` = ? AND `status` IN ('pending', 'overdue') AND `paid_principal` = ? AND `paid_interest` = ? AND `paid_service_fee` = ? AND `paid_penalty` <= ?", m.table)
+		return conn.ExecCtx(ctx, query, data.Penalty, data.OverdueDays, data.Id, data.PaidPrincipal, data.PaidInterest, data.PaidServiceFee, data.Penalty)
+	}, m.planCacheKeys(data)...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// ReplaceByApplicationId 在同一事务中删除申请原有还款计划并写入新计划,已开始还款时返回 ErrRepaymentStarted
+func (m *customLoanRepaymentPlansModel) ReplaceByApplicationId(ctx context.Context, applicationId uint64, plans []*LoanRepaymentPlans) error {
+	existing, err := m.FindByApplicationId(ctx, applicationId)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(existing)*2)
+	for _, plan := range existing {
+		keys = append(keys, m.planCacheKeys(plan)...)
+	}
+
+	err = m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		// 加锁检查是否已有还款,避免与并发还款交错导致已还金额被清除
+		var started int64
+		checkQuery := fmt.Sprintf("SELECT count(*) FROM %s WHERE `application_id` = ? AND (`status` <> 'pending' OR `paid_principal` > 0 OR `paid_interest` > 0 OR `paid_penalty` > 0 OR `paid_service_fee` > 0) FOR UPDATE", m.table)
+		if err := session.QueryRowCtx(ctx, &started, checkQuery, applicationId); err != nil {
+			return err
+		}
+		if started > 0 {
+			return ErrRepaymentStarted
+		}
+
+		deleteQuery := fmt.Sprintf("DELETE FROM %s WHERE `application_id` = ?", m.table)
+		if _, err := session.ExecCtx(ctx, deleteQuery, applicationId); err != nil {
+			return err
+		}
+
+		insertQuery := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, loanRepaymentPlansRowsExpectAutoSet)
+		for _, plan := range plans {
+			if _, err := session.ExecCtx(ctx, insertQuery, plan.ApplicationId, plan.InstallmentNo, plan.DueDate, plan.Principal,
+				plan.Interest, plan.TotalAmount, plan.RemainingPrincipal, plan.RepaymentMethod, plan.PaidPrincipal, plan.PaidInterest,
+				plan.Penalty, plan.PaidPenalty, plan.ServiceFee, plan.PaidServiceFee, plan.OverdueDays, plan.PaidAt, plan.Status); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// 事务提交后清理缓存
+	if len(keys) > 0 {
+		return m.DelCacheCtx(ctx, keys...)
+	}
+	return nil
+}
+
+// DeleteByApplicationIdWithSession 在事务中删除申请未开始还款的还款计划,返回被删除的计划用于清理缓存;
+// 已开始还款时返回 ErrRepaymentStarted
+func (m *customLoanRepaymentPlansModel) DeleteByApplicationIdWithSession(ctx context.Context, session sqlx.Session, applicationId uint64) ([]*LoanRepaymentPlans, error) {
+	var plans []*LoanRepaymentPlans
+	lockQuery := fmt.Sprintf("SELECT %s FROM %s WHERE `application_id` = ? ORDER BY installment_no ASC FOR UPDATE", loanRepaymentPlansRows, m.table)
+	if err := session.QueryRowsCtx(ctx, &plans, lockQuery, applicationId); err != nil {
+		return nil, err
+	}
+	for _, plan := range plans {
+		if plan.Status != "pending" || plan.PaidPrincipal > 0 || plan.PaidInterest > 0 || plan.PaidPenalty > 0 || plan.PaidServiceFee > 0 {
+			return nil, ErrRepaymentStarted
+		}
+	}
+
+	deleteQuery := fmt.Sprintf("DELETE FROM %s WHERE `application_id` = ?", m.table)
+	if _, err := session.ExecCtx(ctx, deleteQuery, applicationId); err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+// DelPlansCache 清理还款计划缓存
+func (m *customLoanRepaymentPlansModel) DelPlansCache(ctx context.Context, plans ...*LoanRepaymentPlans) error {
+	if len(plans) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(plans)*2)
+	for _, plan := range plans {
+		keys = append(keys, m.planCacheKeys(plan)...)
+	}
+	return m.DelCacheCtx(ctx, keys...)
+}
+
+func (m *customLoanRepaymentPlansModel) planCacheKeys(data *LoanRepaymentPlans) []string {
+	return []string{
+		fmt.Sprintf("%s%v", cacheLoanRepaymentPlansIdPrefix, data.Id),
+		fmt.Sprintf("%s%v:%v", cacheLoanRepaymentPlansApplicationIdInstallmentNoPrefix, data.ApplicationId, data.InstallmentNo),
+	}
+}
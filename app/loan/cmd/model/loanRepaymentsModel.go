@@ -0,0 +1,136 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ LoanRepaymentsModel = (*customLoanRepaymentsModel)(nil)
+
+type (
+	// LoanRepaymentsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customLoanRepaymentsModel.
+	LoanRepaymentsModel interface {
+		loanRepaymentsModel
+		// 自定义方法
+		FindByApplicationId(ctx context.Context, applicationId uint64) ([]*LoanRepayments, error)
+		InsertWithPlans(ctx context.Context, applicationId uint64, allocate RepaymentAllocator) error
+		SettleWithPlans(ctx context.Context, application *LoanApplications, allocate RepaymentAllocator) error
+	}
+
+	// RepaymentAllocator 根据事务内加锁读取的还款计划,计算还款记录及被冲抵的还款计划
+	RepaymentAllocator func(plans []*LoanRepaymentPlans) (*LoanRepayments, []*LoanRepaymentPlans, error)
+
+	customLoanRepaymentsModel struct {
+		*defaultLoanRepaymentsModel
+	}
+)
+
+// NewLoanRepaymentsModel returns a model for the database table.
+func NewLoanRepaymentsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) LoanRepaymentsModel {
+	return &customLoanRepaymentsModel{
+		defaultLoanRepaymentsModel: newLoanRepaymentsModel(conn, c, opts...),
+	}
+}
+
+// FindByApplicationId 根据申请ID查询还款记录
+func (m *customLoanRepaymentsModel) FindByApplicationId(ctx context.Context, applicationId uint64) ([]*LoanRepayments, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `application_id` = ? ORDER BY created_at DESC", loanRepaymentsRows, m.table)
+
+	var repayments []*LoanRepayments
+	err := m.QueryRowsNoCacheCtx(ctx, &repayments, query, applicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return repayments, nil
+}
+
+// InsertWithPlans 在同一事务中加锁读取还款计划、写入还款记录并更新被冲抵的还款计划
+func (m *customLoanRepaymentsModel) InsertWithPlans(ctx context.Context, applicationId uint64, allocate RepaymentAllocator) error {
+	var touched []*LoanRepaymentPlans
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		var err error
+		touched, err = m.insertWithPlans(ctx, session, applicationId, allocate)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	// 事务提交后清理缓存
+	return m.delPlansCache(ctx, touched)
+}
+
+// SettleWithPlans 提前结清: 在同一事务中写入还款记录、结清剩余还款计划并按版本号更新申请(状态由调用方置为settled)
+func (m *customLoanRepaymentsModel) SettleWithPlans(ctx context.Context, application *LoanApplications, allocate RepaymentAllocator) error {
+	var touched []*LoanRepaymentPlans
+	err := m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		var err error
+		touched, err = m.insertWithPlans(ctx, session, application.Id, allocate)
+		if err != nil {
+			return err
+		}
+
+		// 按版本号更新申请状态,防止并发重复结清
+		return updateLoanApplicationWithVersion(ctx, session, application)
+	})
+	if err != nil {
+		return err
+	}
+
+	// 事务提交后清理缓存
+	if err := m.delPlansCache(ctx, touched); err != nil {
+		return err
+	}
+	return m.DelCacheCtx(ctx, loanApplicationCacheKeys(application)...)
+}
+
+// insertWithPlans 在事务中加锁读取还款计划,按最新已还金额冲抵后写入还款记录并更新还款计划,
+// 并发还款与逾期计提在行锁上串行,不会覆盖彼此的结果
+func (m *customLoanRepaymentsModel) insertWithPlans(ctx context.Context, session sqlx.Session, applicationId uint64, allocate RepaymentAllocator) ([]*LoanRepaymentPlans, error) {
+	var plans []*LoanRepaymentPlans
+	lockQuery := fmt.Sprintf("SELECT %s FROM `loan_repayment_plans` WHERE `application_id` = ? ORDER BY installment_no ASC FOR UPDATE", loanRepaymentPlansRows)
+	if err := session.QueryRowsCtx(ctx, &plans, lockQuery, applicationId); err != nil {
+		return nil, err
+	}
+
+	data, touched, err := allocate(plans)
+	if err != nil {
+		return nil, err
+	}
+
+	insertQuery := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, loanRepaymentsRowsExpectAutoSet)
+	if _, err := session.ExecCtx(ctx, insertQuery, data.RepaymentNo, data.ApplicationId, data.UserId, data.Amount, data.PrincipalAmount,
+		data.InterestAmount, data.PenaltyAmount, data.FeeAmount, data.InstallmentNos, data.RepaymentType, data.Channel, data.Remark); err != nil {
+		return nil, err
+	}
+
+	updateQuery := "UPDATE `loan_repayment_plans` SET `interest` = ?, `total_amount` = ?, `paid_principal` = ?, `paid_interest` = ?, `paid_penalty` = ?, `service_fee` = ?, `paid_service_fee` = ?, `status` = ?, `paid_at` = ? WHERE `id` = ?"
+	for _, plan := range touched {
+		if _, err := session.ExecCtx(ctx, updateQuery, plan.Interest, plan.TotalAmount, plan.PaidPrincipal, plan.PaidInterest,
+			plan.PaidPenalty, plan.ServiceFee, plan.PaidServiceFee, plan.Status, plan.PaidAt, plan.Id); err != nil {
+			return nil, err
+		}
+	}
+	return touched, nil
+}
+
+// delPlansCache 清理还款计划缓存
+func (m *customLoanRepaymentsModel) delPlansCache(ctx context.Context, plans []*LoanRepaymentPlans) error {
+	if len(plans) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(plans)*2)
+	for _, plan := range plans {
+		keys = append(keys,
+			fmt.Sprintf("%s%v", cacheLoanRepaymentPlansIdPrefix, plan.Id),
+			fmt.Sprintf("%s%v:%v", cacheLoanRepaymentPlansApplicationIdInstallmentNoPrefix, plan.ApplicationId, plan.InstallmentNo),
+		)
+	}
+	return m.DelCacheCtx(ctx, keys...)
+}
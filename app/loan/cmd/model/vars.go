@@ -1,5 +1,15 @@
 package model
 
-import "github.com/zeromicro/go-zero/core/stores/sqlx"
+import (
+	"errors"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
 
 var ErrNotFound = sqlx.ErrNotFound
+
+// ErrVersionConflict 按版本号更新申请时申请已被其他操作修改
+var ErrVersionConflict = errors.New("application version conflict")
+
+// ErrRepaymentStarted 还款计划已开始还款,不能重新生成
+var ErrRepaymentStarted = errors.New("repayment schedule already started")
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"rpc/internal/pkg/creditscore"
+
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/zrpc"
 	"github.com/zeromicro/zero-contrib/zrpc/registry/consul"
@@ -20,6 +22,32 @@ type Config struct {
 	// Redis 缓存配置
 	CacheConf cache.CacheConf
 
+	// 放款渠道配置 - 默认使用本地模拟银行核心
+	Disburser struct {
+		Channel string `json:",default=mock"`
+	}
+
+	// 逾期检测任务配置 - 默认每日凌晨1点执行
+	OverdueJob struct {
+		Enabled bool `json:",default=true"`
+		RunHour int  `json:",default=1,range=[0:23]"`
+	}
+
+	// 信用评分规则配置 - 未配置规则时使用内置规则
+	CreditScore creditscore.Config
+
+	// 申请准入配置 - 月供收入比上限、在途(待审批/已批准)申请数上限,0表示不限制
+	Eligibility struct {
+		MaxDebtToIncome     float64 `json:",default=0.5"`
+		MaxActivePerUser    int     `json:",default=3"`
+		MaxActivePerProduct int     `json:",default=1"`
+	}
+
+	// 提交幂等配置 - 幂等键处理结果默认在Redis中保留24小时,过期后由数据库唯一索引兜底
+	Idempotency struct {
+		Expire int `json:",default=86400"`
+	}
+
 	// 其他RPC服务配置
 	LoanProductRpc zrpc.RpcClientConf
 	AppUserRpc     zrpc.RpcClientConf
@@ -0,0 +1,168 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"common/repayment"
+	"loanproductrpc/loanproductservice"
+	"rpc/internal/breaker"
+	"rpc/internal/pkg/productsnapshot"
+	"rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// OverdueJob 逾期检测任务
+// 每日扫描已过应还日期且未结清的还款计划,标记逾期并按申请冻结的罚息日利率计提罚息,
+// 另按申请冻结的滞纳金标准每期加收一次滞纳金
+type OverdueJob struct {
+	svcCtx *svc.ServiceContext
+	done   chan struct{}
+}
+
+func NewOverdueJob(svcCtx *svc.ServiceContext) *OverdueJob {
+	return &OverdueJob{
+		svcCtx: svcCtx,
+		done:   make(chan struct{}),
+	}
+}
+
+// Start 启动任务: 启动时执行一次,之后每日在配置的整点执行
+func (j *OverdueJob) Start() {
+	if !j.svcCtx.Config.OverdueJob.Enabled {
+		logx.Info("逾期检测任务未启用")
+		return
+	}
+
+	j.Run(context.Background())
+	for {
+		timer := time.NewTimer(time.Until(j.nextRunTime(time.Now())))
+		select {
+		case <-timer.C:
+			j.Run(context.Background())
+		case <-j.done:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// Stop 停止任务
+func (j *OverdueJob) Stop() {
+	close(j.done)
+}
+
+// nextRunTime 计算下一次执行时间
+func (j *OverdueJob) nextRunTime(now time.Time) time.Time {
+	next := time.Date(now.Year(), now.Month(), now.Day(), j.svcCtx.Config.OverdueJob.RunHour, 0, 0, 0, now.Location())
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// Run 执行一次逾期检测
+func (j *OverdueJob) Run(ctx context.Context) {
+	logger := logx.WithContext(ctx)
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	plans, err := j.svcCtx.LoanRepaymentPlansModel.FindUnpaidDueBefore(ctx, today)
+	if err != nil {
+		logger.Errorf("查询逾期还款计划失败: %v", err)
+		return
+	}
+
+	// 同一次执行内缓存申请状态与罚息利率,避免重复查询
+	disbursed := make(map[uint64]bool)
+	lateFees := make(map[uint64]float64)
+	penaltyRates := make(map[uint64]float64)
+	productPenaltyRates := make(map[uint64]float64)
+
+	var updated int
+	for _, plan := range plans {
+		ok, found := disbursed[plan.ApplicationId]
+		if !found {
+			application, err := j.svcCtx.LoanApplicationsModel.FindOne(ctx, plan.ApplicationId)
+			if err != nil {
+				logger.Errorf("查询申请失败, application_id: %d, err: %v", plan.ApplicationId, err)
+				continue
+			}
+			ok = application.Status == "disbursed"
+			if ok {
+				// 罚息利率取申请冻结的条款快照,历史申请无快照时按产品当前配置
+				snapshot, err := productsnapshot.Decode(application.ProductSnapshot)
+				if err != nil {
+					logger.Errorf("解析产品条款快照失败, application_id: %d, err: %v", plan.ApplicationId, err)
+					continue
+				}
+				if snapshot != nil {
+					penaltyRates[plan.ApplicationId] = snapshot.PenaltyRate
+				} else {
+					rate, found := productPenaltyRates[application.ProductId]
+					if !found {
+						rate, err = j.getPenaltyRate(ctx, application.ProductId)
+						if err != nil {
+							logger.Errorf("查询产品罚息利率失败, product_id: %d, err: %v", application.ProductId, err)
+							continue
+						}
+						productPenaltyRates[application.ProductId] = rate
+					}
+					penaltyRates[plan.ApplicationId] = rate
+				}
+			}
+			disbursed[plan.ApplicationId] = ok
+			lateFees[plan.ApplicationId] = application.LateFee
+		}
+		if !ok {
+			continue
+		}
+		rate := penaltyRates[plan.ApplicationId]
+
+		// 罚息按当前逾期未还本息重新计算,重复执行结果一致
+		days := repayment.OverdueDays(plan.DueDate, today)
+		overdue := repayment.Round2(plan.Principal - plan.PaidPrincipal + plan.Interest - plan.PaidInterest)
+		penalty := repayment.Round2(repayment.Penalty(overdue, rate, days) + lateFees[plan.ApplicationId])
+		if penalty < plan.PaidPenalty {
+			penalty = plan.PaidPenalty
+		}
+
+		if plan.Status == "overdue" && int(plan.OverdueDays) == days && plan.Penalty == penalty {
+			continue
+		}
+
+		plan.Status = "overdue"
+		plan.OverdueDays = uint64(days)
+		plan.Penalty = penalty
+		// 仅更新逾期字段,期间有还款入账时跳过,避免覆盖已还金额
+		marked, err := j.svcCtx.LoanRepaymentPlansModel.MarkOverdue(ctx, plan)
+		if err != nil {
+			logger.Errorf("更新逾期还款计划失败, plan_id: %d, err: %v", plan.Id, err)
+			continue
+		}
+		if !marked {
+			logger.Infof("还款计划已变化, 跳过本次逾期计提, plan_id: %d", plan.Id)
+			continue
+		}
+		updated++
+	}
+
+	logger.Infof("逾期检测完成, 待处理计划: %d, 更新: %d", len(plans), updated)
+}
+
+// getPenaltyRate 查询产品罚息日利率
+func (j *OverdueJob) getPenaltyRate(ctx context.Context, productId uint64) (float64, error) {
+	productResp, err := breaker.DoWithBreakerResultAcceptable(ctx, "loanproduct-rpc", func() (*loanproductservice.GetLoanProductResp, error) {
+		return j.svcCtx.LoanProductClient.GetLoanProduct(ctx, &loanproductservice.GetLoanProductReq{
+			Id: int64(productId),
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		return 0, err
+	}
+	if productResp.Data == nil {
+		return 0, nil
+	}
+	return productResp.Data.PenaltyRate, nil
+}
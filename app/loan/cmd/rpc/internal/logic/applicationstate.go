@@ -0,0 +1,129 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"common/statemachine"
+	"model"
+	"rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// 贷款申请在通用审批状态之后的状态与事件
+const (
+	statusDisbursed = "disbursed"
+	statusSettled   = "settled"
+
+	eventDisburse = "disburse"
+	eventSettle   = "settle"
+)
+
+// loanApplicationMachine 贷款申请状态机
+// 在通用审批状态机基础上增加: approved --disburse--> disbursed --settle--> settled
+// 已批准未放款的申请允许撤销,撤销后释放占用的产品放贷额度
+// 审批链已有环节通过后不允许修改申请,避免修改金额后按新金额匹配的审批链跳过已签批的环节
+var loanApplicationMachine = statemachine.NewApplicationMachine("贷款申请").
+	State(statusDisbursed, "已放款").
+	State(statusSettled, "已结清").
+	Permit(statemachine.EventCancel, "撤销", statemachine.StatusCancelled, statemachine.StatusPending, statemachine.StatusApproved).
+	Permit(eventDisburse, "放款", statusDisbursed, statemachine.StatusApproved).
+	Permit(eventSettle, "结清", statusSettled, statusDisbursed).
+	Guard(statemachine.EventUpdate, guardUpdateBeforeReview).
+	Guard(eventSettle, guardSettleOutstanding).
+	OnTransition(logTransition)
+
+// guardUpdateBeforeReview 修改前校验审批链是否已开始,Payload 为已通过的审批环节数
+func guardUpdateBeforeReview(ctx context.Context, t statemachine.Transition) error {
+	if reviewed, ok := t.Payload.(int); ok && reviewed > 0 {
+		return errApplicationReviewed
+	}
+	return nil
+}
+
+// guardSettleOutstanding 结清前校验剩余待还金额,Payload 为剩余待还金额
+func guardSettleOutstanding(ctx context.Context, t statemachine.Transition) error {
+	if outstanding, ok := t.Payload.(float64); ok && outstanding > 0.005 {
+		return fmt.Errorf("申请状态错误，仍有%.2f元未还清，不能结清", outstanding)
+	}
+	return nil
+}
+
+// logTransition 记录申请状态变更
+func logTransition(ctx context.Context, t statemachine.Transition) {
+	logx.WithContext(ctx).Infof("贷款申请状态变更: %s %s -> %s, 事件: %s", t.Key, t.From, t.To, t.Event)
+}
+
+// fireApplicationEvent 触发申请状态迁移并按版本号持久化
+// persist 为空时使用 UpdateWithVersion 更新申请,非空时由调用方在事务中完成持久化
+func fireApplicationEvent(ctx context.Context, svcCtx *svc.ServiceContext, application *model.LoanApplications, event string,
+	payload any, persist func(application *model.LoanApplications) error) error {
+	if persist == nil {
+		persist = func(application *model.LoanApplications) error {
+			return svcCtx.LoanApplicationsModel.UpdateWithVersion(ctx, application)
+		}
+	}
+
+	from, version := application.Status, application.Version
+	_, err := loanApplicationMachine.Fire(ctx, statemachine.Transition{
+		Key:     application.ApplicationId,
+		Event:   event,
+		From:    from,
+		Payload: payload,
+	}, func(t statemachine.Transition) error {
+		application.Status = t.To
+		application.UpdatedAt = time.Now()
+		return persist(application)
+	})
+	if err != nil {
+		application.Status, application.Version = from, version
+		if errors.Is(err, model.ErrVersionConflict) {
+			return errApplicationConflict
+		}
+	}
+	return err
+}
+
+// saveApplicationWithApproval 在同一事务中按版本号更新申请并写入审批记录,事务提交后清理申请缓存
+// approval 为空时仅更新申请
+func saveApplicationWithApproval(ctx context.Context, svcCtx *svc.ServiceContext, approval *model.LoanApprovals) func(application *model.LoanApplications) error {
+	return func(application *model.LoanApplications) error {
+		err := svcCtx.LoanApplicationsModel.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+			if err := svcCtx.LoanApplicationsModel.UpdateWithVersionSession(ctx, session, application); err != nil {
+				return err
+			}
+			if approval == nil {
+				return nil
+			}
+			_, err := svcCtx.LoanApprovalsModel.InsertWithSession(ctx, session, approval)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		return svcCtx.LoanApplicationsModel.DelApplicationCache(ctx, application)
+	}
+}
+
+// checkApplicationEvent 校验申请当前状态是否允许触发事件,用于执行外部操作前提前拒绝非法迁移
+func checkApplicationEvent(application *model.LoanApplications, event string) error {
+	_, err := loanApplicationMachine.Target(application.Status, event)
+	return err
+}
+
+// errApplicationConflict 申请已被并发修改
+var errApplicationConflict = errors.New("申请状态错误，申请已被其他操作修改，请刷新后重试")
+
+// errApplicationReviewed 审批链已有环节通过,申请不能再修改
+var errApplicationReviewed = errors.New("申请状态错误，审批已开始，不能修改申请")
+
+// isApplicationStateError 是否为状态机返回的业务错误(非法迁移、前置校验失败或并发冲突)
+func isApplicationStateError(err error) bool {
+	var transitionErr *statemachine.TransitionError
+	return errors.As(err, &transitionErr) || errors.Is(err, errApplicationConflict) || errors.Is(err, errApplicationReviewed)
+}
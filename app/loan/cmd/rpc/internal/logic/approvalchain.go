@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"common/approval"
+	"loanproductrpc/loanproductservice"
+	"model"
+	"rpc/internal/breaker"
+	"rpc/internal/svc"
+)
+
+// loadApprovalChain 查询产品配置的审批链
+func loadApprovalChain(ctx context.Context, svcCtx *svc.ServiceContext, productId int64) (approval.Chain, error) {
+	productResp, err := breaker.DoWithBreakerResultAcceptable(ctx, "loanproduct-rpc", func() (*loanproductservice.GetLoanProductResp, error) {
+		return svcCtx.LoanProductClient.GetLoanProduct(ctx, &loanproductservice.GetLoanProductReq{
+			Id: productId,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		return approval.Chain{}, fmt.Errorf("查询产品审批链失败: %v", err)
+	}
+	if productResp.Data == nil {
+		return approval.Chain{}, fmt.Errorf("产品不存在")
+	}
+
+	return approval.Parse(productResp.Data.ApprovalChain)
+}
+
+// reviewedStages 统计审批链已通过的环节数
+func reviewedStages(approvals []*model.LoanApprovals) int {
+	var reviewed int
+	for _, item := range approvals {
+		if item.Action == approval.ActionApprove {
+			reviewed++
+		}
+	}
+	return reviewed
+}
+
+// approvalRecords 将审批记录转换为审批链已完成环节
+func approvalRecords(approvals []*model.LoanApprovals) []approval.Record {
+	records := make([]approval.Record, 0, len(approvals))
+	for _, item := range approvals {
+		records = append(records, approval.Record{
+			Stage:     int(item.Stage),
+			Action:    item.Action,
+			AuditorId: int64(item.AuditorId),
+		})
+	}
+	return records
+}
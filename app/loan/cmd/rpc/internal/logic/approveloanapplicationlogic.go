@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"time"
 
+	"common/repayment"
+	"common/statemachine"
 	"model"
 	"rpc/internal/svc"
 	"rpc/loan"
@@ -42,46 +44,45 @@ func (l *ApproveLoanApplicationLogic) ApproveLoanApplication(in *loan.ApproveLoa
 	}
 
 	// 检查申请状态是否可以审批
-	if application.Status != "pending" {
-		return nil, fmt.Errorf("申请状态不允许审批")
+	if err := checkApplicationEvent(application, statemachine.EventReview); err != nil {
+		return nil, err
 	}
 
-	// 开始事务处理
-	// 1. 更新申请状态
-	now := time.Now()
-	newStatus := "approved"
-	if in.Action == "reject" {
-		newStatus = "rejected"
-	}
-
-	updatedApplication := &model.LoanApplications{
-		Id:            application.Id,
-		ApplicationId: application.ApplicationId,
-		UserId:        application.UserId,
-		ApplicantName: application.ApplicantName,
-		ProductId:     application.ProductId,
-		Name:          application.Name,
-		Type:          application.Type,
-		Amount:        application.Amount,
-		Duration:      application.Duration,
-		Purpose:       application.Purpose,
-		Status:        newStatus,
-		CreatedAt:     application.CreatedAt,
-		UpdatedAt:     now,
-	}
-
-	err = l.svcCtx.LoanApplicationsModel.Update(l.ctx, updatedApplication)
+	// 按产品审批链确定本次审批环节
+	chain, err := loadApprovalChain(l.ctx, l.svcCtx, int64(application.ProductId))
 	if err != nil {
-		l.Errorf("更新申请状态失败: %v", err)
-		return nil, fmt.Errorf("审批失败")
+		l.Errorf("加载审批链失败: %v", err)
+		return nil, err
+	}
+
+	approvals, err := l.svcCtx.LoanApprovalsModel.FindByApplicationId(l.ctx, int64(application.Id))
+	if err != nil {
+		l.Errorf("查询审批记录失败: %v", err)
+		return nil, fmt.Errorf("查询审批记录失败")
+	}
+
+	decision, err := chain.Next(application.Amount, approvalRecords(approvals), in.Action, in.AuditorRole, in.AuditorId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 批准利率须符合产品按批准金额、期限适用的利率档位
+	if in.Action == "approve" {
+		if err := checkApprovedRate(l.ctx, l.svcCtx, int64(application.ProductId), in.ApprovedAmount, int(in.ApprovedDuration), in.InterestRate); err != nil {
+			return nil, err
+		}
 	}
 
-	// 2. 创建审批记录
+	// 1. 组装审批记录
+	now := time.Now()
 	approval := &model.LoanApprovals{
 		ApplicationId:    application.Id,
 		AuditorId:        uint64(in.AuditorId),
 		AuditorName:      in.AuditorName,
 		Action:           in.Action,
+		Stage:            uint64(decision.Stage),
+		StageName:        decision.StepName,
+		AuditorRole:      in.AuditorRole,
 		Suggestions:      sql.NullString{String: in.Suggestions, Valid: in.Suggestions != ""},
 		ApprovedAmount:   sql.NullFloat64{Float64: in.ApprovedAmount, Valid: in.ApprovedAmount > 0},
 		ApprovedDuration: sql.NullInt64{Int64: int64(in.ApprovedDuration), Valid: in.ApprovedDuration > 0},
@@ -89,16 +90,69 @@ func (l *ApproveLoanApplicationLogic) ApproveLoanApplication(in *loan.ApproveLoa
 		CreatedAt:        now,
 	}
 
-	_, err = l.svcCtx.LoanApprovalsModel.Insert(l.ctx, approval)
-	if err != nil {
-		l.Errorf("创建审批记录失败: %v", err)
-		return nil, fmt.Errorf("审批记录创建失败")
+	// 2. 审批链结束时迁移申请状态,未结束时申请保持pending等待下一环节
+	// 每个环节均按版本号更新申请并在同一事务中写入审批记录,防止同一环节被并发审批或审批无记录
+	event := statemachine.EventReview
+	if decision.Finished {
+		event = statemachine.EventReject
+		if decision.Status == statemachine.StatusApproved {
+			event = statemachine.EventApprove
+		}
 	}
 
-	// TODO: 如果是批准，可能需要调用其他服务执行后续操作
-	// 比如发送通知等
+	// 最终批准前按批准金额占用产品放贷额度,额度不足时不批准
+	if event == statemachine.EventApprove {
+		if err := reserveProductQuota(l.ctx, l.svcCtx, application, in.ApprovedAmount); err != nil {
+			return nil, err
+		}
+	}
+	if err := fireApplicationEvent(l.ctx, l.svcCtx, application, event, nil, saveApplicationWithApproval(l.ctx, l.svcCtx, approval)); err != nil {
+		l.Errorf("更新申请状态失败: %v", err)
+		if event == statemachine.EventApprove {
+			l.compensateQuota(application)
+		}
+		if isApplicationStateError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("审批失败")
+	}
+
+	// 拒绝时释放可能残留的额度占用
+	if event == statemachine.EventReject {
+		releaseProductQuota(l.ctx, l.svcCtx, application)
+	}
 
-	return &loan.ApproveLoanApplicationResp{}, nil
+	// 3. 审批链最终批准后生成还款计划，起息日为审批日
+	if event == statemachine.EventApprove {
+		err = saveRepaymentSchedule(l.ctx, l.svcCtx, application, in.RepaymentMethod,
+			in.ApprovedAmount, int(in.ApprovedDuration), in.InterestRate, now)
+		if err != nil {
+			// 审批已生效，还款计划可通过 GenerateRepaymentSchedule 重新生成
+			l.Errorf("生成还款计划失败: %v", err)
+		}
+	}
+
+	return &loan.ApproveLoanApplicationResp{
+		Stage:      int32(decision.Stage),
+		StageName:  decision.StepName,
+		TotalStage: int32(decision.TotalStage),
+		Finished:   decision.Finished,
+		Status:     application.Status,
+	}, nil
+}
+
+// compensateQuota 审批未生效时释放已占用的额度
+// 申请已被并发的审批批准时占用属于该审批,不能释放
+func (l *ApproveLoanApplicationLogic) compensateQuota(application *model.LoanApplications) {
+	current, err := l.svcCtx.LoanApplicationsModel.FindOneByApplicationId(l.ctx, application.ApplicationId)
+	if err != nil {
+		l.Errorf("查询申请失败, 额度占用待人工核对, 申请编号: %s, 错误: %v", application.ApplicationId, err)
+		return
+	}
+	if current.Status == statemachine.StatusApproved {
+		return
+	}
+	releaseProductQuota(l.ctx, l.svcCtx, application)
 }
 
 // validateApproveRequest 验证审批请求参数
@@ -115,6 +169,9 @@ func (l *ApproveLoanApplicationLogic) validateApproveRequest(in *loan.ApproveLoa
 	if in.Action != "approve" && in.Action != "reject" {
 		return fmt.Errorf("审批动作必须为approve或reject")
 	}
+	if in.AuditorRole != "" && in.AuditorRole != "admin" && in.AuditorRole != "operator" {
+		return fmt.Errorf("审核员角色必须为admin或operator")
+	}
 	if in.Action == "approve" {
 		if in.ApprovedAmount <= 0 {
 			return fmt.Errorf("批准金额必须大于0")
@@ -125,6 +182,12 @@ func (l *ApproveLoanApplicationLogic) validateApproveRequest(in *loan.ApproveLoa
 		if in.InterestRate < 0 {
 			return fmt.Errorf("利率不能小于0")
 		}
+		if in.RepaymentMethod == "" {
+			in.RepaymentMethod = repayment.MethodEqualInstallment
+		}
+		if !repayment.IsValidMethod(in.RepaymentMethod) {
+			return fmt.Errorf("还款方式必须为equal_installment、equal_principal或interest_only")
+		}
 	}
 	return nil
 }
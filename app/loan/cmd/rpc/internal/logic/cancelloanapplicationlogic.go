@@ -6,11 +6,13 @@ import (
 	"fmt"
 	"time"
 
+	"common/statemachine"
 	"model"
 	"rpc/internal/svc"
 	"rpc/loan"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
 type CancelLoanApplicationLogic struct {
@@ -40,37 +42,10 @@ func (l *CancelLoanApplicationLogic) CancelLoanApplication(in *loan.CancelLoanAp
 		return nil, fmt.Errorf("申请不存在")
 	}
 
-	// 检查申请状态是否可以撤销
-	if application.Status != "pending" {
-		return nil, fmt.Errorf("只有待审批状态的申请才可以撤销")
-	}
-
-	// 更新申请状态为已撤销
-	updatedApplication := &model.LoanApplications{
-		Id:            application.Id,
-		ApplicationId: application.ApplicationId,
-		UserId:        application.UserId,
-		ApplicantName: application.ApplicantName,
-		ProductId:     application.ProductId,
-		Name:          application.Name,
-		Type:          application.Type,
-		Amount:        application.Amount,
-		Duration:      application.Duration,
-		Purpose:       application.Purpose,
-		Status:        "cancelled",
-		CreatedAt:     application.CreatedAt,
-		UpdatedAt:     time.Now(),
-	}
-
-	err = l.svcCtx.LoanApplicationsModel.Update(l.ctx, updatedApplication)
-	if err != nil {
-		l.Errorf("撤销申请失败: %v", err)
-		return nil, fmt.Errorf("撤销申请失败")
-	}
-
-	// 记录撤销原因（可以创建一个审批记录）
+	// 记录撤销原因（创建一个审批记录）,与状态变更在同一事务中写入
+	var approval *model.LoanApprovals
 	if in.Reason != "" {
-		approval := &model.LoanApprovals{
+		approval = &model.LoanApprovals{
 			ApplicationId:    application.Id,
 			AuditorId:        uint64(application.UserId), // 用户自己撤销
 			AuditorName:      application.ApplicantName,
@@ -81,13 +56,56 @@ func (l *CancelLoanApplicationLogic) CancelLoanApplication(in *loan.CancelLoanAp
 			InterestRate:     sql.NullFloat64{Valid: false},
 			CreatedAt:        time.Now(),
 		}
+	}
 
-		_, err = l.svcCtx.LoanApprovalsModel.Insert(l.ctx, approval)
+	// 按状态机将申请迁移为已撤销,同一事务中作废审批时生成的还款计划
+	var rejectErr error
+	err = fireApplicationEvent(l.ctx, l.svcCtx, application, statemachine.EventCancel, nil, func(application *model.LoanApplications) error {
+		var plans []*model.LoanRepaymentPlans
+		err := l.svcCtx.LoanApplicationsModel.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
+			// 先按版本号更新申请以持有申请行锁,与放款占用申请串行
+			if err := l.svcCtx.LoanApplicationsModel.UpdateWithVersionSession(ctx, session, application); err != nil {
+				return err
+			}
+			if _, err := l.svcCtx.LoanDisbursementsModel.FindActiveByApplicationIdWithSession(ctx, session, application.Id); err == nil {
+				rejectErr = fmt.Errorf("状态错误，申请放款处理中，不能撤销")
+				return rejectErr
+			} else if err != model.ErrNotFound {
+				return err
+			}
+			if approval != nil {
+				if _, err := l.svcCtx.LoanApprovalsModel.InsertWithSession(ctx, session, approval); err != nil {
+					return err
+				}
+			}
+
+			deleted, err := l.svcCtx.LoanRepaymentPlansModel.DeleteByApplicationIdWithSession(ctx, session, application.Id)
+			plans = deleted
+			return err
+		})
 		if err != nil {
-			l.Errorf("创建撤销记录失败: %v", err)
-			// 不影响主流程，只记录日志
+			return err
+		}
+
+		// 事务提交后清理缓存
+		if err := l.svcCtx.LoanRepaymentPlansModel.DelPlansCache(l.ctx, plans...); err != nil {
+			l.Errorf("清理还款计划缓存失败: %v", err)
 		}
+		return l.svcCtx.LoanApplicationsModel.DelApplicationCache(l.ctx, application)
+	})
+	if rejectErr != nil {
+		return nil, rejectErr
 	}
+	if err != nil {
+		l.Errorf("撤销申请失败: %v", err)
+		if isApplicationStateError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("撤销申请失败")
+	}
+
+	// 已批准未放款的申请撤销后释放占用的产品放贷额度
+	releaseProductQuota(l.ctx, l.svcCtx, application)
 
 	return &loan.CancelLoanApplicationResp{}, nil
 }
@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"rpc/internal/svc"
+	"rpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// productActiveStatuses 引用产品的进行中申请状态,已放款未结清的贷款仍需按产品条款还款
+const productActiveStatuses = "'pending','approved','disbursed'"
+
+type CountProductApplicationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCountProductApplicationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CountProductApplicationsLogic {
+	return &CountProductApplicationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 产品引用检查
+func (l *CountProductApplicationsLogic) CountProductApplications(in *loan.CountProductApplicationsReq) (*loan.CountProductApplicationsResp, error) {
+	// 参数验证
+	if in.ProductId <= 0 {
+		return nil, fmt.Errorf("产品ID不能为空")
+	}
+
+	total, err := l.svcCtx.LoanApplicationsModel.CountWithConditions(l.ctx, "WHERE product_id = ?", []interface{}{in.ProductId})
+	if err != nil {
+		l.Errorf("统计产品申请失败: %v", err)
+		return nil, fmt.Errorf("统计产品申请失败")
+	}
+
+	active, err := l.svcCtx.LoanApplicationsModel.CountWithConditions(l.ctx,
+		"WHERE product_id = ? AND status IN ("+productActiveStatuses+")", []interface{}{in.ProductId})
+	if err != nil {
+		l.Errorf("统计产品进行中申请失败: %v", err)
+		return nil, fmt.Errorf("统计产品申请失败")
+	}
+
+	return &loan.CountProductApplicationsResp{
+		Active: active,
+		Total:  total,
+	}, nil
+}
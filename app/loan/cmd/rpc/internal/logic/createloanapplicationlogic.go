@@ -3,13 +3,16 @@ package logic
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
 	"appuserrpc/appuserclient"
+	"common/statemachine"
 	"loanproductrpc/loanproductservice"
 	"model"
 	"rpc/internal/breaker"
+	"rpc/internal/pkg/idempotency"
 	"rpc/internal/svc"
 	"rpc/loan"
 
@@ -38,6 +41,49 @@ func (l *CreateLoanApplicationLogic) CreateLoanApplication(in *loan.CreateLoanAp
 		return nil, err
 	}
 
+	if in.IdempotencyKey == "" {
+		return l.createApplication(in)
+	}
+
+	// 幂等校验: 相同幂等键的重复提交直接返回首次创建的申请编号
+	applicationId, err := l.findIdempotentApplication(in.UserId, in.IdempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	if applicationId != "" {
+		l.Infof("幂等键重复提交，返回已创建的申请 - 用户ID: %d, 申请编号: %s", in.UserId, applicationId)
+		return &loan.CreateLoanApplicationResp{
+			ApplicationId: applicationId,
+		}, nil
+	}
+
+	// 处理期间占用幂等键,Redis不可用时由数据库唯一索引保证幂等
+	acquired, err := l.svcCtx.Idempotency.Acquire(l.ctx, in.UserId, in.IdempotencyKey)
+	if err != nil {
+		l.Errorf("占用幂等键失败: %v", err)
+	} else if !acquired {
+		return nil, idempotency.ErrProcessing
+	}
+
+	// 创建失败或未通过准入校验时释放幂等键,允许修改后重新提交
+	resp, err := l.createApplication(in)
+	if err != nil || resp.Rejected {
+		if acquired {
+			if err := l.svcCtx.Idempotency.Release(l.ctx, in.UserId, in.IdempotencyKey); err != nil {
+				l.Errorf("释放幂等键失败: %v", err)
+			}
+		}
+		return resp, err
+	}
+
+	if err := l.svcCtx.Idempotency.Save(l.ctx, in.UserId, in.IdempotencyKey, resp.ApplicationId); err != nil {
+		l.Errorf("记录幂等键失败: %v", err)
+	}
+	return resp, nil
+}
+
+// createApplication 校验用户与产品并创建申请
+func (l *CreateLoanApplicationLogic) createApplication(in *loan.CreateLoanApplicationReq) (*loan.CreateLoanApplicationResp, error) {
 	// 1. 使用熔断器调用AppUser RPC验证用户信息并获取用户姓名
 	userResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "appuser-rpc", func() (*appuserclient.GetUserInfoResp, error) {
 		return l.svcCtx.AppUserClient.GetUserById(l.ctx, &appuserclient.GetUserByIdReq{
@@ -65,6 +111,9 @@ func (l *CreateLoanApplicationLogic) CreateLoanApplication(in *loan.CreateLoanAp
 
 	if err != nil {
 		l.Errorf("调用LoanProduct服务失败: %v", err)
+		if breaker.IsAcceptableError(err) {
+			return nil, fmt.Errorf("产品不存在或已删除")
+		}
 		return nil, fmt.Errorf("产品信息验证失败，请稍后重试")
 	}
 
@@ -74,6 +123,11 @@ func (l *CreateLoanApplicationLogic) CreateLoanApplication(in *loan.CreateLoanAp
 
 	product := productResp.Data
 
+	// 已下架的产品不再受理新申请
+	if product.Status != 1 {
+		return nil, fmt.Errorf("状态错误，产品已下架，暂不受理申请")
+	}
+
 	// 3. 验证申请金额是否在产品限额内
 	if in.Amount < product.MinAmount || in.Amount > product.MaxAmount {
 		return nil, fmt.Errorf("申请金额应在%.2f到%.2f之间", product.MinAmount, product.MaxAmount)
@@ -84,31 +138,85 @@ func (l *CreateLoanApplicationLogic) CreateLoanApplication(in *loan.CreateLoanAp
 		return nil, fmt.Errorf("申请期限应在%d到%d个月之间", product.MinDuration, product.MaxDuration)
 	}
 
-	// 5. 生成申请ID
+	// 5. 校验月供收入比和在途申请数量
+	reasons, err := checkEligibility(l.ctx, l.svcCtx, in, userResp.UserInfo, product)
+	if err != nil {
+		l.Errorf("申请准入校验失败: %v", err)
+		return nil, fmt.Errorf("创建申请失败，请稍后重试")
+	}
+	if len(reasons) > 0 {
+		l.Infof("贷款申请未通过准入校验 - 用户ID: %d, 产品ID: %d, 原因数: %d", in.UserId, in.ProductId, len(reasons))
+		return &loan.CreateLoanApplicationResp{
+			Rejected:      true,
+			RejectReasons: reasons,
+		}, nil
+	}
+
+	// 6. 试算综合年化利率,随产品费用一并冻结到申请中
+	apr, err := estimateAPR(in.Amount, int(in.Duration), product, productFees(product))
+	if err != nil {
+		l.Errorf("试算综合年化利率失败: %v", err)
+		return nil, fmt.Errorf("参数错误，%v", err)
+	}
+
+	// 7. 记录产品条款快照,产品后续修改不影响本次申请
+	now := time.Now()
+	snapshot, err := encodeProductSnapshot(product, now)
+	if err != nil {
+		l.Errorf("记录产品条款快照失败: %v", err)
+		return nil, fmt.Errorf("创建申请失败，请稍后重试")
+	}
+
+	// 8. 生成申请ID
 	applicationId := l.generateApplicationId()
 
-	// 6. 创建贷款申请记录
+	// 9. 创建贷款申请记录
 	application := &model.LoanApplications{
-		ApplicationId: applicationId,
-		UserId:        uint64(in.UserId),
-		ApplicantName: applicantName,
-		ProductId:     uint64(in.ProductId),
-		Name:          in.Name,
-		Type:          in.Type,
-		Amount:        in.Amount,
-		Duration:      uint64(in.Duration),
-		Purpose:       sql.NullString{String: in.Purpose, Valid: in.Purpose != ""},
-		Status:        "pending", // 待审核
-		CreatedAt:     time.Now(),
-		UpdatedAt:     time.Now(),
-	}
-
-	_, err = l.svcCtx.LoanApplicationsModel.Insert(l.ctx, application)
+		ApplicationId:      applicationId,
+		UserId:             uint64(in.UserId),
+		ApplicantName:      applicantName,
+		ProductId:          uint64(in.ProductId),
+		Name:               in.Name,
+		Type:               in.Type,
+		Amount:             in.Amount,
+		Duration:           uint64(in.Duration),
+		Purpose:            sql.NullString{String: in.Purpose, Valid: in.Purpose != ""},
+		OriginationFeeRate: product.OriginationFeeRate,
+		ServiceFeeRate:     product.ServiceFeeRate,
+		GuaranteeFeeRate:   product.GuaranteeFeeRate,
+		LateFee:            product.LateFee,
+		Apr:                apr,
+		ProductSnapshot:    snapshot,
+		Status:             statemachine.StatusPending, // 待审核
+		IdempotencyKey:     sql.NullString{String: in.IdempotencyKey, Valid: in.IdempotencyKey != ""},
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+
+	result, err := l.svcCtx.LoanApplicationsModel.Insert(l.ctx, application)
 	if err != nil {
+		// 并发提交相同幂等键时唯一索引冲突,返回先创建的申请
+		if in.IdempotencyKey != "" {
+			if existing, findErr := l.svcCtx.LoanApplicationsModel.FindOneByUserIdIdempotencyKey(l.ctx, uint64(in.UserId), application.IdempotencyKey); findErr == nil {
+				return &loan.CreateLoanApplicationResp{
+					ApplicationId: existing.ApplicationId,
+				}, nil
+			}
+		}
 		l.Errorf("创建贷款申请失败: %v", err)
 		return nil, fmt.Errorf("创建申请失败，请稍后重试")
 	}
 
+	// 10. 计算信用评分供审核参考,评分失败不影响申请提交
+	if id, err := result.LastInsertId(); err != nil {
+		l.Errorf("获取申请ID失败: %v", err)
+	} else {
+		application.Id = uint64(id)
+		if err := scoreApplication(l.ctx, l.svcCtx, application, userResp.UserInfo, product); err != nil {
+			l.Errorf("计算信用评分失败: %v", err)
+		}
+	}
+
 	return &loan.CreateLoanApplicationResp{
 		ApplicationId: applicationId,
 	}, nil
@@ -119,6 +227,9 @@ func (l *CreateLoanApplicationLogic) validateCreateRequest(in *loan.CreateLoanAp
 	if in.UserId <= 0 {
 		return fmt.Errorf("用户ID无效")
 	}
+	if len(in.IdempotencyKey) > idempotency.MaxKeyLength {
+		return fmt.Errorf("参数错误，幂等键长度不能超过%d个字符", idempotency.MaxKeyLength)
+	}
 	if in.ProductId <= 0 {
 		return fmt.Errorf("产品ID无效")
 	}
@@ -148,3 +259,28 @@ func (l *CreateLoanApplicationLogic) generateApplicationId() string {
 	randomStr := stringx.Randn(6)
 	return fmt.Sprintf("LOAN%s%s", dateStr, randomStr)
 }
+
+// findIdempotentApplication 查询幂等键对应的申请编号,未提交过时返回空字符串
+func (l *CreateLoanApplicationLogic) findIdempotentApplication(userId int64, key string) (string, error) {
+	applicationId, err := l.svcCtx.Idempotency.Get(l.ctx, userId, key)
+	if errors.Is(err, idempotency.ErrProcessing) {
+		return "", err
+	}
+	if err != nil {
+		l.Errorf("查询幂等键失败: %v", err)
+	}
+	if applicationId != "" {
+		return applicationId, nil
+	}
+
+	// Redis未命中(结果过期或Redis不可用)时以数据库为准
+	application, err := l.svcCtx.LoanApplicationsModel.FindOneByUserIdIdempotencyKey(l.ctx, uint64(userId), sql.NullString{String: key, Valid: true})
+	if err == model.ErrNotFound {
+		return "", nil
+	}
+	if err != nil {
+		l.Errorf("查询幂等申请失败: %v", err)
+		return "", fmt.Errorf("创建申请失败，请稍后重试")
+	}
+	return application.ApplicationId, nil
+}
@@ -0,0 +1,109 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"appuserrpc/appuserclient"
+	"loanproductrpc/loanproductservice"
+	"model"
+	"rpc/internal/breaker"
+	"rpc/internal/pkg/creditscore"
+	"rpc/internal/svc"
+	"rpc/loan"
+)
+
+// scoreApplication 按评分规则计算申请信用评分并保存
+func scoreApplication(ctx context.Context, svcCtx *svc.ServiceContext, application *model.LoanApplications,
+	user *appuserclient.UserInfo, product *loanproductservice.LoanProductInfo) error {
+	result := svcCtx.CreditScorer.Evaluate(creditscore.Input{
+		Income:     user.Income,
+		Age:        int(user.Age),
+		Occupation: user.Occupation,
+		Amount:     application.Amount,
+		Duration:   int(application.Duration),
+		MaxAmount:  product.MaxAmount,
+	})
+
+	explanation, err := json.Marshal(result.Items)
+	if err != nil {
+		return err
+	}
+
+	return svcCtx.LoanCreditScoresModel.Save(ctx, &model.LoanCreditScores{
+		ApplicationId:  application.Id,
+		Score:          int64(result.Score),
+		Grade:          result.Grade,
+		Recommendation: result.Recommendation,
+		Explanation:    sql.NullString{String: string(explanation), Valid: true},
+		RuleVersion:    result.Version,
+	})
+}
+
+// rescoreApplication 重新获取申请人和产品信息后计算申请信用评分
+func rescoreApplication(ctx context.Context, svcCtx *svc.ServiceContext, application *model.LoanApplications) error {
+	userResp, err := breaker.DoWithBreakerResultAcceptable(ctx, "appuser-rpc", func() (*appuserclient.GetUserInfoResp, error) {
+		return svcCtx.AppUserClient.GetUserById(ctx, &appuserclient.GetUserByIdReq{
+			UserId: int64(application.UserId),
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		return fmt.Errorf("调用AppUser服务失败: %w", err)
+	}
+	if userResp.UserInfo == nil {
+		return fmt.Errorf("用户信息不存在")
+	}
+
+	productResp, err := breaker.DoWithBreakerResultAcceptable(ctx, "loanproduct-rpc", func() (*loanproductservice.GetLoanProductResp, error) {
+		return svcCtx.LoanProductClient.GetLoanProduct(ctx, &loanproductservice.GetLoanProductReq{
+			Id: int64(application.ProductId),
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		return fmt.Errorf("调用LoanProduct服务失败: %w", err)
+	}
+	if productResp.Data == nil {
+		return fmt.Errorf("产品不存在")
+	}
+
+	return scoreApplication(ctx, svcCtx, application, userResp.UserInfo, productResp.Data)
+}
+
+// findCreditScore 查询申请信用评分,未评分时返回空
+func findCreditScore(ctx context.Context, svcCtx *svc.ServiceContext, applicationId uint64) (*loan.CreditScoreInfo, error) {
+	score, err := svcCtx.LoanCreditScoresModel.FindOneByApplicationId(ctx, applicationId)
+	if err == model.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var items []creditscore.Item
+	if score.Explanation.Valid && score.Explanation.String != "" {
+		if err := json.Unmarshal([]byte(score.Explanation.String), &items); err != nil {
+			return nil, err
+		}
+	}
+
+	info := &loan.CreditScoreInfo{
+		Score:          int32(score.Score),
+		Grade:          score.Grade,
+		Recommendation: score.Recommendation,
+		RuleVersion:    score.RuleVersion,
+		ScoredAt:       score.UpdatedAt.Unix(),
+		Items:          make([]*loan.CreditScoreItem, 0, len(items)),
+	}
+	for _, item := range items {
+		info.Items = append(info.Items, &loan.CreditScoreItem{
+			Rule:   item.Rule,
+			Factor: item.Factor,
+			Value:  item.Value,
+			Points: item.Points,
+			Reason: item.Reason,
+		})
+	}
+	return info, nil
+}
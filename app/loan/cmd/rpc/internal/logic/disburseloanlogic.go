@@ -0,0 +1,324 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"common/repayment"
+	"model"
+	"rpc/internal/pkg/disburser"
+	"rpc/internal/svc"
+	"rpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+// 放款记录状态
+const (
+	disbursementStatusPending = "pending" // 已占用申请,等待银行核心处理
+	disbursementStatusUnknown = "unknown" // 银行核心调用异常,出款结果待核对
+	disbursementStatusSuccess = "success"
+	disbursementStatusFailed  = "failed"
+)
+
+// disbursementPendingTimeout 在途放款超过该时长仍未更新,视为处理中断,按结果未知向银行核心核对
+const disbursementPendingTimeout = 5 * time.Minute
+
+type DisburseLoanLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDisburseLoanLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DisburseLoanLogic {
+	return &DisburseLoanLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 放款管理
+func (l *DisburseLoanLogic) DisburseLoan(in *loan.DisburseLoanReq) (*loan.DisburseLoanResp, error) {
+	// 参数验证
+	if err := l.validateDisburseRequest(in); err != nil {
+		return nil, err
+	}
+
+	// 查询申请信息
+	application, err := l.svcCtx.LoanApplicationsModel.FindOneByApplicationId(l.ctx, in.ApplicationId)
+	if err != nil {
+		l.Errorf("查询申请失败: %v", err)
+		return nil, fmt.Errorf("申请不存在")
+	}
+
+	if err := checkApplicationEvent(application, eventDisburse); err != nil {
+		return nil, err
+	}
+
+	// 放款金额以批准金额扣除一次性收取的手续费、担保费为准
+	approval, err := findLatestApproval(l.ctx, l.svcCtx, application.Id)
+	if err != nil {
+		l.Errorf("查询批准记录失败: %v", err)
+		return nil, err
+	}
+
+	// 1. 核对申请占用中的放款记录,确认未出款前不再发起新的放款
+	disbursement, err := l.resolveActiveDisbursement(application)
+	if err != nil {
+		return nil, err
+	}
+	if disbursement == nil {
+		// 2. 创建放款记录并占用申请,同一申请只能有一笔在途或成功的放款
+		disbursement, err = l.createDisbursement(in, application, approval)
+		if err != nil {
+			return nil, err
+		}
+
+		// 3. 调用银行核心出款
+		disbursement, err = l.executeDisbursement(disbursement)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 4. 更新申请状态为已放款
+	if err := fireApplicationEvent(l.ctx, l.svcCtx, application, eventDisburse, nil, nil); err != nil {
+		l.Errorf("更新申请状态失败: %v", err)
+		return nil, fmt.Errorf("放款成功但更新申请状态失败")
+	}
+
+	// 5. 以放款日为起息日重新生成还款计划
+	l.rebaseRepaymentSchedule(application, approval, disbursement.DisbursedAt.Time)
+
+	disbursement, err = l.svcCtx.LoanDisbursementsModel.FindOne(l.ctx, disbursement.Id)
+	if err != nil {
+		l.Errorf("查询放款记录失败: %v", err)
+		return nil, fmt.Errorf("放款成功但查询失败")
+	}
+
+	return &loan.DisburseLoanResp{
+		DisbursementInfo: &loan.LoanDisbursementInfo{
+			Id:             int64(disbursement.Id),
+			DisbursementNo: disbursement.DisbursementNo,
+			ApplicationId:  int64(disbursement.ApplicationId),
+			Amount:         disbursement.Amount,
+			AccountName:    disbursement.AccountName,
+			AccountNo:      disbursement.AccountNo,
+			BankName:       disbursement.BankName,
+			Channel:        disbursement.Channel,
+			BankSerialNo:   disbursement.BankSerialNo,
+			Status:         disbursement.Status,
+			FailReason:     disbursement.FailReason,
+			OperatorId:     int64(disbursement.OperatorId),
+			OperatorName:   disbursement.OperatorName,
+			DisbursedAt:    disbursement.DisbursedAt.Time.Unix(),
+			CreatedAt:      disbursement.CreatedAt.Unix(),
+		},
+	}, nil
+}
+
+// resolveActiveDisbursement 核对申请占用中的放款记录,返回已成功的放款;
+// 无占用或银行核心确认未出款时返回 nil,允许重新放款
+func (l *DisburseLoanLogic) resolveActiveDisbursement(application *model.LoanApplications) (*model.LoanDisbursements, error) {
+	active, err := l.svcCtx.LoanDisbursementsModel.FindActiveByApplicationId(l.ctx, application.Id)
+	if err == model.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		l.Errorf("查询放款记录失败: %v", err)
+		return nil, fmt.Errorf("查询放款记录失败")
+	}
+
+	switch active.Status {
+	case disbursementStatusSuccess:
+		// 上次放款已成功但申请状态未更新,继续完成后续处理
+		l.Infof("申请已放款成功, 补全申请状态: applicationId=%s, disbursementNo=%s", application.ApplicationId, active.DisbursementNo)
+		return active, nil
+	case disbursementStatusPending:
+		if time.Since(active.UpdatedAt) < disbursementPendingTimeout {
+			return nil, fmt.Errorf("申请正在放款中，请勿重复提交")
+		}
+	}
+
+	// 结果未知或在途超时的放款,先向银行核心核对结果
+	result, err := l.svcCtx.Disburser.Query(l.ctx, active.DisbursementNo)
+	if err != nil {
+		l.Errorf("查询银行核心放款结果失败: %v", err)
+		return nil, fmt.Errorf("放款结果待确认，请稍后重试")
+	}
+	active, err = l.settleDisbursement(active, result)
+	if err != nil {
+		return nil, err
+	}
+	if active.Status == disbursementStatusSuccess {
+		return active, nil
+	}
+
+	l.Infof("银行核心确认未出款, 允许重新放款: applicationId=%s, disbursementNo=%s", application.ApplicationId, active.DisbursementNo)
+	return nil, nil
+}
+
+// createDisbursement 创建在途放款记录并占用申请,并发提交时仅一笔能创建成功;
+// 锁定申请后校验状态再写入,与撤销申请在申请行锁上串行,已撤销的申请不会出款
+func (l *DisburseLoanLogic) createDisbursement(in *loan.DisburseLoanReq, application *model.LoanApplications, approval *model.LoanApprovals) (*model.LoanDisbursements, error) {
+	disbursement := &model.LoanDisbursements{
+		DisbursementNo:      fmt.Sprintf("DISB%s%s", time.Now().Format("20060102"), stringx.Randn(6)),
+		ApplicationId:       application.Id,
+		ActiveApplicationId: sql.NullInt64{Int64: int64(application.Id), Valid: true},
+		Amount:              disbursedAmount(application, approval),
+		AccountName:         in.AccountName,
+		AccountNo:           in.AccountNo,
+		BankName:            in.BankName,
+		Channel:             l.svcCtx.Disburser.Channel(),
+		Status:              disbursementStatusPending,
+		OperatorId:          uint64(in.OperatorId),
+		OperatorName:        in.OperatorName,
+	}
+
+	var rejectErr error
+	err := l.svcCtx.LoanApplicationsModel.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
+		locked, err := l.svcCtx.LoanApplicationsModel.FindOneForUpdateWithSession(ctx, session, application.Id)
+		if err != nil {
+			return err
+		}
+		if err := checkApplicationEvent(locked, eventDisburse); err != nil {
+			rejectErr = err
+			return err
+		}
+
+		result, err := l.svcCtx.LoanDisbursementsModel.InsertWithSession(ctx, session, disbursement)
+		if err != nil {
+			return err
+		}
+		disbursementId, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		disbursement.Id = uint64(disbursementId)
+		return nil
+	})
+	if rejectErr != nil {
+		return nil, rejectErr
+	}
+	if err != nil {
+		// 占用冲突说明其他请求已发起放款
+		if _, findErr := l.svcCtx.LoanDisbursementsModel.FindActiveByApplicationId(l.ctx, application.Id); findErr == nil {
+			return nil, fmt.Errorf("申请正在放款或已放款，请勿重复提交")
+		}
+		l.Errorf("创建放款记录失败: %v", err)
+		return nil, fmt.Errorf("创建放款记录失败")
+	}
+
+	return disbursement, nil
+}
+
+// disbursedAmount 计算实际出款金额: 批准金额扣除放款时一次性收取的手续费、担保费,与披露的到手金额一致
+func disbursedAmount(application *model.LoanApplications, approval *model.LoanApprovals) float64 {
+	amount := repayment.Round2(approval.ApprovedAmount.Float64)
+	origination, guarantee := applicationFees(application).Upfront(amount)
+	return repayment.Round2(amount - origination - guarantee)
+}
+
+// executeDisbursement 调用银行核心出款并记录结果,调用异常时保留占用等待核对
+func (l *DisburseLoanLogic) executeDisbursement(disbursement *model.LoanDisbursements) (*model.LoanDisbursements, error) {
+	result, err := l.svcCtx.Disburser.Disburse(l.ctx, &disburser.Request{
+		DisbursementNo: disbursement.DisbursementNo,
+		Amount:         disbursement.Amount,
+		AccountName:    disbursement.AccountName,
+		AccountNo:      disbursement.AccountNo,
+		BankName:       disbursement.BankName,
+	})
+	if err != nil {
+		// 结果未知时不能视为失败,否则重试可能重复出款
+		l.Errorf("调用银行核心放款失败: %v", err)
+		disbursement.Status = disbursementStatusUnknown
+		disbursement.FailReason = "银行核心调用异常，结果待核对"
+		if _, err := l.svcCtx.LoanDisbursementsModel.UpdateIfStatus(l.ctx, disbursement, disbursementStatusPending); err != nil {
+			l.Errorf("更新放款记录失败: %v", err)
+		}
+		return nil, fmt.Errorf("放款结果未知，请稍后重试确认")
+	}
+
+	disbursement, err = l.settleDisbursement(disbursement, result)
+	if err != nil {
+		return nil, err
+	}
+	if disbursement.Status != disbursementStatusSuccess {
+		return nil, fmt.Errorf("放款失败: %s", result.Message)
+	}
+	return disbursement, nil
+}
+
+// settleDisbursement 按银行核心的确定结果更新放款记录,失败时释放占用以允许重新放款
+func (l *DisburseLoanLogic) settleDisbursement(disbursement *model.LoanDisbursements, result *disburser.Result) (*model.LoanDisbursements, error) {
+	from := disbursement.Status
+	if result.Success {
+		disbursement.Status = disbursementStatusSuccess
+		disbursement.BankSerialNo = result.SerialNo
+		disbursement.FailReason = ""
+		disbursement.DisbursedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	} else {
+		disbursement.Status = disbursementStatusFailed
+		disbursement.FailReason = result.Message
+		disbursement.ActiveApplicationId = sql.NullInt64{}
+	}
+
+	ok, err := l.svcCtx.LoanDisbursementsModel.UpdateIfStatus(l.ctx, disbursement, from)
+	if err != nil {
+		l.Errorf("更新放款记录失败: %v", err)
+		if result.Success {
+			return nil, fmt.Errorf("放款成功但更新放款记录失败")
+		}
+		return nil, fmt.Errorf("更新放款记录失败")
+	}
+	if !ok {
+		return nil, fmt.Errorf("放款记录状态已变化，请刷新后重试")
+	}
+	return disbursement, nil
+}
+
+// rebaseRepaymentSchedule 以放款日为起息日重新生成还款计划,沿用审批时选择的还款方式
+func (l *DisburseLoanLogic) rebaseRepaymentSchedule(application *model.LoanApplications, approval *model.LoanApprovals, start time.Time) {
+	method := repayment.MethodEqualInstallment
+	plans, err := l.svcCtx.LoanRepaymentPlansModel.FindByApplicationId(l.ctx, application.Id)
+	if err != nil {
+		l.Errorf("查询还款计划失败: %v", err)
+	} else if len(plans) > 0 && repayment.IsValidMethod(plans[0].RepaymentMethod) {
+		method = plans[0].RepaymentMethod
+	}
+
+	err = saveRepaymentSchedule(l.ctx, l.svcCtx, application, method,
+		approval.ApprovedAmount.Float64, int(approval.ApprovedDuration.Int64), approval.InterestRate.Float64, start)
+	if err != nil {
+		// 放款已生效，还款计划可通过 GenerateRepaymentSchedule 重新生成
+		l.Errorf("生成还款计划失败: %v", err)
+	}
+}
+
+// validateDisburseRequest 验证放款请求参数
+func (l *DisburseLoanLogic) validateDisburseRequest(in *loan.DisburseLoanReq) error {
+	if in.ApplicationId == "" {
+		return fmt.Errorf("申请编号不能为空")
+	}
+	if in.OperatorId <= 0 {
+		return fmt.Errorf("操作员ID不能为空")
+	}
+	if in.OperatorName == "" {
+		return fmt.Errorf("操作员姓名不能为空")
+	}
+	if in.AccountName == "" {
+		return fmt.Errorf("收款户名不能为空")
+	}
+	if in.AccountNo == "" {
+		return fmt.Errorf("收款账号不能为空")
+	}
+	if in.BankName == "" {
+		return fmt.Errorf("开户行不能为空")
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"appuserrpc/appuserclient"
+	"common/eligibility"
+	"common/repayment"
+	"loanproductrpc/loanproductservice"
+	"rpc/internal/svc"
+	"rpc/loan"
+)
+
+// 准入校验未通过原因
+const (
+	rejectIncomeMissing    = "income_missing"     // 未填写月收入
+	rejectDebtToIncome     = "debt_to_income"     // 月供收入比超限
+	rejectActivePerUser    = "active_per_user"    // 用户在途申请数超限
+	rejectActivePerProduct = "active_per_product" // 用户同一产品在途申请数超限
+)
+
+// activeApplicationStatuses 在途申请状态,已放款未结清的贷款仍占用还款能力,与 productActiveStatuses 保持一致
+const activeApplicationStatuses = "'pending','approved','disbursed'"
+
+// checkEligibility 校验申请准入条件及产品准入规则,返回全部未通过原因,全部通过时返回空
+func checkEligibility(ctx context.Context, svcCtx *svc.ServiceContext, in *loan.CreateLoanApplicationReq,
+	user *appuserclient.UserInfo, product *loanproductservice.LoanProductInfo) ([]*loan.EligibilityReason, error) {
+	limits := svcCtx.Config.Eligibility
+	var reasons []*loan.EligibilityReason
+
+	// 1. 月供收入比: (本次申请月均还款额 + 现有贷款月均还款额) / 月收入
+	if limits.MaxDebtToIncome > 0 {
+		newPayment, err := estimateMonthlyPayment(in.Amount, int(in.Duration), product)
+		if err != nil {
+			return nil, err
+		}
+		existingPayment, err := existingMonthlyPayment(ctx, svcCtx, uint64(in.UserId), time.Now())
+		if err != nil {
+			return nil, err
+		}
+		payment := repayment.Round2(newPayment + existingPayment)
+		if user.Income <= 0 {
+			reasons = append(reasons, &loan.EligibilityReason{
+				Code:    rejectIncomeMissing,
+				Message: "未填写月收入，无法评估还款能力，请先完善个人信息",
+				Limit:   limits.MaxDebtToIncome,
+			})
+		} else if ratio := repayment.Round2(payment / user.Income); ratio > limits.MaxDebtToIncome {
+			reasons = append(reasons, &loan.EligibilityReason{
+				Code: rejectDebtToIncome,
+				Message: fmt.Sprintf("预计月均还款%.2f元(其中现有贷款%.2f元)，占月收入%.2f元的%.0f%%，超过%.0f%%的上限，请降低申请金额或延长期限",
+					payment, existingPayment, user.Income, ratio*100, limits.MaxDebtToIncome*100),
+				Limit:  limits.MaxDebtToIncome,
+				Actual: ratio,
+			})
+		}
+	}
+
+	// 2. 用户在途申请数
+	if limits.MaxActivePerUser > 0 {
+		count, err := svcCtx.LoanApplicationsModel.CountWithConditions(ctx,
+			"WHERE user_id = ? AND status IN ("+activeApplicationStatuses+")", []interface{}{in.UserId})
+		if err != nil {
+			return nil, fmt.Errorf("统计在途申请失败: %v", err)
+		}
+		if count >= int64(limits.MaxActivePerUser) {
+			reasons = append(reasons, &loan.EligibilityReason{
+				Code:    rejectActivePerUser,
+				Message: fmt.Sprintf("您已有%d笔待审批、已批准或未结清的贷款，最多同时办理%d笔", count, limits.MaxActivePerUser),
+				Limit:   float64(limits.MaxActivePerUser),
+				Actual:  float64(count),
+			})
+		}
+	}
+
+	// 3. 用户同一产品在途申请数
+	if limits.MaxActivePerProduct > 0 {
+		count, err := svcCtx.LoanApplicationsModel.CountWithConditions(ctx,
+			"WHERE user_id = ? AND product_id = ? AND status IN ("+activeApplicationStatuses+")", []interface{}{in.UserId, in.ProductId})
+		if err != nil {
+			return nil, fmt.Errorf("统计在途申请失败: %v", err)
+		}
+		if count >= int64(limits.MaxActivePerProduct) {
+			reasons = append(reasons, &loan.EligibilityReason{
+				Code:    rejectActivePerProduct,
+				Message: fmt.Sprintf("您在该产品下已有%d笔待审批、已批准或未结清的贷款，同一产品最多同时办理%d笔", count, limits.MaxActivePerProduct),
+				Limit:   float64(limits.MaxActivePerProduct),
+				Actual:  float64(count),
+			})
+		}
+	}
+
+	// 4. 产品准入规则: 职业、年龄区间与最低月收入
+	rule, err := eligibility.Parse(product.EligibilityRule)
+	if err != nil {
+		return nil, fmt.Errorf("解析产品准入规则失败: %v", err)
+	}
+	applicant := eligibility.Applicant{
+		Age:        int(user.Age),
+		Occupation: user.Occupation,
+		Income:     user.Income,
+	}
+	for _, reason := range rule.Check(applicant) {
+		reasons = append(reasons, &loan.EligibilityReason{
+			Code:    reason.Code,
+			Message: reason.Message,
+			Limit:   reason.Limit,
+			Actual:  reason.Actual,
+		})
+	}
+
+	return reasons, nil
+}
+
+// estimateMonthlyPayment 按产品利率和还款模式估算月均还款额(还款总额 / 贷款期限)
+// 申请时尚未确定还款方式,按等额本息估算;季节性产品按收获季计划的还款总额平均到每月
+// 产品配置利率档位时按申请金额、期限适用档位的挂牌利率估算
+func estimateMonthlyPayment(amount float64, months int, product *loanproductservice.LoanProductInfo) (float64, error) {
+	rate, err := productInterestRate(product, amount, months)
+	if err != nil {
+		return 0, err
+	}
+	_, plans, err := repayment.Build(repayment.MethodEqualInstallment, amount, rate, months, time.Now(), productRepaymentProfile(product))
+	if err != nil {
+		return 0, fmt.Errorf("估算月还款额失败: %v", err)
+	}
+
+	var total float64
+	for _, plan := range plans {
+		total += plan.Total
+	}
+	return repayment.Round2(total / float64(months)), nil
+}
+
+// existingMonthlyPayment 估算用户现有贷款(已批准或已放款未结清)的月均还款额
+// 每笔贷款按未结清期数的剩余应还金额平均到剩余月数,与 estimateMonthlyPayment 口径一致
+func existingMonthlyPayment(ctx context.Context, svcCtx *svc.ServiceContext, userId uint64, asOf time.Time) (float64, error) {
+	plans, err := svcCtx.LoanRepaymentPlansModel.FindUnpaidByUserId(ctx, userId)
+	if err != nil {
+		return 0, fmt.Errorf("查询现有贷款还款计划失败: %v", err)
+	}
+
+	outstanding := make(map[uint64]float64)
+	lastDue := make(map[uint64]time.Time)
+	for _, plan := range plans {
+		outstanding[plan.ApplicationId] += outstandingAmount(plan)
+		if plan.DueDate.After(lastDue[plan.ApplicationId]) {
+			lastDue[plan.ApplicationId] = plan.DueDate
+		}
+	}
+
+	var total float64
+	for applicationId, amount := range outstanding {
+		total += amount / float64(remainingMonths(asOf, lastDue[applicationId]))
+	}
+	return repayment.Round2(total), nil
+}
+
+// remainingMonths 计算自 asOf 至最后一期应还日的剩余月数,不足一个月按一个月计
+func remainingMonths(asOf, lastDue time.Time) int {
+	months := (lastDue.Year()-asOf.Year())*12 + int(lastDue.Month()-asOf.Month())
+	if lastDue.Day() > asOf.Day() {
+		months++
+	}
+	if months < 1 {
+		return 1
+	}
+	return months
+}
@@ -0,0 +1,112 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"common/repayment"
+	"common/statemachine"
+	"model"
+	"rpc/internal/svc"
+	"rpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GenerateRepaymentScheduleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGenerateRepaymentScheduleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GenerateRepaymentScheduleLogic {
+	return &GenerateRepaymentScheduleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 还款计划管理
+func (l *GenerateRepaymentScheduleLogic) GenerateRepaymentSchedule(in *loan.GenerateRepaymentScheduleReq) (*loan.GenerateRepaymentScheduleResp, error) {
+	// 参数验证
+	if in.ApplicationId == "" {
+		return nil, fmt.Errorf("申请编号不能为空")
+	}
+	if in.RepaymentMethod == "" {
+		in.RepaymentMethod = repayment.MethodEqualInstallment
+	}
+	if !repayment.IsValidMethod(in.RepaymentMethod) {
+		return nil, fmt.Errorf("还款方式必须为equal_installment、equal_principal或interest_only")
+	}
+
+	// 查询申请信息
+	application, err := l.svcCtx.LoanApplicationsModel.FindOneByApplicationId(l.ctx, in.ApplicationId)
+	if err != nil {
+		l.Errorf("查询申请失败: %v", err)
+		return nil, fmt.Errorf("申请不存在")
+	}
+
+	if application.Status != statemachine.StatusApproved && application.Status != statusDisbursed {
+		return nil, fmt.Errorf("申请状态错误，仅已批准或已放款的申请可生成还款计划")
+	}
+
+	// 已开始还款的计划不允许重新生成
+	existing, err := l.svcCtx.LoanRepaymentPlansModel.FindByApplicationId(l.ctx, application.Id)
+	if err != nil {
+		l.Errorf("查询还款计划失败: %v", err)
+		return nil, fmt.Errorf("查询还款计划失败")
+	}
+	for _, plan := range existing {
+		if plan.Status != planStatusPending || plan.PaidPrincipal > 0 || plan.PaidInterest > 0 || plan.PaidServiceFee > 0 || plan.PaidPenalty > 0 {
+			return nil, fmt.Errorf("还款计划已开始执行，不允许重新生成")
+		}
+	}
+	repayments, err := l.svcCtx.LoanRepaymentsModel.FindByApplicationId(l.ctx, application.Id)
+	if err != nil {
+		l.Errorf("查询还款记录失败: %v", err)
+		return nil, fmt.Errorf("查询还款记录失败")
+	}
+	if len(repayments) > 0 {
+		return nil, fmt.Errorf("还款计划已开始执行，不允许重新生成")
+	}
+
+	// 以最近一次批准记录的金额、期限、利率为准
+	approval, err := findLatestApproval(l.ctx, l.svcCtx, application.Id)
+	if err != nil {
+		l.Errorf("查询批准记录失败: %v", err)
+		return nil, err
+	}
+
+	// 起息日: 已放款的以放款日为准,否则以审批日为准
+	start := approval.CreatedAt
+	if application.Status == statusDisbursed {
+		disbursement, err := l.svcCtx.LoanDisbursementsModel.FindSuccessByApplicationId(l.ctx, application.Id)
+		if err != nil {
+			l.Errorf("查询放款记录失败: %v", err)
+			return nil, fmt.Errorf("查询放款记录失败")
+		}
+		start = disbursement.DisbursedAt.Time
+	}
+
+	err = saveRepaymentSchedule(l.ctx, l.svcCtx, application, in.RepaymentMethod,
+		approval.ApprovedAmount.Float64, int(approval.ApprovedDuration.Int64), approval.InterestRate.Float64, start)
+	if errors.Is(err, model.ErrRepaymentStarted) {
+		return nil, fmt.Errorf("还款计划已开始执行，不允许重新生成")
+	}
+	if err != nil {
+		l.Errorf("生成还款计划失败: %v", err)
+		return nil, fmt.Errorf("生成还款计划失败")
+	}
+
+	plans, err := l.svcCtx.LoanRepaymentPlansModel.FindByApplicationId(l.ctx, application.Id)
+	if err != nil {
+		l.Errorf("查询还款计划失败: %v", err)
+		return nil, fmt.Errorf("查询还款计划失败")
+	}
+
+	return &loan.GenerateRepaymentScheduleResp{
+		List: convertRepaymentPlans(plans),
+	}, nil
+}
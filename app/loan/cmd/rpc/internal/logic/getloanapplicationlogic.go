@@ -37,22 +37,41 @@ func (l *GetLoanApplicationLogic) GetLoanApplication(in *loan.GetLoanApplication
 		return nil, fmt.Errorf("贷款申请不存在")
 	}
 
+	// 查询信用评分,评分缺失不影响申请查询
+	creditScore, err := findCreditScore(l.ctx, l.svcCtx, loanApplication.Id)
+	if err != nil {
+		l.Errorf("查询信用评分失败: %v", err)
+	}
+
+	// 解析申请时的产品条款快照
+	snapshot, err := decodeProductSnapshot(loanApplication)
+	if err != nil {
+		l.Errorf("解析产品条款快照失败: %v", err)
+	}
+
 	// 构造响应
 	return &loan.GetLoanApplicationResp{
 		ApplicationInfo: &loan.LoanApplicationInfo{
-			Id:            int64(loanApplication.Id),
-			ApplicationId: loanApplication.ApplicationId,
-			UserId:        int64(loanApplication.UserId),
-			ApplicantName: loanApplication.ApplicantName,
-			ProductId:     int64(loanApplication.ProductId),
-			Name:          loanApplication.Name,
-			Type:          loanApplication.Type,
-			Amount:        loanApplication.Amount,
-			Duration:      int32(loanApplication.Duration),
-			Purpose:       loanApplication.Purpose.String,
-			Status:        loanApplication.Status,
-			CreatedAt:     loanApplication.CreatedAt.Unix(),
-			UpdatedAt:     loanApplication.UpdatedAt.Unix(),
+			Id:                 int64(loanApplication.Id),
+			ApplicationId:      loanApplication.ApplicationId,
+			UserId:             int64(loanApplication.UserId),
+			ApplicantName:      loanApplication.ApplicantName,
+			ProductId:          int64(loanApplication.ProductId),
+			Name:               loanApplication.Name,
+			Type:               loanApplication.Type,
+			Amount:             loanApplication.Amount,
+			Duration:           int32(loanApplication.Duration),
+			Purpose:            loanApplication.Purpose.String,
+			Status:             loanApplication.Status,
+			CreatedAt:          loanApplication.CreatedAt.Unix(),
+			UpdatedAt:          loanApplication.UpdatedAt.Unix(),
+			OriginationFeeRate: loanApplication.OriginationFeeRate,
+			ServiceFeeRate:     loanApplication.ServiceFeeRate,
+			GuaranteeFeeRate:   loanApplication.GuaranteeFeeRate,
+			LateFee:            loanApplication.LateFee,
+			Apr:                loanApplication.Apr,
 		},
+		CreditScore:     creditScore,
+		ProductSnapshot: snapshot,
 	}, nil
 }
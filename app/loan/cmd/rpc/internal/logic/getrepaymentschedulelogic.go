@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"common/repayment"
+	"rpc/internal/svc"
+	"rpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRepaymentScheduleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetRepaymentScheduleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRepaymentScheduleLogic {
+	return &GetRepaymentScheduleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetRepaymentScheduleLogic) GetRepaymentSchedule(in *loan.GetRepaymentScheduleReq) (*loan.GetRepaymentScheduleResp, error) {
+	// 参数验证
+	if in.ApplicationId == "" {
+		return nil, fmt.Errorf("申请编号不能为空")
+	}
+
+	// 查询申请信息
+	application, err := l.svcCtx.LoanApplicationsModel.FindOneByApplicationId(l.ctx, in.ApplicationId)
+	if err != nil {
+		l.Errorf("查询申请失败: %v", err)
+		return nil, fmt.Errorf("申请不存在")
+	}
+
+	// 查询还款计划
+	plans, err := l.svcCtx.LoanRepaymentPlansModel.FindByApplicationId(l.ctx, application.Id)
+	if err != nil {
+		l.Errorf("查询还款计划失败: %v", err)
+		return nil, fmt.Errorf("查询还款计划失败")
+	}
+
+	resp := &loan.GetRepaymentScheduleResp{
+		ApplicationId: application.ApplicationId,
+		List:          convertRepaymentPlans(plans),
+	}
+	for _, plan := range plans {
+		resp.RepaymentMethod = plan.RepaymentMethod
+		resp.TotalPrincipal += plan.Principal
+		resp.TotalInterest += plan.Interest
+		resp.TotalAmount += plan.TotalAmount
+		resp.TotalPenalty += plan.Penalty
+		resp.TotalServiceFee += plan.ServiceFee
+		resp.PaidAmount += plan.PaidPrincipal + plan.PaidInterest + plan.PaidServiceFee + plan.PaidPenalty
+		resp.OutstandingAmount += outstandingAmount(plan)
+	}
+	resp.TotalPrincipal = repayment.Round2(resp.TotalPrincipal)
+	resp.TotalInterest = repayment.Round2(resp.TotalInterest)
+	resp.TotalAmount = repayment.Round2(resp.TotalAmount)
+	resp.TotalPenalty = repayment.Round2(resp.TotalPenalty)
+	resp.TotalServiceFee = repayment.Round2(resp.TotalServiceFee)
+	resp.PaidAmount = repayment.Round2(resp.PaidAmount)
+	resp.OutstandingAmount = repayment.Round2(resp.OutstandingAmount)
+
+	return resp, nil
+}
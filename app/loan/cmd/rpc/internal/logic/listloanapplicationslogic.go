@@ -79,19 +79,24 @@ func (l *ListLoanApplicationsLogic) ListLoanApplications(in *loan.ListLoanApplic
 	var list []*loan.LoanApplicationInfo
 	for _, app := range applications {
 		list = append(list, &loan.LoanApplicationInfo{
-			Id:            int64(app.Id),
-			ApplicationId: app.ApplicationId,
-			UserId:        int64(app.UserId),
-			ApplicantName: app.ApplicantName,
-			ProductId:     int64(app.ProductId),
-			Name:          app.Name,
-			Type:          app.Type,
-			Amount:        app.Amount,
-			Duration:      int32(app.Duration),
-			Purpose:       app.Purpose.String,
-			Status:        app.Status,
-			CreatedAt:     app.CreatedAt.Unix(),
-			UpdatedAt:     app.UpdatedAt.Unix(),
+			Id:                 int64(app.Id),
+			ApplicationId:      app.ApplicationId,
+			UserId:             int64(app.UserId),
+			ApplicantName:      app.ApplicantName,
+			ProductId:          int64(app.ProductId),
+			Name:               app.Name,
+			Type:               app.Type,
+			Amount:             app.Amount,
+			Duration:           int32(app.Duration),
+			Purpose:            app.Purpose.String,
+			Status:             app.Status,
+			CreatedAt:          app.CreatedAt.Unix(),
+			UpdatedAt:          app.UpdatedAt.Unix(),
+			OriginationFeeRate: app.OriginationFeeRate,
+			ServiceFeeRate:     app.ServiceFeeRate,
+			GuaranteeFeeRate:   app.GuaranteeFeeRate,
+			LateFee:            app.LateFee,
+			Apr:                app.Apr,
 		})
 	}
 
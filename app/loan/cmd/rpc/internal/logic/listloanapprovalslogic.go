@@ -58,6 +58,9 @@ func (l *ListLoanApprovalsLogic) ListLoanApprovals(in *loan.ListLoanApprovalsReq
 			ApprovedDuration: int32(approval.ApprovedDuration.Int64),
 			InterestRate:     approval.InterestRate.Float64,
 			CreatedAt:        approval.CreatedAt.Unix(),
+			Stage:            int32(approval.Stage),
+			StageName:        approval.StageName,
+			AuditorRole:      approval.AuditorRole,
 		}
 		approvalList = append(approvalList, approvalInfo)
 	}
@@ -0,0 +1,55 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"rpc/internal/svc"
+	"rpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListRepaymentsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListRepaymentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListRepaymentsLogic {
+	return &ListRepaymentsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListRepaymentsLogic) ListRepayments(in *loan.ListRepaymentsReq) (*loan.ListRepaymentsResp, error) {
+	// 参数验证
+	if in.ApplicationId == "" {
+		return nil, fmt.Errorf("申请编号不能为空")
+	}
+
+	// 先查询申请是否存在
+	application, err := l.svcCtx.LoanApplicationsModel.FindOneByApplicationId(l.ctx, in.ApplicationId)
+	if err != nil {
+		l.Errorf("查询申请失败: %v", err)
+		return nil, fmt.Errorf("申请不存在")
+	}
+
+	// 查询还款记录
+	repayments, err := l.svcCtx.LoanRepaymentsModel.FindByApplicationId(l.ctx, application.Id)
+	if err != nil {
+		l.Errorf("查询还款记录失败: %v", err)
+		return nil, fmt.Errorf("查询还款记录失败")
+	}
+
+	list := make([]*loan.LoanRepaymentInfo, 0, len(repayments))
+	for _, item := range repayments {
+		list = append(list, convertRepayment(item))
+	}
+
+	return &loan.ListRepaymentsResp{
+		List: list,
+	}, nil
+}
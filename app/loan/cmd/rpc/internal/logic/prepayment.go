@@ -0,0 +1,180 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"common/repayment"
+	"loanproductrpc/loanproductservice"
+	"model"
+	"rpc/internal/breaker"
+	"rpc/internal/pkg/productsnapshot"
+	"rpc/internal/svc"
+)
+
+// prepaymentQuote 提前结清试算结果
+type prepaymentQuote struct {
+	QuoteDate            time.Time
+	ValueDate            time.Time // 起息日(放款日)
+	OutstandingPrincipal float64   // 剩余未还本金
+	AccruedInterest      float64   // 应计利息
+	Penalty              float64   // 未还罚息
+	ServiceFee           float64   // 应收服务费
+	FeeRate              float64   // 提前还款手续费率(%)
+	Fee                  float64   // 提前还款手续费
+	WaivedInterest       float64   // 免收的未到期利息
+	Total                float64   // 应还总额
+
+	// 按结清结果调整后的未结清还款计划
+	Plans []*model.LoanRepaymentPlans
+}
+
+// loadPrepaymentQuote 校验申请归属与状态后,按当日计算提前结清金额
+func loadPrepaymentQuote(ctx context.Context, svcCtx *svc.ServiceContext, applicationId string, userId int64) (*model.LoanApplications, *prepaymentQuote, error) {
+	if applicationId == "" {
+		return nil, nil, fmt.Errorf("申请编号不能为空")
+	}
+	if userId <= 0 {
+		return nil, nil, fmt.Errorf("用户ID不能为空")
+	}
+
+	application, err := svcCtx.LoanApplicationsModel.FindOneByApplicationId(ctx, applicationId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("申请不存在")
+	}
+	if application.UserId != uint64(userId) {
+		return nil, nil, fmt.Errorf("无权限操作该申请")
+	}
+	if application.Status != statusDisbursed {
+		return nil, nil, fmt.Errorf("申请状态错误，仅已放款的申请可提前还款")
+	}
+
+	plans, err := svcCtx.LoanRepaymentPlansModel.FindByApplicationId(ctx, application.Id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("查询还款计划失败")
+	}
+
+	// 首期以放款日为计息起点
+	disbursement, err := svcCtx.LoanDisbursementsModel.FindSuccessByApplicationId(ctx, application.Id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("查询放款记录失败")
+	}
+
+	feeRate, err := getPrepaymentFeeRate(ctx, svcCtx, application)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	quote := buildPrepaymentQuote(plans, disbursement.DisbursedAt.Time, feeRate, time.Now())
+	if len(quote.Plans) == 0 {
+		return nil, nil, fmt.Errorf("还款计划已全部结清")
+	}
+	return application, quote, nil
+}
+
+// buildPrepaymentQuote 计算提前结清金额
+// 已到期期数: 归还全部未还本金、利息、服务费、罚息
+// 当期(首个未到期期数): 归还剩余本金,利息与服务费按本期已计息天数计提,本期自上一期应还日(首期自放款日)起息
+// 后续未到期期数: 仅归还剩余本金,免收利息与服务费
+// 提前还款手续费按未到期期数的剩余本金计收
+func buildPrepaymentQuote(plans []*model.LoanRepaymentPlans, valueDate time.Time, feeRate float64, asOf time.Time) *prepaymentQuote {
+	today := time.Date(asOf.Year(), asOf.Month(), asOf.Day(), 0, 0, 0, 0, asOf.Location())
+	quote := &prepaymentQuote{
+		QuoteDate: today,
+		ValueDate: valueDate,
+		FeeRate:   feeRate,
+	}
+
+	var prepaidPrincipal float64
+	currentFound := false
+	periodStart := valueDate
+	for _, plan := range plans {
+		// 季节性、宽限期等还款模式各期间隔不固定,以上一期应还日作为本期起息日
+		start := periodStart
+		periodStart = plan.DueDate
+		if plan.Status == planStatusPaid {
+			continue
+		}
+
+		quote.OutstandingPrincipal += plan.Principal - plan.PaidPrincipal
+		quote.Penalty += plan.Penalty - plan.PaidPenalty
+
+		charged, chargedFee := plan.Interest, plan.ServiceFee
+		if plan.DueDate.After(today) {
+			prepaidPrincipal += plan.Principal - plan.PaidPrincipal
+			charged, chargedFee = 0, 0
+			if !currentFound {
+				// 当期利息与服务费按已计息天数占本期天数的比例计提
+				currentFound = true
+				elapsed := repayment.OverdueDays(start, today)
+				total := repayment.OverdueDays(start, plan.DueDate)
+				if total > 0 {
+					charged = repayment.Round2(plan.Interest * float64(elapsed) / float64(total))
+					chargedFee = repayment.Round2(plan.ServiceFee * float64(elapsed) / float64(total))
+				}
+			}
+			if charged < plan.PaidInterest {
+				charged = plan.PaidInterest
+			}
+			if chargedFee < plan.PaidServiceFee {
+				chargedFee = plan.PaidServiceFee
+			}
+			quote.WaivedInterest += plan.Interest - charged
+		}
+		quote.AccruedInterest += charged - plan.PaidInterest
+		quote.ServiceFee += chargedFee - plan.PaidServiceFee
+
+		// 结清后本期按实际计收金额视为已还清
+		plan.Interest = charged
+		plan.ServiceFee = chargedFee
+		plan.TotalAmount = repayment.Round2(plan.Principal + charged + chargedFee)
+		quote.Plans = append(quote.Plans, plan)
+	}
+
+	quote.OutstandingPrincipal = repayment.Round2(quote.OutstandingPrincipal)
+	quote.AccruedInterest = repayment.Round2(quote.AccruedInterest)
+	quote.Penalty = repayment.Round2(quote.Penalty)
+	quote.ServiceFee = repayment.Round2(quote.ServiceFee)
+	quote.WaivedInterest = repayment.Round2(quote.WaivedInterest)
+	quote.Fee = repayment.Round2(prepaidPrincipal * feeRate / 100)
+	quote.Total = repayment.Round2(quote.OutstandingPrincipal + quote.AccruedInterest + quote.ServiceFee + quote.Penalty + quote.Fee)
+	return quote
+}
+
+// settlePlans 将试算涉及的还款计划标记为已结清
+func (q *prepaymentQuote) settlePlans(now time.Time) {
+	for _, plan := range q.Plans {
+		plan.PaidPrincipal = plan.Principal
+		plan.PaidInterest = plan.Interest
+		plan.PaidPenalty = plan.Penalty
+		plan.PaidServiceFee = plan.ServiceFee
+		plan.Status = planStatusPaid
+		plan.PaidAt = sql.NullTime{Time: now, Valid: true}
+	}
+}
+
+// getPrepaymentFeeRate 返回申请冻结的提前还款手续费率,历史申请无条款快照时按产品当前配置
+func getPrepaymentFeeRate(ctx context.Context, svcCtx *svc.ServiceContext, application *model.LoanApplications) (float64, error) {
+	snapshot, err := productsnapshot.Decode(application.ProductSnapshot)
+	if err != nil {
+		return 0, fmt.Errorf("解析产品条款快照失败: %v", err)
+	}
+	if snapshot != nil {
+		return snapshot.PrepaymentFeeRate, nil
+	}
+
+	productResp, err := breaker.DoWithBreakerResultAcceptable(ctx, "loanproduct-rpc", func() (*loanproductservice.GetLoanProductResp, error) {
+		return svcCtx.LoanProductClient.GetLoanProduct(ctx, &loanproductservice.GetLoanProductReq{
+			Id: int64(application.ProductId),
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		return 0, fmt.Errorf("查询产品提前还款手续费率失败: %v", err)
+	}
+	if productResp.Data == nil {
+		return 0, fmt.Errorf("产品不存在")
+	}
+	return productResp.Data.PrepaymentFeeRate, nil
+}
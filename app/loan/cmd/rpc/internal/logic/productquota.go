@@ -0,0 +1,45 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"loanproductrpc/loanproductservice"
+	"model"
+	"rpc/internal/breaker"
+	"rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// reserveProductQuota 审批通过时按批准金额占用产品放贷额度,同一申请重复占用时产品服务直接返回
+func reserveProductQuota(ctx context.Context, svcCtx *svc.ServiceContext, application *model.LoanApplications, amount float64) error {
+	_, err := breaker.DoWithBreakerResultAcceptable(ctx, "loanproduct-rpc", func() (*loanproductservice.ReserveLoanQuotaResp, error) {
+		return svcCtx.LoanProductClient.ReserveLoanQuota(ctx, &loanproductservice.ReserveLoanQuotaReq{
+			ProductId:     int64(application.ProductId),
+			ApplicationId: application.ApplicationId,
+			Amount:        amount,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("占用放贷额度失败, 申请编号: %s, 错误: %v", application.ApplicationId, err)
+		if breaker.IsAcceptableError(err) {
+			return err
+		}
+		return fmt.Errorf("占用产品放贷额度失败")
+	}
+	return nil
+}
+
+// releaseProductQuota 申请撤销、拒绝或审批失败时释放产品放贷额度,未占用时产品服务直接返回
+// 释放失败不影响申请状态,记录日志后可重复调用释放
+func releaseProductQuota(ctx context.Context, svcCtx *svc.ServiceContext, application *model.LoanApplications) {
+	_, err := breaker.DoWithBreakerResultAcceptable(ctx, "loanproduct-rpc", func() (*loanproductservice.ReleaseLoanQuotaResp, error) {
+		return svcCtx.LoanProductClient.ReleaseLoanQuota(ctx, &loanproductservice.ReleaseLoanQuotaReq{
+			ApplicationId: application.ApplicationId,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("释放放贷额度失败, 申请编号: %s, 错误: %v", application.ApplicationId, err)
+	}
+}
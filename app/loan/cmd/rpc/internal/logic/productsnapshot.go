@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"database/sql"
+	"time"
+
+	"loanproductrpc/loanproductservice"
+	"model"
+	"rpc/internal/pkg/productsnapshot"
+	"rpc/loan"
+)
+
+// encodeProductSnapshot 记录产品当前条款
+func encodeProductSnapshot(product *loanproductservice.LoanProductInfo, now time.Time) (sql.NullString, error) {
+	return productsnapshot.Encode(productsnapshot.Snapshot{
+		ProductCode:        product.ProductCode,
+		Name:               product.Name,
+		Type:               product.Type,
+		InterestRate:       product.InterestRate,
+		MinAmount:          product.MinAmount,
+		MaxAmount:          product.MaxAmount,
+		MinDuration:        product.MinDuration,
+		MaxDuration:        product.MaxDuration,
+		RepaymentProfile:   product.RepaymentProfile,
+		GraceMonths:        product.GraceMonths,
+		HarvestMonths:      product.HarvestMonths,
+		PenaltyRate:        product.PenaltyRate,
+		PrepaymentFeeRate:  product.PrepaymentFeeRate,
+		OriginationFeeRate: product.OriginationFeeRate,
+		ServiceFeeRate:     product.ServiceFeeRate,
+		GuaranteeFeeRate:   product.GuaranteeFeeRate,
+		LateFee:            product.LateFee,
+		ProductUpdatedAt:   product.UpdatedAt,
+		SnapshotAt:         now.Unix(),
+	})
+}
+
+// decodeProductSnapshot 解析申请的产品条款快照,历史申请无快照时返回空
+func decodeProductSnapshot(application *model.LoanApplications) (*loan.LoanProductSnapshot, error) {
+	snapshot, err := productsnapshot.Decode(application.ProductSnapshot)
+	if err != nil || snapshot == nil {
+		return nil, err
+	}
+	return &loan.LoanProductSnapshot{
+		ProductCode:        snapshot.ProductCode,
+		Name:               snapshot.Name,
+		Type:               snapshot.Type,
+		InterestRate:       snapshot.InterestRate,
+		MinAmount:          snapshot.MinAmount,
+		MaxAmount:          snapshot.MaxAmount,
+		MinDuration:        snapshot.MinDuration,
+		MaxDuration:        snapshot.MaxDuration,
+		RepaymentProfile:   snapshot.RepaymentProfile,
+		GraceMonths:        snapshot.GraceMonths,
+		HarvestMonths:      snapshot.HarvestMonths,
+		PenaltyRate:        snapshot.PenaltyRate,
+		PrepaymentFeeRate:  snapshot.PrepaymentFeeRate,
+		OriginationFeeRate: snapshot.OriginationFeeRate,
+		ServiceFeeRate:     snapshot.ServiceFeeRate,
+		GuaranteeFeeRate:   snapshot.GuaranteeFeeRate,
+		LateFee:            snapshot.LateFee,
+		ProductUpdatedAt:   snapshot.ProductUpdatedAt,
+		SnapshotAt:         snapshot.SnapshotAt,
+	}, nil
+}
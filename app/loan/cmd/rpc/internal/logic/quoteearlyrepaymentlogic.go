@@ -0,0 +1,46 @@
+package logic
+
+import (
+	"context"
+
+	"rpc/internal/svc"
+	"rpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type QuoteEarlyRepaymentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewQuoteEarlyRepaymentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *QuoteEarlyRepaymentLogic {
+	return &QuoteEarlyRepaymentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 提前还款
+func (l *QuoteEarlyRepaymentLogic) QuoteEarlyRepayment(in *loan.QuoteEarlyRepaymentReq) (*loan.QuoteEarlyRepaymentResp, error) {
+	_, quote, err := loadPrepaymentQuote(l.ctx, l.svcCtx, in.ApplicationId, in.UserId)
+	if err != nil {
+		l.Errorf("提前还款试算失败: %v", err)
+		return nil, err
+	}
+
+	return &loan.QuoteEarlyRepaymentResp{
+		ApplicationId:        in.ApplicationId,
+		QuoteDate:            quote.QuoteDate.Format("2006-01-02"),
+		OutstandingPrincipal: quote.OutstandingPrincipal,
+		AccruedInterest:      quote.AccruedInterest,
+		Penalty:              quote.Penalty,
+		PrepaymentFeeRate:    quote.FeeRate,
+		PrepaymentFee:        quote.Fee,
+		TotalAmount:          quote.Total,
+		WaivedInterest:       quote.WaivedInterest,
+		ServiceFee:           quote.ServiceFee,
+	}, nil
+}
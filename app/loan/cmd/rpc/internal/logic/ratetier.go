@@ -0,0 +1,50 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"common/ratetier"
+	"loanproductrpc/loanproductservice"
+	"rpc/internal/breaker"
+	"rpc/internal/svc"
+)
+
+// productRateTiers 转换产品利率档位
+func productRateTiers(product *loanproductservice.LoanProductInfo) []ratetier.Tier {
+	tiers := make([]ratetier.Tier, 0, len(product.RateTiers))
+	for _, item := range product.RateTiers {
+		tiers = append(tiers, ratetier.Tier{
+			MinAmount:    item.MinAmount,
+			MaxAmount:    item.MaxAmount,
+			MinDuration:  int(item.MinDuration),
+			MaxDuration:  int(item.MaxDuration),
+			InterestRate: item.InterestRate,
+			MinRate:      item.MinRate,
+			MaxRate:      item.MaxRate,
+		})
+	}
+	return tiers
+}
+
+// productInterestRate 返回金额、期限适用的产品挂牌利率,未配置利率档位时为产品利率
+func productInterestRate(product *loanproductservice.LoanProductInfo, amount float64, months int) (float64, error) {
+	return ratetier.Rate(productRateTiers(product), product.InterestRate, amount, months)
+}
+
+// checkApprovedRate 校验批准利率是否落在批准金额、期限适用的利率档位区间内,产品未配置档位时不限制
+func checkApprovedRate(ctx context.Context, svcCtx *svc.ServiceContext, productId int64, amount float64, months int, rate float64) error {
+	productResp, err := breaker.DoWithBreakerResultAcceptable(ctx, "loanproduct-rpc", func() (*loanproductservice.GetLoanProductResp, error) {
+		return svcCtx.LoanProductClient.GetLoanProduct(ctx, &loanproductservice.GetLoanProductReq{
+			Id: productId,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		return fmt.Errorf("查询产品利率档位失败: %v", err)
+	}
+	if productResp.Data == nil {
+		return fmt.Errorf("产品不存在")
+	}
+
+	return ratetier.CheckRate(productRateTiers(productResp.Data), amount, months, rate)
+}
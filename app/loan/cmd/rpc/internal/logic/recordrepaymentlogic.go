@@ -0,0 +1,188 @@
+package logic
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"common/repayment"
+	"model"
+	"rpc/internal/svc"
+	"rpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+type RecordRepaymentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRecordRepaymentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RecordRepaymentLogic {
+	return &RecordRepaymentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 还款管理
+func (l *RecordRepaymentLogic) RecordRepayment(in *loan.RecordRepaymentReq) (*loan.RecordRepaymentResp, error) {
+	// 参数验证
+	if err := l.validateRecordRequest(in); err != nil {
+		return nil, err
+	}
+
+	// 查询申请信息
+	application, err := l.svcCtx.LoanApplicationsModel.FindOneByApplicationId(l.ctx, in.ApplicationId)
+	if err != nil {
+		l.Errorf("查询申请失败: %v", err)
+		return nil, fmt.Errorf("申请不存在")
+	}
+
+	if application.UserId != uint64(in.UserId) {
+		return nil, fmt.Errorf("无权限操作该申请")
+	}
+	if application.Status != statusDisbursed {
+		return nil, fmt.Errorf("申请状态错误，仅已放款的申请可还款")
+	}
+
+	// 按期数顺序依次冲抵罚息、服务费、利息、本金,基于事务内加锁读取的最新还款计划计算
+	now := time.Now()
+	record := &model.LoanRepayments{
+		RepaymentNo:   fmt.Sprintf("REPAY%s%s", now.Format("20060102"), stringx.Randn(6)),
+		ApplicationId: application.Id,
+		UserId:        application.UserId,
+		Amount:        repayment.Round2(in.Amount),
+		RepaymentType: "regular",
+		Channel:       in.Channel,
+		Remark:        in.Remark,
+	}
+
+	var outstanding float64
+	var rejectErr error
+	err = l.svcCtx.LoanRepaymentsModel.InsertWithPlans(l.ctx, application.Id, func(plans []*model.LoanRepaymentPlans) (*model.LoanRepayments, []*model.LoanRepaymentPlans, error) {
+		outstanding = 0
+		for _, plan := range plans {
+			if plan.Status != planStatusPaid {
+				outstanding += outstandingAmount(plan)
+			}
+		}
+		outstanding = repayment.Round2(outstanding)
+		if outstanding <= 0 {
+			rejectErr = fmt.Errorf("还款计划已全部结清")
+			return nil, nil, rejectErr
+		}
+		if record.Amount > outstanding {
+			rejectErr = fmt.Errorf("还款金额超过剩余应还金额%.2f", outstanding)
+			return nil, nil, rejectErr
+		}
+
+		return record, allocateRepayment(record, plans, now), nil
+	})
+	if rejectErr != nil {
+		return nil, rejectErr
+	}
+	if err != nil {
+		l.Errorf("登记还款失败: %v", err)
+		return nil, fmt.Errorf("登记还款失败")
+	}
+
+	// 全部结清后更新申请状态
+	if remaining := outstanding - record.Amount; remaining <= 0 {
+		if err := fireApplicationEvent(l.ctx, l.svcCtx, application, eventSettle, remaining, nil); err != nil {
+			l.Errorf("更新申请状态失败: %v", err)
+			return nil, fmt.Errorf("还款成功但更新申请状态失败")
+		}
+	}
+
+	saved, err := l.svcCtx.LoanRepaymentsModel.FindOneByRepaymentNo(l.ctx, record.RepaymentNo)
+	if err != nil {
+		l.Errorf("查询还款记录失败: %v", err)
+		return nil, fmt.Errorf("还款成功但查询失败")
+	}
+
+	return &loan.RecordRepaymentResp{
+		RepaymentInfo:     convertRepayment(saved),
+		ApplicationStatus: application.Status,
+	}, nil
+}
+
+// allocateRepayment 按期数顺序将还款金额依次冲抵各期罚息、服务费、利息、本金,返回被冲抵的还款计划
+func allocateRepayment(record *model.LoanRepayments, plans []*model.LoanRepaymentPlans, now time.Time) []*model.LoanRepaymentPlans {
+	var touched []*model.LoanRepaymentPlans
+	var installmentNos []string
+	remaining := record.Amount
+	for _, plan := range plans {
+		if remaining <= 0 {
+			break
+		}
+		if plan.Status == planStatusPaid {
+			continue
+		}
+
+		penalty := allocate(&remaining, plan.Penalty-plan.PaidPenalty)
+		serviceFee := allocate(&remaining, plan.ServiceFee-plan.PaidServiceFee)
+		interest := allocate(&remaining, plan.Interest-plan.PaidInterest)
+		principal := allocate(&remaining, plan.Principal-plan.PaidPrincipal)
+
+		plan.PaidPenalty = repayment.Round2(plan.PaidPenalty + penalty)
+		plan.PaidServiceFee = repayment.Round2(plan.PaidServiceFee + serviceFee)
+		plan.PaidInterest = repayment.Round2(plan.PaidInterest + interest)
+		plan.PaidPrincipal = repayment.Round2(plan.PaidPrincipal + principal)
+		if outstandingAmount(plan) <= 0 {
+			plan.Status = planStatusPaid
+			plan.PaidAt = sql.NullTime{Time: now, Valid: true}
+		}
+
+		record.PenaltyAmount += penalty
+		record.FeeAmount += serviceFee
+		record.InterestAmount += interest
+		record.PrincipalAmount += principal
+		touched = append(touched, plan)
+		installmentNos = append(installmentNos, strconv.FormatUint(plan.InstallmentNo, 10))
+	}
+	record.PenaltyAmount = repayment.Round2(record.PenaltyAmount)
+	record.FeeAmount = repayment.Round2(record.FeeAmount)
+	record.InterestAmount = repayment.Round2(record.InterestAmount)
+	record.PrincipalAmount = repayment.Round2(record.PrincipalAmount)
+	record.InstallmentNos = strings.Join(installmentNos, ",")
+	return touched
+}
+
+// allocate 从剩余还款金额中冲抵应还金额,返回实际冲抵金额
+func allocate(remaining *float64, due float64) float64 {
+	due = repayment.Round2(due)
+	if due <= 0 || *remaining <= 0 {
+		return 0
+	}
+	paid := repayment.Round2(math.Min(*remaining, due))
+	*remaining = repayment.Round2(*remaining - paid)
+	return paid
+}
+
+// validateRecordRequest 验证还款请求参数
+func (l *RecordRepaymentLogic) validateRecordRequest(in *loan.RecordRepaymentReq) error {
+	if in.ApplicationId == "" {
+		return fmt.Errorf("申请编号不能为空")
+	}
+	if in.UserId <= 0 {
+		return fmt.Errorf("用户ID不能为空")
+	}
+	if repayment.Round2(in.Amount) <= 0 {
+		return fmt.Errorf("还款金额必须大于0")
+	}
+	if in.Channel == "" {
+		in.Channel = "online"
+	}
+	if in.Channel != "online" && in.Channel != "bank_transfer" && in.Channel != "cash" {
+		return fmt.Errorf("还款渠道必须为online、bank_transfer或cash")
+	}
+	return nil
+}
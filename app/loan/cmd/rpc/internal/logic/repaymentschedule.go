@@ -0,0 +1,202 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"common/repayment"
+	"loanproductrpc/loanproductservice"
+	"model"
+	"rpc/internal/breaker"
+	"rpc/internal/pkg/productsnapshot"
+	"rpc/internal/svc"
+	"rpc/loan"
+)
+
+// 还款计划状态
+const (
+	planStatusPending = "pending"
+	planStatusOverdue = "overdue"
+	planStatusPaid    = "paid"
+)
+
+// saveRepaymentSchedule 根据批准的金额、期限和利率生成还款计划并落库(覆盖原计划)
+// 产品配置为季节性还款或宽限期时,按申请冻结的还款模式生成;服务费按申请冻结的费率计入各期应还
+func saveRepaymentSchedule(ctx context.Context, svcCtx *svc.ServiceContext, application *model.LoanApplications,
+	method string, amount float64, duration int, interestRate float64, start time.Time) error {
+	profile, err := getRepaymentProfile(ctx, svcCtx, application)
+	if err != nil {
+		return err
+	}
+
+	method, installments, err := repayment.Build(method, amount, interestRate, duration, start, profile)
+	if err != nil {
+		return err
+	}
+
+	serviceFees := repayment.Disclose(amount, start, installments, applicationFees(application)).ServiceFees
+	plans := make([]*model.LoanRepaymentPlans, 0, len(installments))
+	for i, item := range installments {
+		plans = append(plans, &model.LoanRepaymentPlans{
+			ApplicationId:      application.Id,
+			InstallmentNo:      uint64(item.No),
+			DueDate:            item.DueDate,
+			Principal:          item.Principal,
+			Interest:           item.Interest,
+			TotalAmount:        repayment.Round2(item.Total + serviceFees[i]),
+			RemainingPrincipal: item.RemainingPrincipal,
+			RepaymentMethod:    method,
+			ServiceFee:         serviceFees[i],
+			Status:             planStatusPending,
+		})
+	}
+
+	if err := svcCtx.LoanRepaymentPlansModel.ReplaceByApplicationId(ctx, application.Id, plans); err != nil {
+		return fmt.Errorf("保存还款计划失败: %w", err)
+	}
+
+	return nil
+}
+
+// getRepaymentProfile 返回申请冻结的还款模式,历史申请无条款快照时按产品当前配置
+func getRepaymentProfile(ctx context.Context, svcCtx *svc.ServiceContext, application *model.LoanApplications) (repayment.Profile, error) {
+	snapshot, err := productsnapshot.Decode(application.ProductSnapshot)
+	if err != nil {
+		return repayment.Profile{}, fmt.Errorf("解析产品条款快照失败: %v", err)
+	}
+	if snapshot != nil {
+		return snapshot.Profile(), nil
+	}
+
+	productResp, err := breaker.DoWithBreakerResultAcceptable(ctx, "loanproduct-rpc", func() (*loanproductservice.GetLoanProductResp, error) {
+		return svcCtx.LoanProductClient.GetLoanProduct(ctx, &loanproductservice.GetLoanProductReq{
+			Id: int64(application.ProductId),
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		return repayment.Profile{}, fmt.Errorf("查询产品还款模式失败: %v", err)
+	}
+	if productResp.Data == nil {
+		return repayment.Profile{}, fmt.Errorf("产品不存在")
+	}
+
+	return productRepaymentProfile(productResp.Data), nil
+}
+
+// productRepaymentProfile 转换产品还款模式
+func productRepaymentProfile(product *loanproductservice.LoanProductInfo) repayment.Profile {
+	return repayment.Profile{
+		Seasonal:      product.RepaymentProfile == repayment.MethodSeasonal,
+		GraceMonths:   int(product.GraceMonths),
+		HarvestMonths: repayment.ParseHarvestMonths(product.HarvestMonths),
+	}
+}
+
+// productFees 转换产品费用配置
+func productFees(product *loanproductservice.LoanProductInfo) repayment.Fees {
+	return repayment.Fees{
+		OriginationRate: product.OriginationFeeRate,
+		ServiceRate:     product.ServiceFeeRate,
+		GuaranteeRate:   product.GuaranteeFeeRate,
+	}
+}
+
+// applicationFees 返回申请创建时冻结的费用配置
+func applicationFees(application *model.LoanApplications) repayment.Fees {
+	return repayment.Fees{
+		OriginationRate: application.OriginationFeeRate,
+		ServiceRate:     application.ServiceFeeRate,
+		GuaranteeRate:   application.GuaranteeFeeRate,
+	}
+}
+
+// estimateAPR 按等额本息试算申请的综合年化利率,计入利息及各项费用
+// 产品配置利率档位时按申请金额、期限适用档位的挂牌利率试算
+func estimateAPR(amount float64, months int, product *loanproductservice.LoanProductInfo, fees repayment.Fees) (float64, error) {
+	rate, err := productInterestRate(product, amount, months)
+	if err != nil {
+		return 0, err
+	}
+	start := time.Now()
+	_, plans, err := repayment.Build(repayment.MethodEqualInstallment, amount, rate, months, start, productRepaymentProfile(product))
+	if err != nil {
+		return 0, fmt.Errorf("试算综合年化利率失败: %v", err)
+	}
+	return repayment.Disclose(amount, start, plans, fees).APR, nil
+}
+
+// findLatestApproval 查询最近一次批准记录
+func findLatestApproval(ctx context.Context, svcCtx *svc.ServiceContext, applicationId uint64) (*model.LoanApprovals, error) {
+	approvals, err := svcCtx.LoanApprovalsModel.FindByApplicationId(ctx, int64(applicationId))
+	if err != nil {
+		return nil, fmt.Errorf("查询审批记录失败")
+	}
+
+	for i := len(approvals) - 1; i >= 0; i-- {
+		if approvals[i].Action == "approve" {
+			return approvals[i], nil
+		}
+	}
+	return nil, fmt.Errorf("批准记录不存在")
+}
+
+// outstandingAmount 计算还款计划未还金额(本金+利息+服务费+罚息)
+func outstandingAmount(plan *model.LoanRepaymentPlans) float64 {
+	return repayment.Round2(plan.Principal - plan.PaidPrincipal + plan.Interest - plan.PaidInterest +
+		plan.ServiceFee - plan.PaidServiceFee + plan.Penalty - plan.PaidPenalty)
+}
+
+// convertRepaymentPlans 将还款计划转换为响应格式
+func convertRepaymentPlans(plans []*model.LoanRepaymentPlans) []*loan.RepaymentPlanInfo {
+	list := make([]*loan.RepaymentPlanInfo, 0, len(plans))
+	for _, plan := range plans {
+		var paidAt int64
+		if plan.PaidAt.Valid {
+			paidAt = plan.PaidAt.Time.Unix()
+		}
+		list = append(list, &loan.RepaymentPlanInfo{
+			Id:                 int64(plan.Id),
+			ApplicationId:      int64(plan.ApplicationId),
+			InstallmentNo:      int32(plan.InstallmentNo),
+			DueDate:            plan.DueDate.Format("2006-01-02"),
+			Principal:          plan.Principal,
+			Interest:           plan.Interest,
+			TotalAmount:        plan.TotalAmount,
+			RemainingPrincipal: plan.RemainingPrincipal,
+			RepaymentMethod:    plan.RepaymentMethod,
+			Status:             plan.Status,
+			CreatedAt:          plan.CreatedAt.Unix(),
+			UpdatedAt:          plan.UpdatedAt.Unix(),
+			PaidPrincipal:      plan.PaidPrincipal,
+			PaidInterest:       plan.PaidInterest,
+			Penalty:            plan.Penalty,
+			PaidPenalty:        plan.PaidPenalty,
+			OverdueDays:        int32(plan.OverdueDays),
+			PaidAt:             paidAt,
+			ServiceFee:         plan.ServiceFee,
+			PaidServiceFee:     plan.PaidServiceFee,
+		})
+	}
+	return list
+}
+
+// convertRepayment 将还款记录转换为响应格式
+func convertRepayment(item *model.LoanRepayments) *loan.LoanRepaymentInfo {
+	return &loan.LoanRepaymentInfo{
+		Id:              int64(item.Id),
+		RepaymentNo:     item.RepaymentNo,
+		ApplicationId:   int64(item.ApplicationId),
+		UserId:          int64(item.UserId),
+		Amount:          item.Amount,
+		PrincipalAmount: item.PrincipalAmount,
+		InterestAmount:  item.InterestAmount,
+		PenaltyAmount:   item.PenaltyAmount,
+		InstallmentNos:  item.InstallmentNos,
+		Channel:         item.Channel,
+		Remark:          item.Remark,
+		CreatedAt:       item.CreatedAt.Unix(),
+		FeeAmount:       item.FeeAmount,
+		RepaymentType:   item.RepaymentType,
+	}
+}
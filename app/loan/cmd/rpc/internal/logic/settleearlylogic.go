@@ -0,0 +1,108 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"common/repayment"
+	"model"
+	"rpc/internal/svc"
+	"rpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+type SettleEarlyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSettleEarlyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SettleEarlyLogic {
+	return &SettleEarlyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *SettleEarlyLogic) SettleEarly(in *loan.SettleEarlyReq) (*loan.SettleEarlyResp, error) {
+	if in.Channel == "" {
+		in.Channel = "online"
+	}
+	if in.Channel != "online" && in.Channel != "bank_transfer" && in.Channel != "cash" {
+		return nil, fmt.Errorf("还款渠道必须为online、bank_transfer或cash")
+	}
+
+	application, quote, err := loadPrepaymentQuote(l.ctx, l.svcCtx, in.ApplicationId, in.UserId)
+	if err != nil {
+		l.Errorf("提前还款试算失败: %v", err)
+		return nil, err
+	}
+
+	// 结清金额须与当日试算金额一致,避免按过期报价结清
+	if repayment.Round2(in.Amount) != quote.Total {
+		return nil, fmt.Errorf("结清金额与当日试算金额%.2f不一致，请重新试算", quote.Total)
+	}
+
+	now := time.Now()
+	record := &model.LoanRepayments{
+		RepaymentNo:   fmt.Sprintf("REPAY%s%s", now.Format("20060102"), stringx.Randn(6)),
+		ApplicationId: application.Id,
+		UserId:        application.UserId,
+		RepaymentType: "prepay",
+		Channel:       in.Channel,
+		Remark:        in.Remark,
+	}
+
+	// 同一事务内写入还款记录、结清剩余期数并更新申请状态
+	var rejectErr error
+	err = fireApplicationEvent(l.ctx, l.svcCtx, application, eventSettle, 0.0, func(application *model.LoanApplications) error {
+		return l.svcCtx.LoanRepaymentsModel.SettleWithPlans(l.ctx, application, func(plans []*model.LoanRepaymentPlans) (*model.LoanRepayments, []*model.LoanRepaymentPlans, error) {
+			// 按加锁读取的还款计划重新试算,期间有还款入账或计提罚息时需重新试算
+			locked := buildPrepaymentQuote(plans, quote.ValueDate, quote.FeeRate, now)
+			if len(locked.Plans) == 0 || locked.Total != quote.Total {
+				rejectErr = fmt.Errorf("还款计划已变化，结清金额与当日试算金额%.2f不一致，请重新试算", locked.Total)
+				return nil, nil, rejectErr
+			}
+			locked.settlePlans(now)
+
+			installmentNos := make([]string, 0, len(locked.Plans))
+			for _, plan := range locked.Plans {
+				installmentNos = append(installmentNos, strconv.FormatUint(plan.InstallmentNo, 10))
+			}
+			record.Amount = locked.Total
+			record.PrincipalAmount = locked.OutstandingPrincipal
+			record.InterestAmount = locked.AccruedInterest
+			record.PenaltyAmount = locked.Penalty
+			record.FeeAmount = repayment.Round2(locked.Fee + locked.ServiceFee)
+			record.InstallmentNos = strings.Join(installmentNos, ",")
+			return record, locked.Plans, nil
+		})
+	})
+	if rejectErr != nil {
+		return nil, rejectErr
+	}
+	if err != nil {
+		l.Errorf("提前结清失败: %v", err)
+		if isApplicationStateError(err) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("提前结清失败")
+	}
+
+	saved, err := l.svcCtx.LoanRepaymentsModel.FindOneByRepaymentNo(l.ctx, record.RepaymentNo)
+	if err != nil {
+		l.Errorf("查询还款记录失败: %v", err)
+		return nil, fmt.Errorf("结清成功但查询失败")
+	}
+
+	return &loan.SettleEarlyResp{
+		RepaymentInfo:     convertRepayment(saved),
+		ApplicationStatus: application.Status,
+	}, nil
+}
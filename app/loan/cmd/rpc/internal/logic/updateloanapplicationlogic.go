@@ -3,8 +3,10 @@ package logic
 import (
 	"context"
 	"fmt"
-	"time"
 
+	"common/statemachine"
+	"loanproductrpc/loanproductservice"
+	"rpc/internal/breaker"
 	"rpc/internal/svc"
 	"rpc/loan"
 
@@ -39,8 +41,19 @@ func (l *UpdateLoanApplicationLogic) UpdateLoanApplication(in *loan.UpdateLoanAp
 	}
 
 	// 检查申请状态是否可以修改
-	if application.Status != "pending" {
-		return nil, fmt.Errorf("只有待审核状态的申请才能修改")
+	if err := checkApplicationEvent(application, statemachine.EventUpdate); err != nil {
+		return nil, err
+	}
+
+	// 审批链已有环节通过时不允许修改,期间新增的审批会更新申请版本号,修改按版本冲突处理
+	approvals, err := l.svcCtx.LoanApprovalsModel.FindByApplicationId(l.ctx, int64(application.Id))
+	if err != nil {
+		l.Errorf("查询审批记录失败: %v", err)
+		return nil, fmt.Errorf("更新申请失败")
+	}
+	reviewed := reviewedStages(approvals)
+	if reviewed > 0 {
+		return nil, errApplicationReviewed
 	}
 
 	// 验证更新参数
@@ -51,19 +64,45 @@ func (l *UpdateLoanApplicationLogic) UpdateLoanApplication(in *loan.UpdateLoanAp
 		return nil, fmt.Errorf("贷款期限必须大于0")
 	}
 
+	// 按申请冻结的费用重新试算综合年化利率
+	productResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "loanproduct-rpc", func() (*loanproductservice.GetLoanProductResp, error) {
+		return l.svcCtx.LoanProductClient.GetLoanProduct(l.ctx, &loanproductservice.GetLoanProductReq{
+			Id: int64(application.ProductId),
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用LoanProduct服务失败: %v", err)
+		return nil, fmt.Errorf("更新申请失败，请稍后重试")
+	}
+	if productResp.Data == nil {
+		return nil, fmt.Errorf("产品不存在")
+	}
+	apr, err := estimateAPR(in.Amount, int(in.Duration), productResp.Data, applicationFees(application))
+	if err != nil {
+		return nil, fmt.Errorf("参数错误，%v", err)
+	}
+
 	// 更新申请信息
 	application.Amount = in.Amount
 	application.Duration = uint64(in.Duration)
 	application.Purpose.String = in.Purpose
 	application.Purpose.Valid = in.Purpose != ""
-	application.UpdatedAt = time.Now()
+	application.Apr = apr
 
-	err = l.svcCtx.LoanApplicationsModel.Update(l.ctx, application)
+	err = fireApplicationEvent(l.ctx, l.svcCtx, application, statemachine.EventUpdate, reviewed, saveApplicationWithApproval(l.ctx, l.svcCtx, nil))
 	if err != nil {
 		l.Errorf("更新申请失败: %v", err)
+		if isApplicationStateError(err) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("更新申请失败")
 	}
 
+	// 金额或期限变化后重新计算信用评分
+	if err := rescoreApplication(l.ctx, l.svcCtx, application); err != nil {
+		l.Errorf("重新计算信用评分失败: %v", err)
+	}
+
 	// 查询更新后的申请信息
 	updatedApplication, err := l.svcCtx.LoanApplicationsModel.FindOneByApplicationId(l.ctx, in.ApplicationId)
 	if err != nil {
@@ -74,19 +113,24 @@ func (l *UpdateLoanApplicationLogic) UpdateLoanApplication(in *loan.UpdateLoanAp
 	// 构造响应
 	return &loan.UpdateLoanApplicationResp{
 		ApplicationInfo: &loan.LoanApplicationInfo{
-			Id:            int64(updatedApplication.Id),
-			ApplicationId: updatedApplication.ApplicationId,
-			UserId:        int64(updatedApplication.UserId),
-			ApplicantName: updatedApplication.ApplicantName,
-			ProductId:     int64(updatedApplication.ProductId),
-			Name:          updatedApplication.Name,
-			Type:          updatedApplication.Type,
-			Amount:        updatedApplication.Amount,
-			Duration:      int32(updatedApplication.Duration),
-			Purpose:       updatedApplication.Purpose.String,
-			Status:        updatedApplication.Status,
-			CreatedAt:     updatedApplication.CreatedAt.Unix(),
-			UpdatedAt:     updatedApplication.UpdatedAt.Unix(),
+			Id:                 int64(updatedApplication.Id),
+			ApplicationId:      updatedApplication.ApplicationId,
+			UserId:             int64(updatedApplication.UserId),
+			ApplicantName:      updatedApplication.ApplicantName,
+			ProductId:          int64(updatedApplication.ProductId),
+			Name:               updatedApplication.Name,
+			Type:               updatedApplication.Type,
+			Amount:             updatedApplication.Amount,
+			Duration:           int32(updatedApplication.Duration),
+			Purpose:            updatedApplication.Purpose.String,
+			Status:             updatedApplication.Status,
+			CreatedAt:          updatedApplication.CreatedAt.Unix(),
+			UpdatedAt:          updatedApplication.UpdatedAt.Unix(),
+			OriginationFeeRate: updatedApplication.OriginationFeeRate,
+			ServiceFeeRate:     updatedApplication.ServiceFeeRate,
+			GuaranteeFeeRate:   updatedApplication.GuaranteeFeeRate,
+			LateFee:            updatedApplication.LateFee,
+			Apr:                updatedApplication.Apr,
 		},
 	}, nil
 }
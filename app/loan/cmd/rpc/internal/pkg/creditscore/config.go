@@ -0,0 +1,136 @@
+package creditscore
+
+// 评分因子
+const (
+	FactorIncome         = "income"          // 月收入(元)
+	FactorAge            = "age"             // 年龄
+	FactorOccupation     = "occupation"      // 职业
+	FactorAmount         = "amount"          // 申请金额(元)
+	FactorDuration       = "duration"        // 申请期限(月)
+	FactorIncomeMultiple = "income_multiple" // 申请金额/年收入
+	FactorAmountUsage    = "amount_usage"    // 申请金额/产品最高额度
+)
+
+// 审批建议
+const (
+	RecommendApprove = "approve" // 建议通过
+	RecommendReview  = "review"  // 建议人工复核
+	RecommendReject  = "reject"  // 建议拒绝
+)
+
+// Band 评分区间
+// 数值因子按 [Min, Max) 匹配,Max 为0表示无上限;职业因子按 Keywords 包含匹配,Keywords 为空的区间作为兜底
+type Band struct {
+	Min      float64  `json:",optional"`
+	Max      float64  `json:",optional"`
+	Keywords []string `json:",optional"`
+	Points   float64
+	Reason   string `json:",optional"`
+}
+
+// Rule 评分规则,按顺序取第一个命中的区间,得分乘以权重计入总分
+type Rule struct {
+	Name   string
+	Factor string
+	Weight float64 `json:",default=1"`
+	Bands  []Band
+}
+
+// Grade 评分等级,分数不低于 MinScore 时评为该等级
+type Grade struct {
+	Name     string
+	MinScore int
+}
+
+// Config 评分规则配置,Rules/Grades 未配置时使用内置规则
+type Config struct {
+	Version      string  `json:",default=v1"`
+	BaseScore    float64 `json:",default=500"`
+	ApproveScore int     `json:",default=650"` // 不低于该分数建议通过
+	RejectScore  int     `json:",default=450"` // 低于该分数建议拒绝
+	Grades       []Grade `json:",optional"`
+	Rules        []Rule  `json:",optional"`
+}
+
+// DefaultGrades 内置评分等级
+func DefaultGrades() []Grade {
+	return []Grade{
+		{Name: "A", MinScore: 700},
+		{Name: "B", MinScore: 650},
+		{Name: "C", MinScore: 550},
+		{Name: "D", MinScore: 450},
+		{Name: "E", MinScore: 0},
+	}
+}
+
+// DefaultRules 内置评分规则
+func DefaultRules() []Rule {
+	return []Rule{
+		{
+			Name:   "月收入",
+			Factor: FactorIncome,
+			Weight: 1,
+			Bands: []Band{
+				{Min: 0, Max: 2000, Points: -40, Reason: "月收入低于2000元"},
+				{Min: 2000, Max: 5000, Points: 0, Reason: "月收入2000至5000元"},
+				{Min: 5000, Max: 10000, Points: 40, Reason: "月收入5000至10000元"},
+				{Min: 10000, Points: 80, Reason: "月收入不低于10000元"},
+			},
+		},
+		{
+			Name:   "年龄",
+			Factor: FactorAge,
+			Weight: 1,
+			Bands: []Band{
+				{Min: 0, Max: 18, Points: -200, Reason: "未满18周岁"},
+				{Min: 18, Max: 25, Points: -20, Reason: "年龄偏小,收入稳定性不足"},
+				{Min: 25, Max: 56, Points: 30, Reason: "处于主要劳动年龄"},
+				{Min: 56, Max: 66, Points: 0, Reason: "年龄偏大"},
+				{Min: 66, Points: -60, Reason: "超过65周岁"},
+			},
+		},
+		{
+			Name:   "职业",
+			Factor: FactorOccupation,
+			Weight: 1,
+			Bands: []Band{
+				{Keywords: []string{"教师", "医生", "公务员", "职员", "工程师"}, Points: 40, Reason: "职业收入稳定"},
+				{Keywords: []string{"种植", "养殖", "农", "渔", "牧"}, Points: 30, Reason: "从事农业生产,与涉农贷款用途匹配"},
+				{Keywords: []string{"个体", "经营", "合作社"}, Points: 20, Reason: "从事个体或合作经营"},
+				{Points: 0, Reason: "职业无加分项"},
+			},
+		},
+		{
+			Name:   "收入负债比",
+			Factor: FactorIncomeMultiple,
+			Weight: 1,
+			Bands: []Band{
+				{Min: 0, Max: 0.5, Points: 60, Reason: "申请金额不超过年收入的50%"},
+				{Min: 0.5, Max: 1, Points: 30, Reason: "申请金额不超过年收入"},
+				{Min: 1, Max: 2, Points: 0, Reason: "申请金额为年收入的1至2倍"},
+				{Min: 2, Max: 4, Points: -40, Reason: "申请金额为年收入的2至4倍"},
+				{Min: 4, Points: -100, Reason: "申请金额超过年收入的4倍"},
+			},
+		},
+		{
+			Name:   "额度使用率",
+			Factor: FactorAmountUsage,
+			Weight: 1,
+			Bands: []Band{
+				{Min: 0, Max: 0.5, Points: 20, Reason: "申请金额低于产品最高额度的50%"},
+				{Min: 0.5, Max: 0.9, Points: 0, Reason: "申请金额为产品最高额度的50%至90%"},
+				{Min: 0.9, Points: -20, Reason: "申请金额接近产品最高额度"},
+			},
+		},
+		{
+			Name:   "贷款期限",
+			Factor: FactorDuration,
+			Weight: 1,
+			Bands: []Band{
+				{Min: 0, Max: 13, Points: 20, Reason: "期限不超过12个月"},
+				{Min: 13, Max: 37, Points: 0, Reason: "期限13至36个月"},
+				{Min: 37, Points: -20, Reason: "期限超过36个月"},
+			},
+		},
+	}
+}
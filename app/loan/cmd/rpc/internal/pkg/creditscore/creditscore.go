@@ -0,0 +1,238 @@
+// Package creditscore 规则化信用评分
+// 总分 = 基础分 + Σ(规则命中区间得分 × 权重),按等级分数线评级并给出审批建议,规则通过配置文件调整
+package creditscore
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Input 评分输入,来自申请人信息、申请信息和产品信息
+type Input struct {
+	Income     float64 // 月收入(元)
+	Age        int     // 年龄
+	Occupation string  // 职业
+	Amount     float64 // 申请金额(元)
+	Duration   int     // 申请期限(月)
+	MaxAmount  float64 // 产品最高额度(元)
+}
+
+// Item 单条规则评分明细
+type Item struct {
+	Rule   string  `json:"rule"`
+	Factor string  `json:"factor"`
+	Value  string  `json:"value"`
+	Points float64 `json:"points"`
+	Reason string  `json:"reason"`
+}
+
+// Result 评分结果
+type Result struct {
+	Score          int
+	Grade          string
+	Recommendation string
+	Version        string
+	Items          []Item
+}
+
+// Engine 评分引擎
+type Engine struct {
+	c Config
+}
+
+// New 校验评分规则并创建评分引擎
+func New(c Config) (*Engine, error) {
+	if len(c.Rules) == 0 {
+		c.Rules = DefaultRules()
+	}
+	if len(c.Grades) == 0 {
+		c.Grades = DefaultGrades()
+	}
+	if c.RejectScore > c.ApproveScore {
+		return nil, fmt.Errorf("评分规则错误: 拒绝分数线不能高于通过分数线")
+	}
+
+	for _, rule := range c.Rules {
+		if !isFactor(rule.Factor) {
+			return nil, fmt.Errorf("评分规则错误: 规则[%s]的评分因子%s不支持", rule.Name, rule.Factor)
+		}
+		if rule.Weight < 0 {
+			return nil, fmt.Errorf("评分规则错误: 规则[%s]的权重不能小于0", rule.Name)
+		}
+		if len(rule.Bands) == 0 {
+			return nil, fmt.Errorf("评分规则错误: 规则[%s]未配置评分区间", rule.Name)
+		}
+	}
+
+	// 等级按分数线从高到低匹配
+	grades := append([]Grade(nil), c.Grades...)
+	sort.Slice(grades, func(i, j int) bool {
+		return grades[i].MinScore > grades[j].MinScore
+	})
+	c.Grades = grades
+
+	return &Engine{c: c}, nil
+}
+
+// MustNew 创建评分引擎,规则错误时退出
+func MustNew(c Config) *Engine {
+	e, err := New(c)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// Version 评分规则版本
+func (e *Engine) Version() string {
+	return e.c.Version
+}
+
+// Evaluate 按规则计算评分
+func (e *Engine) Evaluate(in Input) *Result {
+	total := e.c.BaseScore
+	items := make([]Item, 0, len(e.c.Rules))
+	for _, rule := range e.c.Rules {
+		item := evaluateRule(rule, in)
+		total += item.Points
+		items = append(items, item)
+	}
+
+	score := int(math.Round(math.Max(total, 0)))
+	return &Result{
+		Score:          score,
+		Grade:          e.grade(score),
+		Recommendation: e.recommend(score),
+		Version:        e.c.Version,
+		Items:          items,
+	}
+}
+
+// grade 按分数线评级
+func (e *Engine) grade(score int) string {
+	for _, grade := range e.c.Grades {
+		if score >= grade.MinScore {
+			return grade.Name
+		}
+	}
+	return ""
+}
+
+// recommend 按分数线给出审批建议
+func (e *Engine) recommend(score int) string {
+	switch {
+	case score >= e.c.ApproveScore:
+		return RecommendApprove
+	case score < e.c.RejectScore:
+		return RecommendReject
+	default:
+		return RecommendReview
+	}
+}
+
+// evaluateRule 计算单条规则得分
+func evaluateRule(rule Rule, in Input) Item {
+	item := Item{
+		Rule:   rule.Name,
+		Factor: rule.Factor,
+	}
+
+	if rule.Factor == FactorOccupation {
+		item.Value = in.Occupation
+		if band, ok := matchKeywords(rule.Bands, in.Occupation); ok {
+			item.Points = band.Points * rule.Weight
+			item.Reason = band.Reason
+		} else {
+			item.Reason = "未命中评分区间"
+		}
+		return item
+	}
+
+	value, ok := factorValue(rule.Factor, in)
+	if !ok {
+		item.Reason = "缺少评分数据"
+		return item
+	}
+	item.Value = strconv.FormatFloat(value, 'f', -1, 64)
+	if band, ok := matchRange(rule.Bands, value); ok {
+		item.Points = band.Points * rule.Weight
+		item.Reason = band.Reason
+	} else {
+		item.Reason = "未命中评分区间"
+	}
+	return item
+}
+
+// factorValue 计算数值因子取值,数据缺失时返回 false
+func factorValue(factor string, in Input) (float64, bool) {
+	switch factor {
+	case FactorIncome:
+		return in.Income, in.Income > 0
+	case FactorAge:
+		return float64(in.Age), in.Age > 0
+	case FactorAmount:
+		return in.Amount, true
+	case FactorDuration:
+		return float64(in.Duration), true
+	case FactorIncomeMultiple:
+		if in.Income <= 0 {
+			return 0, false
+		}
+		return math.Round(in.Amount/(in.Income*12)*100) / 100, true
+	case FactorAmountUsage:
+		if in.MaxAmount <= 0 {
+			return 0, false
+		}
+		return math.Round(in.Amount/in.MaxAmount*100) / 100, true
+	default:
+		return 0, false
+	}
+}
+
+// matchRange 取第一个包含取值的区间
+func matchRange(bands []Band, value float64) (Band, bool) {
+	for _, band := range bands {
+		if value >= band.Min && (band.Max == 0 || value < band.Max) {
+			return band, true
+		}
+	}
+	return Band{}, false
+}
+
+// matchKeywords 取第一个关键字命中的区间,未命中时取未配置关键字的兜底区间
+func matchKeywords(bands []Band, value string) (Band, bool) {
+	var fallback *Band
+	for i, band := range bands {
+		if len(band.Keywords) == 0 {
+			if fallback == nil {
+				fallback = &bands[i]
+			}
+			continue
+		}
+		if value == "" {
+			continue
+		}
+		for _, keyword := range band.Keywords {
+			if keyword != "" && strings.Contains(value, keyword) {
+				return band, true
+			}
+		}
+	}
+	if fallback != nil {
+		return *fallback, true
+	}
+	return Band{}, false
+}
+
+// isFactor 是否为支持的评分因子
+func isFactor(factor string) bool {
+	switch factor {
+	case FactorIncome, FactorAge, FactorOccupation, FactorAmount, FactorDuration, FactorIncomeMultiple, FactorAmountUsage:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,57 @@
+package disburser
+
+import (
+	"context"
+	"fmt"
+)
+
+// 放款渠道
+const (
+	ChannelMock = "mock" // 本地模拟银行核心
+)
+
+// Request 放款指令
+type Request struct {
+	DisbursementNo string  // 放款流水号,作为银行核心幂等键
+	Amount         float64 // 放款金额
+	AccountName    string  // 收款户名
+	AccountNo      string  // 收款账号
+	BankName       string  // 开户行
+}
+
+// Result 银行核心处理结果
+type Result struct {
+	Success  bool   // 是否出款成功
+	SerialNo string // 银行核心交易流水号
+	Message  string // 失败原因
+}
+
+// Disburser 银行核心放款适配器,接入真实核心系统时实现该接口即可
+type Disburser interface {
+	// Channel 返回放款渠道名称
+	Channel() string
+	// Disburse 发起放款,返回 error 表示调用异常(结果未知),业务失败通过 Result.Success 返回
+	Disburse(ctx context.Context, req *Request) (*Result, error)
+	// Query 按放款流水号查询出款结果,用于核对结果未知的放款;银行核心未受理该指令时返回 Result.Success=false,
+	// 返回 error 表示仍无法确认结果
+	Query(ctx context.Context, disbursementNo string) (*Result, error)
+}
+
+// New 根据渠道创建放款适配器
+func New(channel string) (Disburser, error) {
+	switch channel {
+	case "", ChannelMock:
+		return NewMockBankCore(), nil
+	default:
+		return nil, fmt.Errorf("不支持的放款渠道: %s", channel)
+	}
+}
+
+// MustNew 根据渠道创建放款适配器,失败时退出
+func MustNew(channel string) Disburser {
+	d, err := New(channel)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
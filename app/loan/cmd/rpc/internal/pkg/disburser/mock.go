@@ -0,0 +1,50 @@
+package disburser
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stringx"
+)
+
+// MockBankCore 本地模拟银行核心,校验基本参数后直接返回出款成功,按放款流水号保证幂等
+type MockBankCore struct {
+	results sync.Map // 放款流水号 -> *Result
+}
+
+// NewMockBankCore 创建模拟银行核心
+func NewMockBankCore() *MockBankCore {
+	return &MockBankCore{}
+}
+
+func (m *MockBankCore) Channel() string {
+	return ChannelMock
+}
+
+func (m *MockBankCore) Disburse(ctx context.Context, req *Request) (*Result, error) {
+	if result, ok := m.results.Load(req.DisbursementNo); ok {
+		return result.(*Result), nil
+	}
+	if req.Amount <= 0 {
+		return &Result{Success: false, Message: "放款金额必须大于0"}, nil
+	}
+	if req.AccountNo == "" || req.AccountName == "" {
+		return &Result{Success: false, Message: "收款账户信息不完整"}, nil
+	}
+
+	serialNo := fmt.Sprintf("MOCK%s%s", time.Now().Format("20060102150405"), stringx.Randn(6))
+	logx.WithContext(ctx).Infof("模拟银行核心出款成功, 放款流水号: %s, 金额: %.2f, 银行流水号: %s", req.DisbursementNo, req.Amount, serialNo)
+
+	result, _ := m.results.LoadOrStore(req.DisbursementNo, &Result{Success: true, SerialNo: serialNo})
+	return result.(*Result), nil
+}
+
+func (m *MockBankCore) Query(ctx context.Context, disbursementNo string) (*Result, error) {
+	if result, ok := m.results.Load(disbursementNo); ok {
+		return result.(*Result), nil
+	}
+	return &Result{Success: false, Message: "银行核心未受理该放款指令"}, nil
+}
@@ -0,0 +1,72 @@
+// Package idempotency 提交幂等控制
+// Redis 记录幂等键对应的处理结果并在处理期间占用幂等键,MySQL 唯一索引兜底 Redis 不可用或结果过期的情况
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// processing 幂等键处理中的占位值
+const processing = "processing"
+
+// lockSeconds 幂等键占用时长,超过该时长未写入结果视为处理失败
+const lockSeconds = 30
+
+// MaxKeyLength 幂等键最大长度,与数据库字段长度一致
+const MaxKeyLength = 64
+
+// ErrProcessing 相同幂等键的请求正在处理中
+var ErrProcessing = errors.New("重复提交，申请正在处理中，请稍后刷新查看")
+
+// Store 幂等键存储
+type Store struct {
+	rds    *redis.Redis
+	prefix string
+	expire int
+}
+
+// NewStore 创建幂等键存储,prefix 区分业务,expire 为处理结果保留时长(秒)
+func NewStore(rds *redis.Redis, prefix string, expire int) *Store {
+	return &Store{
+		rds:    rds,
+		prefix: prefix,
+		expire: expire,
+	}
+}
+
+// Get 查询幂等键对应的处理结果,未记录时返回空字符串,处理中返回 ErrProcessing
+func (s *Store) Get(ctx context.Context, userId int64, key string) (string, error) {
+	val, err := s.rds.GetCtx(ctx, s.redisKey(userId, key))
+	if err != nil {
+		return "", err
+	}
+	if val == processing {
+		return "", ErrProcessing
+	}
+	return val, nil
+}
+
+// Acquire 占用幂等键,已被占用时返回 false
+func (s *Store) Acquire(ctx context.Context, userId int64, key string) (bool, error) {
+	return s.rds.SetnxExCtx(ctx, s.redisKey(userId, key), processing, lockSeconds)
+}
+
+// Save 记录幂等键对应的处理结果
+func (s *Store) Save(ctx context.Context, userId int64, key, result string) error {
+	return s.rds.SetexCtx(ctx, s.redisKey(userId, key), result, s.expire)
+}
+
+// Release 处理失败时释放幂等键,允许客户端使用相同幂等键重试
+func (s *Store) Release(ctx context.Context, userId int64, key string) error {
+	_, err := s.rds.DelCtx(ctx, s.redisKey(userId, key))
+	return err
+}
+
+// redisKey 幂等键按用户隔离
+func (s *Store) redisKey(userId int64, key string) string {
+	return fmt.Sprintf("idempotency:%s:%d:%s", s.prefix, userId, key)
+}
@@ -0,0 +1,64 @@
+// Package productsnapshot 申请创建时冻结的产品条款快照
+// 审批、还款计划、逾期罚息、提前还款等按快照条款执行,产品后续修改不影响已提交的申请
+package productsnapshot
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"common/repayment"
+)
+
+// Snapshot 产品条款快照
+type Snapshot struct {
+	ProductCode        string  `json:"product_code"`
+	Name               string  `json:"name"`
+	Type               string  `json:"type"`
+	InterestRate       float64 `json:"interest_rate"`
+	MinAmount          float64 `json:"min_amount"`
+	MaxAmount          float64 `json:"max_amount"`
+	MinDuration        int32   `json:"min_duration"`
+	MaxDuration        int32   `json:"max_duration"`
+	RepaymentProfile   string  `json:"repayment_profile"`
+	GraceMonths        int32   `json:"grace_months"`
+	HarvestMonths      string  `json:"harvest_months"`
+	PenaltyRate        float64 `json:"penalty_rate"`
+	PrepaymentFeeRate  float64 `json:"prepayment_fee_rate"`
+	OriginationFeeRate float64 `json:"origination_fee_rate"`
+	ServiceFeeRate     float64 `json:"service_fee_rate"`
+	GuaranteeFeeRate   float64 `json:"guarantee_fee_rate"`
+	LateFee            float64 `json:"late_fee"`
+	ProductUpdatedAt   int64   `json:"product_updated_at"`
+	SnapshotAt         int64   `json:"snapshot_at"`
+}
+
+// Encode 序列化快照用于落库
+func Encode(snapshot Snapshot) (sql.NullString, error) {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// Decode 解析申请记录中的快照,历史申请无快照时返回空
+func Decode(raw sql.NullString) (*Snapshot, error) {
+	if !raw.Valid || raw.String == "" {
+		return nil, nil
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal([]byte(raw.String), &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// Profile 返回快照冻结的还款模式
+func (s *Snapshot) Profile() repayment.Profile {
+	return repayment.Profile{
+		Seasonal:      s.RepaymentProfile == repayment.MethodSeasonal,
+		GraceMonths:   int(s.GraceMonths),
+		HarvestMonths: repayment.ParseHarvestMonths(s.HarvestMonths),
+	}
+}
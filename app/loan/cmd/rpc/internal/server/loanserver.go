@@ -59,3 +59,48 @@ func (s *LoanServer) ListLoanApprovals(ctx context.Context, in *loan.ListLoanApp
 	l := logic.NewListLoanApprovalsLogic(ctx, s.svcCtx)
 	return l.ListLoanApprovals(in)
 }
+
+// 还款计划管理
+func (s *LoanServer) GenerateRepaymentSchedule(ctx context.Context, in *loan.GenerateRepaymentScheduleReq) (*loan.GenerateRepaymentScheduleResp, error) {
+	l := logic.NewGenerateRepaymentScheduleLogic(ctx, s.svcCtx)
+	return l.GenerateRepaymentSchedule(in)
+}
+
+func (s *LoanServer) GetRepaymentSchedule(ctx context.Context, in *loan.GetRepaymentScheduleReq) (*loan.GetRepaymentScheduleResp, error) {
+	l := logic.NewGetRepaymentScheduleLogic(ctx, s.svcCtx)
+	return l.GetRepaymentSchedule(in)
+}
+
+// 放款管理
+func (s *LoanServer) DisburseLoan(ctx context.Context, in *loan.DisburseLoanReq) (*loan.DisburseLoanResp, error) {
+	l := logic.NewDisburseLoanLogic(ctx, s.svcCtx)
+	return l.DisburseLoan(in)
+}
+
+// 还款管理
+func (s *LoanServer) RecordRepayment(ctx context.Context, in *loan.RecordRepaymentReq) (*loan.RecordRepaymentResp, error) {
+	l := logic.NewRecordRepaymentLogic(ctx, s.svcCtx)
+	return l.RecordRepayment(in)
+}
+
+func (s *LoanServer) ListRepayments(ctx context.Context, in *loan.ListRepaymentsReq) (*loan.ListRepaymentsResp, error) {
+	l := logic.NewListRepaymentsLogic(ctx, s.svcCtx)
+	return l.ListRepayments(in)
+}
+
+// 提前还款
+func (s *LoanServer) QuoteEarlyRepayment(ctx context.Context, in *loan.QuoteEarlyRepaymentReq) (*loan.QuoteEarlyRepaymentResp, error) {
+	l := logic.NewQuoteEarlyRepaymentLogic(ctx, s.svcCtx)
+	return l.QuoteEarlyRepayment(in)
+}
+
+func (s *LoanServer) SettleEarly(ctx context.Context, in *loan.SettleEarlyReq) (*loan.SettleEarlyResp, error) {
+	l := logic.NewSettleEarlyLogic(ctx, s.svcCtx)
+	return l.SettleEarly(in)
+}
+
+// 产品引用检查
+func (s *LoanServer) CountProductApplications(ctx context.Context, in *loan.CountProductApplicationsReq) (*loan.CountProductApplicationsResp, error) {
+	l := logic.NewCountProductApplicationsLogic(ctx, s.svcCtx)
+	return l.CountProductApplications(in)
+}
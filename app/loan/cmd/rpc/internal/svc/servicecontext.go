@@ -6,15 +6,32 @@ import (
 	"model"
 	"rpc/internal/breaker"
 	"rpc/internal/config"
+	"rpc/internal/pkg/creditscore"
+	"rpc/internal/pkg/disburser"
+	"rpc/internal/pkg/idempotency"
 
+	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
-	Config                config.Config
-	LoanApplicationsModel model.LoanApplicationsModel
-	LoanApprovalsModel    model.LoanApprovalsModel
+	Config                  config.Config
+	LoanApplicationsModel   model.LoanApplicationsModel
+	LoanApprovalsModel      model.LoanApprovalsModel
+	LoanRepaymentPlansModel model.LoanRepaymentPlansModel
+	LoanDisbursementsModel  model.LoanDisbursementsModel
+	LoanRepaymentsModel     model.LoanRepaymentsModel
+	LoanCreditScoresModel   model.LoanCreditScoresModel
+
+	// 银行核心放款适配器
+	Disburser disburser.Disburser
+
+	// 信用评分引擎
+	CreditScorer *creditscore.Engine
+
+	// 申请提交幂等控制
+	Idempotency *idempotency.Store
 
 	// RPC 客户端 - 通过consul服务发现调用其他服务
 	LoanProductClient loanproductservice.LoanProductService
@@ -28,9 +45,22 @@ type ServiceContext struct {
 func NewServiceContext(c config.Config) *ServiceContext {
 	conn := sqlx.NewMysql(c.MySQL.DataSource)
 	return &ServiceContext{
-		Config:                c,
-		LoanApplicationsModel: model.NewLoanApplicationsModel(conn, c.CacheConf),
-		LoanApprovalsModel:    model.NewLoanApprovalsModel(conn, c.CacheConf),
+		Config:                  c,
+		LoanApplicationsModel:   model.NewLoanApplicationsModel(conn, c.CacheConf),
+		LoanApprovalsModel:      model.NewLoanApprovalsModel(conn, c.CacheConf),
+		LoanRepaymentPlansModel: model.NewLoanRepaymentPlansModel(conn, c.CacheConf),
+		LoanDisbursementsModel:  model.NewLoanDisbursementsModel(conn, c.CacheConf),
+		LoanRepaymentsModel:     model.NewLoanRepaymentsModel(conn, c.CacheConf),
+		LoanCreditScoresModel:   model.NewLoanCreditScoresModel(conn, c.CacheConf),
+
+		// 初始化放款适配器
+		Disburser: disburser.MustNew(c.Disburser.Channel),
+
+		// 加载信用评分规则
+		CreditScorer: creditscore.MustNew(c.CreditScore),
+
+		// 幂等键与模型缓存共用Redis
+		Idempotency: idempotency.NewStore(redis.MustNewRedis(c.CacheConf[0].RedisConf), "loan:create", c.Idempotency.Expire),
 
 		// 通过consul服务发现初始化RPC客户端
 		LoanProductClient: loanproductservice.NewLoanProductService(zrpc.MustNewClient(c.LoanProductRpc)),
@@ -24,22 +24,27 @@ const (
 
 // 贷款申请基础信息
 type LoanApplicationInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                           // 申请ID
-	ApplicationId string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"` // 申请编号
-	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 用户ID
-	ApplicantName string                 `protobuf:"bytes,4,opt,name=applicant_name,json=applicantName,proto3" json:"applicant_name,omitempty"` // 申请人姓名
-	ProductId     int64                  `protobuf:"varint,5,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`            // 贷款产品ID
-	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`                                        // 申请名称
-	Type          string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`                                        // 贷款类型
-	Amount        float64                `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`                                  // 申请金额
-	Duration      int32                  `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`                               // 贷款期限(月)
-	Purpose       string                 `protobuf:"bytes,10,opt,name=purpose,proto3" json:"purpose,omitempty"`                                 // 贷款用途
-	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`                                   // 状态 pending/approved/rejected/cancelled
-	CreatedAt     int64                  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`           // 创建时间
-	UpdatedAt     int64                  `protobuf:"varint,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`           // 更新时间
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                               // 申请ID
+	ApplicationId      string                 `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`                     // 申请编号
+	UserId             int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                         // 用户ID
+	ApplicantName      string                 `protobuf:"bytes,4,opt,name=applicant_name,json=applicantName,proto3" json:"applicant_name,omitempty"`                     // 申请人姓名
+	ProductId          int64                  `protobuf:"varint,5,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`                                // 贷款产品ID
+	Name               string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`                                                            // 申请名称
+	Type               string                 `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`                                                            // 贷款类型
+	Amount             float64                `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`                                                      // 申请金额
+	Duration           int32                  `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`                                                   // 贷款期限(月)
+	Purpose            string                 `protobuf:"bytes,10,opt,name=purpose,proto3" json:"purpose,omitempty"`                                                     // 贷款用途
+	Status             string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`                                                       // 状态 pending/approved/rejected/cancelled/disbursed/settled
+	CreatedAt          int64                  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                               // 创建时间
+	UpdatedAt          int64                  `protobuf:"varint,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                               // 更新时间
+	OriginationFeeRate float64                `protobuf:"fixed64,14,opt,name=origination_fee_rate,json=originationFeeRate,proto3" json:"origination_fee_rate,omitempty"` // 手续费率(%)
+	ServiceFeeRate     float64                `protobuf:"fixed64,15,opt,name=service_fee_rate,json=serviceFeeRate,proto3" json:"service_fee_rate,omitempty"`             // 服务费月费率(%)
+	GuaranteeFeeRate   float64                `protobuf:"fixed64,16,opt,name=guarantee_fee_rate,json=guaranteeFeeRate,proto3" json:"guarantee_fee_rate,omitempty"`       // 担保费率(%)
+	LateFee            float64                `protobuf:"fixed64,17,opt,name=late_fee,json=lateFee,proto3" json:"late_fee,omitempty"`                                    // 逾期滞纳金(元/期)
+	Apr                float64                `protobuf:"fixed64,18,opt,name=apr,proto3" json:"apr,omitempty"`                                                           // 综合年化利率(IRR,%)
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *LoanApplicationInfo) Reset() {
@@ -163,151 +168,145 @@ func (x *LoanApplicationInfo) GetUpdatedAt() int64 {
 	return 0
 }
 
-// 贷款审批记录基础信息
-type LoanApprovalInfo struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                     // 审批ID
-	ApplicationId    int64                  `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`          // 申请ID
-	AuditorId        int64                  `protobuf:"varint,3,opt,name=auditor_id,json=auditorId,proto3" json:"auditor_id,omitempty"`                      // 审核员ID
-	AuditorName      string                 `protobuf:"bytes,4,opt,name=auditor_name,json=auditorName,proto3" json:"auditor_name,omitempty"`                 // 审核员姓名
-	Action           string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`                                              // 审批动作 approve/reject
-	Suggestions      string                 `protobuf:"bytes,6,opt,name=suggestions,proto3" json:"suggestions,omitempty"`                                    // 审批意见
-	ApprovedAmount   float64                `protobuf:"fixed64,7,opt,name=approved_amount,json=approvedAmount,proto3" json:"approved_amount,omitempty"`      // 批准金额
-	ApprovedDuration int32                  `protobuf:"varint,8,opt,name=approved_duration,json=approvedDuration,proto3" json:"approved_duration,omitempty"` // 批准期限(月)
-	InterestRate     float64                `protobuf:"fixed64,9,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`            // 利率(%)
-	CreatedAt        int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                     // 创建时间
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *LoanApprovalInfo) Reset() {
-	*x = LoanApprovalInfo{}
-	mi := &file_loan_rpc_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *LoanApprovalInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*LoanApprovalInfo) ProtoMessage() {}
-
-func (x *LoanApprovalInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_rpc_proto_msgTypes[1]
+func (x *LoanApplicationInfo) GetOriginationFeeRate() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OriginationFeeRate
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use LoanApprovalInfo.ProtoReflect.Descriptor instead.
-func (*LoanApprovalInfo) Descriptor() ([]byte, []int) {
-	return file_loan_rpc_proto_rawDescGZIP(), []int{1}
+	return 0
 }
 
-func (x *LoanApprovalInfo) GetId() int64 {
+func (x *LoanApplicationInfo) GetServiceFeeRate() float64 {
 	if x != nil {
-		return x.Id
+		return x.ServiceFeeRate
 	}
 	return 0
 }
 
-func (x *LoanApprovalInfo) GetApplicationId() int64 {
+func (x *LoanApplicationInfo) GetGuaranteeFeeRate() float64 {
 	if x != nil {
-		return x.ApplicationId
+		return x.GuaranteeFeeRate
 	}
 	return 0
 }
 
-func (x *LoanApprovalInfo) GetAuditorId() int64 {
+func (x *LoanApplicationInfo) GetLateFee() float64 {
 	if x != nil {
-		return x.AuditorId
+		return x.LateFee
 	}
 	return 0
 }
 
-func (x *LoanApprovalInfo) GetAuditorName() string {
+func (x *LoanApplicationInfo) GetApr() float64 {
 	if x != nil {
-		return x.AuditorName
+		return x.Apr
 	}
-	return ""
+	return 0
 }
 
-func (x *LoanApprovalInfo) GetAction() string {
+// 信用评分明细
+type CreditScoreItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`       // 规则名称
+	Factor        string                 `protobuf:"bytes,2,opt,name=factor,proto3" json:"factor,omitempty"`   // 评分因子
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`     // 因子取值
+	Points        float64                `protobuf:"fixed64,4,opt,name=points,proto3" json:"points,omitempty"` // 得分
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`   // 评分说明
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditScoreItem) Reset() {
+	*x = CreditScoreItem{}
+	mi := &file_loan_rpc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditScoreItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditScoreItem) ProtoMessage() {}
+
+func (x *CreditScoreItem) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[1]
 	if x != nil {
-		return x.Action
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *LoanApprovalInfo) GetSuggestions() string {
+// Deprecated: Use CreditScoreItem.ProtoReflect.Descriptor instead.
+func (*CreditScoreItem) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreditScoreItem) GetRule() string {
 	if x != nil {
-		return x.Suggestions
+		return x.Rule
 	}
 	return ""
 }
 
-func (x *LoanApprovalInfo) GetApprovedAmount() float64 {
+func (x *CreditScoreItem) GetFactor() string {
 	if x != nil {
-		return x.ApprovedAmount
+		return x.Factor
 	}
-	return 0
+	return ""
 }
 
-func (x *LoanApprovalInfo) GetApprovedDuration() int32 {
+func (x *CreditScoreItem) GetValue() string {
 	if x != nil {
-		return x.ApprovedDuration
+		return x.Value
 	}
-	return 0
+	return ""
 }
 
-func (x *LoanApprovalInfo) GetInterestRate() float64 {
+func (x *CreditScoreItem) GetPoints() float64 {
 	if x != nil {
-		return x.InterestRate
+		return x.Points
 	}
 	return 0
 }
 
-func (x *LoanApprovalInfo) GetCreatedAt() int64 {
+func (x *CreditScoreItem) GetReason() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Reason
 	}
-	return 0
+	return ""
 }
 
-// 创建贷款申请
-type CreateLoanApplicationReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Duration      int32                  `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
-	Purpose       string                 `protobuf:"bytes,7,opt,name=purpose,proto3" json:"purpose,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 信用评分
+type CreditScoreInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Score          int32                  `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`                               // 信用评分
+	Grade          string                 `protobuf:"bytes,2,opt,name=grade,proto3" json:"grade,omitempty"`                                // 评分等级
+	Recommendation string                 `protobuf:"bytes,3,opt,name=recommendation,proto3" json:"recommendation,omitempty"`              // 审批建议 approve/review/reject
+	Items          []*CreditScoreItem     `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`                                // 评分明细
+	RuleVersion    string                 `protobuf:"bytes,5,opt,name=rule_version,json=ruleVersion,proto3" json:"rule_version,omitempty"` // 评分规则版本
+	ScoredAt       int64                  `protobuf:"varint,6,opt,name=scored_at,json=scoredAt,proto3" json:"scored_at,omitempty"`         // 评分时间
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *CreateLoanApplicationReq) Reset() {
-	*x = CreateLoanApplicationReq{}
+func (x *CreditScoreInfo) Reset() {
+	*x = CreditScoreInfo{}
 	mi := &file_loan_rpc_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateLoanApplicationReq) String() string {
+func (x *CreditScoreInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLoanApplicationReq) ProtoMessage() {}
+func (*CreditScoreInfo) ProtoMessage() {}
 
-func (x *CreateLoanApplicationReq) ProtoReflect() protoreflect.Message {
+func (x *CreditScoreInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_loan_rpc_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -319,81 +318,93 @@ func (x *CreateLoanApplicationReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLoanApplicationReq.ProtoReflect.Descriptor instead.
-func (*CreateLoanApplicationReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreditScoreInfo.ProtoReflect.Descriptor instead.
+func (*CreditScoreInfo) Descriptor() ([]byte, []int) {
 	return file_loan_rpc_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateLoanApplicationReq) GetUserId() int64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *CreateLoanApplicationReq) GetProductId() int64 {
+func (x *CreditScoreInfo) GetScore() int32 {
 	if x != nil {
-		return x.ProductId
+		return x.Score
 	}
 	return 0
 }
 
-func (x *CreateLoanApplicationReq) GetName() string {
+func (x *CreditScoreInfo) GetGrade() string {
 	if x != nil {
-		return x.Name
+		return x.Grade
 	}
 	return ""
 }
 
-func (x *CreateLoanApplicationReq) GetType() string {
+func (x *CreditScoreInfo) GetRecommendation() string {
 	if x != nil {
-		return x.Type
+		return x.Recommendation
 	}
 	return ""
 }
 
-func (x *CreateLoanApplicationReq) GetAmount() float64 {
+func (x *CreditScoreInfo) GetItems() []*CreditScoreItem {
 	if x != nil {
-		return x.Amount
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-func (x *CreateLoanApplicationReq) GetDuration() int32 {
+func (x *CreditScoreInfo) GetRuleVersion() string {
 	if x != nil {
-		return x.Duration
+		return x.RuleVersion
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateLoanApplicationReq) GetPurpose() string {
+func (x *CreditScoreInfo) GetScoredAt() int64 {
 	if x != nil {
-		return x.Purpose
+		return x.ScoredAt
 	}
-	return ""
-}
-
-type CreateLoanApplicationResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return 0
 }
 
-func (x *CreateLoanApplicationResp) Reset() {
-	*x = CreateLoanApplicationResp{}
+// 申请时的产品条款快照
+type LoanProductSnapshot struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode        string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                           // 产品编码
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                            // 产品名称
+	Type               string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                                            // 产品类型
+	InterestRate       float64                `protobuf:"fixed64,4,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`                      // 年利率(%)
+	MinAmount          float64                `protobuf:"fixed64,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`                               // 最小金额
+	MaxAmount          float64                `protobuf:"fixed64,6,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`                               // 最大金额
+	MinDuration        int32                  `protobuf:"varint,7,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`                          // 最小期限(月)
+	MaxDuration        int32                  `protobuf:"varint,8,opt,name=max_duration,json=maxDuration,proto3" json:"max_duration,omitempty"`                          // 最大期限(月)
+	RepaymentProfile   string                 `protobuf:"bytes,9,opt,name=repayment_profile,json=repaymentProfile,proto3" json:"repayment_profile,omitempty"`            // 还款模式 standard/seasonal
+	GraceMonths        int32                  `protobuf:"varint,10,opt,name=grace_months,json=graceMonths,proto3" json:"grace_months,omitempty"`                         // 宽限期(月)
+	HarvestMonths      string                 `protobuf:"bytes,11,opt,name=harvest_months,json=harvestMonths,proto3" json:"harvest_months,omitempty"`                    // 收获月份
+	PenaltyRate        float64                `protobuf:"fixed64,12,opt,name=penalty_rate,json=penaltyRate,proto3" json:"penalty_rate,omitempty"`                        // 罚息日利率(%)
+	PrepaymentFeeRate  float64                `protobuf:"fixed64,13,opt,name=prepayment_fee_rate,json=prepaymentFeeRate,proto3" json:"prepayment_fee_rate,omitempty"`    // 提前还款手续费率(%)
+	OriginationFeeRate float64                `protobuf:"fixed64,14,opt,name=origination_fee_rate,json=originationFeeRate,proto3" json:"origination_fee_rate,omitempty"` // 手续费率(%)
+	ServiceFeeRate     float64                `protobuf:"fixed64,15,opt,name=service_fee_rate,json=serviceFeeRate,proto3" json:"service_fee_rate,omitempty"`             // 服务费月费率(%)
+	GuaranteeFeeRate   float64                `protobuf:"fixed64,16,opt,name=guarantee_fee_rate,json=guaranteeFeeRate,proto3" json:"guarantee_fee_rate,omitempty"`       // 担保费率(%)
+	LateFee            float64                `protobuf:"fixed64,17,opt,name=late_fee,json=lateFee,proto3" json:"late_fee,omitempty"`                                    // 逾期滞纳金(元/期)
+	ProductUpdatedAt   int64                  `protobuf:"varint,18,opt,name=product_updated_at,json=productUpdatedAt,proto3" json:"product_updated_at,omitempty"`        // 快照对应的产品更新时间
+	SnapshotAt         int64                  `protobuf:"varint,19,opt,name=snapshot_at,json=snapshotAt,proto3" json:"snapshot_at,omitempty"`                            // 快照时间
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *LoanProductSnapshot) Reset() {
+	*x = LoanProductSnapshot{}
 	mi := &file_loan_rpc_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateLoanApplicationResp) String() string {
+func (x *LoanProductSnapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLoanApplicationResp) ProtoMessage() {}
+func (*LoanProductSnapshot) ProtoMessage() {}
 
-func (x *CreateLoanApplicationResp) ProtoReflect() protoreflect.Message {
+func (x *LoanProductSnapshot) ProtoReflect() protoreflect.Message {
 	mi := &file_loan_rpc_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -405,133 +416,1804 @@ func (x *CreateLoanApplicationResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLoanApplicationResp.ProtoReflect.Descriptor instead.
-func (*CreateLoanApplicationResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoanProductSnapshot.ProtoReflect.Descriptor instead.
+func (*LoanProductSnapshot) Descriptor() ([]byte, []int) {
 	return file_loan_rpc_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateLoanApplicationResp) GetApplicationId() string {
+func (x *LoanProductSnapshot) GetProductCode() string {
 	if x != nil {
-		return x.ApplicationId
+		return x.ProductCode
 	}
 	return ""
 }
 
-// 获取贷款申请
-type GetLoanApplicationReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LoanProductSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *GetLoanApplicationReq) Reset() {
-	*x = GetLoanApplicationReq{}
-	mi := &file_loan_rpc_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *LoanProductSnapshot) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x *GetLoanApplicationReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LoanProductSnapshot) GetInterestRate() float64 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
 }
 
-func (*GetLoanApplicationReq) ProtoMessage() {}
+func (x *LoanProductSnapshot) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
 
-func (x *GetLoanApplicationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_rpc_proto_msgTypes[4]
+func (x *LoanProductSnapshot) GetMaxAmount() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MaxAmount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetLoanApplicationReq.ProtoReflect.Descriptor instead.
-func (*GetLoanApplicationReq) Descriptor() ([]byte, []int) {
-	return file_loan_rpc_proto_rawDescGZIP(), []int{4}
+func (x *LoanProductSnapshot) GetMinDuration() int32 {
+	if x != nil {
+		return x.MinDuration
+	}
+	return 0
 }
 
-func (x *GetLoanApplicationReq) GetApplicationId() string {
+func (x *LoanProductSnapshot) GetMaxDuration() int32 {
 	if x != nil {
-		return x.ApplicationId
+		return x.MaxDuration
+	}
+	return 0
+}
+
+func (x *LoanProductSnapshot) GetRepaymentProfile() string {
+	if x != nil {
+		return x.RepaymentProfile
 	}
 	return ""
 }
 
-type GetLoanApplicationResp struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationInfo *LoanApplicationInfo   `protobuf:"bytes,1,opt,name=application_info,json=applicationInfo,proto3" json:"application_info,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *LoanProductSnapshot) GetGraceMonths() int32 {
+	if x != nil {
+		return x.GraceMonths
+	}
+	return 0
 }
 
-func (x *GetLoanApplicationResp) Reset() {
-	*x = GetLoanApplicationResp{}
-	mi := &file_loan_rpc_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *LoanProductSnapshot) GetHarvestMonths() string {
+	if x != nil {
+		return x.HarvestMonths
+	}
+	return ""
 }
 
-func (x *GetLoanApplicationResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LoanProductSnapshot) GetPenaltyRate() float64 {
+	if x != nil {
+		return x.PenaltyRate
+	}
+	return 0
 }
 
-func (*GetLoanApplicationResp) ProtoMessage() {}
+func (x *LoanProductSnapshot) GetPrepaymentFeeRate() float64 {
+	if x != nil {
+		return x.PrepaymentFeeRate
+	}
+	return 0
+}
 
-func (x *GetLoanApplicationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_rpc_proto_msgTypes[5]
+func (x *LoanProductSnapshot) GetOriginationFeeRate() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.OriginationFeeRate
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetLoanApplicationResp.ProtoReflect.Descriptor instead.
-func (*GetLoanApplicationResp) Descriptor() ([]byte, []int) {
-	return file_loan_rpc_proto_rawDescGZIP(), []int{5}
+func (x *LoanProductSnapshot) GetServiceFeeRate() float64 {
+	if x != nil {
+		return x.ServiceFeeRate
+	}
+	return 0
 }
 
-func (x *GetLoanApplicationResp) GetApplicationInfo() *LoanApplicationInfo {
+func (x *LoanProductSnapshot) GetGuaranteeFeeRate() float64 {
 	if x != nil {
-		return x.ApplicationInfo
+		return x.GuaranteeFeeRate
 	}
-	return nil
+	return 0
 }
 
-// 获取贷款申请列表
-type ListLoanApplicationsReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+func (x *LoanProductSnapshot) GetLateFee() float64 {
+	if x != nil {
+		return x.LateFee
+	}
+	return 0
+}
+
+func (x *LoanProductSnapshot) GetProductUpdatedAt() int64 {
+	if x != nil {
+		return x.ProductUpdatedAt
+	}
+	return 0
+}
+
+func (x *LoanProductSnapshot) GetSnapshotAt() int64 {
+	if x != nil {
+		return x.SnapshotAt
+	}
+	return 0
+}
+
+// 贷款审批记录基础信息
+type LoanApprovalInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                     // 审批ID
+	ApplicationId    int64                  `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`          // 申请ID
+	AuditorId        int64                  `protobuf:"varint,3,opt,name=auditor_id,json=auditorId,proto3" json:"auditor_id,omitempty"`                      // 审核员ID
+	AuditorName      string                 `protobuf:"bytes,4,opt,name=auditor_name,json=auditorName,proto3" json:"auditor_name,omitempty"`                 // 审核员姓名
+	Action           string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`                                              // 审批动作 approve/reject
+	Suggestions      string                 `protobuf:"bytes,6,opt,name=suggestions,proto3" json:"suggestions,omitempty"`                                    // 审批意见
+	ApprovedAmount   float64                `protobuf:"fixed64,7,opt,name=approved_amount,json=approvedAmount,proto3" json:"approved_amount,omitempty"`      // 批准金额
+	ApprovedDuration int32                  `protobuf:"varint,8,opt,name=approved_duration,json=approvedDuration,proto3" json:"approved_duration,omitempty"` // 批准期限(月)
+	InterestRate     float64                `protobuf:"fixed64,9,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`            // 利率(%)
+	CreatedAt        int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                     // 创建时间
+	Stage            int32                  `protobuf:"varint,11,opt,name=stage,proto3" json:"stage,omitempty"`                                              // 审批环节序号
+	StageName        string                 `protobuf:"bytes,12,opt,name=stage_name,json=stageName,proto3" json:"stage_name,omitempty"`                      // 审批环节名称
+	AuditorRole      string                 `protobuf:"bytes,13,opt,name=auditor_role,json=auditorRole,proto3" json:"auditor_role,omitempty"`                // 审核员角色 admin/operator
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LoanApprovalInfo) Reset() {
+	*x = LoanApprovalInfo{}
+	mi := &file_loan_rpc_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanApprovalInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanApprovalInfo) ProtoMessage() {}
+
+func (x *LoanApprovalInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanApprovalInfo.ProtoReflect.Descriptor instead.
+func (*LoanApprovalInfo) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LoanApprovalInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LoanApprovalInfo) GetApplicationId() int64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *LoanApprovalInfo) GetAuditorId() int64 {
+	if x != nil {
+		return x.AuditorId
+	}
+	return 0
+}
+
+func (x *LoanApprovalInfo) GetAuditorName() string {
+	if x != nil {
+		return x.AuditorName
+	}
+	return ""
+}
+
+func (x *LoanApprovalInfo) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *LoanApprovalInfo) GetSuggestions() string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return ""
+}
+
+func (x *LoanApprovalInfo) GetApprovedAmount() float64 {
+	if x != nil {
+		return x.ApprovedAmount
+	}
+	return 0
+}
+
+func (x *LoanApprovalInfo) GetApprovedDuration() int32 {
+	if x != nil {
+		return x.ApprovedDuration
+	}
+	return 0
+}
+
+func (x *LoanApprovalInfo) GetInterestRate() float64 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
+
+func (x *LoanApprovalInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *LoanApprovalInfo) GetStage() int32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *LoanApprovalInfo) GetStageName() string {
+	if x != nil {
+		return x.StageName
+	}
+	return ""
+}
+
+func (x *LoanApprovalInfo) GetAuditorRole() string {
+	if x != nil {
+		return x.AuditorRole
+	}
+	return ""
+}
+
+// 还款计划基础信息
+type RepaymentPlanInfo struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                            // 还款计划ID
+	ApplicationId      int64                  `protobuf:"varint,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`                 // 申请ID
+	InstallmentNo      int32                  `protobuf:"varint,3,opt,name=installment_no,json=installmentNo,proto3" json:"installment_no,omitempty"`                 // 期数
+	DueDate            string                 `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`                                    // 应还日期 YYYY-MM-DD
+	Principal          float64                `protobuf:"fixed64,5,opt,name=principal,proto3" json:"principal,omitempty"`                                             // 应还本金
+	Interest           float64                `protobuf:"fixed64,6,opt,name=interest,proto3" json:"interest,omitempty"`                                               // 应还利息
+	TotalAmount        float64                `protobuf:"fixed64,7,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`                      // 应还总额(本金+利息+服务费)
+	RemainingPrincipal float64                `protobuf:"fixed64,8,opt,name=remaining_principal,json=remainingPrincipal,proto3" json:"remaining_principal,omitempty"` // 剩余本金
+	RepaymentMethod    string                 `protobuf:"bytes,9,opt,name=repayment_method,json=repaymentMethod,proto3" json:"repayment_method,omitempty"`            // 还款方式 equal_installment/equal_principal/interest_only/seasonal
+	Status             string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`                                                    // 状态 pending/paid/overdue
+	CreatedAt          int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                            // 创建时间
+	UpdatedAt          int64                  `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                            // 更新时间
+	PaidPrincipal      float64                `protobuf:"fixed64,13,opt,name=paid_principal,json=paidPrincipal,proto3" json:"paid_principal,omitempty"`               // 已还本金
+	PaidInterest       float64                `protobuf:"fixed64,14,opt,name=paid_interest,json=paidInterest,proto3" json:"paid_interest,omitempty"`                  // 已还利息
+	Penalty            float64                `protobuf:"fixed64,15,opt,name=penalty,proto3" json:"penalty,omitempty"`                                                // 应还罚息
+	PaidPenalty        float64                `protobuf:"fixed64,16,opt,name=paid_penalty,json=paidPenalty,proto3" json:"paid_penalty,omitempty"`                     // 已还罚息
+	OverdueDays        int32                  `protobuf:"varint,17,opt,name=overdue_days,json=overdueDays,proto3" json:"overdue_days,omitempty"`                      // 逾期天数
+	PaidAt             int64                  `protobuf:"varint,18,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`                                     // 结清时间
+	ServiceFee         float64                `protobuf:"fixed64,19,opt,name=service_fee,json=serviceFee,proto3" json:"service_fee,omitempty"`                        // 应还服务费
+	PaidServiceFee     float64                `protobuf:"fixed64,20,opt,name=paid_service_fee,json=paidServiceFee,proto3" json:"paid_service_fee,omitempty"`          // 已还服务费
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RepaymentPlanInfo) Reset() {
+	*x = RepaymentPlanInfo{}
+	mi := &file_loan_rpc_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepaymentPlanInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepaymentPlanInfo) ProtoMessage() {}
+
+func (x *RepaymentPlanInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepaymentPlanInfo.ProtoReflect.Descriptor instead.
+func (*RepaymentPlanInfo) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RepaymentPlanInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetApplicationId() int64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetInstallmentNo() int32 {
+	if x != nil {
+		return x.InstallmentNo
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *RepaymentPlanInfo) GetPrincipal() float64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetInterest() float64 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetRemainingPrincipal() float64 {
+	if x != nil {
+		return x.RemainingPrincipal
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetRepaymentMethod() string {
+	if x != nil {
+		return x.RepaymentMethod
+	}
+	return ""
+}
+
+func (x *RepaymentPlanInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RepaymentPlanInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetPaidPrincipal() float64 {
+	if x != nil {
+		return x.PaidPrincipal
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetPaidInterest() float64 {
+	if x != nil {
+		return x.PaidInterest
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetPenalty() float64 {
+	if x != nil {
+		return x.Penalty
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetPaidPenalty() float64 {
+	if x != nil {
+		return x.PaidPenalty
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetOverdueDays() int32 {
+	if x != nil {
+		return x.OverdueDays
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetPaidAt() int64 {
+	if x != nil {
+		return x.PaidAt
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetServiceFee() float64 {
+	if x != nil {
+		return x.ServiceFee
+	}
+	return 0
+}
+
+func (x *RepaymentPlanInfo) GetPaidServiceFee() float64 {
+	if x != nil {
+		return x.PaidServiceFee
+	}
+	return 0
+}
+
+// 还款记录基础信息
+type LoanRepaymentInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                   // 还款记录ID
+	RepaymentNo     string                 `protobuf:"bytes,2,opt,name=repayment_no,json=repaymentNo,proto3" json:"repayment_no,omitempty"`               // 还款流水号
+	ApplicationId   int64                  `protobuf:"varint,3,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`        // 申请ID
+	UserId          int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                             // 还款用户ID
+	Amount          float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`                                          // 还款金额
+	PrincipalAmount float64                `protobuf:"fixed64,6,opt,name=principal_amount,json=principalAmount,proto3" json:"principal_amount,omitempty"` // 冲抵本金
+	InterestAmount  float64                `protobuf:"fixed64,7,opt,name=interest_amount,json=interestAmount,proto3" json:"interest_amount,omitempty"`    // 冲抵利息
+	PenaltyAmount   float64                `protobuf:"fixed64,8,opt,name=penalty_amount,json=penaltyAmount,proto3" json:"penalty_amount,omitempty"`       // 冲抵罚息
+	InstallmentNos  string                 `protobuf:"bytes,9,opt,name=installment_nos,json=installmentNos,proto3" json:"installment_nos,omitempty"`      // 冲抵期数,逗号分隔
+	Channel         string                 `protobuf:"bytes,10,opt,name=channel,proto3" json:"channel,omitempty"`                                         // 还款渠道
+	Remark          string                 `protobuf:"bytes,11,opt,name=remark,proto3" json:"remark,omitempty"`                                           // 备注
+	CreatedAt       int64                  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                   // 还款时间
+	FeeAmount       float64                `protobuf:"fixed64,13,opt,name=fee_amount,json=feeAmount,proto3" json:"fee_amount,omitempty"`                  // 冲抵费用(服务费、提前还款手续费)
+	RepaymentType   string                 `protobuf:"bytes,14,opt,name=repayment_type,json=repaymentType,proto3" json:"repayment_type,omitempty"`        // 还款类型 regular/prepay
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LoanRepaymentInfo) Reset() {
+	*x = LoanRepaymentInfo{}
+	mi := &file_loan_rpc_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanRepaymentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanRepaymentInfo) ProtoMessage() {}
+
+func (x *LoanRepaymentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanRepaymentInfo.ProtoReflect.Descriptor instead.
+func (*LoanRepaymentInfo) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LoanRepaymentInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LoanRepaymentInfo) GetRepaymentNo() string {
+	if x != nil {
+		return x.RepaymentNo
+	}
+	return ""
+}
+
+func (x *LoanRepaymentInfo) GetApplicationId() int64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *LoanRepaymentInfo) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LoanRepaymentInfo) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LoanRepaymentInfo) GetPrincipalAmount() float64 {
+	if x != nil {
+		return x.PrincipalAmount
+	}
+	return 0
+}
+
+func (x *LoanRepaymentInfo) GetInterestAmount() float64 {
+	if x != nil {
+		return x.InterestAmount
+	}
+	return 0
+}
+
+func (x *LoanRepaymentInfo) GetPenaltyAmount() float64 {
+	if x != nil {
+		return x.PenaltyAmount
+	}
+	return 0
+}
+
+func (x *LoanRepaymentInfo) GetInstallmentNos() string {
+	if x != nil {
+		return x.InstallmentNos
+	}
+	return ""
+}
+
+func (x *LoanRepaymentInfo) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *LoanRepaymentInfo) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *LoanRepaymentInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *LoanRepaymentInfo) GetFeeAmount() float64 {
+	if x != nil {
+		return x.FeeAmount
+	}
+	return 0
+}
+
+func (x *LoanRepaymentInfo) GetRepaymentType() string {
+	if x != nil {
+		return x.RepaymentType
+	}
+	return ""
+}
+
+// 放款记录基础信息
+type LoanDisbursementInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                              // 放款ID
+	DisbursementNo string                 `protobuf:"bytes,2,opt,name=disbursement_no,json=disbursementNo,proto3" json:"disbursement_no,omitempty"` // 放款流水号
+	ApplicationId  int64                  `protobuf:"varint,3,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`   // 申请ID
+	Amount         float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`                                     // 放款金额
+	AccountName    string                 `protobuf:"bytes,5,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`          // 收款户名
+	AccountNo      string                 `protobuf:"bytes,6,opt,name=account_no,json=accountNo,proto3" json:"account_no,omitempty"`                // 收款账号
+	BankName       string                 `protobuf:"bytes,7,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`                   // 开户行
+	Channel        string                 `protobuf:"bytes,8,opt,name=channel,proto3" json:"channel,omitempty"`                                     // 放款渠道
+	BankSerialNo   string                 `protobuf:"bytes,9,opt,name=bank_serial_no,json=bankSerialNo,proto3" json:"bank_serial_no,omitempty"`     // 银行核心交易流水号
+	Status         string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`                                      // 状态 pending/unknown/success/failed
+	FailReason     string                 `protobuf:"bytes,11,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`            // 失败原因
+	OperatorId     int64                  `protobuf:"varint,12,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`           // 操作员ID
+	OperatorName   string                 `protobuf:"bytes,13,opt,name=operator_name,json=operatorName,proto3" json:"operator_name,omitempty"`      // 操作员姓名
+	DisbursedAt    int64                  `protobuf:"varint,14,opt,name=disbursed_at,json=disbursedAt,proto3" json:"disbursed_at,omitempty"`        // 放款成功时间
+	CreatedAt      int64                  `protobuf:"varint,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`              // 创建时间
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LoanDisbursementInfo) Reset() {
+	*x = LoanDisbursementInfo{}
+	mi := &file_loan_rpc_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanDisbursementInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanDisbursementInfo) ProtoMessage() {}
+
+func (x *LoanDisbursementInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanDisbursementInfo.ProtoReflect.Descriptor instead.
+func (*LoanDisbursementInfo) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LoanDisbursementInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LoanDisbursementInfo) GetDisbursementNo() string {
+	if x != nil {
+		return x.DisbursementNo
+	}
+	return ""
+}
+
+func (x *LoanDisbursementInfo) GetApplicationId() int64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *LoanDisbursementInfo) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LoanDisbursementInfo) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *LoanDisbursementInfo) GetAccountNo() string {
+	if x != nil {
+		return x.AccountNo
+	}
+	return ""
+}
+
+func (x *LoanDisbursementInfo) GetBankName() string {
+	if x != nil {
+		return x.BankName
+	}
+	return ""
+}
+
+func (x *LoanDisbursementInfo) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *LoanDisbursementInfo) GetBankSerialNo() string {
+	if x != nil {
+		return x.BankSerialNo
+	}
+	return ""
+}
+
+func (x *LoanDisbursementInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LoanDisbursementInfo) GetFailReason() string {
+	if x != nil {
+		return x.FailReason
+	}
+	return ""
+}
+
+func (x *LoanDisbursementInfo) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *LoanDisbursementInfo) GetOperatorName() string {
+	if x != nil {
+		return x.OperatorName
+	}
+	return ""
+}
+
+func (x *LoanDisbursementInfo) GetDisbursedAt() int64 {
+	if x != nil {
+		return x.DisbursedAt
+	}
+	return 0
+}
+
+func (x *LoanDisbursementInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// 创建贷款申请
+type CreateLoanApplicationReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId      int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type           string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Amount         float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Duration       int32                  `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	Purpose        string                 `protobuf:"bytes,7,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,8,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 幂等键,同一用户相同幂等键的重复提交返回首次创建的申请编号
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateLoanApplicationReq) Reset() {
+	*x = CreateLoanApplicationReq{}
+	mi := &file_loan_rpc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLoanApplicationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLoanApplicationReq) ProtoMessage() {}
+
+func (x *CreateLoanApplicationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLoanApplicationReq.ProtoReflect.Descriptor instead.
+func (*CreateLoanApplicationReq) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateLoanApplicationReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateLoanApplicationReq) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CreateLoanApplicationReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateLoanApplicationReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateLoanApplicationReq) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateLoanApplicationReq) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CreateLoanApplicationReq) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *CreateLoanApplicationReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+// 准入校验未通过原因
+type EligibilityReason struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`       // income_missing/debt_to_income/active_per_user/active_per_product,产品准入规则: occupation/age/income/profile_gap
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 原因说明
+	Limit         float64                `protobuf:"fixed64,3,opt,name=limit,proto3" json:"limit,omitempty"`   // 限额
+	Actual        float64                `protobuf:"fixed64,4,opt,name=actual,proto3" json:"actual,omitempty"` // 实际值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EligibilityReason) Reset() {
+	*x = EligibilityReason{}
+	mi := &file_loan_rpc_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EligibilityReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EligibilityReason) ProtoMessage() {}
+
+func (x *EligibilityReason) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EligibilityReason.ProtoReflect.Descriptor instead.
+func (*EligibilityReason) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EligibilityReason) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *EligibilityReason) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EligibilityReason) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *EligibilityReason) GetActual() float64 {
+	if x != nil {
+		return x.Actual
+	}
+	return 0
+}
+
+type CreateLoanApplicationResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"` // 申请编号,未通过准入校验时为空
+	Rejected      bool                   `protobuf:"varint,2,opt,name=rejected,proto3" json:"rejected,omitempty"`                               // 是否未通过准入校验
+	RejectReasons []*EligibilityReason   `protobuf:"bytes,3,rep,name=reject_reasons,json=rejectReasons,proto3" json:"reject_reasons,omitempty"` // 未通过准入校验的原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLoanApplicationResp) Reset() {
+	*x = CreateLoanApplicationResp{}
+	mi := &file_loan_rpc_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLoanApplicationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLoanApplicationResp) ProtoMessage() {}
+
+func (x *CreateLoanApplicationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLoanApplicationResp.ProtoReflect.Descriptor instead.
+func (*CreateLoanApplicationResp) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateLoanApplicationResp) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *CreateLoanApplicationResp) GetRejected() bool {
+	if x != nil {
+		return x.Rejected
+	}
+	return false
+}
+
+func (x *CreateLoanApplicationResp) GetRejectReasons() []*EligibilityReason {
+	if x != nil {
+		return x.RejectReasons
+	}
+	return nil
+}
+
+// 获取贷款申请
+type GetLoanApplicationReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoanApplicationReq) Reset() {
+	*x = GetLoanApplicationReq{}
+	mi := &file_loan_rpc_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoanApplicationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanApplicationReq) ProtoMessage() {}
+
+func (x *GetLoanApplicationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanApplicationReq.ProtoReflect.Descriptor instead.
+func (*GetLoanApplicationReq) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetLoanApplicationReq) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type GetLoanApplicationResp struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationInfo *LoanApplicationInfo   `protobuf:"bytes,1,opt,name=application_info,json=applicationInfo,proto3" json:"application_info,omitempty"`
+	CreditScore     *CreditScoreInfo       `protobuf:"bytes,2,opt,name=credit_score,json=creditScore,proto3" json:"credit_score,omitempty"`             // 信用评分,未评分时为空
+	ProductSnapshot *LoanProductSnapshot   `protobuf:"bytes,3,opt,name=product_snapshot,json=productSnapshot,proto3" json:"product_snapshot,omitempty"` // 申请时的产品条款,历史申请无快照时为空
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetLoanApplicationResp) Reset() {
+	*x = GetLoanApplicationResp{}
+	mi := &file_loan_rpc_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoanApplicationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoanApplicationResp) ProtoMessage() {}
+
+func (x *GetLoanApplicationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoanApplicationResp.ProtoReflect.Descriptor instead.
+func (*GetLoanApplicationResp) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetLoanApplicationResp) GetApplicationInfo() *LoanApplicationInfo {
+	if x != nil {
+		return x.ApplicationInfo
+	}
+	return nil
+}
+
+func (x *GetLoanApplicationResp) GetCreditScore() *CreditScoreInfo {
+	if x != nil {
+		return x.CreditScore
+	}
+	return nil
+}
+
+func (x *GetLoanApplicationResp) GetProductSnapshot() *LoanProductSnapshot {
+	if x != nil {
+		return x.ProductSnapshot
+	}
+	return nil
+}
+
+// 获取贷款申请列表
+type ListLoanApplicationsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 	UserId        int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLoanApplicationsReq) Reset() {
-	*x = ListLoanApplicationsReq{}
-	mi := &file_loan_rpc_proto_msgTypes[6]
+func (x *ListLoanApplicationsReq) Reset() {
+	*x = ListLoanApplicationsReq{}
+	mi := &file_loan_rpc_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoanApplicationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoanApplicationsReq) ProtoMessage() {}
+
+func (x *ListLoanApplicationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoanApplicationsReq.ProtoReflect.Descriptor instead.
+func (*ListLoanApplicationsReq) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListLoanApplicationsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListLoanApplicationsReq) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListLoanApplicationsReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListLoanApplicationsReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListLoanApplicationsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*LoanApplicationInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoanApplicationsResp) Reset() {
+	*x = ListLoanApplicationsResp{}
+	mi := &file_loan_rpc_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoanApplicationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoanApplicationsResp) ProtoMessage() {}
+
+func (x *ListLoanApplicationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoanApplicationsResp.ProtoReflect.Descriptor instead.
+func (*ListLoanApplicationsResp) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListLoanApplicationsResp) GetList() []*LoanApplicationInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+func (x *ListLoanApplicationsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 更新贷款申请
+type UpdateLoanApplicationReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Duration      int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Purpose       string                 `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLoanApplicationReq) Reset() {
+	*x = UpdateLoanApplicationReq{}
+	mi := &file_loan_rpc_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLoanApplicationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLoanApplicationReq) ProtoMessage() {}
+
+func (x *UpdateLoanApplicationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLoanApplicationReq.ProtoReflect.Descriptor instead.
+func (*UpdateLoanApplicationReq) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateLoanApplicationReq) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *UpdateLoanApplicationReq) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *UpdateLoanApplicationReq) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *UpdateLoanApplicationReq) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+type UpdateLoanApplicationResp struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationInfo *LoanApplicationInfo   `protobuf:"bytes,1,opt,name=application_info,json=applicationInfo,proto3" json:"application_info,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateLoanApplicationResp) Reset() {
+	*x = UpdateLoanApplicationResp{}
+	mi := &file_loan_rpc_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLoanApplicationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLoanApplicationResp) ProtoMessage() {}
+
+func (x *UpdateLoanApplicationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLoanApplicationResp.ProtoReflect.Descriptor instead.
+func (*UpdateLoanApplicationResp) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateLoanApplicationResp) GetApplicationInfo() *LoanApplicationInfo {
+	if x != nil {
+		return x.ApplicationInfo
+	}
+	return nil
+}
+
+// 撤销贷款申请 - 待审批或已批准未放款的申请可撤销,撤销已批准的申请会释放占用的产品放贷额度并作废还款计划,放款处理中的申请不能撤销
+type CancelLoanApplicationReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelLoanApplicationReq) Reset() {
+	*x = CancelLoanApplicationReq{}
+	mi := &file_loan_rpc_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelLoanApplicationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelLoanApplicationReq) ProtoMessage() {}
+
+func (x *CancelLoanApplicationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelLoanApplicationReq.ProtoReflect.Descriptor instead.
+func (*CancelLoanApplicationReq) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CancelLoanApplicationReq) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *CancelLoanApplicationReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelLoanApplicationResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelLoanApplicationResp) Reset() {
+	*x = CancelLoanApplicationResp{}
+	mi := &file_loan_rpc_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelLoanApplicationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelLoanApplicationResp) ProtoMessage() {}
+
+func (x *CancelLoanApplicationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelLoanApplicationResp.ProtoReflect.Descriptor instead.
+func (*CancelLoanApplicationResp) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{18}
+}
+
+// 审批贷款申请
+type ApproveLoanApplicationReq struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId    string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	AuditorId        int64                  `protobuf:"varint,2,opt,name=auditor_id,json=auditorId,proto3" json:"auditor_id,omitempty"`
+	AuditorName      string                 `protobuf:"bytes,3,opt,name=auditor_name,json=auditorName,proto3" json:"auditor_name,omitempty"`
+	Action           string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"` // approve/reject
+	Suggestions      string                 `protobuf:"bytes,5,opt,name=suggestions,proto3" json:"suggestions,omitempty"`
+	ApprovedAmount   float64                `protobuf:"fixed64,6,opt,name=approved_amount,json=approvedAmount,proto3" json:"approved_amount,omitempty"`
+	ApprovedDuration int32                  `protobuf:"varint,7,opt,name=approved_duration,json=approvedDuration,proto3" json:"approved_duration,omitempty"`
+	InterestRate     float64                `protobuf:"fixed64,8,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	RepaymentMethod  string                 `protobuf:"bytes,9,opt,name=repayment_method,json=repaymentMethod,proto3" json:"repayment_method,omitempty"` // equal_installment/equal_principal/interest_only, 默认equal_installment; 季节性产品固定按收获季还款
+	AuditorRole      string                 `protobuf:"bytes,10,opt,name=auditor_role,json=auditorRole,proto3" json:"auditor_role,omitempty"`            // 审核员角色 admin/operator,按产品审批链校验
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ApproveLoanApplicationReq) Reset() {
+	*x = ApproveLoanApplicationReq{}
+	mi := &file_loan_rpc_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveLoanApplicationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveLoanApplicationReq) ProtoMessage() {}
+
+func (x *ApproveLoanApplicationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveLoanApplicationReq.ProtoReflect.Descriptor instead.
+func (*ApproveLoanApplicationReq) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ApproveLoanApplicationReq) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ApproveLoanApplicationReq) GetAuditorId() int64 {
+	if x != nil {
+		return x.AuditorId
+	}
+	return 0
+}
+
+func (x *ApproveLoanApplicationReq) GetAuditorName() string {
+	if x != nil {
+		return x.AuditorName
+	}
+	return ""
+}
+
+func (x *ApproveLoanApplicationReq) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ApproveLoanApplicationReq) GetSuggestions() string {
+	if x != nil {
+		return x.Suggestions
+	}
+	return ""
+}
+
+func (x *ApproveLoanApplicationReq) GetApprovedAmount() float64 {
+	if x != nil {
+		return x.ApprovedAmount
+	}
+	return 0
+}
+
+func (x *ApproveLoanApplicationReq) GetApprovedDuration() int32 {
+	if x != nil {
+		return x.ApprovedDuration
+	}
+	return 0
+}
+
+func (x *ApproveLoanApplicationReq) GetInterestRate() float64 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
+
+func (x *ApproveLoanApplicationReq) GetRepaymentMethod() string {
+	if x != nil {
+		return x.RepaymentMethod
+	}
+	return ""
+}
+
+func (x *ApproveLoanApplicationReq) GetAuditorRole() string {
+	if x != nil {
+		return x.AuditorRole
+	}
+	return ""
+}
+
+// 按产品审批链逐级审批,最后一个环节批准后申请才变为approved
+type ApproveLoanApplicationResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stage         int32                  `protobuf:"varint,1,opt,name=stage,proto3" json:"stage,omitempty"`                             // 本次处理的审批环节序号
+	StageName     string                 `protobuf:"bytes,2,opt,name=stage_name,json=stageName,proto3" json:"stage_name,omitempty"`     // 本次处理的审批环节名称
+	TotalStage    int32                  `protobuf:"varint,3,opt,name=total_stage,json=totalStage,proto3" json:"total_stage,omitempty"` // 该申请需要经过的审批环节总数
+	Finished      bool                   `protobuf:"varint,4,opt,name=finished,proto3" json:"finished,omitempty"`                       // 审批链是否已结束
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                            // 审批后申请状态
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveLoanApplicationResp) Reset() {
+	*x = ApproveLoanApplicationResp{}
+	mi := &file_loan_rpc_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveLoanApplicationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveLoanApplicationResp) ProtoMessage() {}
+
+func (x *ApproveLoanApplicationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveLoanApplicationResp.ProtoReflect.Descriptor instead.
+func (*ApproveLoanApplicationResp) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ApproveLoanApplicationResp) GetStage() int32 {
+	if x != nil {
+		return x.Stage
+	}
+	return 0
+}
+
+func (x *ApproveLoanApplicationResp) GetStageName() string {
+	if x != nil {
+		return x.StageName
+	}
+	return ""
+}
+
+func (x *ApproveLoanApplicationResp) GetTotalStage() int32 {
+	if x != nil {
+		return x.TotalStage
+	}
+	return 0
+}
+
+func (x *ApproveLoanApplicationResp) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *ApproveLoanApplicationResp) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// 获取审批记录列表
+type ListLoanApprovalsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoanApprovalsReq) Reset() {
+	*x = ListLoanApprovalsReq{}
+	mi := &file_loan_rpc_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoanApprovalsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoanApprovalsReq) ProtoMessage() {}
+
+func (x *ListLoanApprovalsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoanApprovalsReq.ProtoReflect.Descriptor instead.
+func (*ListLoanApprovalsReq) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListLoanApprovalsReq) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type ListLoanApprovalsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*LoanApprovalInfo    `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoanApprovalsResp) Reset() {
+	*x = ListLoanApprovalsResp{}
+	mi := &file_loan_rpc_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoanApprovalsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoanApprovalsResp) ProtoMessage() {}
+
+func (x *ListLoanApprovalsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoanApprovalsResp.ProtoReflect.Descriptor instead.
+func (*ListLoanApprovalsResp) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListLoanApprovalsResp) GetList() []*LoanApprovalInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 生成还款计划(已批准申请,重新生成时会覆盖原计划)
+type GenerateRepaymentScheduleReq struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId   string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	RepaymentMethod string                 `protobuf:"bytes,2,opt,name=repayment_method,json=repaymentMethod,proto3" json:"repayment_method,omitempty"` // equal_installment/equal_principal/interest_only
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GenerateRepaymentScheduleReq) Reset() {
+	*x = GenerateRepaymentScheduleReq{}
+	mi := &file_loan_rpc_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRepaymentScheduleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRepaymentScheduleReq) ProtoMessage() {}
+
+func (x *GenerateRepaymentScheduleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRepaymentScheduleReq.ProtoReflect.Descriptor instead.
+func (*GenerateRepaymentScheduleReq) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GenerateRepaymentScheduleReq) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *GenerateRepaymentScheduleReq) GetRepaymentMethod() string {
+	if x != nil {
+		return x.RepaymentMethod
+	}
+	return ""
+}
+
+type GenerateRepaymentScheduleResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*RepaymentPlanInfo   `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateRepaymentScheduleResp) Reset() {
+	*x = GenerateRepaymentScheduleResp{}
+	mi := &file_loan_rpc_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLoanApplicationsReq) String() string {
+func (x *GenerateRepaymentScheduleResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLoanApplicationsReq) ProtoMessage() {}
+func (*GenerateRepaymentScheduleResp) ProtoMessage() {}
 
-func (x *ListLoanApplicationsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_rpc_proto_msgTypes[6]
+func (x *GenerateRepaymentScheduleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,62 +2224,207 @@ func (x *ListLoanApplicationsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLoanApplicationsReq.ProtoReflect.Descriptor instead.
-func (*ListLoanApplicationsReq) Descriptor() ([]byte, []int) {
-	return file_loan_rpc_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GenerateRepaymentScheduleResp.ProtoReflect.Descriptor instead.
+func (*GenerateRepaymentScheduleResp) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ListLoanApplicationsReq) GetPage() int32 {
+func (x *GenerateRepaymentScheduleResp) GetList() []*RepaymentPlanInfo {
 	if x != nil {
-		return x.Page
+		return x.List
+	}
+	return nil
+}
+
+// 获取还款计划
+type GetRepaymentScheduleReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepaymentScheduleReq) Reset() {
+	*x = GetRepaymentScheduleReq{}
+	mi := &file_loan_rpc_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepaymentScheduleReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepaymentScheduleReq) ProtoMessage() {}
+
+func (x *GetRepaymentScheduleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepaymentScheduleReq.ProtoReflect.Descriptor instead.
+func (*GetRepaymentScheduleReq) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetRepaymentScheduleReq) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type GetRepaymentScheduleResp struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId     string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	RepaymentMethod   string                 `protobuf:"bytes,2,opt,name=repayment_method,json=repaymentMethod,proto3" json:"repayment_method,omitempty"`
+	TotalPrincipal    float64                `protobuf:"fixed64,3,opt,name=total_principal,json=totalPrincipal,proto3" json:"total_principal,omitempty"`
+	TotalInterest     float64                `protobuf:"fixed64,4,opt,name=total_interest,json=totalInterest,proto3" json:"total_interest,omitempty"`
+	TotalAmount       float64                `protobuf:"fixed64,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	List              []*RepaymentPlanInfo   `protobuf:"bytes,6,rep,name=list,proto3" json:"list,omitempty"`
+	TotalPenalty      float64                `protobuf:"fixed64,7,opt,name=total_penalty,json=totalPenalty,proto3" json:"total_penalty,omitempty"`                // 累计罚息
+	PaidAmount        float64                `protobuf:"fixed64,8,opt,name=paid_amount,json=paidAmount,proto3" json:"paid_amount,omitempty"`                      // 已还金额(含罚息、服务费)
+	OutstandingAmount float64                `protobuf:"fixed64,9,opt,name=outstanding_amount,json=outstandingAmount,proto3" json:"outstanding_amount,omitempty"` // 剩余应还金额(含罚息、服务费)
+	TotalServiceFee   float64                `protobuf:"fixed64,10,opt,name=total_service_fee,json=totalServiceFee,proto3" json:"total_service_fee,omitempty"`    // 累计服务费
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetRepaymentScheduleResp) Reset() {
+	*x = GetRepaymentScheduleResp{}
+	mi := &file_loan_rpc_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepaymentScheduleResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepaymentScheduleResp) ProtoMessage() {}
+
+func (x *GetRepaymentScheduleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepaymentScheduleResp.ProtoReflect.Descriptor instead.
+func (*GetRepaymentScheduleResp) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetRepaymentScheduleResp) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *GetRepaymentScheduleResp) GetRepaymentMethod() string {
+	if x != nil {
+		return x.RepaymentMethod
+	}
+	return ""
+}
+
+func (x *GetRepaymentScheduleResp) GetTotalPrincipal() float64 {
+	if x != nil {
+		return x.TotalPrincipal
 	}
 	return 0
 }
 
-func (x *ListLoanApplicationsReq) GetSize() int32 {
+func (x *GetRepaymentScheduleResp) GetTotalInterest() float64 {
 	if x != nil {
-		return x.Size
+		return x.TotalInterest
 	}
 	return 0
 }
 
-func (x *ListLoanApplicationsReq) GetUserId() int64 {
+func (x *GetRepaymentScheduleResp) GetTotalAmount() float64 {
 	if x != nil {
-		return x.UserId
+		return x.TotalAmount
 	}
 	return 0
 }
 
-func (x *ListLoanApplicationsReq) GetStatus() string {
+func (x *GetRepaymentScheduleResp) GetList() []*RepaymentPlanInfo {
 	if x != nil {
-		return x.Status
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-type ListLoanApplicationsResp struct {
+func (x *GetRepaymentScheduleResp) GetTotalPenalty() float64 {
+	if x != nil {
+		return x.TotalPenalty
+	}
+	return 0
+}
+
+func (x *GetRepaymentScheduleResp) GetPaidAmount() float64 {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return 0
+}
+
+func (x *GetRepaymentScheduleResp) GetOutstandingAmount() float64 {
+	if x != nil {
+		return x.OutstandingAmount
+	}
+	return 0
+}
+
+func (x *GetRepaymentScheduleResp) GetTotalServiceFee() float64 {
+	if x != nil {
+		return x.TotalServiceFee
+	}
+	return 0
+}
+
+// 贷款放款
+type DisburseLoanReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          []*LoanApplicationInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	OperatorName  string                 `protobuf:"bytes,3,opt,name=operator_name,json=operatorName,proto3" json:"operator_name,omitempty"`
+	AccountName   string                 `protobuf:"bytes,4,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	AccountNo     string                 `protobuf:"bytes,5,opt,name=account_no,json=accountNo,proto3" json:"account_no,omitempty"`
+	BankName      string                 `protobuf:"bytes,6,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLoanApplicationsResp) Reset() {
-	*x = ListLoanApplicationsResp{}
-	mi := &file_loan_rpc_proto_msgTypes[7]
+func (x *DisburseLoanReq) Reset() {
+	*x = DisburseLoanReq{}
+	mi := &file_loan_rpc_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLoanApplicationsResp) String() string {
+func (x *DisburseLoanReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLoanApplicationsResp) ProtoMessage() {}
+func (*DisburseLoanReq) ProtoMessage() {}
 
-func (x *ListLoanApplicationsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_rpc_proto_msgTypes[7]
+func (x *DisburseLoanReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -608,51 +2435,124 @@ func (x *ListLoanApplicationsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLoanApplicationsResp.ProtoReflect.Descriptor instead.
-func (*ListLoanApplicationsResp) Descriptor() ([]byte, []int) {
-	return file_loan_rpc_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DisburseLoanReq.ProtoReflect.Descriptor instead.
+func (*DisburseLoanReq) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ListLoanApplicationsResp) GetList() []*LoanApplicationInfo {
+func (x *DisburseLoanReq) GetApplicationId() string {
 	if x != nil {
-		return x.List
+		return x.ApplicationId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListLoanApplicationsResp) GetTotal() int64 {
+func (x *DisburseLoanReq) GetOperatorId() int64 {
 	if x != nil {
-		return x.Total
+		return x.OperatorId
 	}
 	return 0
 }
 
-// 更新贷款申请
-type UpdateLoanApplicationReq struct {
+func (x *DisburseLoanReq) GetOperatorName() string {
+	if x != nil {
+		return x.OperatorName
+	}
+	return ""
+}
+
+func (x *DisburseLoanReq) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *DisburseLoanReq) GetAccountNo() string {
+	if x != nil {
+		return x.AccountNo
+	}
+	return ""
+}
+
+func (x *DisburseLoanReq) GetBankName() string {
+	if x != nil {
+		return x.BankName
+	}
+	return ""
+}
+
+type DisburseLoanResp struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DisbursementInfo *LoanDisbursementInfo  `protobuf:"bytes,1,opt,name=disbursement_info,json=disbursementInfo,proto3" json:"disbursement_info,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DisburseLoanResp) Reset() {
+	*x = DisburseLoanResp{}
+	mi := &file_loan_rpc_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisburseLoanResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisburseLoanResp) ProtoMessage() {}
+
+func (x *DisburseLoanResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisburseLoanResp.ProtoReflect.Descriptor instead.
+func (*DisburseLoanResp) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DisburseLoanResp) GetDisbursementInfo() *LoanDisbursementInfo {
+	if x != nil {
+		return x.DisbursementInfo
+	}
+	return nil
+}
+
+// 登记还款(按期数顺序依次冲抵罚息、服务费、利息、本金)
+type RecordRepaymentReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Duration      int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
-	Purpose       string                 `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"` // online/bank_transfer/cash,默认online
+	Remark        string                 `protobuf:"bytes,5,opt,name=remark,proto3" json:"remark,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateLoanApplicationReq) Reset() {
-	*x = UpdateLoanApplicationReq{}
-	mi := &file_loan_rpc_proto_msgTypes[8]
+func (x *RecordRepaymentReq) Reset() {
+	*x = RecordRepaymentReq{}
+	mi := &file_loan_rpc_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLoanApplicationReq) String() string {
+func (x *RecordRepaymentReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLoanApplicationReq) ProtoMessage() {}
+func (*RecordRepaymentReq) ProtoMessage() {}
 
-func (x *UpdateLoanApplicationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_rpc_proto_msgTypes[8]
+func (x *RecordRepaymentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,61 +2563,121 @@ func (x *UpdateLoanApplicationReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLoanApplicationReq.ProtoReflect.Descriptor instead.
-func (*UpdateLoanApplicationReq) Descriptor() ([]byte, []int) {
-	return file_loan_rpc_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use RecordRepaymentReq.ProtoReflect.Descriptor instead.
+func (*RecordRepaymentReq) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UpdateLoanApplicationReq) GetApplicationId() string {
+func (x *RecordRepaymentReq) GetApplicationId() string {
 	if x != nil {
 		return x.ApplicationId
 	}
 	return ""
 }
 
-func (x *UpdateLoanApplicationReq) GetAmount() float64 {
+func (x *RecordRepaymentReq) GetUserId() int64 {
 	if x != nil {
-		return x.Amount
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpdateLoanApplicationReq) GetDuration() int32 {
+func (x *RecordRepaymentReq) GetAmount() float64 {
 	if x != nil {
-		return x.Duration
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *UpdateLoanApplicationReq) GetPurpose() string {
+func (x *RecordRepaymentReq) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *RecordRepaymentReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type RecordRepaymentResp struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RepaymentInfo     *LoanRepaymentInfo     `protobuf:"bytes,1,opt,name=repayment_info,json=repaymentInfo,proto3" json:"repayment_info,omitempty"`
+	ApplicationStatus string                 `protobuf:"bytes,2,opt,name=application_status,json=applicationStatus,proto3" json:"application_status,omitempty"` // 还款后申请状态,全部结清为settled
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RecordRepaymentResp) Reset() {
+	*x = RecordRepaymentResp{}
+	mi := &file_loan_rpc_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordRepaymentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordRepaymentResp) ProtoMessage() {}
+
+func (x *RecordRepaymentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordRepaymentResp.ProtoReflect.Descriptor instead.
+func (*RecordRepaymentResp) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RecordRepaymentResp) GetRepaymentInfo() *LoanRepaymentInfo {
+	if x != nil {
+		return x.RepaymentInfo
+	}
+	return nil
+}
+
+func (x *RecordRepaymentResp) GetApplicationStatus() string {
 	if x != nil {
-		return x.Purpose
+		return x.ApplicationStatus
 	}
 	return ""
 }
 
-type UpdateLoanApplicationResp struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationInfo *LoanApplicationInfo   `protobuf:"bytes,1,opt,name=application_info,json=applicationInfo,proto3" json:"application_info,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// 获取还款记录列表
+type ListRepaymentsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateLoanApplicationResp) Reset() {
-	*x = UpdateLoanApplicationResp{}
-	mi := &file_loan_rpc_proto_msgTypes[9]
+func (x *ListRepaymentsReq) Reset() {
+	*x = ListRepaymentsReq{}
+	mi := &file_loan_rpc_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateLoanApplicationResp) String() string {
+func (x *ListRepaymentsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLoanApplicationResp) ProtoMessage() {}
+func (*ListRepaymentsReq) ProtoMessage() {}
 
-func (x *UpdateLoanApplicationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_rpc_proto_msgTypes[9]
+func (x *ListRepaymentsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,42 +2688,40 @@ func (x *UpdateLoanApplicationResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLoanApplicationResp.ProtoReflect.Descriptor instead.
-func (*UpdateLoanApplicationResp) Descriptor() ([]byte, []int) {
-	return file_loan_rpc_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListRepaymentsReq.ProtoReflect.Descriptor instead.
+func (*ListRepaymentsReq) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UpdateLoanApplicationResp) GetApplicationInfo() *LoanApplicationInfo {
+func (x *ListRepaymentsReq) GetApplicationId() string {
 	if x != nil {
-		return x.ApplicationInfo
+		return x.ApplicationId
 	}
-	return nil
+	return ""
 }
 
-// 撤销贷款申请
-type CancelLoanApplicationReq struct {
+type ListRepaymentsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	List          []*LoanRepaymentInfo   `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelLoanApplicationReq) Reset() {
-	*x = CancelLoanApplicationReq{}
-	mi := &file_loan_rpc_proto_msgTypes[10]
+func (x *ListRepaymentsResp) Reset() {
+	*x = ListRepaymentsResp{}
+	mi := &file_loan_rpc_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelLoanApplicationReq) String() string {
+func (x *ListRepaymentsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelLoanApplicationReq) ProtoMessage() {}
+func (*ListRepaymentsResp) ProtoMessage() {}
 
-func (x *CancelLoanApplicationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_rpc_proto_msgTypes[10]
+func (x *ListRepaymentsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -774,46 +2732,42 @@ func (x *CancelLoanApplicationReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelLoanApplicationReq.ProtoReflect.Descriptor instead.
-func (*CancelLoanApplicationReq) Descriptor() ([]byte, []int) {
-	return file_loan_rpc_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *CancelLoanApplicationReq) GetApplicationId() string {
-	if x != nil {
-		return x.ApplicationId
-	}
-	return ""
+// Deprecated: Use ListRepaymentsResp.ProtoReflect.Descriptor instead.
+func (*ListRepaymentsResp) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *CancelLoanApplicationReq) GetReason() string {
+func (x *ListRepaymentsResp) GetList() []*LoanRepaymentInfo {
 	if x != nil {
-		return x.Reason
+		return x.List
 	}
-	return ""
+	return nil
 }
 
-type CancelLoanApplicationResp struct {
+// 提前还款试算(截至当日): 已到期未还本息罚息及服务费 + 未到期剩余本金 + 当期应计利息及服务费 + 提前还款手续费
+type QuoteEarlyRepaymentReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelLoanApplicationResp) Reset() {
-	*x = CancelLoanApplicationResp{}
-	mi := &file_loan_rpc_proto_msgTypes[11]
+func (x *QuoteEarlyRepaymentReq) Reset() {
+	*x = QuoteEarlyRepaymentReq{}
+	mi := &file_loan_rpc_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelLoanApplicationResp) String() string {
+func (x *QuoteEarlyRepaymentReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelLoanApplicationResp) ProtoMessage() {}
+func (*QuoteEarlyRepaymentReq) ProtoMessage() {}
 
-func (x *CancelLoanApplicationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_rpc_proto_msgTypes[11]
+func (x *QuoteEarlyRepaymentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -824,41 +2778,56 @@ func (x *CancelLoanApplicationResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelLoanApplicationResp.ProtoReflect.Descriptor instead.
-func (*CancelLoanApplicationResp) Descriptor() ([]byte, []int) {
-	return file_loan_rpc_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use QuoteEarlyRepaymentReq.ProtoReflect.Descriptor instead.
+func (*QuoteEarlyRepaymentReq) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{33}
 }
 
-// 审批贷款申请
-type ApproveLoanApplicationReq struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationId    string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	AuditorId        int64                  `protobuf:"varint,2,opt,name=auditor_id,json=auditorId,proto3" json:"auditor_id,omitempty"`
-	AuditorName      string                 `protobuf:"bytes,3,opt,name=auditor_name,json=auditorName,proto3" json:"auditor_name,omitempty"`
-	Action           string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"` // approve/reject
-	Suggestions      string                 `protobuf:"bytes,5,opt,name=suggestions,proto3" json:"suggestions,omitempty"`
-	ApprovedAmount   float64                `protobuf:"fixed64,6,opt,name=approved_amount,json=approvedAmount,proto3" json:"approved_amount,omitempty"`
-	ApprovedDuration int32                  `protobuf:"varint,7,opt,name=approved_duration,json=approvedDuration,proto3" json:"approved_duration,omitempty"`
-	InterestRate     float64                `protobuf:"fixed64,8,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *QuoteEarlyRepaymentReq) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
 }
 
-func (x *ApproveLoanApplicationReq) Reset() {
-	*x = ApproveLoanApplicationReq{}
-	mi := &file_loan_rpc_proto_msgTypes[12]
+func (x *QuoteEarlyRepaymentReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type QuoteEarlyRepaymentResp struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId        string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	QuoteDate            string                 `protobuf:"bytes,2,opt,name=quote_date,json=quoteDate,proto3" json:"quote_date,omitempty"`                                    // 试算日期 YYYY-MM-DD
+	OutstandingPrincipal float64                `protobuf:"fixed64,3,opt,name=outstanding_principal,json=outstandingPrincipal,proto3" json:"outstanding_principal,omitempty"` // 剩余未还本金
+	AccruedInterest      float64                `protobuf:"fixed64,4,opt,name=accrued_interest,json=accruedInterest,proto3" json:"accrued_interest,omitempty"`                // 应计利息(已到期未还利息+当期按日计提利息)
+	Penalty              float64                `protobuf:"fixed64,5,opt,name=penalty,proto3" json:"penalty,omitempty"`                                                       // 未还罚息
+	PrepaymentFeeRate    float64                `protobuf:"fixed64,6,opt,name=prepayment_fee_rate,json=prepaymentFeeRate,proto3" json:"prepayment_fee_rate,omitempty"`        // 提前还款手续费率(%)
+	PrepaymentFee        float64                `protobuf:"fixed64,7,opt,name=prepayment_fee,json=prepaymentFee,proto3" json:"prepayment_fee,omitempty"`                      // 提前还款手续费(按未到期本金计收)
+	TotalAmount          float64                `protobuf:"fixed64,8,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`                            // 提前结清应还总额
+	WaivedInterest       float64                `protobuf:"fixed64,9,opt,name=waived_interest,json=waivedInterest,proto3" json:"waived_interest,omitempty"`                   // 提前结清免收的未到期利息
+	ServiceFee           float64                `protobuf:"fixed64,10,opt,name=service_fee,json=serviceFee,proto3" json:"service_fee,omitempty"`                              // 应收服务费(已到期未还服务费+当期按日计提服务费)
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *QuoteEarlyRepaymentResp) Reset() {
+	*x = QuoteEarlyRepaymentResp{}
+	mi := &file_loan_rpc_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveLoanApplicationReq) String() string {
+func (x *QuoteEarlyRepaymentResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveLoanApplicationReq) ProtoMessage() {}
+func (*QuoteEarlyRepaymentResp) ProtoMessage() {}
 
-func (x *ApproveLoanApplicationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_rpc_proto_msgTypes[12]
+func (x *QuoteEarlyRepaymentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -869,88 +2838,108 @@ func (x *ApproveLoanApplicationReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveLoanApplicationReq.ProtoReflect.Descriptor instead.
-func (*ApproveLoanApplicationReq) Descriptor() ([]byte, []int) {
-	return file_loan_rpc_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use QuoteEarlyRepaymentResp.ProtoReflect.Descriptor instead.
+func (*QuoteEarlyRepaymentResp) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ApproveLoanApplicationReq) GetApplicationId() string {
+func (x *QuoteEarlyRepaymentResp) GetApplicationId() string {
 	if x != nil {
 		return x.ApplicationId
 	}
 	return ""
 }
 
-func (x *ApproveLoanApplicationReq) GetAuditorId() int64 {
+func (x *QuoteEarlyRepaymentResp) GetQuoteDate() string {
 	if x != nil {
-		return x.AuditorId
+		return x.QuoteDate
+	}
+	return ""
+}
+
+func (x *QuoteEarlyRepaymentResp) GetOutstandingPrincipal() float64 {
+	if x != nil {
+		return x.OutstandingPrincipal
 	}
 	return 0
 }
 
-func (x *ApproveLoanApplicationReq) GetAuditorName() string {
+func (x *QuoteEarlyRepaymentResp) GetAccruedInterest() float64 {
 	if x != nil {
-		return x.AuditorName
+		return x.AccruedInterest
 	}
-	return ""
+	return 0
 }
 
-func (x *ApproveLoanApplicationReq) GetAction() string {
+func (x *QuoteEarlyRepaymentResp) GetPenalty() float64 {
 	if x != nil {
-		return x.Action
+		return x.Penalty
 	}
-	return ""
+	return 0
 }
 
-func (x *ApproveLoanApplicationReq) GetSuggestions() string {
+func (x *QuoteEarlyRepaymentResp) GetPrepaymentFeeRate() float64 {
 	if x != nil {
-		return x.Suggestions
+		return x.PrepaymentFeeRate
 	}
-	return ""
+	return 0
 }
 
-func (x *ApproveLoanApplicationReq) GetApprovedAmount() float64 {
+func (x *QuoteEarlyRepaymentResp) GetPrepaymentFee() float64 {
 	if x != nil {
-		return x.ApprovedAmount
+		return x.PrepaymentFee
 	}
 	return 0
 }
 
-func (x *ApproveLoanApplicationReq) GetApprovedDuration() int32 {
+func (x *QuoteEarlyRepaymentResp) GetTotalAmount() float64 {
 	if x != nil {
-		return x.ApprovedDuration
+		return x.TotalAmount
 	}
 	return 0
 }
 
-func (x *ApproveLoanApplicationReq) GetInterestRate() float64 {
+func (x *QuoteEarlyRepaymentResp) GetWaivedInterest() float64 {
 	if x != nil {
-		return x.InterestRate
+		return x.WaivedInterest
 	}
 	return 0
 }
 
-type ApproveLoanApplicationResp struct {
+func (x *QuoteEarlyRepaymentResp) GetServiceFee() float64 {
+	if x != nil {
+		return x.ServiceFee
+	}
+	return 0
+}
+
+// 提前结清: 按当日试算金额一次性结清剩余全部期数
+type SettleEarlyReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"` // 结清金额,须与当日试算总额一致
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"` // online/bank_transfer/cash,默认online
+	Remark        string                 `protobuf:"bytes,5,opt,name=remark,proto3" json:"remark,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApproveLoanApplicationResp) Reset() {
-	*x = ApproveLoanApplicationResp{}
-	mi := &file_loan_rpc_proto_msgTypes[13]
+func (x *SettleEarlyReq) Reset() {
+	*x = SettleEarlyReq{}
+	mi := &file_loan_rpc_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApproveLoanApplicationResp) String() string {
+func (x *SettleEarlyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApproveLoanApplicationResp) ProtoMessage() {}
+func (*SettleEarlyReq) ProtoMessage() {}
 
-func (x *ApproveLoanApplicationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_rpc_proto_msgTypes[13]
+func (x *SettleEarlyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -961,34 +2950,121 @@ func (x *ApproveLoanApplicationResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApproveLoanApplicationResp.ProtoReflect.Descriptor instead.
-func (*ApproveLoanApplicationResp) Descriptor() ([]byte, []int) {
-	return file_loan_rpc_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use SettleEarlyReq.ProtoReflect.Descriptor instead.
+func (*SettleEarlyReq) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{35}
 }
 
-// 获取审批记录列表
-type ListLoanApprovalsReq struct {
+func (x *SettleEarlyReq) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *SettleEarlyReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SettleEarlyReq) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SettleEarlyReq) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *SettleEarlyReq) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type SettleEarlyResp struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RepaymentInfo     *LoanRepaymentInfo     `protobuf:"bytes,1,opt,name=repayment_info,json=repaymentInfo,proto3" json:"repayment_info,omitempty"`
+	ApplicationStatus string                 `protobuf:"bytes,2,opt,name=application_status,json=applicationStatus,proto3" json:"application_status,omitempty"` // 结清后申请状态 settled
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SettleEarlyResp) Reset() {
+	*x = SettleEarlyResp{}
+	mi := &file_loan_rpc_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleEarlyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleEarlyResp) ProtoMessage() {}
+
+func (x *SettleEarlyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleEarlyResp.ProtoReflect.Descriptor instead.
+func (*SettleEarlyResp) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SettleEarlyResp) GetRepaymentInfo() *LoanRepaymentInfo {
+	if x != nil {
+		return x.RepaymentInfo
+	}
+	return nil
+}
+
+func (x *SettleEarlyResp) GetApplicationStatus() string {
+	if x != nil {
+		return x.ApplicationStatus
+	}
+	return ""
+}
+
+// 产品引用检查 - 产品服务删除产品前确认是否仍有进行中的申请
+type CountProductApplicationsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ApplicationId string                 `protobuf:"bytes,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLoanApprovalsReq) Reset() {
-	*x = ListLoanApprovalsReq{}
-	mi := &file_loan_rpc_proto_msgTypes[14]
+func (x *CountProductApplicationsReq) Reset() {
+	*x = CountProductApplicationsReq{}
+	mi := &file_loan_rpc_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLoanApprovalsReq) String() string {
+func (x *CountProductApplicationsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLoanApprovalsReq) ProtoMessage() {}
+func (*CountProductApplicationsReq) ProtoMessage() {}
 
-func (x *ListLoanApprovalsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_rpc_proto_msgTypes[14]
+func (x *CountProductApplicationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -999,40 +3075,41 @@ func (x *ListLoanApprovalsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLoanApprovalsReq.ProtoReflect.Descriptor instead.
-func (*ListLoanApprovalsReq) Descriptor() ([]byte, []int) {
-	return file_loan_rpc_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CountProductApplicationsReq.ProtoReflect.Descriptor instead.
+func (*CountProductApplicationsReq) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ListLoanApprovalsReq) GetApplicationId() string {
+func (x *CountProductApplicationsReq) GetProductId() int64 {
 	if x != nil {
-		return x.ApplicationId
+		return x.ProductId
 	}
-	return ""
+	return 0
 }
 
-type ListLoanApprovalsResp struct {
+type CountProductApplicationsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	List          []*LoanApprovalInfo    `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	Active        int64                  `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"` // 进行中(待审批/已批准/已放款)的申请数
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`   // 引用该产品的申请总数
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListLoanApprovalsResp) Reset() {
-	*x = ListLoanApprovalsResp{}
-	mi := &file_loan_rpc_proto_msgTypes[15]
+func (x *CountProductApplicationsResp) Reset() {
+	*x = CountProductApplicationsResp{}
+	mi := &file_loan_rpc_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListLoanApprovalsResp) String() string {
+func (x *CountProductApplicationsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListLoanApprovalsResp) ProtoMessage() {}
+func (*CountProductApplicationsResp) ProtoMessage() {}
 
-func (x *ListLoanApprovalsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_loan_rpc_proto_msgTypes[15]
+func (x *CountProductApplicationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loan_rpc_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1043,23 +3120,30 @@ func (x *ListLoanApprovalsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListLoanApprovalsResp.ProtoReflect.Descriptor instead.
-func (*ListLoanApprovalsResp) Descriptor() ([]byte, []int) {
-	return file_loan_rpc_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use CountProductApplicationsResp.ProtoReflect.Descriptor instead.
+func (*CountProductApplicationsResp) Descriptor() ([]byte, []int) {
+	return file_loan_rpc_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ListLoanApprovalsResp) GetList() []*LoanApprovalInfo {
+func (x *CountProductApplicationsResp) GetActive() int64 {
 	if x != nil {
-		return x.List
+		return x.Active
 	}
-	return nil
+	return 0
+}
+
+func (x *CountProductApplicationsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
 }
 
 var File_loan_rpc_proto protoreflect.FileDescriptor
 
 const file_loan_rpc_proto_rawDesc = "" +
 	"\n" +
-	"\x0eloan-rpc.proto\x12\x04loan\x1a\x1bgoogle/protobuf/empty.proto\"\xf7\x02\n" +
+	"\x0eloan-rpc.proto\x12\x04loan\x1a\x1bgoogle/protobuf/empty.proto\"\xae\x04\n" +
 	"\x13LoanApplicationInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
 	"\x0eapplication_id\x18\x02 \x01(\tR\rapplicationId\x12\x17\n" +
@@ -1077,7 +3161,49 @@ const file_loan_rpc_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\f \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\r \x01(\x03R\tupdatedAt\"\xdf\x02\n" +
+	"updated_at\x18\r \x01(\x03R\tupdatedAt\x120\n" +
+	"\x14origination_fee_rate\x18\x0e \x01(\x01R\x12originationFeeRate\x12(\n" +
+	"\x10service_fee_rate\x18\x0f \x01(\x01R\x0eserviceFeeRate\x12,\n" +
+	"\x12guarantee_fee_rate\x18\x10 \x01(\x01R\x10guaranteeFeeRate\x12\x19\n" +
+	"\blate_fee\x18\x11 \x01(\x01R\alateFee\x12\x10\n" +
+	"\x03apr\x18\x12 \x01(\x01R\x03apr\"\x83\x01\n" +
+	"\x0fCreditScoreItem\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x16\n" +
+	"\x06factor\x18\x02 \x01(\tR\x06factor\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x16\n" +
+	"\x06points\x18\x04 \x01(\x01R\x06points\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xd2\x01\n" +
+	"\x0fCreditScoreInfo\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x05R\x05score\x12\x14\n" +
+	"\x05grade\x18\x02 \x01(\tR\x05grade\x12&\n" +
+	"\x0erecommendation\x18\x03 \x01(\tR\x0erecommendation\x12+\n" +
+	"\x05items\x18\x04 \x03(\v2\x15.loan.CreditScoreItemR\x05items\x12!\n" +
+	"\frule_version\x18\x05 \x01(\tR\vruleVersion\x12\x1b\n" +
+	"\tscored_at\x18\x06 \x01(\x03R\bscoredAt\"\xc7\x05\n" +
+	"\x13LoanProductSnapshot\x12!\n" +
+	"\fproduct_code\x18\x01 \x01(\tR\vproductCode\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12#\n" +
+	"\rinterest_rate\x18\x04 \x01(\x01R\finterestRate\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x05 \x01(\x01R\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\x06 \x01(\x01R\tmaxAmount\x12!\n" +
+	"\fmin_duration\x18\a \x01(\x05R\vminDuration\x12!\n" +
+	"\fmax_duration\x18\b \x01(\x05R\vmaxDuration\x12+\n" +
+	"\x11repayment_profile\x18\t \x01(\tR\x10repaymentProfile\x12!\n" +
+	"\fgrace_months\x18\n" +
+	" \x01(\x05R\vgraceMonths\x12%\n" +
+	"\x0eharvest_months\x18\v \x01(\tR\rharvestMonths\x12!\n" +
+	"\fpenalty_rate\x18\f \x01(\x01R\vpenaltyRate\x12.\n" +
+	"\x13prepayment_fee_rate\x18\r \x01(\x01R\x11prepaymentFeeRate\x120\n" +
+	"\x14origination_fee_rate\x18\x0e \x01(\x01R\x12originationFeeRate\x12(\n" +
+	"\x10service_fee_rate\x18\x0f \x01(\x01R\x0eserviceFeeRate\x12,\n" +
+	"\x12guarantee_fee_rate\x18\x10 \x01(\x01R\x10guaranteeFeeRate\x12\x19\n" +
+	"\blate_fee\x18\x11 \x01(\x01R\alateFee\x12,\n" +
+	"\x12product_updated_at\x18\x12 \x01(\x03R\x10productUpdatedAt\x12\x1f\n" +
+	"\vsnapshot_at\x18\x13 \x01(\x03R\n" +
+	"snapshotAt\"\xb7\x03\n" +
 	"\x10LoanApprovalInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
 	"\x0eapplication_id\x18\x02 \x01(\x03R\rapplicationId\x12\x1d\n" +
@@ -1091,7 +3217,75 @@ const file_loan_rpc_proto_rawDesc = "" +
 	"\rinterest_rate\x18\t \x01(\x01R\finterestRate\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\n" +
-	" \x01(\x03R\tcreatedAt\"\xc8\x01\n" +
+	" \x01(\x03R\tcreatedAt\x12\x14\n" +
+	"\x05stage\x18\v \x01(\x05R\x05stage\x12\x1d\n" +
+	"\n" +
+	"stage_name\x18\f \x01(\tR\tstageName\x12!\n" +
+	"\fauditor_role\x18\r \x01(\tR\vauditorRole\"\xab\x05\n" +
+	"\x11RepaymentPlanInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12%\n" +
+	"\x0eapplication_id\x18\x02 \x01(\x03R\rapplicationId\x12%\n" +
+	"\x0einstallment_no\x18\x03 \x01(\x05R\rinstallmentNo\x12\x19\n" +
+	"\bdue_date\x18\x04 \x01(\tR\adueDate\x12\x1c\n" +
+	"\tprincipal\x18\x05 \x01(\x01R\tprincipal\x12\x1a\n" +
+	"\binterest\x18\x06 \x01(\x01R\binterest\x12!\n" +
+	"\ftotal_amount\x18\a \x01(\x01R\vtotalAmount\x12/\n" +
+	"\x13remaining_principal\x18\b \x01(\x01R\x12remainingPrincipal\x12)\n" +
+	"\x10repayment_method\x18\t \x01(\tR\x0frepaymentMethod\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\x03R\tupdatedAt\x12%\n" +
+	"\x0epaid_principal\x18\r \x01(\x01R\rpaidPrincipal\x12#\n" +
+	"\rpaid_interest\x18\x0e \x01(\x01R\fpaidInterest\x12\x18\n" +
+	"\apenalty\x18\x0f \x01(\x01R\apenalty\x12!\n" +
+	"\fpaid_penalty\x18\x10 \x01(\x01R\vpaidPenalty\x12!\n" +
+	"\foverdue_days\x18\x11 \x01(\x05R\voverdueDays\x12\x17\n" +
+	"\apaid_at\x18\x12 \x01(\x03R\x06paidAt\x12\x1f\n" +
+	"\vservice_fee\x18\x13 \x01(\x01R\n" +
+	"serviceFee\x12(\n" +
+	"\x10paid_service_fee\x18\x14 \x01(\x01R\x0epaidServiceFee\"\xd9\x03\n" +
+	"\x11LoanRepaymentInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12!\n" +
+	"\frepayment_no\x18\x02 \x01(\tR\vrepaymentNo\x12%\n" +
+	"\x0eapplication_id\x18\x03 \x01(\x03R\rapplicationId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12)\n" +
+	"\x10principal_amount\x18\x06 \x01(\x01R\x0fprincipalAmount\x12'\n" +
+	"\x0finterest_amount\x18\a \x01(\x01R\x0einterestAmount\x12%\n" +
+	"\x0epenalty_amount\x18\b \x01(\x01R\rpenaltyAmount\x12'\n" +
+	"\x0finstallment_nos\x18\t \x01(\tR\x0einstallmentNos\x12\x18\n" +
+	"\achannel\x18\n" +
+	" \x01(\tR\achannel\x12\x16\n" +
+	"\x06remark\x18\v \x01(\tR\x06remark\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"fee_amount\x18\r \x01(\x01R\tfeeAmount\x12%\n" +
+	"\x0erepayment_type\x18\x0e \x01(\tR\rrepaymentType\"\xee\x03\n" +
+	"\x14LoanDisbursementInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x0fdisbursement_no\x18\x02 \x01(\tR\x0edisbursementNo\x12%\n" +
+	"\x0eapplication_id\x18\x03 \x01(\x03R\rapplicationId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12!\n" +
+	"\faccount_name\x18\x05 \x01(\tR\vaccountName\x12\x1d\n" +
+	"\n" +
+	"account_no\x18\x06 \x01(\tR\taccountNo\x12\x1b\n" +
+	"\tbank_name\x18\a \x01(\tR\bbankName\x12\x18\n" +
+	"\achannel\x18\b \x01(\tR\achannel\x12$\n" +
+	"\x0ebank_serial_no\x18\t \x01(\tR\fbankSerialNo\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12\x1f\n" +
+	"\vfail_reason\x18\v \x01(\tR\n" +
+	"failReason\x12\x1f\n" +
+	"\voperator_id\x18\f \x01(\x03R\n" +
+	"operatorId\x12#\n" +
+	"\roperator_name\x18\r \x01(\tR\foperatorName\x12!\n" +
+	"\fdisbursed_at\x18\x0e \x01(\x03R\vdisbursedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\x03R\tcreatedAt\"\xf1\x01\n" +
 	"\x18CreateLoanApplicationReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
 	"\n" +
@@ -1100,13 +3294,23 @@ const file_loan_rpc_proto_rawDesc = "" +
 	"\x04type\x18\x04 \x01(\tR\x04type\x12\x16\n" +
 	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x1a\n" +
 	"\bduration\x18\x06 \x01(\x05R\bduration\x12\x18\n" +
-	"\apurpose\x18\a \x01(\tR\apurpose\"B\n" +
+	"\apurpose\x18\a \x01(\tR\apurpose\x12'\n" +
+	"\x0fidempotency_key\x18\b \x01(\tR\x0eidempotencyKey\"o\n" +
+	"\x11EligibilityReason\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x01R\x05limit\x12\x16\n" +
+	"\x06actual\x18\x04 \x01(\x01R\x06actual\"\x9e\x01\n" +
 	"\x19CreateLoanApplicationResp\x12%\n" +
-	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\">\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1a\n" +
+	"\brejected\x18\x02 \x01(\bR\brejected\x12>\n" +
+	"\x0ereject_reasons\x18\x03 \x03(\v2\x17.loan.EligibilityReasonR\rrejectReasons\">\n" +
 	"\x15GetLoanApplicationReq\x12%\n" +
-	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"^\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"\xde\x01\n" +
 	"\x16GetLoanApplicationResp\x12D\n" +
-	"\x10application_info\x18\x01 \x01(\v2\x19.loan.LoanApplicationInfoR\x0fapplicationInfo\"r\n" +
+	"\x10application_info\x18\x01 \x01(\v2\x19.loan.LoanApplicationInfoR\x0fapplicationInfo\x128\n" +
+	"\fcredit_score\x18\x02 \x01(\v2\x15.loan.CreditScoreInfoR\vcreditScore\x12D\n" +
+	"\x10product_snapshot\x18\x03 \x01(\v2\x19.loan.LoanProductSnapshotR\x0fproductSnapshot\"r\n" +
 	"\x17ListLoanApplicationsReq\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
 	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x17\n" +
@@ -1125,7 +3329,7 @@ const file_loan_rpc_proto_rawDesc = "" +
 	"\x18CancelLoanApplicationReq\x12%\n" +
 	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x1b\n" +
-	"\x19CancelLoanApplicationResp\"\xb9\x02\n" +
+	"\x19CancelLoanApplicationResp\"\x87\x03\n" +
 	"\x19ApproveLoanApplicationReq\x12%\n" +
 	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1d\n" +
 	"\n" +
@@ -1135,12 +3339,98 @@ const file_loan_rpc_proto_rawDesc = "" +
 	"\vsuggestions\x18\x05 \x01(\tR\vsuggestions\x12'\n" +
 	"\x0fapproved_amount\x18\x06 \x01(\x01R\x0eapprovedAmount\x12+\n" +
 	"\x11approved_duration\x18\a \x01(\x05R\x10approvedDuration\x12#\n" +
-	"\rinterest_rate\x18\b \x01(\x01R\finterestRate\"\x1c\n" +
-	"\x1aApproveLoanApplicationResp\"=\n" +
+	"\rinterest_rate\x18\b \x01(\x01R\finterestRate\x12)\n" +
+	"\x10repayment_method\x18\t \x01(\tR\x0frepaymentMethod\x12!\n" +
+	"\fauditor_role\x18\n" +
+	" \x01(\tR\vauditorRole\"\xa6\x01\n" +
+	"\x1aApproveLoanApplicationResp\x12\x14\n" +
+	"\x05stage\x18\x01 \x01(\x05R\x05stage\x12\x1d\n" +
+	"\n" +
+	"stage_name\x18\x02 \x01(\tR\tstageName\x12\x1f\n" +
+	"\vtotal_stage\x18\x03 \x01(\x05R\n" +
+	"totalStage\x12\x1a\n" +
+	"\bfinished\x18\x04 \x01(\bR\bfinished\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"=\n" +
 	"\x14ListLoanApprovalsReq\x12%\n" +
 	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"C\n" +
 	"\x15ListLoanApprovalsResp\x12*\n" +
-	"\x04list\x18\x01 \x03(\v2\x16.loan.LoanApprovalInfoR\x04list2\xe7\x04\n" +
+	"\x04list\x18\x01 \x03(\v2\x16.loan.LoanApprovalInfoR\x04list\"p\n" +
+	"\x1cGenerateRepaymentScheduleReq\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12)\n" +
+	"\x10repayment_method\x18\x02 \x01(\tR\x0frepaymentMethod\"L\n" +
+	"\x1dGenerateRepaymentScheduleResp\x12+\n" +
+	"\x04list\x18\x01 \x03(\v2\x17.loan.RepaymentPlanInfoR\x04list\"@\n" +
+	"\x17GetRepaymentScheduleReq\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"\xad\x03\n" +
+	"\x18GetRepaymentScheduleResp\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12)\n" +
+	"\x10repayment_method\x18\x02 \x01(\tR\x0frepaymentMethod\x12'\n" +
+	"\x0ftotal_principal\x18\x03 \x01(\x01R\x0etotalPrincipal\x12%\n" +
+	"\x0etotal_interest\x18\x04 \x01(\x01R\rtotalInterest\x12!\n" +
+	"\ftotal_amount\x18\x05 \x01(\x01R\vtotalAmount\x12+\n" +
+	"\x04list\x18\x06 \x03(\v2\x17.loan.RepaymentPlanInfoR\x04list\x12#\n" +
+	"\rtotal_penalty\x18\a \x01(\x01R\ftotalPenalty\x12\x1f\n" +
+	"\vpaid_amount\x18\b \x01(\x01R\n" +
+	"paidAmount\x12-\n" +
+	"\x12outstanding_amount\x18\t \x01(\x01R\x11outstandingAmount\x12*\n" +
+	"\x11total_service_fee\x18\n" +
+	" \x01(\x01R\x0ftotalServiceFee\"\xdd\x01\n" +
+	"\x0fDisburseLoanReq\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\x12#\n" +
+	"\roperator_name\x18\x03 \x01(\tR\foperatorName\x12!\n" +
+	"\faccount_name\x18\x04 \x01(\tR\vaccountName\x12\x1d\n" +
+	"\n" +
+	"account_no\x18\x05 \x01(\tR\taccountNo\x12\x1b\n" +
+	"\tbank_name\x18\x06 \x01(\tR\bbankName\"[\n" +
+	"\x10DisburseLoanResp\x12G\n" +
+	"\x11disbursement_info\x18\x01 \x01(\v2\x1a.loan.LoanDisbursementInfoR\x10disbursementInfo\"\x9e\x01\n" +
+	"\x12RecordRepaymentReq\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x12\x16\n" +
+	"\x06remark\x18\x05 \x01(\tR\x06remark\"\x84\x01\n" +
+	"\x13RecordRepaymentResp\x12>\n" +
+	"\x0erepayment_info\x18\x01 \x01(\v2\x17.loan.LoanRepaymentInfoR\rrepaymentInfo\x12-\n" +
+	"\x12application_status\x18\x02 \x01(\tR\x11applicationStatus\":\n" +
+	"\x11ListRepaymentsReq\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\"A\n" +
+	"\x12ListRepaymentsResp\x12+\n" +
+	"\x04list\x18\x01 \x03(\v2\x17.loan.LoanRepaymentInfoR\x04list\"X\n" +
+	"\x16QuoteEarlyRepaymentReq\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\x9d\x03\n" +
+	"\x17QuoteEarlyRepaymentResp\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x1d\n" +
+	"\n" +
+	"quote_date\x18\x02 \x01(\tR\tquoteDate\x123\n" +
+	"\x15outstanding_principal\x18\x03 \x01(\x01R\x14outstandingPrincipal\x12)\n" +
+	"\x10accrued_interest\x18\x04 \x01(\x01R\x0faccruedInterest\x12\x18\n" +
+	"\apenalty\x18\x05 \x01(\x01R\apenalty\x12.\n" +
+	"\x13prepayment_fee_rate\x18\x06 \x01(\x01R\x11prepaymentFeeRate\x12%\n" +
+	"\x0eprepayment_fee\x18\a \x01(\x01R\rprepaymentFee\x12!\n" +
+	"\ftotal_amount\x18\b \x01(\x01R\vtotalAmount\x12'\n" +
+	"\x0fwaived_interest\x18\t \x01(\x01R\x0ewaivedInterest\x12\x1f\n" +
+	"\vservice_fee\x18\n" +
+	" \x01(\x01R\n" +
+	"serviceFee\"\x9a\x01\n" +
+	"\x0eSettleEarlyReq\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x12\x16\n" +
+	"\x06remark\x18\x05 \x01(\tR\x06remark\"\x80\x01\n" +
+	"\x0fSettleEarlyResp\x12>\n" +
+	"\x0erepayment_info\x18\x01 \x01(\v2\x17.loan.LoanRepaymentInfoR\rrepaymentInfo\x12-\n" +
+	"\x12application_status\x18\x02 \x01(\tR\x11applicationStatus\"<\n" +
+	"\x1bCountProductApplicationsReq\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\"L\n" +
+	"\x1cCountProductApplicationsResp\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\x03R\x06active\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total2\xe3\t\n" +
 	"\x04Loan\x12X\n" +
 	"\x15CreateLoanApplication\x12\x1e.loan.CreateLoanApplicationReq\x1a\x1f.loan.CreateLoanApplicationResp\x12O\n" +
 	"\x12GetLoanApplication\x12\x1b.loan.GetLoanApplicationReq\x1a\x1c.loan.GetLoanApplicationResp\x12U\n" +
@@ -1148,7 +3438,15 @@ const file_loan_rpc_proto_rawDesc = "" +
 	"\x15UpdateLoanApplication\x12\x1e.loan.UpdateLoanApplicationReq\x1a\x1f.loan.UpdateLoanApplicationResp\x12X\n" +
 	"\x15CancelLoanApplication\x12\x1e.loan.CancelLoanApplicationReq\x1a\x1f.loan.CancelLoanApplicationResp\x12[\n" +
 	"\x16ApproveLoanApplication\x12\x1f.loan.ApproveLoanApplicationReq\x1a .loan.ApproveLoanApplicationResp\x12L\n" +
-	"\x11ListLoanApprovals\x12\x1a.loan.ListLoanApprovalsReq\x1a\x1b.loan.ListLoanApprovalsRespB\bZ\x06./loanb\x06proto3"
+	"\x11ListLoanApprovals\x12\x1a.loan.ListLoanApprovalsReq\x1a\x1b.loan.ListLoanApprovalsResp\x12d\n" +
+	"\x19GenerateRepaymentSchedule\x12\".loan.GenerateRepaymentScheduleReq\x1a#.loan.GenerateRepaymentScheduleResp\x12U\n" +
+	"\x14GetRepaymentSchedule\x12\x1d.loan.GetRepaymentScheduleReq\x1a\x1e.loan.GetRepaymentScheduleResp\x12=\n" +
+	"\fDisburseLoan\x12\x15.loan.DisburseLoanReq\x1a\x16.loan.DisburseLoanResp\x12F\n" +
+	"\x0fRecordRepayment\x12\x18.loan.RecordRepaymentReq\x1a\x19.loan.RecordRepaymentResp\x12C\n" +
+	"\x0eListRepayments\x12\x17.loan.ListRepaymentsReq\x1a\x18.loan.ListRepaymentsResp\x12R\n" +
+	"\x13QuoteEarlyRepayment\x12\x1c.loan.QuoteEarlyRepaymentReq\x1a\x1d.loan.QuoteEarlyRepaymentResp\x12:\n" +
+	"\vSettleEarly\x12\x14.loan.SettleEarlyReq\x1a\x15.loan.SettleEarlyResp\x12a\n" +
+	"\x18CountProductApplications\x12!.loan.CountProductApplicationsReq\x1a\".loan.CountProductApplicationsRespB\bZ\x06./loanb\x06proto3"
 
 var (
 	file_loan_rpc_proto_rawDescOnce sync.Once
@@ -1162,49 +3460,98 @@ func file_loan_rpc_proto_rawDescGZIP() []byte {
 	return file_loan_rpc_proto_rawDescData
 }
 
-var file_loan_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_loan_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_loan_rpc_proto_goTypes = []any{
-	(*LoanApplicationInfo)(nil),        // 0: loan.LoanApplicationInfo
-	(*LoanApprovalInfo)(nil),           // 1: loan.LoanApprovalInfo
-	(*CreateLoanApplicationReq)(nil),   // 2: loan.CreateLoanApplicationReq
-	(*CreateLoanApplicationResp)(nil),  // 3: loan.CreateLoanApplicationResp
-	(*GetLoanApplicationReq)(nil),      // 4: loan.GetLoanApplicationReq
-	(*GetLoanApplicationResp)(nil),     // 5: loan.GetLoanApplicationResp
-	(*ListLoanApplicationsReq)(nil),    // 6: loan.ListLoanApplicationsReq
-	(*ListLoanApplicationsResp)(nil),   // 7: loan.ListLoanApplicationsResp
-	(*UpdateLoanApplicationReq)(nil),   // 8: loan.UpdateLoanApplicationReq
-	(*UpdateLoanApplicationResp)(nil),  // 9: loan.UpdateLoanApplicationResp
-	(*CancelLoanApplicationReq)(nil),   // 10: loan.CancelLoanApplicationReq
-	(*CancelLoanApplicationResp)(nil),  // 11: loan.CancelLoanApplicationResp
-	(*ApproveLoanApplicationReq)(nil),  // 12: loan.ApproveLoanApplicationReq
-	(*ApproveLoanApplicationResp)(nil), // 13: loan.ApproveLoanApplicationResp
-	(*ListLoanApprovalsReq)(nil),       // 14: loan.ListLoanApprovalsReq
-	(*ListLoanApprovalsResp)(nil),      // 15: loan.ListLoanApprovalsResp
+	(*LoanApplicationInfo)(nil),           // 0: loan.LoanApplicationInfo
+	(*CreditScoreItem)(nil),               // 1: loan.CreditScoreItem
+	(*CreditScoreInfo)(nil),               // 2: loan.CreditScoreInfo
+	(*LoanProductSnapshot)(nil),           // 3: loan.LoanProductSnapshot
+	(*LoanApprovalInfo)(nil),              // 4: loan.LoanApprovalInfo
+	(*RepaymentPlanInfo)(nil),             // 5: loan.RepaymentPlanInfo
+	(*LoanRepaymentInfo)(nil),             // 6: loan.LoanRepaymentInfo
+	(*LoanDisbursementInfo)(nil),          // 7: loan.LoanDisbursementInfo
+	(*CreateLoanApplicationReq)(nil),      // 8: loan.CreateLoanApplicationReq
+	(*EligibilityReason)(nil),             // 9: loan.EligibilityReason
+	(*CreateLoanApplicationResp)(nil),     // 10: loan.CreateLoanApplicationResp
+	(*GetLoanApplicationReq)(nil),         // 11: loan.GetLoanApplicationReq
+	(*GetLoanApplicationResp)(nil),        // 12: loan.GetLoanApplicationResp
+	(*ListLoanApplicationsReq)(nil),       // 13: loan.ListLoanApplicationsReq
+	(*ListLoanApplicationsResp)(nil),      // 14: loan.ListLoanApplicationsResp
+	(*UpdateLoanApplicationReq)(nil),      // 15: loan.UpdateLoanApplicationReq
+	(*UpdateLoanApplicationResp)(nil),     // 16: loan.UpdateLoanApplicationResp
+	(*CancelLoanApplicationReq)(nil),      // 17: loan.CancelLoanApplicationReq
+	(*CancelLoanApplicationResp)(nil),     // 18: loan.CancelLoanApplicationResp
+	(*ApproveLoanApplicationReq)(nil),     // 19: loan.ApproveLoanApplicationReq
+	(*ApproveLoanApplicationResp)(nil),    // 20: loan.ApproveLoanApplicationResp
+	(*ListLoanApprovalsReq)(nil),          // 21: loan.ListLoanApprovalsReq
+	(*ListLoanApprovalsResp)(nil),         // 22: loan.ListLoanApprovalsResp
+	(*GenerateRepaymentScheduleReq)(nil),  // 23: loan.GenerateRepaymentScheduleReq
+	(*GenerateRepaymentScheduleResp)(nil), // 24: loan.GenerateRepaymentScheduleResp
+	(*GetRepaymentScheduleReq)(nil),       // 25: loan.GetRepaymentScheduleReq
+	(*GetRepaymentScheduleResp)(nil),      // 26: loan.GetRepaymentScheduleResp
+	(*DisburseLoanReq)(nil),               // 27: loan.DisburseLoanReq
+	(*DisburseLoanResp)(nil),              // 28: loan.DisburseLoanResp
+	(*RecordRepaymentReq)(nil),            // 29: loan.RecordRepaymentReq
+	(*RecordRepaymentResp)(nil),           // 30: loan.RecordRepaymentResp
+	(*ListRepaymentsReq)(nil),             // 31: loan.ListRepaymentsReq
+	(*ListRepaymentsResp)(nil),            // 32: loan.ListRepaymentsResp
+	(*QuoteEarlyRepaymentReq)(nil),        // 33: loan.QuoteEarlyRepaymentReq
+	(*QuoteEarlyRepaymentResp)(nil),       // 34: loan.QuoteEarlyRepaymentResp
+	(*SettleEarlyReq)(nil),                // 35: loan.SettleEarlyReq
+	(*SettleEarlyResp)(nil),               // 36: loan.SettleEarlyResp
+	(*CountProductApplicationsReq)(nil),   // 37: loan.CountProductApplicationsReq
+	(*CountProductApplicationsResp)(nil),  // 38: loan.CountProductApplicationsResp
 }
 var file_loan_rpc_proto_depIdxs = []int32{
-	0,  // 0: loan.GetLoanApplicationResp.application_info:type_name -> loan.LoanApplicationInfo
-	0,  // 1: loan.ListLoanApplicationsResp.list:type_name -> loan.LoanApplicationInfo
-	0,  // 2: loan.UpdateLoanApplicationResp.application_info:type_name -> loan.LoanApplicationInfo
-	1,  // 3: loan.ListLoanApprovalsResp.list:type_name -> loan.LoanApprovalInfo
-	2,  // 4: loan.Loan.CreateLoanApplication:input_type -> loan.CreateLoanApplicationReq
-	4,  // 5: loan.Loan.GetLoanApplication:input_type -> loan.GetLoanApplicationReq
-	6,  // 6: loan.Loan.ListLoanApplications:input_type -> loan.ListLoanApplicationsReq
-	8,  // 7: loan.Loan.UpdateLoanApplication:input_type -> loan.UpdateLoanApplicationReq
-	10, // 8: loan.Loan.CancelLoanApplication:input_type -> loan.CancelLoanApplicationReq
-	12, // 9: loan.Loan.ApproveLoanApplication:input_type -> loan.ApproveLoanApplicationReq
-	14, // 10: loan.Loan.ListLoanApprovals:input_type -> loan.ListLoanApprovalsReq
-	3,  // 11: loan.Loan.CreateLoanApplication:output_type -> loan.CreateLoanApplicationResp
-	5,  // 12: loan.Loan.GetLoanApplication:output_type -> loan.GetLoanApplicationResp
-	7,  // 13: loan.Loan.ListLoanApplications:output_type -> loan.ListLoanApplicationsResp
-	9,  // 14: loan.Loan.UpdateLoanApplication:output_type -> loan.UpdateLoanApplicationResp
-	11, // 15: loan.Loan.CancelLoanApplication:output_type -> loan.CancelLoanApplicationResp
-	13, // 16: loan.Loan.ApproveLoanApplication:output_type -> loan.ApproveLoanApplicationResp
-	15, // 17: loan.Loan.ListLoanApprovals:output_type -> loan.ListLoanApprovalsResp
-	11, // [11:18] is the sub-list for method output_type
-	4,  // [4:11] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	1,  // 0: loan.CreditScoreInfo.items:type_name -> loan.CreditScoreItem
+	9,  // 1: loan.CreateLoanApplicationResp.reject_reasons:type_name -> loan.EligibilityReason
+	0,  // 2: loan.GetLoanApplicationResp.application_info:type_name -> loan.LoanApplicationInfo
+	2,  // 3: loan.GetLoanApplicationResp.credit_score:type_name -> loan.CreditScoreInfo
+	3,  // 4: loan.GetLoanApplicationResp.product_snapshot:type_name -> loan.LoanProductSnapshot
+	0,  // 5: loan.ListLoanApplicationsResp.list:type_name -> loan.LoanApplicationInfo
+	0,  // 6: loan.UpdateLoanApplicationResp.application_info:type_name -> loan.LoanApplicationInfo
+	4,  // 7: loan.ListLoanApprovalsResp.list:type_name -> loan.LoanApprovalInfo
+	5,  // 8: loan.GenerateRepaymentScheduleResp.list:type_name -> loan.RepaymentPlanInfo
+	5,  // 9: loan.GetRepaymentScheduleResp.list:type_name -> loan.RepaymentPlanInfo
+	7,  // 10: loan.DisburseLoanResp.disbursement_info:type_name -> loan.LoanDisbursementInfo
+	6,  // 11: loan.RecordRepaymentResp.repayment_info:type_name -> loan.LoanRepaymentInfo
+	6,  // 12: loan.ListRepaymentsResp.list:type_name -> loan.LoanRepaymentInfo
+	6,  // 13: loan.SettleEarlyResp.repayment_info:type_name -> loan.LoanRepaymentInfo
+	8,  // 14: loan.Loan.CreateLoanApplication:input_type -> loan.CreateLoanApplicationReq
+	11, // 15: loan.Loan.GetLoanApplication:input_type -> loan.GetLoanApplicationReq
+	13, // 16: loan.Loan.ListLoanApplications:input_type -> loan.ListLoanApplicationsReq
+	15, // 17: loan.Loan.UpdateLoanApplication:input_type -> loan.UpdateLoanApplicationReq
+	17, // 18: loan.Loan.CancelLoanApplication:input_type -> loan.CancelLoanApplicationReq
+	19, // 19: loan.Loan.ApproveLoanApplication:input_type -> loan.ApproveLoanApplicationReq
+	21, // 20: loan.Loan.ListLoanApprovals:input_type -> loan.ListLoanApprovalsReq
+	23, // 21: loan.Loan.GenerateRepaymentSchedule:input_type -> loan.GenerateRepaymentScheduleReq
+	25, // 22: loan.Loan.GetRepaymentSchedule:input_type -> loan.GetRepaymentScheduleReq
+	27, // 23: loan.Loan.DisburseLoan:input_type -> loan.DisburseLoanReq
+	29, // 24: loan.Loan.RecordRepayment:input_type -> loan.RecordRepaymentReq
+	31, // 25: loan.Loan.ListRepayments:input_type -> loan.ListRepaymentsReq
+	33, // 26: loan.Loan.QuoteEarlyRepayment:input_type -> loan.QuoteEarlyRepaymentReq
+	35, // 27: loan.Loan.SettleEarly:input_type -> loan.SettleEarlyReq
+	37, // 28: loan.Loan.CountProductApplications:input_type -> loan.CountProductApplicationsReq
+	10, // 29: loan.Loan.CreateLoanApplication:output_type -> loan.CreateLoanApplicationResp
+	12, // 30: loan.Loan.GetLoanApplication:output_type -> loan.GetLoanApplicationResp
+	14, // 31: loan.Loan.ListLoanApplications:output_type -> loan.ListLoanApplicationsResp
+	16, // 32: loan.Loan.UpdateLoanApplication:output_type -> loan.UpdateLoanApplicationResp
+	18, // 33: loan.Loan.CancelLoanApplication:output_type -> loan.CancelLoanApplicationResp
+	20, // 34: loan.Loan.ApproveLoanApplication:output_type -> loan.ApproveLoanApplicationResp
+	22, // 35: loan.Loan.ListLoanApprovals:output_type -> loan.ListLoanApprovalsResp
+	24, // 36: loan.Loan.GenerateRepaymentSchedule:output_type -> loan.GenerateRepaymentScheduleResp
+	26, // 37: loan.Loan.GetRepaymentSchedule:output_type -> loan.GetRepaymentScheduleResp
+	28, // 38: loan.Loan.DisburseLoan:output_type -> loan.DisburseLoanResp
+	30, // 39: loan.Loan.RecordRepayment:output_type -> loan.RecordRepaymentResp
+	32, // 40: loan.Loan.ListRepayments:output_type -> loan.ListRepaymentsResp
+	34, // 41: loan.Loan.QuoteEarlyRepayment:output_type -> loan.QuoteEarlyRepaymentResp
+	36, // 42: loan.Loan.SettleEarly:output_type -> loan.SettleEarlyResp
+	38, // 43: loan.Loan.CountProductApplications:output_type -> loan.CountProductApplicationsResp
+	29, // [29:44] is the sub-list for method output_type
+	14, // [14:29] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_loan_rpc_proto_init() }
@@ -1218,7 +3565,7 @@ func file_loan_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_loan_rpc_proto_rawDesc), len(file_loan_rpc_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
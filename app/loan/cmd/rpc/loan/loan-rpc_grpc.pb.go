@@ -19,13 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Loan_CreateLoanApplication_FullMethodName  = "/loan.Loan/CreateLoanApplication"
-	Loan_GetLoanApplication_FullMethodName     = "/loan.Loan/GetLoanApplication"
-	Loan_ListLoanApplications_FullMethodName   = "/loan.Loan/ListLoanApplications"
-	Loan_UpdateLoanApplication_FullMethodName  = "/loan.Loan/UpdateLoanApplication"
-	Loan_CancelLoanApplication_FullMethodName  = "/loan.Loan/CancelLoanApplication"
-	Loan_ApproveLoanApplication_FullMethodName = "/loan.Loan/ApproveLoanApplication"
-	Loan_ListLoanApprovals_FullMethodName      = "/loan.Loan/ListLoanApprovals"
+	Loan_CreateLoanApplication_FullMethodName     = "/loan.Loan/CreateLoanApplication"
+	Loan_GetLoanApplication_FullMethodName        = "/loan.Loan/GetLoanApplication"
+	Loan_ListLoanApplications_FullMethodName      = "/loan.Loan/ListLoanApplications"
+	Loan_UpdateLoanApplication_FullMethodName     = "/loan.Loan/UpdateLoanApplication"
+	Loan_CancelLoanApplication_FullMethodName     = "/loan.Loan/CancelLoanApplication"
+	Loan_ApproveLoanApplication_FullMethodName    = "/loan.Loan/ApproveLoanApplication"
+	Loan_ListLoanApprovals_FullMethodName         = "/loan.Loan/ListLoanApprovals"
+	Loan_GenerateRepaymentSchedule_FullMethodName = "/loan.Loan/GenerateRepaymentSchedule"
+	Loan_GetRepaymentSchedule_FullMethodName      = "/loan.Loan/GetRepaymentSchedule"
+	Loan_DisburseLoan_FullMethodName              = "/loan.Loan/DisburseLoan"
+	Loan_RecordRepayment_FullMethodName           = "/loan.Loan/RecordRepayment"
+	Loan_ListRepayments_FullMethodName            = "/loan.Loan/ListRepayments"
+	Loan_QuoteEarlyRepayment_FullMethodName       = "/loan.Loan/QuoteEarlyRepayment"
+	Loan_SettleEarly_FullMethodName               = "/loan.Loan/SettleEarly"
+	Loan_CountProductApplications_FullMethodName  = "/loan.Loan/CountProductApplications"
 )
 
 // LoanClient is the client API for Loan service.
@@ -43,6 +51,19 @@ type LoanClient interface {
 	// 贷款审批管理
 	ApproveLoanApplication(ctx context.Context, in *ApproveLoanApplicationReq, opts ...grpc.CallOption) (*ApproveLoanApplicationResp, error)
 	ListLoanApprovals(ctx context.Context, in *ListLoanApprovalsReq, opts ...grpc.CallOption) (*ListLoanApprovalsResp, error)
+	// 还款计划管理
+	GenerateRepaymentSchedule(ctx context.Context, in *GenerateRepaymentScheduleReq, opts ...grpc.CallOption) (*GenerateRepaymentScheduleResp, error)
+	GetRepaymentSchedule(ctx context.Context, in *GetRepaymentScheduleReq, opts ...grpc.CallOption) (*GetRepaymentScheduleResp, error)
+	// 放款管理
+	DisburseLoan(ctx context.Context, in *DisburseLoanReq, opts ...grpc.CallOption) (*DisburseLoanResp, error)
+	// 还款管理
+	RecordRepayment(ctx context.Context, in *RecordRepaymentReq, opts ...grpc.CallOption) (*RecordRepaymentResp, error)
+	ListRepayments(ctx context.Context, in *ListRepaymentsReq, opts ...grpc.CallOption) (*ListRepaymentsResp, error)
+	// 提前还款
+	QuoteEarlyRepayment(ctx context.Context, in *QuoteEarlyRepaymentReq, opts ...grpc.CallOption) (*QuoteEarlyRepaymentResp, error)
+	SettleEarly(ctx context.Context, in *SettleEarlyReq, opts ...grpc.CallOption) (*SettleEarlyResp, error)
+	// 产品引用检查
+	CountProductApplications(ctx context.Context, in *CountProductApplicationsReq, opts ...grpc.CallOption) (*CountProductApplicationsResp, error)
 }
 
 type loanClient struct {
@@ -123,6 +144,86 @@ func (c *loanClient) ListLoanApprovals(ctx context.Context, in *ListLoanApproval
 	return out, nil
 }
 
+func (c *loanClient) GenerateRepaymentSchedule(ctx context.Context, in *GenerateRepaymentScheduleReq, opts ...grpc.CallOption) (*GenerateRepaymentScheduleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateRepaymentScheduleResp)
+	err := c.cc.Invoke(ctx, Loan_GenerateRepaymentSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanClient) GetRepaymentSchedule(ctx context.Context, in *GetRepaymentScheduleReq, opts ...grpc.CallOption) (*GetRepaymentScheduleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRepaymentScheduleResp)
+	err := c.cc.Invoke(ctx, Loan_GetRepaymentSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanClient) DisburseLoan(ctx context.Context, in *DisburseLoanReq, opts ...grpc.CallOption) (*DisburseLoanResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisburseLoanResp)
+	err := c.cc.Invoke(ctx, Loan_DisburseLoan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanClient) RecordRepayment(ctx context.Context, in *RecordRepaymentReq, opts ...grpc.CallOption) (*RecordRepaymentResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordRepaymentResp)
+	err := c.cc.Invoke(ctx, Loan_RecordRepayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanClient) ListRepayments(ctx context.Context, in *ListRepaymentsReq, opts ...grpc.CallOption) (*ListRepaymentsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRepaymentsResp)
+	err := c.cc.Invoke(ctx, Loan_ListRepayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanClient) QuoteEarlyRepayment(ctx context.Context, in *QuoteEarlyRepaymentReq, opts ...grpc.CallOption) (*QuoteEarlyRepaymentResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteEarlyRepaymentResp)
+	err := c.cc.Invoke(ctx, Loan_QuoteEarlyRepayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanClient) SettleEarly(ctx context.Context, in *SettleEarlyReq, opts ...grpc.CallOption) (*SettleEarlyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleEarlyResp)
+	err := c.cc.Invoke(ctx, Loan_SettleEarly_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanClient) CountProductApplications(ctx context.Context, in *CountProductApplicationsReq, opts ...grpc.CallOption) (*CountProductApplicationsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CountProductApplicationsResp)
+	err := c.cc.Invoke(ctx, Loan_CountProductApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoanServer is the server API for Loan service.
 // All implementations must embed UnimplementedLoanServer
 // for forward compatibility.
@@ -138,6 +239,19 @@ type LoanServer interface {
 	// 贷款审批管理
 	ApproveLoanApplication(context.Context, *ApproveLoanApplicationReq) (*ApproveLoanApplicationResp, error)
 	ListLoanApprovals(context.Context, *ListLoanApprovalsReq) (*ListLoanApprovalsResp, error)
+	// 还款计划管理
+	GenerateRepaymentSchedule(context.Context, *GenerateRepaymentScheduleReq) (*GenerateRepaymentScheduleResp, error)
+	GetRepaymentSchedule(context.Context, *GetRepaymentScheduleReq) (*GetRepaymentScheduleResp, error)
+	// 放款管理
+	DisburseLoan(context.Context, *DisburseLoanReq) (*DisburseLoanResp, error)
+	// 还款管理
+	RecordRepayment(context.Context, *RecordRepaymentReq) (*RecordRepaymentResp, error)
+	ListRepayments(context.Context, *ListRepaymentsReq) (*ListRepaymentsResp, error)
+	// 提前还款
+	QuoteEarlyRepayment(context.Context, *QuoteEarlyRepaymentReq) (*QuoteEarlyRepaymentResp, error)
+	SettleEarly(context.Context, *SettleEarlyReq) (*SettleEarlyResp, error)
+	// 产品引用检查
+	CountProductApplications(context.Context, *CountProductApplicationsReq) (*CountProductApplicationsResp, error)
 	mustEmbedUnimplementedLoanServer()
 }
 
@@ -169,6 +283,30 @@ func (UnimplementedLoanServer) ApproveLoanApplication(context.Context, *ApproveL
 func (UnimplementedLoanServer) ListLoanApprovals(context.Context, *ListLoanApprovalsReq) (*ListLoanApprovalsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLoanApprovals not implemented")
 }
+func (UnimplementedLoanServer) GenerateRepaymentSchedule(context.Context, *GenerateRepaymentScheduleReq) (*GenerateRepaymentScheduleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateRepaymentSchedule not implemented")
+}
+func (UnimplementedLoanServer) GetRepaymentSchedule(context.Context, *GetRepaymentScheduleReq) (*GetRepaymentScheduleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepaymentSchedule not implemented")
+}
+func (UnimplementedLoanServer) DisburseLoan(context.Context, *DisburseLoanReq) (*DisburseLoanResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisburseLoan not implemented")
+}
+func (UnimplementedLoanServer) RecordRepayment(context.Context, *RecordRepaymentReq) (*RecordRepaymentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordRepayment not implemented")
+}
+func (UnimplementedLoanServer) ListRepayments(context.Context, *ListRepaymentsReq) (*ListRepaymentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRepayments not implemented")
+}
+func (UnimplementedLoanServer) QuoteEarlyRepayment(context.Context, *QuoteEarlyRepaymentReq) (*QuoteEarlyRepaymentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteEarlyRepayment not implemented")
+}
+func (UnimplementedLoanServer) SettleEarly(context.Context, *SettleEarlyReq) (*SettleEarlyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleEarly not implemented")
+}
+func (UnimplementedLoanServer) CountProductApplications(context.Context, *CountProductApplicationsReq) (*CountProductApplicationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountProductApplications not implemented")
+}
 func (UnimplementedLoanServer) mustEmbedUnimplementedLoanServer() {}
 func (UnimplementedLoanServer) testEmbeddedByValue()              {}
 
@@ -316,6 +454,150 @@ func _Loan_ListLoanApprovals_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Loan_GenerateRepaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateRepaymentScheduleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServer).GenerateRepaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loan_GenerateRepaymentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServer).GenerateRepaymentSchedule(ctx, req.(*GenerateRepaymentScheduleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loan_GetRepaymentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepaymentScheduleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServer).GetRepaymentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loan_GetRepaymentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServer).GetRepaymentSchedule(ctx, req.(*GetRepaymentScheduleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loan_DisburseLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisburseLoanReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServer).DisburseLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loan_DisburseLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServer).DisburseLoan(ctx, req.(*DisburseLoanReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loan_RecordRepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRepaymentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServer).RecordRepayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loan_RecordRepayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServer).RecordRepayment(ctx, req.(*RecordRepaymentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loan_ListRepayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRepaymentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServer).ListRepayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loan_ListRepayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServer).ListRepayments(ctx, req.(*ListRepaymentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loan_QuoteEarlyRepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteEarlyRepaymentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServer).QuoteEarlyRepayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loan_QuoteEarlyRepayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServer).QuoteEarlyRepayment(ctx, req.(*QuoteEarlyRepaymentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loan_SettleEarly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleEarlyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServer).SettleEarly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loan_SettleEarly_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServer).SettleEarly(ctx, req.(*SettleEarlyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loan_CountProductApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountProductApplicationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanServer).CountProductApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loan_CountProductApplications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanServer).CountProductApplications(ctx, req.(*CountProductApplicationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Loan_ServiceDesc is the grpc.ServiceDesc for Loan service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -351,6 +633,38 @@ var Loan_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLoanApprovals",
 			Handler:    _Loan_ListLoanApprovals_Handler,
 		},
+		{
+			MethodName: "GenerateRepaymentSchedule",
+			Handler:    _Loan_GenerateRepaymentSchedule_Handler,
+		},
+		{
+			MethodName: "GetRepaymentSchedule",
+			Handler:    _Loan_GetRepaymentSchedule_Handler,
+		},
+		{
+			MethodName: "DisburseLoan",
+			Handler:    _Loan_DisburseLoan_Handler,
+		},
+		{
+			MethodName: "RecordRepayment",
+			Handler:    _Loan_RecordRepayment_Handler,
+		},
+		{
+			MethodName: "ListRepayments",
+			Handler:    _Loan_ListRepayments_Handler,
+		},
+		{
+			MethodName: "QuoteEarlyRepayment",
+			Handler:    _Loan_QuoteEarlyRepayment_Handler,
+		},
+		{
+			MethodName: "SettleEarly",
+			Handler:    _Loan_SettleEarly_Handler,
+		},
+		{
+			MethodName: "CountProductApplications",
+			Handler:    _Loan_CountProductApplications_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "loan-rpc.proto",
@@ -14,22 +14,45 @@ import (
 )
 
 type (
-	ApproveLoanApplicationReq  = loan.ApproveLoanApplicationReq
-	ApproveLoanApplicationResp = loan.ApproveLoanApplicationResp
-	CancelLoanApplicationReq   = loan.CancelLoanApplicationReq
-	CancelLoanApplicationResp  = loan.CancelLoanApplicationResp
-	CreateLoanApplicationReq   = loan.CreateLoanApplicationReq
-	CreateLoanApplicationResp  = loan.CreateLoanApplicationResp
-	GetLoanApplicationReq      = loan.GetLoanApplicationReq
-	GetLoanApplicationResp     = loan.GetLoanApplicationResp
-	ListLoanApplicationsReq    = loan.ListLoanApplicationsReq
-	ListLoanApplicationsResp   = loan.ListLoanApplicationsResp
-	ListLoanApprovalsReq       = loan.ListLoanApprovalsReq
-	ListLoanApprovalsResp      = loan.ListLoanApprovalsResp
-	LoanApplicationInfo        = loan.LoanApplicationInfo
-	LoanApprovalInfo           = loan.LoanApprovalInfo
-	UpdateLoanApplicationReq   = loan.UpdateLoanApplicationReq
-	UpdateLoanApplicationResp  = loan.UpdateLoanApplicationResp
+	ApproveLoanApplicationReq     = loan.ApproveLoanApplicationReq
+	ApproveLoanApplicationResp    = loan.ApproveLoanApplicationResp
+	CancelLoanApplicationReq      = loan.CancelLoanApplicationReq
+	CancelLoanApplicationResp     = loan.CancelLoanApplicationResp
+	CountProductApplicationsReq   = loan.CountProductApplicationsReq
+	CountProductApplicationsResp  = loan.CountProductApplicationsResp
+	CreateLoanApplicationReq      = loan.CreateLoanApplicationReq
+	CreateLoanApplicationResp     = loan.CreateLoanApplicationResp
+	CreditScoreInfo               = loan.CreditScoreInfo
+	CreditScoreItem               = loan.CreditScoreItem
+	DisburseLoanReq               = loan.DisburseLoanReq
+	DisburseLoanResp              = loan.DisburseLoanResp
+	EligibilityReason             = loan.EligibilityReason
+	GenerateRepaymentScheduleReq  = loan.GenerateRepaymentScheduleReq
+	GenerateRepaymentScheduleResp = loan.GenerateRepaymentScheduleResp
+	GetLoanApplicationReq         = loan.GetLoanApplicationReq
+	GetLoanApplicationResp        = loan.GetLoanApplicationResp
+	GetRepaymentScheduleReq       = loan.GetRepaymentScheduleReq
+	GetRepaymentScheduleResp      = loan.GetRepaymentScheduleResp
+	ListLoanApplicationsReq       = loan.ListLoanApplicationsReq
+	ListLoanApplicationsResp      = loan.ListLoanApplicationsResp
+	ListLoanApprovalsReq          = loan.ListLoanApprovalsReq
+	ListLoanApprovalsResp         = loan.ListLoanApprovalsResp
+	ListRepaymentsReq             = loan.ListRepaymentsReq
+	ListRepaymentsResp            = loan.ListRepaymentsResp
+	LoanApplicationInfo           = loan.LoanApplicationInfo
+	LoanApprovalInfo              = loan.LoanApprovalInfo
+	LoanDisbursementInfo          = loan.LoanDisbursementInfo
+	LoanProductSnapshot           = loan.LoanProductSnapshot
+	LoanRepaymentInfo             = loan.LoanRepaymentInfo
+	QuoteEarlyRepaymentReq        = loan.QuoteEarlyRepaymentReq
+	QuoteEarlyRepaymentResp       = loan.QuoteEarlyRepaymentResp
+	RecordRepaymentReq            = loan.RecordRepaymentReq
+	RecordRepaymentResp           = loan.RecordRepaymentResp
+	RepaymentPlanInfo             = loan.RepaymentPlanInfo
+	SettleEarlyReq                = loan.SettleEarlyReq
+	SettleEarlyResp               = loan.SettleEarlyResp
+	UpdateLoanApplicationReq      = loan.UpdateLoanApplicationReq
+	UpdateLoanApplicationResp     = loan.UpdateLoanApplicationResp
 
 	Loan interface {
 		// 贷款申请管理
@@ -41,6 +64,19 @@ type (
 		// 贷款审批管理
 		ApproveLoanApplication(ctx context.Context, in *ApproveLoanApplicationReq, opts ...grpc.CallOption) (*ApproveLoanApplicationResp, error)
 		ListLoanApprovals(ctx context.Context, in *ListLoanApprovalsReq, opts ...grpc.CallOption) (*ListLoanApprovalsResp, error)
+		// 还款计划管理
+		GenerateRepaymentSchedule(ctx context.Context, in *GenerateRepaymentScheduleReq, opts ...grpc.CallOption) (*GenerateRepaymentScheduleResp, error)
+		GetRepaymentSchedule(ctx context.Context, in *GetRepaymentScheduleReq, opts ...grpc.CallOption) (*GetRepaymentScheduleResp, error)
+		// 放款管理
+		DisburseLoan(ctx context.Context, in *DisburseLoanReq, opts ...grpc.CallOption) (*DisburseLoanResp, error)
+		// 还款管理
+		RecordRepayment(ctx context.Context, in *RecordRepaymentReq, opts ...grpc.CallOption) (*RecordRepaymentResp, error)
+		ListRepayments(ctx context.Context, in *ListRepaymentsReq, opts ...grpc.CallOption) (*ListRepaymentsResp, error)
+		// 提前还款
+		QuoteEarlyRepayment(ctx context.Context, in *QuoteEarlyRepaymentReq, opts ...grpc.CallOption) (*QuoteEarlyRepaymentResp, error)
+		SettleEarly(ctx context.Context, in *SettleEarlyReq, opts ...grpc.CallOption) (*SettleEarlyResp, error)
+		// 产品引用检查
+		CountProductApplications(ctx context.Context, in *CountProductApplicationsReq, opts ...grpc.CallOption) (*CountProductApplicationsResp, error)
 	}
 
 	defaultLoan struct {
@@ -90,3 +126,48 @@ func (m *defaultLoan) ListLoanApprovals(ctx context.Context, in *ListLoanApprova
 	client := loan.NewLoanClient(m.cli.Conn())
 	return client.ListLoanApprovals(ctx, in, opts...)
 }
+
+// 还款计划管理
+func (m *defaultLoan) GenerateRepaymentSchedule(ctx context.Context, in *GenerateRepaymentScheduleReq, opts ...grpc.CallOption) (*GenerateRepaymentScheduleResp, error) {
+	client := loan.NewLoanClient(m.cli.Conn())
+	return client.GenerateRepaymentSchedule(ctx, in, opts...)
+}
+
+func (m *defaultLoan) GetRepaymentSchedule(ctx context.Context, in *GetRepaymentScheduleReq, opts ...grpc.CallOption) (*GetRepaymentScheduleResp, error) {
+	client := loan.NewLoanClient(m.cli.Conn())
+	return client.GetRepaymentSchedule(ctx, in, opts...)
+}
+
+// 放款管理
+func (m *defaultLoan) DisburseLoan(ctx context.Context, in *DisburseLoanReq, opts ...grpc.CallOption) (*DisburseLoanResp, error) {
+	client := loan.NewLoanClient(m.cli.Conn())
+	return client.DisburseLoan(ctx, in, opts...)
+}
+
+// 还款管理
+func (m *defaultLoan) RecordRepayment(ctx context.Context, in *RecordRepaymentReq, opts ...grpc.CallOption) (*RecordRepaymentResp, error) {
+	client := loan.NewLoanClient(m.cli.Conn())
+	return client.RecordRepayment(ctx, in, opts...)
+}
+
+func (m *defaultLoan) ListRepayments(ctx context.Context, in *ListRepaymentsReq, opts ...grpc.CallOption) (*ListRepaymentsResp, error) {
+	client := loan.NewLoanClient(m.cli.Conn())
+	return client.ListRepayments(ctx, in, opts...)
+}
+
+// 提前还款
+func (m *defaultLoan) QuoteEarlyRepayment(ctx context.Context, in *QuoteEarlyRepaymentReq, opts ...grpc.CallOption) (*QuoteEarlyRepaymentResp, error) {
+	client := loan.NewLoanClient(m.cli.Conn())
+	return client.QuoteEarlyRepayment(ctx, in, opts...)
+}
+
+func (m *defaultLoan) SettleEarly(ctx context.Context, in *SettleEarlyReq, opts ...grpc.CallOption) (*SettleEarlyResp, error) {
+	client := loan.NewLoanClient(m.cli.Conn())
+	return client.SettleEarly(ctx, in, opts...)
+}
+
+// 产品引用检查
+func (m *defaultLoan) CountProductApplications(ctx context.Context, in *CountProductApplicationsReq, opts ...grpc.CallOption) (*CountProductApplicationsResp, error) {
+	client := loan.NewLoanClient(m.cli.Conn())
+	return client.CountProductApplications(ctx, in, opts...)
+}
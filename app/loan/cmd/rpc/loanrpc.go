@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"rpc/internal/config"
+	"rpc/internal/job"
 	"rpc/internal/server"
 	"rpc/internal/svc"
 	"rpc/loan"
@@ -41,8 +42,12 @@ func main() {
 		logx.Errorf("consul register service %s", err)
 	}
 
-	defer s.Stop()
+	// rpc 服务与后台逾期检测任务统一管理
+	group := service.NewServiceGroup()
+	defer group.Stop()
+	group.Add(s)
+	group.Add(job.NewOverdueJob(ctx))
 
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
-	s.Start()
+	group.Start()
 }
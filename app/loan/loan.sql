@@ -24,11 +24,20 @@ CREATE TABLE `loan_applications` (
   `amount` decimal(15,2) NOT NULL COMMENT '申请金额',
   `duration` int UNSIGNED NOT NULL COMMENT '贷款期限(月)',
   `purpose` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT '贷款用途',
-  `status` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT 'pending' COMMENT '状态 pending/approved/rejected/cancelled',
+  `origination_fee_rate` decimal(6,4) UNSIGNED DEFAULT 0.0000 COMMENT '手续费率(%),创建申请时按产品配置冻结',
+  `service_fee_rate` decimal(6,4) UNSIGNED DEFAULT 0.0000 COMMENT '服务费月费率(%),创建申请时按产品配置冻结',
+  `guarantee_fee_rate` decimal(6,4) UNSIGNED DEFAULT 0.0000 COMMENT '担保费率(%),创建申请时按产品配置冻结',
+  `late_fee` decimal(10,2) UNSIGNED DEFAULT 0.00 COMMENT '逾期滞纳金(元/期),创建申请时按产品配置冻结',
+  `apr` decimal(8,2) UNSIGNED DEFAULT 0.00 COMMENT '综合年化利率(IRR,%),按申请金额和期限以等额本息试算',
+  `product_snapshot` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT '产品条款快照(JSON),创建申请时记录利率、额度、期限、费用等',
+  `status` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT 'pending' COMMENT '状态 pending/approved/rejected/cancelled/disbursed/settled',
+  `version` int UNSIGNED NOT NULL DEFAULT 0 COMMENT '乐观锁版本号',
+  `idempotency_key` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT NULL COMMENT '幂等键(客户端Idempotency-Key)',
   `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
   PRIMARY KEY (`id`),
   UNIQUE KEY `uk_application_id` (`application_id`),
+  UNIQUE KEY `uk_user_idempotency_key` (`user_id`, `idempotency_key`),
   KEY `idx_user_id` (`user_id`),
   KEY `idx_product_id` (`product_id`),
   KEY `idx_status` (`status`)
@@ -44,6 +53,9 @@ CREATE TABLE `loan_approvals` (
   `auditor_id` bigint UNSIGNED NOT NULL COMMENT '审核员ID',
   `auditor_name` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '审核员姓名',
   `action` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '审批动作 approve/reject',
+  `stage` int UNSIGNED DEFAULT 1 COMMENT '审批环节序号,从1开始',
+  `stage_name` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '审批环节名称',
+  `auditor_role` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '审核员角色 admin/operator',
   `suggestions` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT '审批意见',
   `approved_amount` decimal(15,2) DEFAULT NULL COMMENT '批准金额',
   `approved_duration` int UNSIGNED DEFAULT NULL COMMENT '批准期限(月)',
@@ -55,6 +67,109 @@ CREATE TABLE `loan_approvals` (
   KEY `idx_action` (`action`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='贷款审批记录表';
 
+-- ----------------------------
+-- 还款计划表
+-- ----------------------------
+DROP TABLE IF EXISTS `loan_repayment_plans`;
+CREATE TABLE `loan_repayment_plans` (
+  `id` bigint UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '还款计划ID',
+  `application_id` bigint UNSIGNED NOT NULL COMMENT '申请ID',
+  `installment_no` int UNSIGNED NOT NULL COMMENT '期数',
+  `due_date` date NOT NULL COMMENT '应还日期',
+  `principal` decimal(15,2) NOT NULL COMMENT '应还本金',
+  `interest` decimal(15,2) NOT NULL COMMENT '应还利息',
+  `total_amount` decimal(15,2) NOT NULL COMMENT '应还总额(本金+利息+服务费)',
+  `remaining_principal` decimal(15,2) NOT NULL COMMENT '剩余本金',
+  `repayment_method` varchar(30) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '还款方式 equal_installment/equal_principal/interest_only/seasonal',
+  `paid_principal` decimal(15,2) DEFAULT 0.00 COMMENT '已还本金',
+  `paid_interest` decimal(15,2) DEFAULT 0.00 COMMENT '已还利息',
+  `penalty` decimal(15,2) DEFAULT 0.00 COMMENT '应还罚息',
+  `paid_penalty` decimal(15,2) DEFAULT 0.00 COMMENT '已还罚息',
+  `service_fee` decimal(15,2) DEFAULT 0.00 COMMENT '应还服务费,按申请冻结的服务费月费率随每期收取',
+  `paid_service_fee` decimal(15,2) DEFAULT 0.00 COMMENT '已还服务费',
+  `overdue_days` int UNSIGNED DEFAULT 0 COMMENT '逾期天数',
+  `paid_at` timestamp NULL DEFAULT NULL COMMENT '结清时间',
+  `status` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT 'pending' COMMENT '状态 pending/paid/overdue',
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_application_installment` (`application_id`, `installment_no`),
+  KEY `idx_due_date` (`due_date`),
+  KEY `idx_status` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='还款计划表';
+
+-- ----------------------------
+-- 放款记录表
+-- ----------------------------
+DROP TABLE IF EXISTS `loan_disbursements`;
+CREATE TABLE `loan_disbursements` (
+  `id` bigint UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '放款ID',
+  `disbursement_no` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '放款流水号',
+  `application_id` bigint UNSIGNED NOT NULL COMMENT '申请ID',
+  `active_application_id` bigint UNSIGNED DEFAULT NULL COMMENT '占用申请ID,在途或成功时等于application_id,失败后置空',
+  `amount` decimal(15,2) NOT NULL COMMENT '放款金额(批准金额扣除手续费、担保费)',
+  `account_name` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '收款户名',
+  `account_no` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '收款账号',
+  `bank_name` varchar(100) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '开户行',
+  `channel` varchar(30) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '放款渠道 mock等',
+  `bank_serial_no` varchar(100) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '银行核心交易流水号',
+  `status` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT 'pending' COMMENT '状态 pending/unknown/success/failed',
+  `fail_reason` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '失败原因',
+  `operator_id` bigint UNSIGNED NOT NULL COMMENT '操作员ID',
+  `operator_name` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '操作员姓名',
+  `disbursed_at` timestamp NULL DEFAULT NULL COMMENT '放款成功时间',
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_disbursement_no` (`disbursement_no`),
+  UNIQUE KEY `uk_active_application_id` (`active_application_id`),
+  KEY `idx_application_id` (`application_id`),
+  KEY `idx_status` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='放款记录表';
+
+-- ----------------------------
+-- 还款记录表
+-- ----------------------------
+DROP TABLE IF EXISTS `loan_repayments`;
+CREATE TABLE `loan_repayments` (
+  `id` bigint UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '还款记录ID',
+  `repayment_no` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '还款流水号',
+  `application_id` bigint UNSIGNED NOT NULL COMMENT '申请ID',
+  `user_id` bigint UNSIGNED NOT NULL COMMENT '还款用户ID',
+  `amount` decimal(15,2) NOT NULL COMMENT '还款金额',
+  `principal_amount` decimal(15,2) DEFAULT 0.00 COMMENT '冲抵本金',
+  `interest_amount` decimal(15,2) DEFAULT 0.00 COMMENT '冲抵利息',
+  `penalty_amount` decimal(15,2) DEFAULT 0.00 COMMENT '冲抵罚息',
+  `fee_amount` decimal(15,2) DEFAULT 0.00 COMMENT '冲抵费用(服务费、提前还款手续费)',
+  `installment_nos` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '冲抵期数,逗号分隔',
+  `repayment_type` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT 'regular' COMMENT '还款类型 regular/prepay',
+  `channel` varchar(30) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT 'online' COMMENT '还款渠道 online/bank_transfer/cash',
+  `remark` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '备注',
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '还款时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_repayment_no` (`repayment_no`),
+  KEY `idx_application_id` (`application_id`),
+  KEY `idx_user_id` (`user_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='还款记录表';
+
+-- ----------------------------
+-- 信用评分表
+-- ----------------------------
+DROP TABLE IF EXISTS `loan_credit_scores`;
+CREATE TABLE `loan_credit_scores` (
+  `id` bigint UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '评分ID',
+  `application_id` bigint UNSIGNED NOT NULL COMMENT '申请ID',
+  `score` int NOT NULL DEFAULT 0 COMMENT '信用评分',
+  `grade` varchar(10) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '评分等级 A/B/C/D/E',
+  `recommendation` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '审批建议 approve/review/reject',
+  `explanation` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci COMMENT '评分明细(JSON)',
+  `rule_version` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT '' COMMENT '评分规则版本',
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '评分时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_application_id` (`application_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='信用评分表';
+
 -- ----------------------------
 -- 初始化数据
 -- ----------------------------
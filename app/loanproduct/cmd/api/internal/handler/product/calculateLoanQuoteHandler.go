@@ -0,0 +1,30 @@
+package product
+
+import (
+	"net/http"
+
+	"api/internal/logic/product"
+	"api/internal/svc"
+	"api/internal/types"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 借款试算
+func CalculateLoanQuoteHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CalculateLoanQuoteReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := product.NewCalculateLoanQuoteLogic(r.Context(), svcCtx)
+		resp, err := l.CalculateLoanQuote(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
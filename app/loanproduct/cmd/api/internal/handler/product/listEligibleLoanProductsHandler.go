@@ -0,0 +1,30 @@
+package product
+
+import (
+	"net/http"
+
+	"api/internal/logic/product"
+	"api/internal/svc"
+	"api/internal/types"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// 获取当前用户满足准入条件的贷款产品列表
+func ListEligibleLoanProductsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ListLoanProductsReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := product.NewListEligibleLoanProductsLogic(r.Context(), svcCtx)
+		resp, err := l.ListEligibleLoanProducts(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -48,12 +48,24 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/products/:id",
 					Handler: admin.DeleteLoanProductHandler(serverCtx),
 				},
+				{
+					// 排期上下架贷款产品
+					Method:  http.MethodPut,
+					Path:    "/products/:id/schedule",
+					Handler: admin.ScheduleLoanProductHandler(serverCtx),
+				},
 				{
 					// 更新产品状态
 					Method:  http.MethodPut,
 					Path:    "/products/:id/status",
 					Handler: admin.UpdateProductStatusHandler(serverCtx),
 				},
+				{
+					// 获取贷款产品版本历史
+					Method:  http.MethodGet,
+					Path:    "/products/:id/versions",
+					Handler: admin.ListLoanProductVersionsHandler(serverCtx),
+				},
 			}...,
 		),
 		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
@@ -74,7 +86,32 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/products/:id",
 				Handler: product.GetLoanProductHandler(serverCtx),
 			},
+			{
+				// 借款试算
+				Method:  http.MethodGet,
+				Path:    "/products/:id/quote",
+				Handler: product.CalculateLoanQuoteHandler(serverCtx),
+			},
+		},
+		rest.WithPrefix("/api/v1/loanproduct"),
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// 获取当前用户满足准入条件的贷款产品列表
+				Method:  http.MethodGet,
+				Path:    "/eligible-products",
+				Handler: product.ListEligibleLoanProductsHandler(serverCtx),
+			},
+			{
+				// 检查当前用户是否满足产品准入条件
+				Method:  http.MethodGet,
+				Path:    "/products/:id/eligibility",
+				Handler: product.CheckEligibilityHandler(serverCtx),
+			},
 		},
+		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
 		rest.WithPrefix("/api/v1/loanproduct"),
 	)
 }
@@ -52,19 +52,41 @@ func (l *ListAllLoanProductsLogic) ListAllLoanProducts(req *types.ListLoanProduc
 	var products []types.LoanProductInfo
 	for _, item := range rpcResp.List {
 		products = append(products, types.LoanProductInfo{
-			Id:           item.Id,
-			ProductCode:  item.ProductCode,
-			Name:         item.Name,
-			Type:         item.Type,
-			MaxAmount:    item.MaxAmount,
-			MinAmount:    item.MinAmount,
-			MaxDuration:  item.MaxDuration,
-			MinDuration:  item.MinDuration,
-			InterestRate: item.InterestRate,
-			Description:  item.Description,
-			Status:       item.Status,
-			CreatedAt:    item.CreatedAt,
-			UpdatedAt:    item.UpdatedAt,
+			Id:                 item.Id,
+			ProductCode:        item.ProductCode,
+			Name:               item.Name,
+			Type:               item.Type,
+			MaxAmount:          item.MaxAmount,
+			MinAmount:          item.MinAmount,
+			MaxDuration:        item.MaxDuration,
+			MinDuration:        item.MinDuration,
+			InterestRate:       item.InterestRate,
+			Description:        item.Description,
+			Status:             item.Status,
+			CreatedAt:          item.CreatedAt,
+			UpdatedAt:          item.UpdatedAt,
+			RepaymentProfile:   item.RepaymentProfile,
+			GraceMonths:        item.GraceMonths,
+			HarvestMonths:      item.HarvestMonths,
+			PenaltyRate:        item.PenaltyRate,
+			PrepaymentFeeRate:  item.PrepaymentFeeRate,
+			OriginationFeeRate: item.OriginationFeeRate,
+			ServiceFeeRate:     item.ServiceFeeRate,
+			GuaranteeFeeRate:   item.GuaranteeFeeRate,
+			LateFee:            item.LateFee,
+			ApprovalChain:      item.ApprovalChain,
+			AprMin:             item.AprMin,
+			AprMax:             item.AprMax,
+			Version:            item.Version,
+			LaunchAt:           item.LaunchAt,
+			DelistAt:           item.DelistAt,
+			TotalBudget:        item.TotalBudget,
+			PeriodQuota:        item.PeriodQuota,
+			QuotaPeriod:        item.QuotaPeriod,
+			BudgetUsed:         item.BudgetUsed,
+			PeriodUsed:         item.PeriodUsed,
+			RemainingQuota:     item.RemainingQuota,
+			EligibilityRule:    item.EligibilityRule,
 		})
 	}
 
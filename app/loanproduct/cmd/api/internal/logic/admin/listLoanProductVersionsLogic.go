@@ -0,0 +1,74 @@
+package admin
+
+import (
+	"context"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/loanproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListLoanProductVersionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 获取贷款产品版本历史
+func NewListLoanProductVersionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListLoanProductVersionsLogic {
+	return &ListLoanProductVersionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListLoanProductVersionsLogic) ListLoanProductVersions(req *types.ListLoanProductVersionsReq) (resp *types.ListLoanProductVersionsResp, err error) {
+	// 调用RPC服务 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "loanproduct-rpc", func() (*loanproduct.ListLoanProductVersionsResp, error) {
+		return l.svcCtx.LoanProductRpc.ListLoanProductVersions(l.ctx, &loanproduct.ListLoanProductVersionsReq{
+			ProductId: req.Id,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return nil, err
+	}
+
+	list := make([]types.LoanProductVersionInfo, 0, len(rpcResp.List))
+	for _, version := range rpcResp.List {
+		list = append(list, *convertProductVersion(version))
+	}
+
+	return &types.ListLoanProductVersionsResp{
+		List: list,
+	}, nil
+}
+
+// convertProductVersion 转换产品条款版本
+func convertProductVersion(version *loanproduct.LoanProductVersionInfo) *types.LoanProductVersionInfo {
+	changes := make([]types.ProductVersionChange, 0, len(version.Changes))
+	for _, change := range version.Changes {
+		changes = append(changes, types.ProductVersionChange{
+			Field: change.Field,
+			From:  change.From,
+			To:    change.To,
+		})
+	}
+
+	return &types.LoanProductVersionInfo{
+		Id:            version.Id,
+		ProductId:     version.ProductId,
+		Version:       version.Version,
+		Status:        version.Status,
+		Changes:       changes,
+		EffectiveFrom: version.EffectiveFrom,
+		EffectiveTo:   version.EffectiveTo,
+		OperatorId:    version.OperatorId,
+		OperatorName:  version.OperatorName,
+		CreatedAt:     version.CreatedAt,
+	}
+}
@@ -0,0 +1,83 @@
+package admin
+
+import (
+	"context"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/loanproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ScheduleLoanProductLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 排期上下架贷款产品
+func NewScheduleLoanProductLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ScheduleLoanProductLogic {
+	return &ScheduleLoanProductLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ScheduleLoanProductLogic) ScheduleLoanProduct(req *types.ScheduleLoanProductReq) (resp *types.ScheduleLoanProductResp, err error) {
+	// 调用RPC服务 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "loanproduct-rpc", func() (*loanproduct.ScheduleLoanProductResp, error) {
+		return l.svcCtx.LoanProductRpc.ScheduleLoanProduct(l.ctx, &loanproduct.ScheduleLoanProductReq{
+			Id:       req.Id,
+			LaunchAt: req.LaunchAt,
+			DelistAt: req.DelistAt,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return nil, err
+	}
+
+	// 转换响应数据
+	return &types.ScheduleLoanProductResp{
+		Data: types.LoanProductInfo{
+			Id:                 rpcResp.Data.Id,
+			ProductCode:        rpcResp.Data.ProductCode,
+			Name:               rpcResp.Data.Name,
+			Type:               rpcResp.Data.Type,
+			MaxAmount:          rpcResp.Data.MaxAmount,
+			MinAmount:          rpcResp.Data.MinAmount,
+			MaxDuration:        rpcResp.Data.MaxDuration,
+			MinDuration:        rpcResp.Data.MinDuration,
+			InterestRate:       rpcResp.Data.InterestRate,
+			Description:        rpcResp.Data.Description,
+			Status:             rpcResp.Data.Status,
+			CreatedAt:          rpcResp.Data.CreatedAt,
+			UpdatedAt:          rpcResp.Data.UpdatedAt,
+			RepaymentProfile:   rpcResp.Data.RepaymentProfile,
+			GraceMonths:        rpcResp.Data.GraceMonths,
+			HarvestMonths:      rpcResp.Data.HarvestMonths,
+			PenaltyRate:        rpcResp.Data.PenaltyRate,
+			PrepaymentFeeRate:  rpcResp.Data.PrepaymentFeeRate,
+			OriginationFeeRate: rpcResp.Data.OriginationFeeRate,
+			ServiceFeeRate:     rpcResp.Data.ServiceFeeRate,
+			GuaranteeFeeRate:   rpcResp.Data.GuaranteeFeeRate,
+			LateFee:            rpcResp.Data.LateFee,
+			ApprovalChain:      rpcResp.Data.ApprovalChain,
+			AprMin:             rpcResp.Data.AprMin,
+			AprMax:             rpcResp.Data.AprMax,
+			Version:            rpcResp.Data.Version,
+			LaunchAt:           rpcResp.Data.LaunchAt,
+			DelistAt:           rpcResp.Data.DelistAt,
+			TotalBudget:        rpcResp.Data.TotalBudget,
+			PeriodQuota:        rpcResp.Data.PeriodQuota,
+			QuotaPeriod:        rpcResp.Data.QuotaPeriod,
+			BudgetUsed:         rpcResp.Data.BudgetUsed,
+			PeriodUsed:         rpcResp.Data.PeriodUsed,
+			RemainingQuota:     rpcResp.Data.RemainingQuota,
+			EligibilityRule:    rpcResp.Data.EligibilityRule,
+		},
+	}, nil
+}
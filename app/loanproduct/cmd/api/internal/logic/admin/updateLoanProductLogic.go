@@ -2,6 +2,8 @@ package admin
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strconv"
 
 	"api/internal/breaker"
@@ -34,18 +36,44 @@ func (l *UpdateLoanProductLogic) UpdateLoanProduct(req *types.UpdateLoanProductR
 		return nil, err
 	}
 
+	// 获取操作人信息 (从JWT中获取),用于记录产品版本的修改人
+	operatorId, err := l.getUserIdFromJWT()
+	if err != nil {
+		l.Errorf("获取操作人ID失败: %v", err)
+		return nil, err
+	}
+	operatorName := l.getUserNameFromJWT()
+
 	// 调用RPC服务 - 使用熔断器
 	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "loanproduct-rpc", func() (*loanproduct.UpdateLoanProductResp, error) {
 		return l.svcCtx.LoanProductRpc.UpdateLoanProduct(l.ctx, &loanproduct.UpdateLoanProductReq{
-			Id:           id,
-			Name:         req.Name,
-			Type:         req.Type,
-			MaxAmount:    req.MaxAmount,
-			MinAmount:    req.MinAmount,
-			MaxDuration:  req.MaxDuration,
-			MinDuration:  req.MinDuration,
-			InterestRate: req.InterestRate,
-			Description:  req.Description,
+			Id:                 id,
+			Name:               req.Name,
+			Type:               req.Type,
+			MaxAmount:          req.MaxAmount,
+			MinAmount:          req.MinAmount,
+			MaxDuration:        req.MaxDuration,
+			MinDuration:        req.MinDuration,
+			InterestRate:       req.InterestRate,
+			Description:        req.Description,
+			RepaymentProfile:   req.RepaymentProfile,
+			GraceMonths:        req.GraceMonths,
+			HarvestMonths:      req.HarvestMonths,
+			PenaltyRate:        req.PenaltyRate,
+			PrepaymentFeeRate:  req.PrepaymentFeeRate,
+			OriginationFeeRate: req.OriginationFeeRate,
+			ServiceFeeRate:     req.ServiceFeeRate,
+			GuaranteeFeeRate:   req.GuaranteeFeeRate,
+			LateFee:            req.LateFee,
+			ApprovalChain:      req.ApprovalChain,
+			TotalBudget:        req.TotalBudget,
+			PeriodQuota:        req.PeriodQuota,
+			QuotaPeriod:        req.QuotaPeriod,
+			EligibilityRule:    req.EligibilityRule,
+			RateTiers:          rateTierReqs(req.RateTiers),
+			EffectiveFrom:      req.EffectiveFrom,
+			OperatorId:         operatorId,
+			OperatorName:       operatorName,
 		})
 	}, breaker.IsAcceptableError)
 	if err != nil {
@@ -53,22 +81,129 @@ func (l *UpdateLoanProductLogic) UpdateLoanProduct(req *types.UpdateLoanProductR
 		return nil, err
 	}
 
+	// 本次修改产生的版本,条款无变化时为空
+	var version *types.LoanProductVersionInfo
+	if rpcResp.Version != nil {
+		version = convertProductVersion(rpcResp.Version)
+	}
+
 	// 转换响应数据
 	return &types.UpdateLoanProductResp{
 		Data: types.LoanProductInfo{
-			Id:           rpcResp.Data.Id,
-			ProductCode:  rpcResp.Data.ProductCode,
-			Name:         rpcResp.Data.Name,
-			Type:         rpcResp.Data.Type,
-			MaxAmount:    rpcResp.Data.MaxAmount,
-			MinAmount:    rpcResp.Data.MinAmount,
-			MaxDuration:  rpcResp.Data.MaxDuration,
-			MinDuration:  rpcResp.Data.MinDuration,
-			InterestRate: rpcResp.Data.InterestRate,
-			Description:  rpcResp.Data.Description,
-			Status:       rpcResp.Data.Status,
-			CreatedAt:    rpcResp.Data.CreatedAt,
-			UpdatedAt:    rpcResp.Data.UpdatedAt,
+			Id:                 rpcResp.Data.Id,
+			ProductCode:        rpcResp.Data.ProductCode,
+			Name:               rpcResp.Data.Name,
+			Type:               rpcResp.Data.Type,
+			MaxAmount:          rpcResp.Data.MaxAmount,
+			MinAmount:          rpcResp.Data.MinAmount,
+			MaxDuration:        rpcResp.Data.MaxDuration,
+			MinDuration:        rpcResp.Data.MinDuration,
+			InterestRate:       rpcResp.Data.InterestRate,
+			Description:        rpcResp.Data.Description,
+			Status:             rpcResp.Data.Status,
+			CreatedAt:          rpcResp.Data.CreatedAt,
+			UpdatedAt:          rpcResp.Data.UpdatedAt,
+			RepaymentProfile:   rpcResp.Data.RepaymentProfile,
+			GraceMonths:        rpcResp.Data.GraceMonths,
+			HarvestMonths:      rpcResp.Data.HarvestMonths,
+			PenaltyRate:        rpcResp.Data.PenaltyRate,
+			PrepaymentFeeRate:  rpcResp.Data.PrepaymentFeeRate,
+			OriginationFeeRate: rpcResp.Data.OriginationFeeRate,
+			ServiceFeeRate:     rpcResp.Data.ServiceFeeRate,
+			GuaranteeFeeRate:   rpcResp.Data.GuaranteeFeeRate,
+			LateFee:            rpcResp.Data.LateFee,
+			ApprovalChain:      rpcResp.Data.ApprovalChain,
+			AprMin:             rpcResp.Data.AprMin,
+			AprMax:             rpcResp.Data.AprMax,
+			Version:            rpcResp.Data.Version,
+			LaunchAt:           rpcResp.Data.LaunchAt,
+			DelistAt:           rpcResp.Data.DelistAt,
+			TotalBudget:        rpcResp.Data.TotalBudget,
+			PeriodQuota:        rpcResp.Data.PeriodQuota,
+			QuotaPeriod:        rpcResp.Data.QuotaPeriod,
+			BudgetUsed:         rpcResp.Data.BudgetUsed,
+			PeriodUsed:         rpcResp.Data.PeriodUsed,
+			RemainingQuota:     rpcResp.Data.RemainingQuota,
+			EligibilityRule:    rpcResp.Data.EligibilityRule,
+			RateTiers:          convertRateTiers(rpcResp.Data.RateTiers),
 		},
+		Version: version,
 	}, nil
 }
+
+// 从JWT中获取用户ID的辅助方法
+func (l *UpdateLoanProductLogic) getUserIdFromJWT() (int64, error) {
+	// 方法1: go-zero标准方式 - 处理json.Number类型
+	if userIdVal := l.ctx.Value("user_id"); userIdVal != nil {
+		// go-zero将JWT中的数字转换为json.Number类型
+		if jsonUid, ok := userIdVal.(json.Number); ok {
+			if int64Uid, err := jsonUid.Int64(); err == nil {
+				return int64Uid, nil
+			} else {
+				logx.WithContext(l.ctx).Errorf("JWT user_id转换失败: %v", err)
+			}
+		}
+		// 备用：尝试其他类型
+		if userId, ok := userIdVal.(float64); ok {
+			return int64(userId), nil
+		}
+		if userId, ok := userIdVal.(int64); ok {
+			return userId, nil
+		}
+		if userIdStr, ok := userIdVal.(string); ok {
+			return strconv.ParseInt(userIdStr, 10, 64)
+		}
+	}
+
+	// 方法2: 尝试从context的其他可能字段获取
+	if userIdVal := l.ctx.Value("userId"); userIdVal != nil {
+		if jsonUid, ok := userIdVal.(json.Number); ok {
+			if int64Uid, err := jsonUid.Int64(); err == nil {
+				return int64Uid, nil
+			}
+		}
+		if userId, ok := userIdVal.(float64); ok {
+			return int64(userId), nil
+		}
+		if userId, ok := userIdVal.(int64); ok {
+			return userId, nil
+		}
+		if userIdStr, ok := userIdVal.(string); ok {
+			return strconv.ParseInt(userIdStr, 10, 64)
+		}
+	}
+
+	// 方法3: 尝试从JWT标准字段获取 (sub字段通常包含用户ID)
+	if subVal := l.ctx.Value("sub"); subVal != nil {
+		if jsonSub, ok := subVal.(json.Number); ok {
+			if int64Sub, err := jsonSub.Int64(); err == nil {
+				return int64Sub, nil
+			}
+		}
+		if subStr, ok := subVal.(string); ok {
+			return strconv.ParseInt(subStr, 10, 64)
+		}
+	}
+
+	return 0, fmt.Errorf("无法从JWT中获取用户ID")
+}
+
+// 从JWT中获取用户名的辅助方法
+func (l *UpdateLoanProductLogic) getUserNameFromJWT() string {
+	if nameVal := l.ctx.Value("username"); nameVal != nil {
+		if name, ok := nameVal.(string); ok {
+			return name
+		}
+	}
+	if nameVal := l.ctx.Value("name"); nameVal != nil {
+		if name, ok := nameVal.(string); ok {
+			return name
+		}
+	}
+	if phoneVal := l.ctx.Value("phone"); phoneVal != nil {
+		if phone, ok := phoneVal.(string); ok {
+			return phone // 如果没有用户名，使用手机号
+		}
+	}
+	return ""
+}
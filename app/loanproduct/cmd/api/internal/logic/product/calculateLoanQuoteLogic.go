@@ -0,0 +1,80 @@
+package product
+
+import (
+	"context"
+
+	"api/internal/breaker"
+	"api/internal/svc"
+	"api/internal/types"
+	"rpc/loanproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CalculateLoanQuoteLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 借款试算
+func NewCalculateLoanQuoteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CalculateLoanQuoteLogic {
+	return &CalculateLoanQuoteLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CalculateLoanQuoteLogic) CalculateLoanQuote(req *types.CalculateLoanQuoteReq) (resp *types.CalculateLoanQuoteResp, err error) {
+	// 调用RPC服务 - 使用熔断器
+	rpcResp, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "loanproduct-rpc", func() (*loanproduct.CalculateLoanQuoteResp, error) {
+		return l.svcCtx.LoanProductRpc.CalculateLoanQuote(l.ctx, &loanproduct.CalculateLoanQuoteReq{
+			Id:       req.Id,
+			Amount:   req.Amount,
+			Duration: req.Duration,
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("调用RPC服务失败: %v", err)
+		return nil, err
+	}
+
+	// 转换响应数据
+	quotes := make([]types.LoanQuote, 0, len(rpcResp.Quotes))
+	for _, quote := range rpcResp.Quotes {
+		installments := make([]types.QuoteInstallment, 0, len(quote.Installments))
+		for _, item := range quote.Installments {
+			installments = append(installments, types.QuoteInstallment{
+				No:                 item.No,
+				DueDate:            item.DueDate,
+				Principal:          item.Principal,
+				Interest:           item.Interest,
+				Total:              item.Total,
+				RemainingPrincipal: item.RemainingPrincipal,
+				ServiceFee:         item.ServiceFee,
+			})
+		}
+		quotes = append(quotes, types.LoanQuote{
+			RepaymentMethod: quote.RepaymentMethod,
+			MonthlyPayment:  quote.MonthlyPayment,
+			TotalInterest:   quote.TotalInterest,
+			TotalAmount:     quote.TotalAmount,
+			Apr:             quote.Apr,
+			Installments:    installments,
+			OriginationFee:  quote.OriginationFee,
+			GuaranteeFee:    quote.GuaranteeFee,
+			ServiceFee:      quote.ServiceFee,
+			TotalFee:        quote.TotalFee,
+			ReceivedAmount:  quote.ReceivedAmount,
+		})
+	}
+
+	return &types.CalculateLoanQuoteResp{
+		ProductId:    rpcResp.ProductId,
+		Amount:       rpcResp.Amount,
+		Duration:     rpcResp.Duration,
+		InterestRate: rpcResp.InterestRate,
+		Quotes:       quotes,
+	}, nil
+}
@@ -48,19 +48,59 @@ func (l *GetLoanProductLogic) GetLoanProduct(idStr string) (resp *types.GetLoanP
 	// 转换响应数据
 	return &types.GetLoanProductResp{
 		Data: types.LoanProductInfo{
-			Id:           rpcResp.Data.Id,
-			ProductCode:  rpcResp.Data.ProductCode,
-			Name:         rpcResp.Data.Name,
-			Type:         rpcResp.Data.Type,
-			MaxAmount:    rpcResp.Data.MaxAmount,
-			MinAmount:    rpcResp.Data.MinAmount,
-			MaxDuration:  rpcResp.Data.MaxDuration,
-			MinDuration:  rpcResp.Data.MinDuration,
-			InterestRate: rpcResp.Data.InterestRate,
-			Description:  rpcResp.Data.Description,
-			Status:       rpcResp.Data.Status,
-			CreatedAt:    rpcResp.Data.CreatedAt,
-			UpdatedAt:    rpcResp.Data.UpdatedAt,
+			Id:                 rpcResp.Data.Id,
+			ProductCode:        rpcResp.Data.ProductCode,
+			Name:               rpcResp.Data.Name,
+			Type:               rpcResp.Data.Type,
+			MaxAmount:          rpcResp.Data.MaxAmount,
+			MinAmount:          rpcResp.Data.MinAmount,
+			MaxDuration:        rpcResp.Data.MaxDuration,
+			MinDuration:        rpcResp.Data.MinDuration,
+			InterestRate:       rpcResp.Data.InterestRate,
+			Description:        rpcResp.Data.Description,
+			Status:             rpcResp.Data.Status,
+			CreatedAt:          rpcResp.Data.CreatedAt,
+			UpdatedAt:          rpcResp.Data.UpdatedAt,
+			RepaymentProfile:   rpcResp.Data.RepaymentProfile,
+			GraceMonths:        rpcResp.Data.GraceMonths,
+			HarvestMonths:      rpcResp.Data.HarvestMonths,
+			PenaltyRate:        rpcResp.Data.PenaltyRate,
+			PrepaymentFeeRate:  rpcResp.Data.PrepaymentFeeRate,
+			OriginationFeeRate: rpcResp.Data.OriginationFeeRate,
+			ServiceFeeRate:     rpcResp.Data.ServiceFeeRate,
+			GuaranteeFeeRate:   rpcResp.Data.GuaranteeFeeRate,
+			LateFee:            rpcResp.Data.LateFee,
+			ApprovalChain:      rpcResp.Data.ApprovalChain,
+			AprMin:             rpcResp.Data.AprMin,
+			AprMax:             rpcResp.Data.AprMax,
+			Version:            rpcResp.Data.Version,
+			LaunchAt:           rpcResp.Data.LaunchAt,
+			DelistAt:           rpcResp.Data.DelistAt,
+			TotalBudget:        rpcResp.Data.TotalBudget,
+			PeriodQuota:        rpcResp.Data.PeriodQuota,
+			QuotaPeriod:        rpcResp.Data.QuotaPeriod,
+			BudgetUsed:         rpcResp.Data.BudgetUsed,
+			PeriodUsed:         rpcResp.Data.PeriodUsed,
+			RemainingQuota:     rpcResp.Data.RemainingQuota,
+			EligibilityRule:    rpcResp.Data.EligibilityRule,
+			RateTiers:          convertRateTiers(rpcResp.Data.RateTiers),
 		},
 	}, nil
 }
+
+// convertRateTiers 转换产品利率档位
+func convertRateTiers(tiers []*loanproduct.LoanRateTier) []types.LoanRateTier {
+	result := make([]types.LoanRateTier, 0, len(tiers))
+	for _, tier := range tiers {
+		result = append(result, types.LoanRateTier{
+			MinAmount:    tier.MinAmount,
+			MaxAmount:    tier.MaxAmount,
+			MinDuration:  tier.MinDuration,
+			MaxDuration:  tier.MaxDuration,
+			InterestRate: tier.InterestRate,
+			MinRate:      tier.MinRate,
+			MaxRate:      tier.MaxRate,
+		})
+	}
+	return result
+}
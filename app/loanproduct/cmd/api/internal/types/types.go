@@ -3,16 +3,54 @@
 
 package types
 
+type CalculateLoanQuoteReq struct {
+	Id       int64   `path:"id"`
+	Amount   float64 `form:"amount"`   // 借款金额
+	Duration int32   `form:"duration"` // 借款期限(月)
+}
+
+type CalculateLoanQuoteResp struct {
+	ProductId    int64       `json:"product_id"`
+	Amount       float64     `json:"amount"`
+	Duration     int32       `json:"duration"`
+	InterestRate float64     `json:"interest_rate"` // 年利率(%),配置利率档位时为适用档位的挂牌利率
+	Quotes       []LoanQuote `json:"quotes"`        // 各还款方式试算结果
+}
+
+type CheckEligibilityReq struct {
+	Id int64 `path:"id"`
+}
+
+type CheckEligibilityResp struct {
+	Eligible bool                `json:"eligible"` // 是否满足准入条件
+	Reasons  []EligibilityReason `json:"reasons"`  // 未满足的条件
+}
+
 type CreateLoanProductReq struct {
-	ProductCode  string  `json:"product_code"`
-	Name         string  `json:"name"`
-	Type         string  `json:"type"`
-	MaxAmount    float64 `json:"max_amount"`
-	MinAmount    float64 `json:"min_amount"`
-	MaxDuration  int32   `json:"max_duration"`
-	MinDuration  int32   `json:"min_duration"`
-	InterestRate float64 `json:"interest_rate"`
-	Description  string  `json:"description"`
+	ProductCode        string         `json:"product_code"`
+	Name               string         `json:"name"`
+	Type               string         `json:"type"`
+	MaxAmount          float64        `json:"max_amount"`
+	MinAmount          float64        `json:"min_amount"`
+	MaxDuration        int32          `json:"max_duration"`
+	MinDuration        int32          `json:"min_duration"`
+	InterestRate       float64        `json:"interest_rate"`
+	Description        string         `json:"description"`
+	RepaymentProfile   string         `json:"repayment_profile,optional"` // standard/seasonal,默认standard
+	GraceMonths        int32          `json:"grace_months,optional"`
+	HarvestMonths      string         `json:"harvest_months,optional"`
+	PenaltyRate        float64        `json:"penalty_rate,optional"`         // 罚息日利率(%)
+	PrepaymentFeeRate  float64        `json:"prepayment_fee_rate,optional"`  // 提前还款手续费率(%)
+	OriginationFeeRate float64        `json:"origination_fee_rate,optional"` // 手续费率(%),放款时按本金一次性收取
+	ServiceFeeRate     float64        `json:"service_fee_rate,optional"`     // 服务费月费率(%),按本金随每期还款收取
+	GuaranteeFeeRate   float64        `json:"guarantee_fee_rate,optional"`   // 担保费率(%),放款时按本金一次性收取
+	LateFee            float64        `json:"late_fee,optional"`             // 逾期滞纳金(元/期)
+	ApprovalChain      string         `json:"approval_chain,optional"`       // 审批链配置(JSON),为空表示单级审批
+	TotalBudget        float64        `json:"total_budget,optional"`         // 放贷总额度(元),0表示不限
+	PeriodQuota        float64        `json:"period_quota,optional"`         // 周期放贷额度(元),0表示不限
+	QuotaPeriod        string         `json:"quota_period,optional"`         // 额度周期 month/quarter/year,默认year
+	EligibilityRule    string         `json:"eligibility_rule,optional"`     // 准入规则配置(JSON),如{"occupations":["农","牧"],"min_age":18,"max_age":60,"min_income":3000},为空表示不限制
+	RateTiers          []LoanRateTier `json:"rate_tiers,optional"`           // 利率档位,为空表示统一使用产品利率
 }
 
 type CreateLoanProductResp struct {
@@ -26,6 +64,13 @@ type DeleteLoanProductReq struct {
 type DeleteLoanProductResp struct {
 }
 
+type EligibilityReason struct {
+	Code    string  `json:"code"`    // occupation:职业不符 age:年龄不符 income:收入不足 profile_gap:资料未填写
+	Message string  `json:"message"` // 原因说明
+	Limit   float64 `json:"limit"`   // 限额
+	Actual  float64 `json:"actual"`  // 实际值
+}
+
 type GetLoanProductReq struct {
 	Id string `path:"id"`
 }
@@ -38,6 +83,14 @@ type GetLoanProductDetailReq struct {
 	Id string `path:"id"`
 }
 
+type ListLoanProductVersionsReq struct {
+	Id int64 `path:"id"`
+}
+
+type ListLoanProductVersionsResp struct {
+	List []LoanProductVersionInfo `json:"list"` // 按版本号倒序
+}
+
 type ListLoanProductsReq struct {
 	Page    int32  `form:"page,default=1"`  // 修改为int32统一分页参数
 	Size    int32  `form:"size,default=10"` // 修改为int32统一分页参数
@@ -51,36 +104,129 @@ type ListLoanProductsResp struct {
 	Total int64             `json:"total"`
 }
 
+type LoanProductVersionInfo struct {
+	Id            int64                  `json:"id"`
+	ProductId     int64                  `json:"product_id"`
+	Version       int32                  `json:"version"`        // 版本号
+	Status        string                 `json:"status"`         // scheduled:待生效 active:生效中 superseded:已失效 cancelled:已取消
+	Changes       []ProductVersionChange `json:"changes"`        // 相对上一版本的变更
+	EffectiveFrom int64                  `json:"effective_from"` // 生效时间
+	EffectiveTo   int64                  `json:"effective_to"`   // 失效时间,生效中或待生效时为0
+	OperatorId    int64                  `json:"operator_id"`    // 操作人ID
+	OperatorName  string                 `json:"operator_name"`  // 操作人姓名
+	CreatedAt     int64                  `json:"created_at"`
+}
+
+type LoanQuote struct {
+	RepaymentMethod string             `json:"repayment_method"` // 还款方式
+	MonthlyPayment  float64            `json:"monthly_payment"`  // 首期应还金额
+	TotalInterest   float64            `json:"total_interest"`   // 利息合计
+	TotalAmount     float64            `json:"total_amount"`     // 本息合计
+	Apr             float64            `json:"apr"`              // 综合年化利率(IRR,%),含利息及各项费用
+	Installments    []QuoteInstallment `json:"installments"`     // 还款计划
+	OriginationFee  float64            `json:"origination_fee"`  // 手续费
+	GuaranteeFee    float64            `json:"guarantee_fee"`    // 担保费
+	ServiceFee      float64            `json:"service_fee"`      // 服务费合计
+	TotalFee        float64            `json:"total_fee"`        // 费用合计
+	ReceivedAmount  float64            `json:"received_amount"`  // 实际到手金额
+}
+
 type LoanProductInfo struct {
-	Id           int64   `json:"id"`
-	ProductCode  string  `json:"product_code"`
-	Name         string  `json:"name"`
-	Type         string  `json:"type"`
-	MaxAmount    float64 `json:"max_amount"`
-	MinAmount    float64 `json:"min_amount"`
-	MaxDuration  int32   `json:"max_duration"`
-	MinDuration  int32   `json:"min_duration"`
-	InterestRate float64 `json:"interest_rate"`
-	Description  string  `json:"description"`
-	Status       int32   `json:"status"`
-	CreatedAt    int64   `json:"created_at"`
-	UpdatedAt    int64   `json:"updated_at"`
+	Id                 int64          `json:"id"`
+	ProductCode        string         `json:"product_code"`
+	Name               string         `json:"name"`
+	Type               string         `json:"type"`
+	MaxAmount          float64        `json:"max_amount"`
+	MinAmount          float64        `json:"min_amount"`
+	MaxDuration        int32          `json:"max_duration"`
+	MinDuration        int32          `json:"min_duration"`
+	InterestRate       float64        `json:"interest_rate"`
+	Description        string         `json:"description"`
+	Status             int32          `json:"status"`
+	CreatedAt          int64          `json:"created_at"`
+	UpdatedAt          int64          `json:"updated_at"`
+	RepaymentProfile   string         `json:"repayment_profile"`    // 还款模式 standard:按月还款 seasonal:按收获季还款
+	GraceMonths        int32          `json:"grace_months"`         // 宽限期(月)
+	HarvestMonths      string         `json:"harvest_months"`       // 收获月份,逗号分隔 如 9,10
+	PenaltyRate        float64        `json:"penalty_rate"`         // 罚息日利率(%)
+	PrepaymentFeeRate  float64        `json:"prepayment_fee_rate"`  // 提前还款手续费率(%)
+	OriginationFeeRate float64        `json:"origination_fee_rate"` // 手续费率(%),放款时按本金一次性收取
+	ServiceFeeRate     float64        `json:"service_fee_rate"`     // 服务费月费率(%),按本金随每期还款收取
+	GuaranteeFeeRate   float64        `json:"guarantee_fee_rate"`   // 担保费率(%),放款时按本金一次性收取
+	LateFee            float64        `json:"late_fee"`             // 逾期滞纳金(元/期)
+	ApprovalChain      string         `json:"approval_chain"`       // 审批链配置(JSON),为空表示单级审批
+	AprMin             float64        `json:"apr_min"`              // 综合年化利率下限(IRR,%),含利息及各项费用
+	AprMax             float64        `json:"apr_max"`              // 综合年化利率上限(IRR,%),含利息及各项费用
+	Version            int32          `json:"version"`              // 当前生效的条款版本号,0表示历史数据尚未建立版本
+	LaunchAt           int64          `json:"launch_at"`            // 计划上架时间,0表示未排期
+	DelistAt           int64          `json:"delist_at"`            // 计划下架时间,0表示长期有效
+	TotalBudget        float64        `json:"total_budget"`         // 放贷总额度(元),0表示不限
+	PeriodQuota        float64        `json:"period_quota"`         // 周期放贷额度(元),0表示不限
+	QuotaPeriod        string         `json:"quota_period"`         // 额度周期 month:月 quarter:季 year:年
+	BudgetUsed         float64        `json:"budget_used"`          // 已占用总额度(元),仅产品详情返回
+	PeriodUsed         float64        `json:"period_used"`          // 本周期已占用额度(元),仅产品详情返回
+	RemainingQuota     float64        `json:"remaining_quota"`      // 当前可用额度(元),-1表示不限,仅产品详情返回
+	EligibilityRule    string         `json:"eligibility_rule"`     // 准入规则配置(JSON),为空表示不限制
+	RateTiers          []LoanRateTier `json:"rate_tiers"`           // 利率档位,为空表示统一使用产品利率
+}
+
+type LoanRateTier struct {
+	MinAmount    float64 `json:"min_amount"`        // 最小金额(元),含
+	MaxAmount    float64 `json:"max_amount"`        // 最大金额(元),不含
+	MinDuration  int32   `json:"min_duration"`      // 最小期限(月),含
+	MaxDuration  int32   `json:"max_duration"`      // 最大期限(月),含
+	InterestRate float64 `json:"interest_rate"`     // 挂牌年利率(%),报价使用
+	MinRate      float64 `json:"min_rate,optional"` // 审批可批准的最低年利率(%),默认与挂牌利率相同
+	MaxRate      float64 `json:"max_rate,optional"` // 审批可批准的最高年利率(%),默认与挂牌利率相同
+}
+
+type ProductVersionChange struct {
+	Field string `json:"field"` // 变更字段
+	From  string `json:"from"`  // 变更前取值(JSON)
+	To    string `json:"to"`    // 变更后取值(JSON)
+}
+
+type QuoteInstallment struct {
+	No                 int32   `json:"no"`                  // 期数
+	DueDate            string  `json:"due_date"`            // 应还日期
+	Principal          float64 `json:"principal"`           // 应还本金
+	Interest           float64 `json:"interest"`            // 应还利息
+	Total              float64 `json:"total"`               // 应还总额
+	RemainingPrincipal float64 `json:"remaining_principal"` // 剩余本金
+	ServiceFee         float64 `json:"service_fee"`         // 应还服务费(不计入应还总额)
 }
 
 type UpdateLoanProductReq struct {
-	Id           string  `path:"id"`
-	Name         string  `json:"name"`
-	Type         string  `json:"type"`
-	MaxAmount    float64 `json:"max_amount"`
-	MinAmount    float64 `json:"min_amount"`
-	MaxDuration  int32   `json:"max_duration"`
-	MinDuration  int32   `json:"min_duration"`
-	InterestRate float64 `json:"interest_rate"`
-	Description  string  `json:"description"`
+	Id                 string         `path:"id"`
+	Name               string         `json:"name"`
+	Type               string         `json:"type"`
+	MaxAmount          float64        `json:"max_amount"`
+	MinAmount          float64        `json:"min_amount"`
+	MaxDuration        int32          `json:"max_duration"`
+	MinDuration        int32          `json:"min_duration"`
+	InterestRate       float64        `json:"interest_rate"`
+	Description        string         `json:"description"`
+	RepaymentProfile   string         `json:"repayment_profile,optional"` // standard/seasonal,默认standard
+	GraceMonths        int32          `json:"grace_months,optional"`
+	HarvestMonths      string         `json:"harvest_months,optional"`
+	PenaltyRate        float64        `json:"penalty_rate,optional"`         // 罚息日利率(%)
+	PrepaymentFeeRate  float64        `json:"prepayment_fee_rate,optional"`  // 提前还款手续费率(%)
+	OriginationFeeRate float64        `json:"origination_fee_rate,optional"` // 手续费率(%),放款时按本金一次性收取
+	ServiceFeeRate     float64        `json:"service_fee_rate,optional"`     // 服务费月费率(%),按本金随每期还款收取
+	GuaranteeFeeRate   float64        `json:"guarantee_fee_rate,optional"`   // 担保费率(%),放款时按本金一次性收取
+	LateFee            float64        `json:"late_fee,optional"`             // 逾期滞纳金(元/期)
+	ApprovalChain      string         `json:"approval_chain,optional"`       // 审批链配置(JSON),为空表示单级审批
+	EffectiveFrom      int64          `json:"effective_from,optional"`       // 生效时间(Unix秒),为空或早于当前时间时立即生效,否则到期后自动生效
+	TotalBudget        float64        `json:"total_budget,optional"`         // 放贷总额度(元),0表示不限,修改立即生效
+	PeriodQuota        float64        `json:"period_quota,optional"`         // 周期放贷额度(元),0表示不限,修改立即生效
+	QuotaPeriod        string         `json:"quota_period,optional"`         // 额度周期 month/quarter/year,默认year
+	EligibilityRule    string         `json:"eligibility_rule,optional"`     // 准入规则配置(JSON),如{"occupations":["农","牧"],"min_age":18,"max_age":60,"min_income":3000},为空表示不限制
+	RateTiers          []LoanRateTier `json:"rate_tiers,optional"`           // 利率档位,整体替换且立即生效,为空表示统一使用产品利率
 }
 
 type UpdateLoanProductResp struct {
-	Data LoanProductInfo `json:"data"` // 添加数据字段
+	Data    LoanProductInfo         `json:"data"`              // 添加数据字段
+	Version *LoanProductVersionInfo `json:"version,omitempty"` // 本次修改产生的版本,条款无变化时为空
 }
 
 type UpdateProductStatusReq struct {
@@ -90,3 +236,13 @@ type UpdateProductStatusReq struct {
 
 type UpdateProductStatusResp struct {
 }
+
+type ScheduleLoanProductReq struct {
+	Id       int64 `path:"id"`
+	LaunchAt int64 `json:"launch_at,optional"` // 计划上架时间(Unix秒),0表示不设置
+	DelistAt int64 `json:"delist_at,optional"` // 计划下架时间(Unix秒),0表示不设置
+}
+
+type ScheduleLoanProductResp struct {
+	Data LoanProductInfo `json:"data"`
+}
@@ -0,0 +1,31 @@
+package logic
+
+import (
+	"context"
+
+	"loanrpc/internal/svc"
+	"loanrpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CountProductApplicationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCountProductApplicationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CountProductApplicationsLogic {
+	return &CountProductApplicationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 产品引用检查
+func (l *CountProductApplicationsLogic) CountProductApplications(in *loan.CountProductApplicationsReq) (*loan.CountProductApplicationsResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loan.CountProductApplicationsResp{}, nil
+}
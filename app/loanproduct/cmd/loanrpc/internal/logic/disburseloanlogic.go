@@ -0,0 +1,31 @@
+package logic
+
+import (
+	"context"
+
+	"loanrpc/internal/svc"
+	"loanrpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DisburseLoanLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDisburseLoanLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DisburseLoanLogic {
+	return &DisburseLoanLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 放款管理
+func (l *DisburseLoanLogic) DisburseLoan(in *loan.DisburseLoanReq) (*loan.DisburseLoanResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loan.DisburseLoanResp{}, nil
+}
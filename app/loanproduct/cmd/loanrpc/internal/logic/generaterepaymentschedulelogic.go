@@ -0,0 +1,31 @@
+package logic
+
+import (
+	"context"
+
+	"loanrpc/internal/svc"
+	"loanrpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GenerateRepaymentScheduleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGenerateRepaymentScheduleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GenerateRepaymentScheduleLogic {
+	return &GenerateRepaymentScheduleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 还款计划管理
+func (l *GenerateRepaymentScheduleLogic) GenerateRepaymentSchedule(in *loan.GenerateRepaymentScheduleReq) (*loan.GenerateRepaymentScheduleResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loan.GenerateRepaymentScheduleResp{}, nil
+}
@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"loanrpc/internal/svc"
+	"loanrpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetLoanApplicationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetLoanApplicationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetLoanApplicationLogic {
+	return &GetLoanApplicationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetLoanApplicationLogic) GetLoanApplication(in *loan.GetLoanApplicationReq) (*loan.GetLoanApplicationResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loan.GetLoanApplicationResp{}, nil
+}
@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"loanrpc/internal/svc"
+	"loanrpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRepaymentScheduleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetRepaymentScheduleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRepaymentScheduleLogic {
+	return &GetRepaymentScheduleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetRepaymentScheduleLogic) GetRepaymentSchedule(in *loan.GetRepaymentScheduleReq) (*loan.GetRepaymentScheduleResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loan.GetRepaymentScheduleResp{}, nil
+}
@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"loanrpc/internal/svc"
+	"loanrpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListLoanApplicationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListLoanApplicationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListLoanApplicationsLogic {
+	return &ListLoanApplicationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListLoanApplicationsLogic) ListLoanApplications(in *loan.ListLoanApplicationsReq) (*loan.ListLoanApplicationsResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loan.ListLoanApplicationsResp{}, nil
+}
@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"loanrpc/internal/svc"
+	"loanrpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListRepaymentsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListRepaymentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListRepaymentsLogic {
+	return &ListRepaymentsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListRepaymentsLogic) ListRepayments(in *loan.ListRepaymentsReq) (*loan.ListRepaymentsResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loan.ListRepaymentsResp{}, nil
+}
@@ -0,0 +1,31 @@
+package logic
+
+import (
+	"context"
+
+	"loanrpc/internal/svc"
+	"loanrpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type QuoteEarlyRepaymentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewQuoteEarlyRepaymentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *QuoteEarlyRepaymentLogic {
+	return &QuoteEarlyRepaymentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 提前还款
+func (l *QuoteEarlyRepaymentLogic) QuoteEarlyRepayment(in *loan.QuoteEarlyRepaymentReq) (*loan.QuoteEarlyRepaymentResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loan.QuoteEarlyRepaymentResp{}, nil
+}
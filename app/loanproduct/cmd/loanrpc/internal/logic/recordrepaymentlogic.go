@@ -0,0 +1,31 @@
+package logic
+
+import (
+	"context"
+
+	"loanrpc/internal/svc"
+	"loanrpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RecordRepaymentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRecordRepaymentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RecordRepaymentLogic {
+	return &RecordRepaymentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 还款管理
+func (l *RecordRepaymentLogic) RecordRepayment(in *loan.RecordRepaymentReq) (*loan.RecordRepaymentResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loan.RecordRepaymentResp{}, nil
+}
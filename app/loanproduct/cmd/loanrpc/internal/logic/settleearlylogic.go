@@ -0,0 +1,30 @@
+package logic
+
+import (
+	"context"
+
+	"loanrpc/internal/svc"
+	"loanrpc/loan"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SettleEarlyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSettleEarlyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SettleEarlyLogic {
+	return &SettleEarlyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *SettleEarlyLogic) SettleEarly(in *loan.SettleEarlyReq) (*loan.SettleEarlyResp, error) {
+	// todo: add your logic here and delete this line
+
+	return &loan.SettleEarlyResp{}, nil
+}
@@ -0,0 +1,127 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ LoanProductQuotaReservationsModel = (*customLoanProductQuotaReservationsModel)(nil)
+
+type (
+	// LoanProductQuotaReservationsModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customLoanProductQuotaReservationsModel.
+	LoanProductQuotaReservationsModel interface {
+		loanProductQuotaReservationsModel
+		// 自定义方法
+		SumReserved(ctx context.Context, productId uint64, since time.Time) (float64, error)
+		ReleaseIfReserved(ctx context.Context, data *LoanProductQuotaReservations, releasedAt time.Time) (bool, error)
+		// 事务方法: 在产品模型的 TransactCtx 中调用 *Session 方法,提交后调用 DelReservationCache 清理缓存
+		FindOneByApplicationIdWithSession(ctx context.Context, session sqlx.Session, applicationId string) (*LoanProductQuotaReservations, error)
+		SumReservedWithSession(ctx context.Context, session sqlx.Session, productId uint64, since time.Time) (float64, error)
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *LoanProductQuotaReservations) (sql.Result, error)
+		DelReservationCache(ctx context.Context, data *LoanProductQuotaReservations) error
+	}
+
+	customLoanProductQuotaReservationsModel struct {
+		*defaultLoanProductQuotaReservationsModel
+	}
+)
+
+// NewLoanProductQuotaReservationsModel returns a model for the database table.
+func NewLoanProductQuotaReservationsModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) LoanProductQuotaReservationsModel {
+	return &customLoanProductQuotaReservationsModel{
+		defaultLoanProductQuotaReservationsModel: newLoanProductQuotaReservationsModel(conn, c, opts...),
+	}
+}
+
+// sumReservedQuery 统计产品占用中的额度,since 为零值时统计全部占用
+func (m *customLoanProductQuotaReservationsModel) sumReservedQuery(productId uint64, since time.Time) (string, []any) {
+	query := fmt.Sprintf("select coalesce(sum(`amount`), 0) from %s where `product_id` = ? and `status` = 'reserved'", m.table)
+	args := []any{productId}
+	if !since.IsZero() {
+		query += " and `created_at` >= ?"
+		args = append(args, since)
+	}
+	return query, args
+}
+
+// SumReserved 统计产品自 since 起占用中的额度
+func (m *customLoanProductQuotaReservationsModel) SumReserved(ctx context.Context, productId uint64, since time.Time) (float64, error) {
+	query, args := m.sumReservedQuery(productId, since)
+
+	var total float64
+	err := m.QueryRowNoCacheCtx(ctx, &total, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// SumReservedWithSession 在事务中统计产品自 since 起占用中的额度
+func (m *customLoanProductQuotaReservationsModel) SumReservedWithSession(ctx context.Context, session sqlx.Session, productId uint64, since time.Time) (float64, error) {
+	query, args := m.sumReservedQuery(productId, since)
+
+	var total float64
+	err := session.QueryRowCtx(ctx, &total, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// FindOneByApplicationIdWithSession 在事务中按申请编号查询额度占用记录,不经过缓存
+func (m *customLoanProductQuotaReservationsModel) FindOneByApplicationIdWithSession(ctx context.Context, session sqlx.Session, applicationId string) (*LoanProductQuotaReservations, error) {
+	query := fmt.Sprintf("select %s from %s where `application_id` = ? limit 1", loanProductQuotaReservationsRows, m.table)
+
+	var reservation LoanProductQuotaReservations
+	err := session.QueryRowCtx(ctx, &reservation, query, applicationId)
+	switch err {
+	case nil:
+		return &reservation, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// InsertWithSession 在事务中写入额度占用记录
+func (m *customLoanProductQuotaReservationsModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *LoanProductQuotaReservations) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?)", m.table, loanProductQuotaReservationsRowsExpectAutoSet)
+	return session.ExecCtx(ctx, query, data.ProductId, data.ApplicationId, data.Amount, data.Status, data.ReleasedAt)
+}
+
+// ReleaseIfReserved 仅当记录仍为占用状态时释放,已被释放时返回 false
+func (m *customLoanProductQuotaReservationsModel) ReleaseIfReserved(ctx context.Context, data *LoanProductQuotaReservations, releasedAt time.Time) (bool, error) {
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `status` = 'released', `released_at` = ? where `id` = ? and `status` = 'reserved'", m.table)
+		return conn.ExecCtx(ctx, query, releasedAt, data.Id)
+	}, m.cacheKeys(data)...)
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// DelReservationCache 清理额度占用记录缓存
+func (m *customLoanProductQuotaReservationsModel) DelReservationCache(ctx context.Context, data *LoanProductQuotaReservations) error {
+	return m.DelCacheCtx(ctx, m.cacheKeys(data)...)
+}
+
+func (m *customLoanProductQuotaReservationsModel) cacheKeys(data *LoanProductQuotaReservations) []string {
+	return []string{
+		fmt.Sprintf("%s%v", cacheLoanProductQuotaReservationsIdPrefix, data.Id),
+		fmt.Sprintf("%s%v", cacheLoanProductQuotaReservationsApplicationIdPrefix, data.ApplicationId),
+	}
+}
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/cache"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ LoanProductRateTiersModel = (*customLoanProductRateTiersModel)(nil)
+
+type (
+	// LoanProductRateTiersModel is an interface to be customized, add more methods here,
+	// and implement the added methods in customLoanProductRateTiersModel.
+	LoanProductRateTiersModel interface {
+		loanProductRateTiersModel
+		// 自定义方法
+		FindByProductId(ctx context.Context, productId uint64) ([]*LoanProductRateTiers, error)
+		ReplaceByProductId(ctx context.Context, productId uint64, tiers []*LoanProductRateTiers) error
+		// 事务方法: 在产品模型的 TransactCtx 中调用,档位按产品整体读取不经过缓存
+		InsertBatchWithSession(ctx context.Context, session sqlx.Session, tiers []*LoanProductRateTiers) error
+	}
+
+	customLoanProductRateTiersModel struct {
+		*defaultLoanProductRateTiersModel
+	}
+)
+
+// NewLoanProductRateTiersModel returns a model for the database table.
+func NewLoanProductRateTiersModel(conn sqlx.SqlConn, c cache.CacheConf, opts ...cache.Option) LoanProductRateTiersModel {
+	return &customLoanProductRateTiersModel{
+		defaultLoanProductRateTiersModel: newLoanProductRateTiersModel(conn, c, opts...),
+	}
+}
+
+// FindByProductId 查询产品的利率档位,按最小金额、最小期限升序
+func (m *customLoanProductRateTiersModel) FindByProductId(ctx context.Context, productId uint64) ([]*LoanProductRateTiers, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `product_id` = ? ORDER BY `min_amount` ASC, `min_duration` ASC", loanProductRateTiersRows, m.table)
+
+	var tiers []*LoanProductRateTiers
+	err := m.QueryRowsNoCacheCtx(ctx, &tiers, query, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	return tiers, nil
+}
+
+// ReplaceByProductId 在同一事务中删除产品原有档位并写入新档位
+func (m *customLoanProductRateTiersModel) ReplaceByProductId(ctx context.Context, productId uint64, tiers []*LoanProductRateTiers) error {
+	existing, err := m.FindByProductId(ctx, productId)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(existing))
+	for _, tier := range existing {
+		keys = append(keys, fmt.Sprintf("%s%v", cacheLoanProductRateTiersIdPrefix, tier.Id))
+	}
+
+	err = m.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		deleteQuery := fmt.Sprintf("DELETE FROM %s WHERE `product_id` = ?", m.table)
+		if _, err := session.ExecCtx(ctx, deleteQuery, productId); err != nil {
+			return err
+		}
+		return m.InsertBatchWithSession(ctx, session, tiers)
+	})
+	if err != nil {
+		return err
+	}
+
+	// 事务提交后清理缓存
+	if len(keys) > 0 {
+		return m.DelCacheCtx(ctx, keys...)
+	}
+	return nil
+}
+
+// InsertBatchWithSession 在事务中写入利率档位
+func (m *customLoanProductRateTiersModel) InsertBatchWithSession(ctx context.Context, session sqlx.Session, tiers []*LoanProductRateTiers) error {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?)", m.table, loanProductRateTiersRowsExpectAutoSet)
+	for _, tier := range tiers {
+		if _, err := session.ExecCtx(ctx, query, tier.ProductId, tier.MinAmount, tier.MaxAmount, tier.MinDuration,
+			tier.MaxDuration, tier.InterestRate, tier.MinRate, tier.MaxRate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
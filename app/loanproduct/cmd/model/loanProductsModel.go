@@ -2,7 +2,9 @@ package model
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/cache"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
@@ -18,6 +20,20 @@ type (
 		// 自定义方法
 		CountWithConditions(ctx context.Context, whereClause string, args []interface{}) (int64, error)
 		ListWithConditions(ctx context.Context, whereClause string, args []interface{}, limit, offset int32) ([]*LoanProducts, error)
+		// 事务方法: 在 TransactCtx 中调用 *Session 方法,提交后调用 DelProductCache 清理缓存
+		TransactCtx(ctx context.Context, fn func(context.Context, sqlx.Session) error) error
+		InsertWithSession(ctx context.Context, session sqlx.Session, data *LoanProducts) (sql.Result, error)
+		UpdateWithSession(ctx context.Context, session sqlx.Session, data *LoanProducts) error
+		FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, id uint64) (*LoanProducts, error)
+		DelProductCache(ctx context.Context, data *LoanProducts) error
+		// 软删除: 已删除的产品按不存在处理
+		SoftDeleteWithSession(ctx context.Context, session sqlx.Session, data *LoanProducts, deletedAt time.Time) error
+		// 放贷额度: 仅更新额度相关字段,不覆盖并发修改的条款与上下架状态
+		UpdateQuota(ctx context.Context, data *LoanProducts) error
+		// 排期上下架
+		FindScheduleDue(ctx context.Context, now time.Time) ([]*LoanProducts, error)
+		UpdateStatusIfMatch(ctx context.Context, data *LoanProducts, from, to uint64) (bool, error)
+		UpdateScheduleIfMatch(ctx context.Context, data, from *LoanProducts) (bool, error)
 	}
 
 	customLoanProductsModel struct {
@@ -53,3 +69,126 @@ func (m *customLoanProductsModel) ListWithConditions(ctx context.Context, whereC
 
 	return products, nil
 }
+
+// InsertWithSession 在事务中写入产品
+func (m *customLoanProductsModel) InsertWithSession(ctx context.Context, session sqlx.Session, data *LoanProducts) (sql.Result, error) {
+	query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, loanProductsRowsExpectAutoSet)
+	return session.ExecCtx(ctx, query, data.ProductCode, data.Name, data.Type, data.MaxAmount, data.MinAmount, data.MaxDuration, data.MinDuration, data.InterestRate, data.Description, data.RepaymentProfile, data.GraceMonths, data.HarvestMonths, data.PenaltyRate, data.PrepaymentFeeRate, data.OriginationFeeRate, data.ServiceFeeRate, data.GuaranteeFeeRate, data.LateFee, data.ApprovalChain, data.EligibilityRule, data.Status, data.Version, data.LaunchAt, data.DelistAt, data.TotalBudget, data.PeriodQuota, data.QuotaPeriod, data.DeletedAt)
+}
+
+// UpdateWithSession 在事务中更新产品,事务提交后需调用 DelProductCache
+func (m *customLoanProductsModel) UpdateWithSession(ctx context.Context, session sqlx.Session, data *LoanProducts) error {
+	query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, loanProductsRowsWithPlaceHolder)
+	_, err := session.ExecCtx(ctx, query, data.ProductCode, data.Name, data.Type, data.MaxAmount, data.MinAmount, data.MaxDuration, data.MinDuration, data.InterestRate, data.Description, data.RepaymentProfile, data.GraceMonths, data.HarvestMonths, data.PenaltyRate, data.PrepaymentFeeRate, data.OriginationFeeRate, data.ServiceFeeRate, data.GuaranteeFeeRate, data.LateFee, data.ApprovalChain, data.EligibilityRule, data.Status, data.Version, data.LaunchAt, data.DelistAt, data.TotalBudget, data.PeriodQuota, data.QuotaPeriod, data.DeletedAt, data.Id)
+	return err
+}
+
+// FindOne 按ID查询产品,已删除的产品返回 ErrNotFound
+func (m *customLoanProductsModel) FindOne(ctx context.Context, id uint64) (*LoanProducts, error) {
+	product, err := m.defaultLoanProductsModel.FindOne(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if product.DeletedAt.Valid {
+		return nil, ErrNotFound
+	}
+	return product, nil
+}
+
+// FindOneByProductCode 按产品编码查询产品,已删除的产品返回 ErrNotFound
+func (m *customLoanProductsModel) FindOneByProductCode(ctx context.Context, productCode string) (*LoanProducts, error) {
+	product, err := m.defaultLoanProductsModel.FindOneByProductCode(ctx, productCode)
+	if err != nil {
+		return nil, err
+	}
+	if product.DeletedAt.Valid {
+		return nil, ErrNotFound
+	}
+	return product, nil
+}
+
+// SoftDeleteWithSession 在事务中软删除产品: 记录删除时间并下架,同时清除排期,产品编码保留不可复用
+func (m *customLoanProductsModel) SoftDeleteWithSession(ctx context.Context, session sqlx.Session, data *LoanProducts, deletedAt time.Time) error {
+	query := fmt.Sprintf("update %s set `deleted_at` = ?, `status` = 2, `launch_at` = NULL where `id` = ? and `deleted_at` is null", m.table)
+	_, err := session.ExecCtx(ctx, query, deletedAt, data.Id)
+	return err
+}
+
+// UpdateQuota 仅更新产品放贷额度配置
+func (m *customLoanProductsModel) UpdateQuota(ctx context.Context, data *LoanProducts) error {
+	_, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `total_budget` = ?, `period_quota` = ?, `quota_period` = ? where `id` = ? and `deleted_at` is null", m.table)
+		return conn.ExecCtx(ctx, query, data.TotalBudget, data.PeriodQuota, data.QuotaPeriod, data.Id)
+	}, fmt.Sprintf("%s%v", cacheLoanProductsIdPrefix, data.Id), fmt.Sprintf("%s%v", cacheLoanProductsProductCodePrefix, data.ProductCode))
+	return err
+}
+
+// FindOneForUpdateWithSession 在事务中查询并锁定产品,用于串行化同一产品的额度占用
+func (m *customLoanProductsModel) FindOneForUpdateWithSession(ctx context.Context, session sqlx.Session, id uint64) (*LoanProducts, error) {
+	query := fmt.Sprintf("select %s from %s where `id` = ? and `deleted_at` is null limit 1 for update", loanProductsRows, m.table)
+
+	var product LoanProducts
+	err := session.QueryRowCtx(ctx, &product, query, id)
+	switch err {
+	case nil:
+		return &product, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// DelProductCache 清理产品缓存
+func (m *customLoanProductsModel) DelProductCache(ctx context.Context, data *LoanProducts) error {
+	return m.DelCacheCtx(ctx,
+		fmt.Sprintf("%s%v", cacheLoanProductsIdPrefix, data.Id),
+		fmt.Sprintf("%s%v", cacheLoanProductsProductCodePrefix, data.ProductCode),
+	)
+}
+
+// FindScheduleDue 查询排期已到需要切换状态的产品: 已到上架时间仍未上架,或已到下架时间仍在上架
+func (m *customLoanProductsModel) FindScheduleDue(ctx context.Context, now time.Time) ([]*LoanProducts, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE `deleted_at` IS NULL AND ((`status` = 2 AND `launch_at` <= ? AND (`delist_at` IS NULL OR `delist_at` > ?)) OR (`status` = 1 AND `delist_at` <= ?))", loanProductsRows, m.table)
+
+	var products []*LoanProducts
+	err := m.QueryRowsNoCacheCtx(ctx, &products, query, now, now, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// UpdateStatusIfMatch 仅当产品仍处于 from 状态时切换为 to,已被其他实例或人工处理时返回 false
+func (m *customLoanProductsModel) UpdateStatusIfMatch(ctx context.Context, data *LoanProducts, from, to uint64) (bool, error) {
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `status` = ? where `id` = ? and `status` = ?", m.table)
+		return conn.ExecCtx(ctx, query, to, data.Id, from)
+	}, fmt.Sprintf("%s%v", cacheLoanProductsIdPrefix, data.Id), fmt.Sprintf("%s%v", cacheLoanProductsProductCodePrefix, data.ProductCode))
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// UpdateScheduleIfMatch 仅更新上下架状态与排期,不覆盖并发修改的条款等字段
+// 产品状态与计划下架时间仍与读取时(from)一致且未删除时才更新,否则返回 false
+func (m *customLoanProductsModel) UpdateScheduleIfMatch(ctx context.Context, data, from *LoanProducts) (bool, error) {
+	result, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (sql.Result, error) {
+		query := fmt.Sprintf("update %s set `status` = ?, `launch_at` = ?, `delist_at` = ? where `id` = ? and `status` = ? and `delist_at` <=> ? and `deleted_at` is null", m.table)
+		return conn.ExecCtx(ctx, query, data.Status, data.LaunchAt, data.DelistAt, data.Id, from.Status, from.DelistAt)
+	}, fmt.Sprintf("%s%v", cacheLoanProductsIdPrefix, data.Id), fmt.Sprintf("%s%v", cacheLoanProductsProductCodePrefix, data.ProductCode))
+	if err != nil {
+		return false, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
@@ -43,19 +43,37 @@ type (
 	}
 
 	LoanProducts struct {
-		Id           uint64    `db:"id"`            // 产品ID
-		ProductCode  string    `db:"product_code"`  // 产品编码
-		Name         string    `db:"name"`          // 产品名称
-		Type         string    `db:"type"`          // 产品类型
-		MaxAmount    float64   `db:"max_amount"`    // 最大金额
-		MinAmount    float64   `db:"min_amount"`    // 最小金额
-		MaxDuration  uint64    `db:"max_duration"`  // 最大期限(月)
-		MinDuration  uint64    `db:"min_duration"`  // 最小期限(月)
-		InterestRate float64   `db:"interest_rate"` // 年利率(%)
-		Description  string    `db:"description"`   // 产品描述
-		Status       uint64    `db:"status"`        // 状态 1:上架 2:下架
-		CreatedAt    time.Time `db:"created_at"`    // 创建时间
-		UpdatedAt    time.Time `db:"updated_at"`    // 更新时间
+		Id                 uint64       `db:"id"`                   // 产品ID
+		ProductCode        string       `db:"product_code"`         // 产品编码
+		Name               string       `db:"name"`                 // 产品名称
+		Type               string       `db:"type"`                 // 产品类型
+		MaxAmount          float64      `db:"max_amount"`           // 最大金额
+		MinAmount          float64      `db:"min_amount"`           // 最小金额
+		MaxDuration        uint64       `db:"max_duration"`         // 最大期限(月)
+		MinDuration        uint64       `db:"min_duration"`         // 最小期限(月)
+		InterestRate       float64      `db:"interest_rate"`        // 年利率(%)
+		Description        string       `db:"description"`          // 产品描述
+		RepaymentProfile   string       `db:"repayment_profile"`    // 还款模式 standard:按月还款 seasonal:按收获季还款
+		GraceMonths        uint64       `db:"grace_months"`         // 宽限期(月),宽限期内不还款,利息累计至首个还款日
+		HarvestMonths      string       `db:"harvest_months"`       // 收获月份,逗号分隔 如 9,10
+		PenaltyRate        float64      `db:"penalty_rate"`         // 罚息日利率(%),逾期未还本息按日计收
+		PrepaymentFeeRate  float64      `db:"prepayment_fee_rate"`  // 提前还款手续费率(%),按提前归还的未到期本金计收
+		OriginationFeeRate float64      `db:"origination_fee_rate"` // 手续费率(%),放款时按本金一次性收取
+		ServiceFeeRate     float64      `db:"service_fee_rate"`     // 服务费月费率(%),按本金随每期还款收取
+		GuaranteeFeeRate   float64      `db:"guarantee_fee_rate"`   // 担保费率(%),放款时按本金一次性收取
+		LateFee            float64      `db:"late_fee"`             // 逾期滞纳金(元/期),每期逾期时一次性收取
+		ApprovalChain      string       `db:"approval_chain"`       // 审批链配置(JSON),如[{"name":"初审","role":"operator"},{"name":"终审","role":"admin"}],为空表示单级审批
+		EligibilityRule    string       `db:"eligibility_rule"`     // 准入规则配置(JSON),如{"occupations":["农","牧"],"min_age":18,"max_age":60,"min_income":3000},为空表示不限制
+		Status             uint64       `db:"status"`               // 状态 1:上架 2:下架
+		Version            uint64       `db:"version"`              // 当前生效的条款版本号
+		LaunchAt           sql.NullTime `db:"launch_at"`            // 计划上架时间,到期后自动上架,为空表示不排期
+		DelistAt           sql.NullTime `db:"delist_at"`            // 计划下架时间,到期后自动下架且不再出现在产品列表,为空表示长期有效
+		TotalBudget        float64      `db:"total_budget"`         // 放贷总额度(元),0表示不限
+		PeriodQuota        float64      `db:"period_quota"`         // 周期放贷额度(元),0表示不限
+		QuotaPeriod        string       `db:"quota_period"`         // 额度周期 month:月 quarter:季 year:年
+		DeletedAt          sql.NullTime `db:"deleted_at"`           // 删除时间,不为空表示产品已归档,不再出现在查询中
+		CreatedAt          time.Time    `db:"created_at"`           // 创建时间
+		UpdatedAt          time.Time    `db:"updated_at"`           // 更新时间
 	}
 )
 
@@ -122,8 +140,8 @@ func (m *defaultLoanProductsModel) Insert(ctx context.Context, data *LoanProduct
 	loanProductsIdKey := fmt.Sprintf("%s%v", cacheLoanProductsIdPrefix, data.Id)
 	loanProductsProductCodeKey := fmt.Sprintf("%s%v", cacheLoanProductsProductCodePrefix, data.ProductCode)
 	ret, err := m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
-		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, loanProductsRowsExpectAutoSet)
-		return conn.ExecCtx(ctx, query, data.ProductCode, data.Name, data.Type, data.MaxAmount, data.MinAmount, data.MaxDuration, data.MinDuration, data.InterestRate, data.Description, data.Status)
+		query := fmt.Sprintf("insert into %s (%s) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", m.table, loanProductsRowsExpectAutoSet)
+		return conn.ExecCtx(ctx, query, data.ProductCode, data.Name, data.Type, data.MaxAmount, data.MinAmount, data.MaxDuration, data.MinDuration, data.InterestRate, data.Description, data.RepaymentProfile, data.GraceMonths, data.HarvestMonths, data.PenaltyRate, data.PrepaymentFeeRate, data.OriginationFeeRate, data.ServiceFeeRate, data.GuaranteeFeeRate, data.LateFee, data.ApprovalChain, data.EligibilityRule, data.Status, data.Version, data.LaunchAt, data.DelistAt, data.TotalBudget, data.PeriodQuota, data.QuotaPeriod, data.DeletedAt)
 	}, loanProductsIdKey, loanProductsProductCodeKey)
 	return ret, err
 }
@@ -138,7 +156,7 @@ func (m *defaultLoanProductsModel) Update(ctx context.Context, newData *LoanProd
 	loanProductsProductCodeKey := fmt.Sprintf("%s%v", cacheLoanProductsProductCodePrefix, data.ProductCode)
 	_, err = m.ExecCtx(ctx, func(ctx context.Context, conn sqlx.SqlConn) (result sql.Result, err error) {
 		query := fmt.Sprintf("update %s set %s where `id` = ?", m.table, loanProductsRowsWithPlaceHolder)
-		return conn.ExecCtx(ctx, query, newData.ProductCode, newData.Name, newData.Type, newData.MaxAmount, newData.MinAmount, newData.MaxDuration, newData.MinDuration, newData.InterestRate, newData.Description, newData.Status, newData.Id)
+		return conn.ExecCtx(ctx, query, newData.ProductCode, newData.Name, newData.Type, newData.MaxAmount, newData.MinAmount, newData.MaxDuration, newData.MinDuration, newData.InterestRate, newData.Description, newData.RepaymentProfile, newData.GraceMonths, newData.HarvestMonths, newData.PenaltyRate, newData.PrepaymentFeeRate, newData.OriginationFeeRate, newData.ServiceFeeRate, newData.GuaranteeFeeRate, newData.LateFee, newData.ApprovalChain, newData.EligibilityRule, newData.Status, newData.Version, newData.LaunchAt, newData.DelistAt, newData.TotalBudget, newData.PeriodQuota, newData.QuotaPeriod, newData.DeletedAt, newData.Id)
 	}, loanProductsIdKey, loanProductsProductCodeKey)
 	return err
 }
@@ -0,0 +1,88 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"common/productschedule"
+	"rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ScheduleJob 产品排期上下架任务
+// 定时扫描已到计划上架或计划下架时间的产品并切换状态,多实例部署时按原状态条件更新,同一产品只会切换一次
+type ScheduleJob struct {
+	svcCtx *svc.ServiceContext
+	done   chan struct{}
+}
+
+func NewScheduleJob(svcCtx *svc.ServiceContext) *ScheduleJob {
+	return &ScheduleJob{
+		svcCtx: svcCtx,
+		done:   make(chan struct{}),
+	}
+}
+
+// Start 启动任务: 启动时执行一次,之后按配置的间隔执行
+func (j *ScheduleJob) Start() {
+	if !j.svcCtx.Config.ScheduleJob.Enabled {
+		logx.Info("产品排期上下架任务未启用")
+		return
+	}
+
+	j.Run(context.Background())
+	ticker := time.NewTicker(time.Duration(j.svcCtx.Config.ScheduleJob.Interval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			j.Run(context.Background())
+		case <-j.done:
+			return
+		}
+	}
+}
+
+// Stop 停止任务
+func (j *ScheduleJob) Stop() {
+	close(j.done)
+}
+
+// Run 执行一次排期扫描
+func (j *ScheduleJob) Run(ctx context.Context) {
+	logger := logx.WithContext(ctx)
+	now := time.Now()
+
+	products, err := j.svcCtx.LoanProductModel.FindScheduleDue(ctx, now)
+	if err != nil {
+		logger.Errorf("查询排期到期产品失败: %v", err)
+		return
+	}
+
+	launched, delisted := 0, 0
+	for _, product := range products {
+		status := productschedule.Status(product.Status, product.LaunchAt, product.DelistAt, now)
+		if status == product.Status {
+			continue
+		}
+
+		ok, err := j.svcCtx.LoanProductModel.UpdateStatusIfMatch(ctx, product, product.Status, status)
+		if err != nil {
+			logger.Errorf("切换产品状态失败, 产品ID: %d, 错误: %v", product.Id, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if status == productschedule.StatusOnSale {
+			launched++
+		} else {
+			delisted++
+		}
+	}
+
+	if launched > 0 || delisted > 0 {
+		logger.Infof("产品排期上下架任务完成, 上架: %d, 下架: %d", launched, delisted)
+	}
+}
@@ -0,0 +1,20 @@
+package logic
+
+import (
+	"strings"
+
+	"common/approval"
+)
+
+// normalizeApprovalChain 校验产品审批链配置,返回规范化后的配置,为空表示单级审批
+func normalizeApprovalChain(config string) (string, error) {
+	if strings.TrimSpace(config) == "" {
+		return "", nil
+	}
+
+	chain, err := approval.Parse(config)
+	if err != nil {
+		return "", err
+	}
+	return chain.String(), nil
+}
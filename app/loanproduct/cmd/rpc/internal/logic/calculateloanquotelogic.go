@@ -0,0 +1,133 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"common/ratetier"
+	"common/repayment"
+	"rpc/internal/svc"
+	"rpc/loanproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CalculateLoanQuoteLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCalculateLoanQuoteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CalculateLoanQuoteLogic {
+	return &CalculateLoanQuoteLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// quoteMethods 按月还款产品支持试算的还款方式
+var quoteMethods = []string{
+	repayment.MethodEqualInstallment,
+	repayment.MethodEqualPrincipal,
+	repayment.MethodInterestOnly,
+}
+
+// CalculateLoanQuote 按产品利率和还款模式试算各还款方式的还款计划,起息日按当天计算
+func (l *CalculateLoanQuoteLogic) CalculateLoanQuote(in *loanproduct.CalculateLoanQuoteReq) (*loanproduct.CalculateLoanQuoteResp, error) {
+	if in.Id <= 0 {
+		return nil, fmt.Errorf("参数错误，产品ID不能为空")
+	}
+	if in.Amount <= 0 {
+		return nil, fmt.Errorf("参数错误，借款金额必须大于0")
+	}
+	if in.Duration <= 0 {
+		return nil, fmt.Errorf("参数错误，借款期限必须大于0")
+	}
+
+	product, err := l.svcCtx.LoanProductModel.FindOne(l.ctx, uint64(in.Id))
+	if err != nil {
+		l.Errorf("查询产品失败: %v", err)
+		return nil, fmt.Errorf("产品不存在")
+	}
+	if product.Status != 1 {
+		return nil, fmt.Errorf("产品状态错误，产品已下架")
+	}
+	if in.Amount < product.MinAmount || in.Amount > product.MaxAmount {
+		return nil, fmt.Errorf("参数错误，借款金额应在%.2f到%.2f之间", product.MinAmount, product.MaxAmount)
+	}
+	if uint64(in.Duration) < product.MinDuration || uint64(in.Duration) > product.MaxDuration {
+		return nil, fmt.Errorf("参数错误，借款期限应在%d到%d个月之间", product.MinDuration, product.MaxDuration)
+	}
+
+	// 配置利率档位时按金额、期限适用档位的挂牌利率试算
+	tiers, err := loadRateTiers(l.ctx, l.svcCtx, product.Id)
+	if err != nil {
+		l.Errorf("查询产品利率档位失败: %v", err)
+		return nil, fmt.Errorf("试算失败")
+	}
+	interestRate, err := ratetier.Rate(tiers, product.InterestRate, in.Amount, int(in.Duration))
+	if err != nil {
+		return nil, err
+	}
+
+	profile := productRepaymentProfile(product)
+	fees := productFees(product)
+
+	// 季节性还款产品的还款日由收获月份决定,只有一种还款计划
+	methods := quoteMethods
+	if profile.Seasonal {
+		methods = []string{repayment.MethodSeasonal}
+	}
+
+	start := time.Now()
+	quotes := make([]*loanproduct.LoanQuote, 0, len(methods))
+	for _, method := range methods {
+		actual, installments, err := repayment.Build(method, in.Amount, interestRate, int(in.Duration), start, profile)
+		if err != nil {
+			l.Errorf("试算还款计划失败: %v", err)
+			return nil, fmt.Errorf("参数错误，%v", err)
+		}
+		quotes = append(quotes, convertLoanQuote(actual, in.Amount, start, installments, fees))
+	}
+
+	return &loanproduct.CalculateLoanQuoteResp{
+		ProductId:    int64(product.Id),
+		Amount:       in.Amount,
+		Duration:     in.Duration,
+		InterestRate: interestRate,
+		Quotes:       quotes,
+	}, nil
+}
+
+// convertLoanQuote 汇总还款计划及费用并转换为响应格式
+func convertLoanQuote(method string, amount float64, start time.Time, installments []repayment.Installment, fees repayment.Fees) *loanproduct.LoanQuote {
+	summary := repayment.Summarize(installments)
+	disclosure := repayment.Disclose(amount, start, installments, fees)
+	quote := &loanproduct.LoanQuote{
+		RepaymentMethod: method,
+		MonthlyPayment:  summary.MonthlyPayment,
+		TotalInterest:   summary.TotalInterest,
+		TotalAmount:     summary.TotalAmount,
+		Apr:             disclosure.APR,
+		Installments:    make([]*loanproduct.QuoteInstallment, 0, len(installments)),
+		OriginationFee:  disclosure.OriginationFee,
+		GuaranteeFee:    disclosure.GuaranteeFee,
+		ServiceFee:      disclosure.ServiceFee,
+		TotalFee:        disclosure.TotalFee,
+		ReceivedAmount:  disclosure.Received,
+	}
+	for i, item := range installments {
+		quote.Installments = append(quote.Installments, &loanproduct.QuoteInstallment{
+			No:                 int32(item.No),
+			DueDate:            item.DueDate.Format("2006-01-02"),
+			Principal:          item.Principal,
+			Interest:           item.Interest,
+			Total:              item.Total,
+			RemainingPrincipal: item.RemainingPrincipal,
+			ServiceFee:         disclosure.ServiceFees[i],
+		})
+	}
+	return quote
+}
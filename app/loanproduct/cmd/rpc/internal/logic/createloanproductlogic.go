@@ -3,12 +3,16 @@ package logic
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"common/productschedule"
+	"common/productversion"
 	"model"
 	"rpc/internal/svc"
 	"rpc/loanproduct"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
 type CreateLoanProductLogic struct {
@@ -32,6 +36,36 @@ func (l *CreateLoanProductLogic) CreateLoanProduct(in *loanproduct.CreateLoanPro
 		return nil, err
 	}
 
+	// 校验还款模式
+	repaymentProfile, harvestMonths, err := normalizeRepaymentProfile(in.RepaymentProfile, in.GraceMonths, in.MinDuration, in.HarvestMonths)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验审批链
+	approvalChain, err := normalizeApprovalChain(in.ApprovalChain)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验准入规则
+	eligibilityRule, err := normalizeEligibilityRule(in.EligibilityRule)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验放贷额度
+	quotaPeriod, err := normalizeQuotaConfig(in.TotalBudget, in.PeriodQuota, in.QuotaPeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验利率档位
+	rateTiers, err := normalizeRateTiers(in.RateTiers)
+	if err != nil {
+		return nil, err
+	}
+
 	// 检查产品编码是否已存在
 	existingProduct, err := l.svcCtx.LoanProductModel.FindOneByProductCode(l.ctx, in.ProductCode)
 	if err == nil && existingProduct != nil {
@@ -40,30 +74,59 @@ func (l *CreateLoanProductLogic) CreateLoanProduct(in *loanproduct.CreateLoanPro
 
 	// 创建产品记录
 	product := &model.LoanProducts{
-		ProductCode:  in.ProductCode,
-		Name:         in.Name,
-		Type:         in.Type,
-		MaxAmount:    in.MaxAmount,
-		MinAmount:    in.MinAmount,
-		MaxDuration:  uint64(in.MaxDuration),
-		MinDuration:  uint64(in.MinDuration),
-		InterestRate: in.InterestRate,
-		Description:  in.Description,
-		Status:       1, // 默认上架状态
-	}
-
-	result, err := l.svcCtx.LoanProductModel.Insert(l.ctx, product)
-	if err != nil {
-		l.Errorf("创建产品失败: %v", err)
-		return nil, fmt.Errorf("创建产品失败")
+		ProductCode:        in.ProductCode,
+		Name:               in.Name,
+		Type:               in.Type,
+		MaxAmount:          in.MaxAmount,
+		MinAmount:          in.MinAmount,
+		MaxDuration:        uint64(in.MaxDuration),
+		MinDuration:        uint64(in.MinDuration),
+		InterestRate:       in.InterestRate,
+		Description:        in.Description,
+		RepaymentProfile:   repaymentProfile,
+		GraceMonths:        uint64(in.GraceMonths),
+		HarvestMonths:      harvestMonths,
+		PenaltyRate:        in.PenaltyRate,
+		PrepaymentFeeRate:  in.PrepaymentFeeRate,
+		OriginationFeeRate: in.OriginationFeeRate,
+		ServiceFeeRate:     in.ServiceFeeRate,
+		GuaranteeFeeRate:   in.GuaranteeFeeRate,
+		LateFee:            in.LateFee,
+		ApprovalChain:      approvalChain,
+		EligibilityRule:    eligibilityRule,
+		Status:             1, // 默认上架状态
+		Version:            1,
+		TotalBudget:        in.TotalBudget,
+		PeriodQuota:        in.PeriodQuota,
+		QuotaPeriod:        quotaPeriod,
 	}
 
-	// 获取新创建的产品ID
-	productId, err := result.LastInsertId()
+	// 产品、首个条款版本及利率档位在同一事务中写入
+	var productId int64
+	err = l.svcCtx.LoanProductModel.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
+		result, err := l.svcCtx.LoanProductModel.InsertWithSession(ctx, session, product)
+		if err != nil {
+			return err
+		}
+		if productId, err = result.LastInsertId(); err != nil {
+			return err
+		}
+
+		version, err := newProductVersion(uint64(productId), product.Version, termsOf(product), []productversion.Change{}, productversion.StatusActive, time.Now(), in.OperatorId, in.OperatorName)
+		if err != nil {
+			return err
+		}
+		if _, err = l.svcCtx.LoanProductVersionsModel.InsertWithSession(ctx, session, version); err != nil {
+			return err
+		}
+		return l.svcCtx.LoanProductRateTiersModel.InsertBatchWithSession(ctx, session, rateTierRows(uint64(productId), rateTiers))
+	})
 	if err != nil {
-		l.Errorf("获取新产品ID失败: %v", err)
-		return nil, fmt.Errorf("创建成功但查询失败")
+		l.Errorf("创建产品失败: %v", err)
+		return nil, fmt.Errorf("创建产品失败")
 	}
+	product.Id = uint64(productId)
+	_ = l.svcCtx.LoanProductModel.DelProductCache(l.ctx, product)
 
 	// 查询完整的产品信息
 	createdProduct, err := l.svcCtx.LoanProductModel.FindOne(l.ctx, uint64(productId))
@@ -72,21 +135,42 @@ func (l *CreateLoanProductLogic) CreateLoanProduct(in *loanproduct.CreateLoanPro
 		return nil, fmt.Errorf("创建成功但查询失败")
 	}
 
+	aprMin, aprMax := productAPRRange(createdProduct, rateTiers)
 	return &loanproduct.CreateLoanProductResp{
 		Data: &loanproduct.LoanProductInfo{
-			Id:           int64(createdProduct.Id),
-			ProductCode:  createdProduct.ProductCode,
-			Name:         createdProduct.Name,
-			Type:         createdProduct.Type,
-			MaxAmount:    createdProduct.MaxAmount,
-			MinAmount:    createdProduct.MinAmount,
-			MaxDuration:  int32(createdProduct.MaxDuration),
-			MinDuration:  int32(createdProduct.MinDuration),
-			InterestRate: createdProduct.InterestRate,
-			Description:  createdProduct.Description,
-			Status:       int32(createdProduct.Status),
-			CreatedAt:    createdProduct.CreatedAt.Unix(),
-			UpdatedAt:    createdProduct.UpdatedAt.Unix(),
+			Id:                 int64(createdProduct.Id),
+			ProductCode:        createdProduct.ProductCode,
+			Name:               createdProduct.Name,
+			Type:               createdProduct.Type,
+			MaxAmount:          createdProduct.MaxAmount,
+			MinAmount:          createdProduct.MinAmount,
+			MaxDuration:        int32(createdProduct.MaxDuration),
+			MinDuration:        int32(createdProduct.MinDuration),
+			InterestRate:       createdProduct.InterestRate,
+			Description:        createdProduct.Description,
+			Status:             int32(createdProduct.Status),
+			CreatedAt:          createdProduct.CreatedAt.Unix(),
+			UpdatedAt:          createdProduct.UpdatedAt.Unix(),
+			RepaymentProfile:   createdProduct.RepaymentProfile,
+			GraceMonths:        int32(createdProduct.GraceMonths),
+			HarvestMonths:      createdProduct.HarvestMonths,
+			PenaltyRate:        createdProduct.PenaltyRate,
+			PrepaymentFeeRate:  createdProduct.PrepaymentFeeRate,
+			OriginationFeeRate: createdProduct.OriginationFeeRate,
+			ServiceFeeRate:     createdProduct.ServiceFeeRate,
+			GuaranteeFeeRate:   createdProduct.GuaranteeFeeRate,
+			LateFee:            createdProduct.LateFee,
+			ApprovalChain:      createdProduct.ApprovalChain,
+			AprMin:             aprMin,
+			AprMax:             aprMax,
+			Version:            int32(createdProduct.Version),
+			LaunchAt:           productschedule.ToUnix(createdProduct.LaunchAt),
+			DelistAt:           productschedule.ToUnix(createdProduct.DelistAt),
+			TotalBudget:        createdProduct.TotalBudget,
+			PeriodQuota:        createdProduct.PeriodQuota,
+			QuotaPeriod:        createdProduct.QuotaPeriod,
+			EligibilityRule:    createdProduct.EligibilityRule,
+			RateTiers:          convertRateTiers(rateTiers),
 		},
 	}, nil
 }
@@ -123,6 +207,24 @@ func (l *CreateLoanProductLogic) validateCreateRequest(in *loanproduct.CreateLoa
 	if in.InterestRate <= 0 {
 		return fmt.Errorf("利率必须大于0")
 	}
+	if in.PenaltyRate < 0 || in.PenaltyRate > 1 {
+		return fmt.Errorf("罚息日利率应在0到1之间")
+	}
+	if in.PrepaymentFeeRate < 0 || in.PrepaymentFeeRate > 10 {
+		return fmt.Errorf("提前还款手续费率应在0到10之间")
+	}
+	if in.OriginationFeeRate < 0 || in.OriginationFeeRate > 10 {
+		return fmt.Errorf("手续费率应在0到10之间")
+	}
+	if in.ServiceFeeRate < 0 || in.ServiceFeeRate > 2 {
+		return fmt.Errorf("服务费月费率应在0到2之间")
+	}
+	if in.GuaranteeFeeRate < 0 || in.GuaranteeFeeRate > 10 {
+		return fmt.Errorf("担保费率应在0到10之间")
+	}
+	if in.LateFee < 0 {
+		return fmt.Errorf("逾期滞纳金不能小于0")
+	}
 	if in.Description == "" {
 		return fmt.Errorf("产品描述不能为空")
 	}
@@ -3,11 +3,16 @@ package logic
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"loanrpc/loanclient"
+	"model"
+	"rpc/internal/breaker"
 	"rpc/internal/svc"
 	"rpc/loanproduct"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
 
 type DeleteLoanProductLogic struct {
@@ -37,16 +42,45 @@ func (l *DeleteLoanProductLogic) DeleteLoanProduct(in *loanproduct.DeleteLoanPro
 		return nil, fmt.Errorf("产品不存在")
 	}
 
-	// TODO: 检查是否有正在进行的贷款申请
-	// 这里应该调用loan.rpc检查是否有未完成的贷款申请
-	// 如果有正在进行的贷款，应该禁止删除
+	// 通过贷款服务检查产品引用,无法确认时拒绝删除; 远程调用不在事务内进行,避免长时间持有产品行锁
+	usage, err := breaker.DoWithBreakerResultAcceptable(l.ctx, "loan-rpc", func() (*loanclient.CountProductApplicationsResp, error) {
+		return l.svcCtx.LoanClient.CountProductApplications(l.ctx, &loanclient.CountProductApplicationsReq{
+			ProductId: int64(product.Id),
+		})
+	}, breaker.IsAcceptableError)
+	if err != nil {
+		l.Errorf("查询产品引用失败: productId=%d, err=%v", product.Id, err)
+		return nil, fmt.Errorf("删除产品失败，无法确认产品引用情况，请稍后重试")
+	}
+	if usage.Active > 0 {
+		return nil, fmt.Errorf("状态错误，产品仍有%d笔进行中的贷款申请，不能删除", usage.Active)
+	}
 
-	// 删除产品
-	err = l.svcCtx.LoanProductModel.Delete(l.ctx, product.Id)
+	// 锁定产品后软删除并取消待生效版本,审批占用放贷额度的请求在删除提交前等待,删除后按产品不存在处理
+	var cancelled []*model.LoanProductVersions
+	err = l.svcCtx.LoanProductModel.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
+		if _, err := l.svcCtx.LoanProductModel.FindOneForUpdateWithSession(ctx, session, product.Id); err != nil {
+			return err
+		}
+
+		// 软删除产品,历史申请仍可追溯产品信息
+		if err := l.svcCtx.LoanProductModel.SoftDeleteWithSession(ctx, session, product, time.Now()); err != nil {
+			return err
+		}
+		versions, err := l.svcCtx.LoanProductVersionsModel.CancelScheduledWithSession(ctx, session, product.Id)
+		cancelled = versions
+		return err
+	})
+	if err == model.ErrNotFound {
+		return nil, fmt.Errorf("产品不存在")
+	}
 	if err != nil {
 		l.Errorf("删除产品失败: %v", err)
 		return nil, fmt.Errorf("删除产品失败")
 	}
+	_ = l.svcCtx.LoanProductModel.DelProductCache(l.ctx, product)
+	_ = l.svcCtx.LoanProductVersionsModel.DelVersionCache(l.ctx, cancelled...)
 
+	l.Infof("产品已归档: productId=%d, productCode=%s, 历史申请数=%d", product.Id, product.ProductCode, usage.Total)
 	return &loanproduct.DeleteLoanProductResp{}, nil
 }
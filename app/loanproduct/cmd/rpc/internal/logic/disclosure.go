@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"time"
+
+	"common/ratetier"
+	"common/repayment"
+	"model"
+)
+
+// productRepaymentProfile 返回产品还款模式
+func productRepaymentProfile(product *model.LoanProducts) repayment.Profile {
+	return repayment.Profile{
+		Seasonal:      product.RepaymentProfile == RepaymentProfileSeasonal,
+		GraceMonths:   int(product.GraceMonths),
+		HarvestMonths: repayment.ParseHarvestMonths(product.HarvestMonths),
+	}
+}
+
+// productFees 返回产品费用配置
+func productFees(product *model.LoanProducts) repayment.Fees {
+	return repayment.Fees{
+		OriginationRate: product.OriginationFeeRate,
+		ServiceRate:     product.ServiceFeeRate,
+		GuaranteeRate:   product.GuaranteeFeeRate,
+	}
+}
+
+// productAPRRange 按最高额度分别试算最短、最长期限的综合年化利率,用于产品页披露
+// 按月还款产品以等额本息为准,试算失败(如宽限期配置不合法)时返回0
+// 配置利率档位时按适用档位的挂牌利率试算,没有适用档位时按产品利率试算
+func productAPRRange(product *model.LoanProducts, tiers []ratetier.Tier) (float64, float64) {
+	start := time.Now()
+	profile := productRepaymentProfile(product)
+	fees := productFees(product)
+
+	var low, high float64
+	found := false
+	for _, months := range []uint64{product.MinDuration, product.MaxDuration} {
+		rate := product.InterestRate
+		if tier, ok := ratetier.Match(tiers, product.MaxAmount, int(months)); ok {
+			rate = tier.InterestRate
+		}
+		_, list, err := repayment.Build(repayment.MethodEqualInstallment, product.MaxAmount, rate, int(months), start, profile)
+		if err != nil {
+			continue
+		}
+		apr := repayment.Disclose(product.MaxAmount, start, list, fees).APR
+		if !found || apr < low {
+			low = apr
+		}
+		if !found || apr > high {
+			high = apr
+		}
+		found = true
+	}
+	return low, high
+}
@@ -3,7 +3,9 @@ package logic
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"common/productschedule"
 	"model"
 	"rpc/internal/svc"
 	"rpc/loanproduct"
@@ -49,21 +51,58 @@ func (l *GetLoanProductLogic) GetLoanProduct(in *loanproduct.GetLoanProductReq)
 		return nil, fmt.Errorf("产品不存在")
 	}
 
+	// 统计放贷额度占用,供管理端展示额度使用情况
+	usage, err := loadQuotaUsage(l.ctx, l.svcCtx, product, time.Now())
+	if err != nil {
+		l.Errorf("查询额度占用失败: %v", err)
+		return nil, fmt.Errorf("查询产品失败")
+	}
+
+	tiers, err := loadRateTiers(l.ctx, l.svcCtx, product.Id)
+	if err != nil {
+		l.Errorf("查询产品利率档位失败: %v", err)
+		return nil, fmt.Errorf("查询产品失败")
+	}
+
+	aprMin, aprMax := productAPRRange(product, tiers)
 	return &loanproduct.GetLoanProductResp{
 		Data: &loanproduct.LoanProductInfo{
-			Id:           int64(product.Id),
-			ProductCode:  product.ProductCode,
-			Name:         product.Name,
-			Type:         product.Type,
-			MaxAmount:    product.MaxAmount,
-			MinAmount:    product.MinAmount,
-			MaxDuration:  int32(product.MaxDuration),
-			MinDuration:  int32(product.MinDuration),
-			InterestRate: product.InterestRate,
-			Description:  product.Description,
-			Status:       int32(product.Status),
-			CreatedAt:    product.CreatedAt.Unix(),
-			UpdatedAt:    product.UpdatedAt.Unix(),
+			Id:                 int64(product.Id),
+			ProductCode:        product.ProductCode,
+			Name:               product.Name,
+			Type:               product.Type,
+			MaxAmount:          product.MaxAmount,
+			MinAmount:          product.MinAmount,
+			MaxDuration:        int32(product.MaxDuration),
+			MinDuration:        int32(product.MinDuration),
+			InterestRate:       product.InterestRate,
+			Description:        product.Description,
+			Status:             int32(product.Status),
+			CreatedAt:          product.CreatedAt.Unix(),
+			UpdatedAt:          product.UpdatedAt.Unix(),
+			RepaymentProfile:   product.RepaymentProfile,
+			GraceMonths:        int32(product.GraceMonths),
+			HarvestMonths:      product.HarvestMonths,
+			PenaltyRate:        product.PenaltyRate,
+			PrepaymentFeeRate:  product.PrepaymentFeeRate,
+			OriginationFeeRate: product.OriginationFeeRate,
+			ServiceFeeRate:     product.ServiceFeeRate,
+			GuaranteeFeeRate:   product.GuaranteeFeeRate,
+			LateFee:            product.LateFee,
+			ApprovalChain:      product.ApprovalChain,
+			AprMin:             aprMin,
+			AprMax:             aprMax,
+			Version:            int32(product.Version),
+			LaunchAt:           productschedule.ToUnix(product.LaunchAt),
+			DelistAt:           productschedule.ToUnix(product.DelistAt),
+			TotalBudget:        product.TotalBudget,
+			PeriodQuota:        product.PeriodQuota,
+			QuotaPeriod:        product.QuotaPeriod,
+			EligibilityRule:    product.EligibilityRule,
+			BudgetUsed:         usage.BudgetUsed,
+			PeriodUsed:         usage.PeriodUsed,
+			RemainingQuota:     usage.remaining(product),
+			RateTiers:          convertRateTiers(tiers),
 		},
 	}, nil
 }
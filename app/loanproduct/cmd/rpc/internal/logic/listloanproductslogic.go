@@ -4,7 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
+	"common/productschedule"
+	"model"
 	"rpc/internal/svc"
 	"rpc/loanproduct"
 
@@ -41,6 +44,9 @@ func (l *ListLoanProductsLogic) ListLoanProducts(in *loanproduct.ListLoanProduct
 	var conditions []string
 	var args []interface{}
 
+	// 已删除(归档)的产品不再展示
+	conditions = append(conditions, "deleted_at IS NULL")
+
 	// 只查询上架的产品(status=1)，如果没有指定状态的话
 	if in.Status == 0 {
 		conditions = append(conditions, "status = ?")
@@ -50,6 +56,12 @@ func (l *ListLoanProductsLogic) ListLoanProducts(in *loanproduct.ListLoanProduct
 		args = append(args, in.Status)
 	}
 
+	// 查询上架产品时排除已过计划下架时间的产品,避免等待后台任务下架期间仍对外展示
+	if in.Status == 0 || uint64(in.Status) == productschedule.StatusOnSale {
+		conditions = append(conditions, "(delist_at IS NULL OR delist_at > ?)")
+		args = append(args, time.Now())
+	}
+
 	if in.Type != "" {
 		conditions = append(conditions, "type = ?")
 		args = append(args, in.Type)
@@ -70,38 +82,76 @@ func (l *ListLoanProductsLogic) ListLoanProducts(in *loanproduct.ListLoanProduct
 		}
 	}
 
-	// 查询总数
-	total, err := l.svcCtx.LoanProductModel.CountWithConditions(l.ctx, whereClause, args)
-	if err != nil {
-		l.Errorf("查询产品总数失败: %v", err)
-		return nil, fmt.Errorf("查询产品失败")
-	}
-
-	// 查询分页数据
+	var productRows []*model.LoanProducts
+	var total int64
 	offset := (in.Page - 1) * in.Size
-	productRows, err := l.svcCtx.LoanProductModel.ListWithConditions(l.ctx, whereClause, args, in.Size, offset)
-	if err != nil && err != sql.ErrNoRows {
-		l.Errorf("查询产品列表失败: %v", err)
-		return nil, fmt.Errorf("查询产品列表失败")
+	if in.UserId > 0 {
+		// 按用户准入规则过滤: 规则为JSON配置无法在SQL中匹配,取出全部候选产品逐个校验后在内存中分页
+		eligibleRows, err := l.listEligibleProducts(in.UserId, whereClause, args)
+		if err != nil {
+			return nil, err
+		}
+		total = int64(len(eligibleRows))
+		productRows = eligibleRows[min(int(offset), len(eligibleRows)):min(int(offset+in.Size), len(eligibleRows))]
+	} else {
+		// 查询总数
+		var err error
+		total, err = l.svcCtx.LoanProductModel.CountWithConditions(l.ctx, whereClause, args)
+		if err != nil {
+			l.Errorf("查询产品总数失败: %v", err)
+			return nil, fmt.Errorf("查询产品失败")
+		}
+
+		// 查询分页数据
+		productRows, err = l.svcCtx.LoanProductModel.ListWithConditions(l.ctx, whereClause, args, in.Size, offset)
+		if err != nil && err != sql.ErrNoRows {
+			l.Errorf("查询产品列表失败: %v", err)
+			return nil, fmt.Errorf("查询产品列表失败")
+		}
 	}
 
 	// 转换为响应格式
 	var products []*loanproduct.LoanProductInfo
 	for _, row := range productRows {
+		// 利率档位仅用于试算综合年化利率,查询失败时按产品利率披露
+		tiers, err := loadRateTiers(l.ctx, l.svcCtx, row.Id)
+		if err != nil {
+			l.Errorf("查询产品利率档位失败, 产品ID: %d, 错误: %v", row.Id, err)
+		}
+		aprMin, aprMax := productAPRRange(row, tiers)
 		products = append(products, &loanproduct.LoanProductInfo{
-			Id:           int64(row.Id),
-			ProductCode:  row.ProductCode,
-			Name:         row.Name,
-			Type:         row.Type,
-			MaxAmount:    row.MaxAmount,
-			MinAmount:    row.MinAmount,
-			MaxDuration:  int32(row.MaxDuration),
-			MinDuration:  int32(row.MinDuration),
-			InterestRate: row.InterestRate,
-			Description:  row.Description,
-			Status:       int32(row.Status),
-			CreatedAt:    row.CreatedAt.Unix(),
-			UpdatedAt:    row.UpdatedAt.Unix(),
+			Id:                 int64(row.Id),
+			ProductCode:        row.ProductCode,
+			Name:               row.Name,
+			Type:               row.Type,
+			MaxAmount:          row.MaxAmount,
+			MinAmount:          row.MinAmount,
+			MaxDuration:        int32(row.MaxDuration),
+			MinDuration:        int32(row.MinDuration),
+			InterestRate:       row.InterestRate,
+			Description:        row.Description,
+			Status:             int32(row.Status),
+			CreatedAt:          row.CreatedAt.Unix(),
+			UpdatedAt:          row.UpdatedAt.Unix(),
+			RepaymentProfile:   row.RepaymentProfile,
+			GraceMonths:        int32(row.GraceMonths),
+			HarvestMonths:      row.HarvestMonths,
+			PenaltyRate:        row.PenaltyRate,
+			PrepaymentFeeRate:  row.PrepaymentFeeRate,
+			OriginationFeeRate: row.OriginationFeeRate,
+			ServiceFeeRate:     row.ServiceFeeRate,
+			GuaranteeFeeRate:   row.GuaranteeFeeRate,
+			LateFee:            row.LateFee,
+			ApprovalChain:      row.ApprovalChain,
+			AprMin:             aprMin,
+			AprMax:             aprMax,
+			Version:            int32(row.Version),
+			LaunchAt:           productschedule.ToUnix(row.LaunchAt),
+			DelistAt:           productschedule.ToUnix(row.DelistAt),
+			TotalBudget:        row.TotalBudget,
+			PeriodQuota:        row.PeriodQuota,
+			QuotaPeriod:        row.QuotaPeriod,
+			EligibilityRule:    row.EligibilityRule,
 		})
 	}
 
@@ -115,3 +165,40 @@ func (l *ListLoanProductsLogic) ListLoanProducts(in *loanproduct.ListLoanProduct
 		Total: total,
 	}, nil
 }
+
+// listEligibleProducts 查询全部候选产品并过滤出用户满足准入规则的产品,保持列表原有排序
+func (l *ListLoanProductsLogic) listEligibleProducts(userId int64, whereClause string, args []interface{}) ([]*model.LoanProducts, error) {
+	applicant, err := loadApplicant(l.ctx, l.svcCtx, userId)
+	if err != nil {
+		l.Errorf("获取用户资料失败: userId=%d, err=%v", userId, err)
+		return nil, fmt.Errorf("用户信息验证失败，请稍后重试")
+	}
+
+	count, err := l.svcCtx.LoanProductModel.CountWithConditions(l.ctx, whereClause, args)
+	if err != nil {
+		l.Errorf("查询产品总数失败: %v", err)
+		return nil, fmt.Errorf("查询产品失败")
+	}
+	if count == 0 {
+		return nil, nil
+	}
+
+	rows, err := l.svcCtx.LoanProductModel.ListWithConditions(l.ctx, whereClause, args, int32(count), 0)
+	if err != nil && err != sql.ErrNoRows {
+		l.Errorf("查询产品列表失败: %v", err)
+		return nil, fmt.Errorf("查询产品列表失败")
+	}
+
+	eligibleRows := make([]*model.LoanProducts, 0, len(rows))
+	for _, row := range rows {
+		reasons, err := checkProductEligibility(row.EligibilityRule, applicant)
+		if err != nil {
+			l.Errorf("解析产品准入规则失败: productId=%d, err=%v", row.Id, err)
+			continue
+		}
+		if len(reasons) == 0 {
+			eligibleRows = append(eligibleRows, row)
+		}
+	}
+	return eligibleRows, nil
+}
@@ -0,0 +1,52 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"rpc/internal/svc"
+	"rpc/loanproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListLoanProductVersionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListLoanProductVersionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListLoanProductVersionsLogic {
+	return &ListLoanProductVersionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListLoanProductVersionsLogic) ListLoanProductVersions(in *loanproduct.ListLoanProductVersionsReq) (*loanproduct.ListLoanProductVersionsResp, error) {
+	if in.ProductId <= 0 {
+		return nil, fmt.Errorf("产品ID不能为空")
+	}
+
+	// 确认产品存在
+	if _, err := l.svcCtx.LoanProductModel.FindOne(l.ctx, uint64(in.ProductId)); err != nil {
+		l.Errorf("查询产品失败: %v", err)
+		return nil, fmt.Errorf("产品不存在")
+	}
+
+	versions, err := l.svcCtx.LoanProductVersionsModel.FindByProductId(l.ctx, uint64(in.ProductId))
+	if err != nil {
+		l.Errorf("查询产品版本失败: %v", err)
+		return nil, fmt.Errorf("查询产品版本失败")
+	}
+
+	list := make([]*loanproduct.LoanProductVersionInfo, 0, len(versions))
+	for _, version := range versions {
+		list = append(list, convertProductVersion(version))
+	}
+
+	return &loanproduct.ListLoanProductVersionsResp{
+		List: list,
+	}, nil
+}
@@ -0,0 +1,240 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"common/productversion"
+	"model"
+	"rpc/internal/svc"
+	"rpc/loanproduct"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// errVersionProcessed 待生效版本已被其他实例处理
+var errVersionProcessed = errors.New("版本已被处理")
+
+// productTerms 纳入版本管理的产品条款,json 标签即版本变更记录中的字段名
+// 产品编码与上下架状态不属于条款,修改后立即生效且不产生版本
+type productTerms struct {
+	Name               string  `json:"name"`
+	Type               string  `json:"type"`
+	MinAmount          float64 `json:"min_amount"`
+	MaxAmount          float64 `json:"max_amount"`
+	MinDuration        uint64  `json:"min_duration"`
+	MaxDuration        uint64  `json:"max_duration"`
+	InterestRate       float64 `json:"interest_rate"`
+	Description        string  `json:"description"`
+	RepaymentProfile   string  `json:"repayment_profile"`
+	GraceMonths        uint64  `json:"grace_months"`
+	HarvestMonths      string  `json:"harvest_months"`
+	PenaltyRate        float64 `json:"penalty_rate"`
+	PrepaymentFeeRate  float64 `json:"prepayment_fee_rate"`
+	OriginationFeeRate float64 `json:"origination_fee_rate"`
+	ServiceFeeRate     float64 `json:"service_fee_rate"`
+	GuaranteeFeeRate   float64 `json:"guarantee_fee_rate"`
+	LateFee            float64 `json:"late_fee"`
+	ApprovalChain      string  `json:"approval_chain"`
+	EligibilityRule    string  `json:"eligibility_rule"`
+}
+
+// termsOf 提取产品当前条款
+func termsOf(product *model.LoanProducts) productTerms {
+	return productTerms{
+		Name:               product.Name,
+		Type:               product.Type,
+		MinAmount:          product.MinAmount,
+		MaxAmount:          product.MaxAmount,
+		MinDuration:        product.MinDuration,
+		MaxDuration:        product.MaxDuration,
+		InterestRate:       product.InterestRate,
+		Description:        product.Description,
+		RepaymentProfile:   product.RepaymentProfile,
+		GraceMonths:        product.GraceMonths,
+		HarvestMonths:      product.HarvestMonths,
+		PenaltyRate:        product.PenaltyRate,
+		PrepaymentFeeRate:  product.PrepaymentFeeRate,
+		OriginationFeeRate: product.OriginationFeeRate,
+		ServiceFeeRate:     product.ServiceFeeRate,
+		GuaranteeFeeRate:   product.GuaranteeFeeRate,
+		LateFee:            product.LateFee,
+		ApprovalChain:      product.ApprovalChain,
+		EligibilityRule:    product.EligibilityRule,
+	}
+}
+
+// applyTo 将条款写回产品
+func (t productTerms) applyTo(product *model.LoanProducts) {
+	product.Name = t.Name
+	product.Type = t.Type
+	product.MinAmount = t.MinAmount
+	product.MaxAmount = t.MaxAmount
+	product.MinDuration = t.MinDuration
+	product.MaxDuration = t.MaxDuration
+	product.InterestRate = t.InterestRate
+	product.Description = t.Description
+	product.RepaymentProfile = t.RepaymentProfile
+	product.GraceMonths = t.GraceMonths
+	product.HarvestMonths = t.HarvestMonths
+	product.PenaltyRate = t.PenaltyRate
+	product.PrepaymentFeeRate = t.PrepaymentFeeRate
+	product.OriginationFeeRate = t.OriginationFeeRate
+	product.ServiceFeeRate = t.ServiceFeeRate
+	product.GuaranteeFeeRate = t.GuaranteeFeeRate
+	product.LateFee = t.LateFee
+	product.ApprovalChain = t.ApprovalChain
+	product.EligibilityRule = t.EligibilityRule
+}
+
+// newProductVersion 构造版本记录,terms 为版本生效后的完整条款
+func newProductVersion(productId, version uint64, terms productTerms, changes []productversion.Change, status string, effectiveFrom time.Time, operatorId int64, operatorName string) (*model.LoanProductVersions, error) {
+	termsJSON, err := json.Marshal(terms)
+	if err != nil {
+		return nil, err
+	}
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.LoanProductVersions{
+		ProductId:     productId,
+		Version:       version,
+		Terms:         string(termsJSON),
+		Changes:       string(changesJSON),
+		Status:        status,
+		EffectiveFrom: effectiveFrom,
+		OperatorId:    uint64(operatorId),
+		OperatorName:  operatorName,
+	}, nil
+}
+
+// switchProductVersion 在事务中更新产品条款并切换生效版本: 原生效版本失效,新版本写入或由待生效转为生效
+// 先锁定产品,apply 在锁定后读取的最新记录上修改条款,不会覆盖并发修改的其他字段或恢复已删除的产品
+// 产品已删除时返回 ErrNotFound; 待生效版本已被其他实例处理时返回 false,事务回滚
+func switchProductVersion(ctx context.Context, svcCtx *svc.ServiceContext, productId uint64, next *model.LoanProductVersions, now time.Time,
+	apply func(product *model.LoanProducts) error) (bool, error) {
+	var product *model.LoanProducts
+	var current *model.LoanProductVersions
+	err := svcCtx.LoanProductModel.TransactCtx(ctx, func(ctx context.Context, session sqlx.Session) error {
+		locked, err := svcCtx.LoanProductModel.FindOneForUpdateWithSession(ctx, session, productId)
+		if err != nil {
+			return err
+		}
+		current, err = svcCtx.LoanProductVersionsModel.FindActiveByProductIdWithSession(ctx, session, productId)
+		if err != nil && err != model.ErrNotFound {
+			return err
+		}
+
+		if err := apply(locked); err != nil {
+			return err
+		}
+		locked.Version = next.Version
+		product = locked
+		if err := svcCtx.LoanProductModel.UpdateWithSession(ctx, session, locked); err != nil {
+			return err
+		}
+		if current != nil {
+			if err := svcCtx.LoanProductVersionsModel.SupersedeWithSession(ctx, session, current, now); err != nil {
+				return err
+			}
+		}
+		if next.Id == 0 {
+			result, err := svcCtx.LoanProductVersionsModel.InsertWithSession(ctx, session, next)
+			if err != nil {
+				return err
+			}
+			id, err := result.LastInsertId()
+			if err != nil {
+				return err
+			}
+			next.Id = uint64(id)
+			return nil
+		}
+
+		ok, err := svcCtx.LoanProductVersionsModel.ActivateWithSession(ctx, session, next)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errVersionProcessed
+		}
+		return nil
+	})
+	if errors.Is(err, errVersionProcessed) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	_ = svcCtx.LoanProductModel.DelProductCache(ctx, product)
+	if current != nil {
+		_ = svcCtx.LoanProductVersionsModel.DelVersionCache(ctx, current, next)
+	} else {
+		_ = svcCtx.LoanProductVersionsModel.DelVersionCache(ctx, next)
+	}
+	return true, nil
+}
+
+// ActivateScheduledVersion 使到期的待生效版本生效
+// 只应用该版本自身变更的字段,排期之后对其他字段的修改保持不变
+func ActivateScheduledVersion(ctx context.Context, svcCtx *svc.ServiceContext, version *model.LoanProductVersions, now time.Time) (bool, error) {
+	var changes []productversion.Change
+	if err := json.Unmarshal([]byte(version.Changes), &changes); err != nil {
+		return false, err
+	}
+
+	return switchProductVersion(ctx, svcCtx, version.ProductId, version, now, func(product *model.LoanProducts) error {
+		terms := termsOf(product)
+		if err := productversion.Apply(&terms, changes); err != nil {
+			return err
+		}
+		termsJSON, err := json.Marshal(terms)
+		if err != nil {
+			return err
+		}
+
+		terms.applyTo(product)
+		version.Terms = string(termsJSON)
+		return nil
+	})
+}
+
+// convertProductVersion 转换版本记录
+func convertProductVersion(version *model.LoanProductVersions) *loanproduct.LoanProductVersionInfo {
+	var changes []productversion.Change
+	_ = json.Unmarshal([]byte(version.Changes), &changes)
+
+	items := make([]*loanproduct.ProductVersionChange, 0, len(changes))
+	for _, change := range changes {
+		from, _ := json.Marshal(change.From)
+		to, _ := json.Marshal(change.To)
+		items = append(items, &loanproduct.ProductVersionChange{
+			Field: change.Field,
+			From:  string(from),
+			To:    string(to),
+		})
+	}
+
+	var effectiveTo int64
+	if version.EffectiveTo.Valid {
+		effectiveTo = version.EffectiveTo.Time.Unix()
+	}
+
+	return &loanproduct.LoanProductVersionInfo{
+		Id:            int64(version.Id),
+		ProductId:     int64(version.ProductId),
+		Version:       int32(version.Version),
+		Status:        version.Status,
+		Changes:       items,
+		EffectiveFrom: version.EffectiveFrom.Unix(),
+		EffectiveTo:   effectiveTo,
+		OperatorId:    int64(version.OperatorId),
+		OperatorName:  version.OperatorName,
+		CreatedAt:     version.CreatedAt.Unix(),
+	}
+}
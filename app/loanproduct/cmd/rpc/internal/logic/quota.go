@@ -0,0 +1,105 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"model"
+	"rpc/internal/svc"
+)
+
+// 额度周期
+const (
+	quotaPeriodMonth   = "month"
+	quotaPeriodQuarter = "quarter"
+	quotaPeriodYear    = "year"
+)
+
+// 额度占用状态
+const (
+	quotaStatusReserved = "reserved"
+	quotaStatusReleased = "released"
+)
+
+var (
+	// errQuotaExceeded 产品放贷额度不足
+	errQuotaExceeded = errors.New("额度不足")
+	// errQuotaReleased 申请占用的额度已释放,不能再次占用
+	errQuotaReleased = errors.New("状态错误，该申请占用的额度已释放")
+)
+
+// normalizeQuotaConfig 校验产品放贷额度配置,返回规范化后的额度周期,为空时按年
+func normalizeQuotaConfig(totalBudget, periodQuota float64, period string) (string, error) {
+	if totalBudget < 0 || periodQuota < 0 {
+		return "", fmt.Errorf("放贷额度不能小于0")
+	}
+	if totalBudget > 0 && periodQuota > totalBudget {
+		return "", fmt.Errorf("周期放贷额度不能大于放贷总额度")
+	}
+
+	switch period {
+	case "":
+		return quotaPeriodYear, nil
+	case quotaPeriodMonth, quotaPeriodQuarter, quotaPeriodYear:
+		return period, nil
+	default:
+		return "", fmt.Errorf("额度周期必须为month、quarter或year")
+	}
+}
+
+// quotaPeriodStart 返回 now 所在额度周期的起始时间
+func quotaPeriodStart(period string, now time.Time) time.Time {
+	switch period {
+	case quotaPeriodMonth:
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	case quotaPeriodQuarter:
+		month := time.Month((int(now.Month())-1)/3*3 + 1)
+		return time.Date(now.Year(), month, 1, 0, 0, 0, 0, now.Location())
+	default:
+		return time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, now.Location())
+	}
+}
+
+// quotaUsage 产品放贷额度占用情况
+type quotaUsage struct {
+	BudgetUsed float64 // 已占用总额度
+	PeriodUsed float64 // 本周期已占用额度
+}
+
+// remaining 当前可用额度,取总额度与周期额度剩余的较小值,均不限时返回 -1
+func (u quotaUsage) remaining(product *model.LoanProducts) float64 {
+	remaining := -1.0
+	if product.TotalBudget > 0 {
+		remaining = math.Max(product.TotalBudget-u.BudgetUsed, 0)
+	}
+	if product.PeriodQuota > 0 {
+		periodRemaining := math.Max(product.PeriodQuota-u.PeriodUsed, 0)
+		if remaining < 0 || periodRemaining < remaining {
+			remaining = periodRemaining
+		}
+	}
+	return remaining
+}
+
+// sumQuotaUsage 按产品额度周期统计占用,sum 返回自 since 起占用中的额度,since 为零值表示全部
+func sumQuotaUsage(sum func(since time.Time) (float64, error), product *model.LoanProducts, now time.Time) (quotaUsage, error) {
+	budgetUsed, err := sum(time.Time{})
+	if err != nil {
+		return quotaUsage{}, err
+	}
+	periodUsed, err := sum(quotaPeriodStart(product.QuotaPeriod, now))
+	if err != nil {
+		return quotaUsage{}, err
+	}
+	return quotaUsage{BudgetUsed: budgetUsed, PeriodUsed: periodUsed}, nil
+}
+
+// loadQuotaUsage 查询产品当前的额度占用情况
+func loadQuotaUsage(ctx context.Context, svcCtx *svc.ServiceContext, product *model.LoanProducts, now time.Time) (quotaUsage, error) {
+	return sumQuotaUsage(func(since time.Time) (float64, error) {
+		return svcCtx.LoanProductQuotaReservationsModel.SumReserved(ctx, product.Id, since)
+	}, product, now)
+}
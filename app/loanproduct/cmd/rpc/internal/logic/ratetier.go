@@ -0,0 +1,84 @@
+package logic
+
+import (
+	"context"
+
+	"common/ratetier"
+	"model"
+	"rpc/internal/svc"
+	"rpc/loanproduct"
+)
+
+// normalizeRateTiers 校验请求中的利率档位配置,为空表示统一使用产品利率
+func normalizeRateTiers(in []*loanproduct.LoanRateTier) ([]ratetier.Tier, error) {
+	tiers := make([]ratetier.Tier, 0, len(in))
+	for _, item := range in {
+		tiers = append(tiers, ratetier.Tier{
+			MinAmount:    item.MinAmount,
+			MaxAmount:    item.MaxAmount,
+			MinDuration:  int(item.MinDuration),
+			MaxDuration:  int(item.MaxDuration),
+			InterestRate: item.InterestRate,
+			MinRate:      item.MinRate,
+			MaxRate:      item.MaxRate,
+		})
+	}
+	return ratetier.Normalize(tiers)
+}
+
+// rateTierRows 转换为产品利率档位记录
+func rateTierRows(productId uint64, tiers []ratetier.Tier) []*model.LoanProductRateTiers {
+	rows := make([]*model.LoanProductRateTiers, 0, len(tiers))
+	for _, tier := range tiers {
+		rows = append(rows, &model.LoanProductRateTiers{
+			ProductId:    productId,
+			MinAmount:    tier.MinAmount,
+			MaxAmount:    tier.MaxAmount,
+			MinDuration:  uint64(tier.MinDuration),
+			MaxDuration:  uint64(tier.MaxDuration),
+			InterestRate: tier.InterestRate,
+			MinRate:      tier.MinRate,
+			MaxRate:      tier.MaxRate,
+		})
+	}
+	return rows
+}
+
+// loadRateTiers 查询产品的利率档位
+func loadRateTiers(ctx context.Context, svcCtx *svc.ServiceContext, productId uint64) ([]ratetier.Tier, error) {
+	rows, err := svcCtx.LoanProductRateTiersModel.FindByProductId(ctx, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers := make([]ratetier.Tier, 0, len(rows))
+	for _, row := range rows {
+		tiers = append(tiers, ratetier.Tier{
+			MinAmount:    row.MinAmount,
+			MaxAmount:    row.MaxAmount,
+			MinDuration:  int(row.MinDuration),
+			MaxDuration:  int(row.MaxDuration),
+			InterestRate: row.InterestRate,
+			MinRate:      row.MinRate,
+			MaxRate:      row.MaxRate,
+		})
+	}
+	return tiers, nil
+}
+
+// convertRateTiers 转换为响应格式
+func convertRateTiers(tiers []ratetier.Tier) []*loanproduct.LoanRateTier {
+	result := make([]*loanproduct.LoanRateTier, 0, len(tiers))
+	for _, tier := range tiers {
+		result = append(result, &loanproduct.LoanRateTier{
+			MinAmount:    tier.MinAmount,
+			MaxAmount:    tier.MaxAmount,
+			MinDuration:  int32(tier.MinDuration),
+			MaxDuration:  int32(tier.MaxDuration),
+			InterestRate: tier.InterestRate,
+			MinRate:      tier.MinRate,
+			MaxRate:      tier.MaxRate,
+		})
+	}
+	return result
+}
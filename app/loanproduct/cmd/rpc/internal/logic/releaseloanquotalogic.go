@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"model"
+	"rpc/internal/svc"
+	"rpc/loanproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReleaseLoanQuotaLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReleaseLoanQuotaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReleaseLoanQuotaLogic {
+	return &ReleaseLoanQuotaLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ReleaseLoanQuotaLogic) ReleaseLoanQuota(in *loanproduct.ReleaseLoanQuotaReq) (*loanproduct.ReleaseLoanQuotaResp, error) {
+	// 参数验证
+	if in.ApplicationId == "" {
+		return nil, fmt.Errorf("申请编号不能为空")
+	}
+
+	// 申请未占用额度时无需释放,撤销或拒绝未审批通过的申请均走此分支
+	reservation, err := l.svcCtx.LoanProductQuotaReservationsModel.FindOneByApplicationId(l.ctx, in.ApplicationId)
+	if err == model.ErrNotFound {
+		return &loanproduct.ReleaseLoanQuotaResp{}, nil
+	}
+	if err != nil {
+		l.Errorf("查询额度占用失败: %v", err)
+		return nil, fmt.Errorf("释放放贷额度失败")
+	}
+
+	// 按占用状态条件释放,重复释放时直接返回
+	released, err := l.svcCtx.LoanProductQuotaReservationsModel.ReleaseIfReserved(l.ctx, reservation, time.Now())
+	if err != nil {
+		l.Errorf("释放放贷额度失败: %v", err)
+		return nil, fmt.Errorf("释放放贷额度失败")
+	}
+	if released {
+		l.Infof("释放放贷额度, 申请编号: %s, 产品ID: %d, 金额: %.2f", reservation.ApplicationId, reservation.ProductId, reservation.Amount)
+	}
+
+	return &loanproduct.ReleaseLoanQuotaResp{
+		Released: released,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 还款模式
+const (
+	RepaymentProfileStandard = "standard" // 按月还款
+	RepaymentProfileSeasonal = "seasonal" // 按收获季还款
+)
+
+// normalizeRepaymentProfile 校验产品还款模式配置,返回规范化后的还款模式和收获月份
+// 宽限期须短于最小期限,否则最短期限的申请无法生成还款计划
+func normalizeRepaymentProfile(profile string, graceMonths, minDuration int32, harvestMonths string) (string, string, error) {
+	if profile == "" {
+		profile = RepaymentProfileStandard
+	}
+	if profile != RepaymentProfileStandard && profile != RepaymentProfileSeasonal {
+		return "", "", fmt.Errorf("还款模式必须为standard或seasonal")
+	}
+	if graceMonths < 0 {
+		return "", "", fmt.Errorf("宽限期不能小于0")
+	}
+	if graceMonths >= minDuration {
+		return "", "", fmt.Errorf("参数错误，宽限期必须小于最小期限%d个月", minDuration)
+	}
+
+	// 解析收获月份,去重并升序排列
+	seen := make(map[int]bool)
+	var months []int
+	for _, part := range strings.Split(harvestMonths, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		month, err := strconv.Atoi(part)
+		if err != nil || month < 1 || month > 12 {
+			return "", "", fmt.Errorf("收获月份格式错误，应为1-12的月份，逗号分隔")
+		}
+		if !seen[month] {
+			seen[month] = true
+			months = append(months, month)
+		}
+	}
+	sort.Ints(months)
+
+	if profile == RepaymentProfileSeasonal && len(months) == 0 {
+		return "", "", fmt.Errorf("季节性还款产品必须配置收获月份")
+	}
+
+	parts := make([]string, 0, len(months))
+	for _, month := range months {
+		parts = append(parts, strconv.Itoa(month))
+	}
+	return profile, strings.Join(parts, ","), nil
+}
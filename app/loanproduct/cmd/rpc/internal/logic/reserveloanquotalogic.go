@@ -0,0 +1,108 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"model"
+	"rpc/internal/svc"
+	"rpc/loanproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+type ReserveLoanQuotaLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReserveLoanQuotaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReserveLoanQuotaLogic {
+	return &ReserveLoanQuotaLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 放贷额度
+func (l *ReserveLoanQuotaLogic) ReserveLoanQuota(in *loanproduct.ReserveLoanQuotaReq) (*loanproduct.ReserveLoanQuotaResp, error) {
+	// 参数验证
+	if in.ProductId <= 0 {
+		return nil, fmt.Errorf("产品ID不能为空")
+	}
+	if in.ApplicationId == "" {
+		return nil, fmt.Errorf("申请编号不能为空")
+	}
+	if in.Amount <= 0 {
+		return nil, fmt.Errorf("占用金额必须大于0")
+	}
+
+	// 锁定产品后统计占用并写入占用记录,同一产品的并发审批按顺序占用额度
+	now := time.Now()
+	reservation := &model.LoanProductQuotaReservations{
+		ProductId:     uint64(in.ProductId),
+		ApplicationId: in.ApplicationId,
+		Amount:        in.Amount,
+		Status:        quotaStatusReserved,
+	}
+	var remaining float64
+	err := l.svcCtx.LoanProductModel.TransactCtx(l.ctx, func(ctx context.Context, session sqlx.Session) error {
+		product, err := l.svcCtx.LoanProductModel.FindOneForUpdateWithSession(ctx, session, uint64(in.ProductId))
+		if err != nil {
+			return err
+		}
+
+		// 同一申请重复占用时直接返回,保证审批重试幂等
+		existing, err := l.svcCtx.LoanProductQuotaReservationsModel.FindOneByApplicationIdWithSession(ctx, session, in.ApplicationId)
+		if err != nil && err != model.ErrNotFound {
+			return err
+		}
+		if existing != nil && existing.Status == quotaStatusReleased {
+			return errQuotaReleased
+		}
+
+		usage, err := sumQuotaUsage(func(since time.Time) (float64, error) {
+			return l.svcCtx.LoanProductQuotaReservationsModel.SumReservedWithSession(ctx, session, product.Id, since)
+		}, product, now)
+		if err != nil {
+			return err
+		}
+		remaining = usage.remaining(product)
+		if existing != nil {
+			return nil
+		}
+
+		if remaining >= 0 && in.Amount > remaining+0.005 {
+			return errQuotaExceeded
+		}
+		if _, err := l.svcCtx.LoanProductQuotaReservationsModel.InsertWithSession(ctx, session, reservation); err != nil {
+			return err
+		}
+		if remaining >= 0 {
+			remaining -= in.Amount
+		}
+		return nil
+	})
+	switch {
+	case err == nil:
+	case err == model.ErrNotFound:
+		return nil, fmt.Errorf("产品不存在")
+	case errors.Is(err, errQuotaExceeded):
+		return nil, fmt.Errorf("额度不足，产品当前可用放贷额度%.2f元", remaining)
+	case errors.Is(err, errQuotaReleased):
+		return nil, err
+	default:
+		l.Errorf("占用放贷额度失败: %v", err)
+		return nil, fmt.Errorf("占用放贷额度失败")
+	}
+
+	_ = l.svcCtx.LoanProductQuotaReservationsModel.DelReservationCache(l.ctx, reservation)
+
+	return &loanproduct.ReserveLoanQuotaResp{
+		RemainingQuota: remaining,
+	}, nil
+}
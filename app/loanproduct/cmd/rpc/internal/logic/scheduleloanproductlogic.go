@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"common/productschedule"
+	"rpc/internal/svc"
+	"rpc/loanproduct"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ScheduleLoanProductLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewScheduleLoanProductLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ScheduleLoanProductLogic {
+	return &ScheduleLoanProductLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ScheduleLoanProductLogic) ScheduleLoanProduct(in *loanproduct.ScheduleLoanProductReq) (*loanproduct.ScheduleLoanProductResp, error) {
+	// 参数验证
+	if in.Id <= 0 {
+		return nil, fmt.Errorf("产品ID不能为空")
+	}
+	now := time.Now()
+	if err := productschedule.Validate(in.LaunchAt, in.DelistAt, now); err != nil {
+		return nil, fmt.Errorf("参数错误，%v", err)
+	}
+
+	// 检查产品是否存在
+	product, err := l.svcCtx.LoanProductModel.FindOne(l.ctx, uint64(in.Id))
+	if err != nil {
+		l.Errorf("查询产品失败: %v", err)
+		return nil, fmt.Errorf("产品不存在")
+	}
+
+	// 保存排期并按排期立即校正状态: 上架时间在未来的产品先下架,上架时间已到的产品立即上架
+	// 仅更新上下架状态与排期字段,期间状态或排期被修改、产品被删除时提示重试
+	updated := *product
+	updated.LaunchAt = productschedule.ToNullTime(in.LaunchAt)
+	updated.DelistAt = productschedule.ToNullTime(in.DelistAt)
+	updated.Status = productschedule.Status(product.Status, updated.LaunchAt, updated.DelistAt, now)
+
+	unchanged := updated.Status == product.Status &&
+		productschedule.ToUnix(product.LaunchAt) == in.LaunchAt && productschedule.ToUnix(product.DelistAt) == in.DelistAt
+	if !unchanged {
+		ok, err := l.svcCtx.LoanProductModel.UpdateScheduleIfMatch(l.ctx, &updated, product)
+		if err != nil {
+			l.Errorf("保存产品排期失败: %v", err)
+			return nil, fmt.Errorf("保存排期失败")
+		}
+		if !ok {
+			return nil, fmt.Errorf("状态错误，产品状态或排期已变化，请刷新后重试")
+		}
+	}
+
+	// 查询更新后的产品信息
+	productResp, err := NewGetLoanProductLogic(l.ctx, l.svcCtx).GetLoanProduct(&loanproduct.GetLoanProductReq{
+		Id: in.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &loanproduct.ScheduleLoanProductResp{
+		Data: productResp.Data,
+	}, nil
+}
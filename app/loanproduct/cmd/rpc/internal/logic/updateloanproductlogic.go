@@ -3,8 +3,12 @@ package logic
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
+	"common/productschedule"
+	"common/productversion"
+	"model"
 	"rpc/internal/svc"
 	"rpc/loanproduct"
 
@@ -43,22 +47,125 @@ func (l *UpdateLoanProductLogic) UpdateLoanProduct(in *loanproduct.UpdateLoanPro
 		return nil, err
 	}
 
-	// 更新产品信息
-	product.Name = in.Name
-	product.Type = in.Type
-	product.MinAmount = in.MinAmount
-	product.MaxAmount = in.MaxAmount
-	product.MinDuration = uint64(in.MinDuration)
-	product.MaxDuration = uint64(in.MaxDuration)
-	product.InterestRate = in.InterestRate
-	product.Description = in.Description
-	product.UpdatedAt = time.Now()
+	// 校验还款模式
+	repaymentProfile, harvestMonths, err := normalizeRepaymentProfile(in.RepaymentProfile, in.GraceMonths, in.MinDuration, in.HarvestMonths)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验审批链
+	approvalChain, err := normalizeApprovalChain(in.ApprovalChain)
+	if err != nil {
+		return nil, err
+	}
+
+	// 校验准入规则
+	eligibilityRule, err := normalizeEligibilityRule(in.EligibilityRule)
+	if err != nil {
+		return nil, err
+	}
 
-	err = l.svcCtx.LoanProductModel.Update(l.ctx, product)
+	// 校验放贷额度
+	quotaPeriod, err := normalizeQuotaConfig(in.TotalBudget, in.PeriodQuota, in.QuotaPeriod)
 	if err != nil {
-		l.Errorf("更新产品失败: %v", err)
+		return nil, err
+	}
+
+	// 校验利率档位
+	rateTiers, err := normalizeRateTiers(in.RateTiers)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按请求组装修改后的条款,与当前条款比较得出变更字段
+	now := time.Now()
+	current := termsOf(product)
+	next := current
+	next.Name = in.Name
+	next.Type = in.Type
+	next.MinAmount = in.MinAmount
+	next.MaxAmount = in.MaxAmount
+	next.MinDuration = uint64(in.MinDuration)
+	next.MaxDuration = uint64(in.MaxDuration)
+	next.InterestRate = in.InterestRate
+	next.Description = in.Description
+	next.RepaymentProfile = repaymentProfile
+	next.GraceMonths = uint64(in.GraceMonths)
+	next.HarvestMonths = harvestMonths
+	next.PenaltyRate = in.PenaltyRate
+	next.PrepaymentFeeRate = in.PrepaymentFeeRate
+	next.OriginationFeeRate = in.OriginationFeeRate
+	next.ServiceFeeRate = in.ServiceFeeRate
+	next.GuaranteeFeeRate = in.GuaranteeFeeRate
+	next.LateFee = in.LateFee
+	next.ApprovalChain = approvalChain
+	next.EligibilityRule = eligibilityRule
+
+	// 放贷额度不属于条款,随本次修改立即生效
+	quotaChanged := product.TotalBudget != in.TotalBudget || product.PeriodQuota != in.PeriodQuota || product.QuotaPeriod != quotaPeriod
+	product.TotalBudget = in.TotalBudget
+	product.PeriodQuota = in.PeriodQuota
+	product.QuotaPeriod = quotaPeriod
+
+	// 条款有变化时生成新版本: 指定未来生效时间的版本待到期后由版本任务生效,否则立即生效
+	var version *model.LoanProductVersions
+	saved := false
+	if changes := productversion.Diff(current, next); len(changes) > 0 {
+		maxVersion, err := l.svcCtx.LoanProductVersionsModel.MaxVersion(l.ctx, product.Id)
+		if err != nil {
+			l.Errorf("查询产品版本失败: %v", err)
+			return nil, fmt.Errorf("更新产品失败")
+		}
+		number := max(maxVersion, product.Version) + 1
+
+		if productversion.IsScheduled(in.EffectiveFrom, now) {
+			version, err = newProductVersion(product.Id, number, next, changes, productversion.StatusScheduled, time.Unix(in.EffectiveFrom, 0), in.OperatorId, in.OperatorName)
+			if err == nil {
+				_, err = l.svcCtx.LoanProductVersionsModel.Insert(l.ctx, version)
+			}
+		} else {
+			version, err = newProductVersion(product.Id, number, next, changes, productversion.StatusActive, now, in.OperatorId, in.OperatorName)
+			if err == nil {
+				// 放贷额度有修改时随条款一并保存
+				saved, err = switchProductVersion(l.ctx, l.svcCtx, product.Id, version, now, func(locked *model.LoanProducts) error {
+					next.applyTo(locked)
+					if quotaChanged {
+						locked.TotalBudget = product.TotalBudget
+						locked.PeriodQuota = product.PeriodQuota
+						locked.QuotaPeriod = product.QuotaPeriod
+					}
+					return nil
+				})
+			}
+		}
+		if err != nil {
+			l.Errorf("更新产品失败: %v", err)
+			return nil, fmt.Errorf("更新产品失败")
+		}
+
+		if savedVersion, err := l.svcCtx.LoanProductVersionsModel.FindOneByProductIdVersion(l.ctx, product.Id, number); err == nil {
+			version = savedVersion
+		}
+	}
+	if quotaChanged && !saved {
+		if err := l.svcCtx.LoanProductModel.UpdateQuota(l.ctx, product); err != nil {
+			l.Errorf("更新产品放贷额度失败: %v", err)
+			return nil, fmt.Errorf("更新产品失败")
+		}
+	}
+
+	// 利率档位与放贷额度一样随本次修改立即生效,档位整体替换
+	currentTiers, err := loadRateTiers(l.ctx, l.svcCtx, product.Id)
+	if err != nil {
+		l.Errorf("查询产品利率档位失败: %v", err)
 		return nil, fmt.Errorf("更新产品失败")
 	}
+	if !slices.Equal(currentTiers, rateTiers) {
+		if err := l.svcCtx.LoanProductRateTiersModel.ReplaceByProductId(l.ctx, product.Id, rateTierRows(product.Id, rateTiers)); err != nil {
+			l.Errorf("更新产品利率档位失败: %v", err)
+			return nil, fmt.Errorf("更新产品失败")
+		}
+	}
 
 	// 查询更新后的产品信息
 	updatedProduct, err := l.svcCtx.LoanProductModel.FindOne(l.ctx, uint64(in.Id))
@@ -67,21 +174,48 @@ func (l *UpdateLoanProductLogic) UpdateLoanProduct(in *loanproduct.UpdateLoanPro
 		return nil, fmt.Errorf("更新成功但查询失败")
 	}
 
+	var versionInfo *loanproduct.LoanProductVersionInfo
+	if version != nil {
+		versionInfo = convertProductVersion(version)
+	}
+
+	aprMin, aprMax := productAPRRange(updatedProduct, rateTiers)
 	return &loanproduct.UpdateLoanProductResp{
 		Data: &loanproduct.LoanProductInfo{
-			Id:           int64(updatedProduct.Id),
-			Name:         updatedProduct.Name,
-			Type:         updatedProduct.Type,
-			MinAmount:    updatedProduct.MinAmount,
-			MaxAmount:    updatedProduct.MaxAmount,
-			MinDuration:  int32(updatedProduct.MinDuration),
-			MaxDuration:  int32(updatedProduct.MaxDuration),
-			InterestRate: updatedProduct.InterestRate,
-			Description:  updatedProduct.Description,
-			Status:       int32(updatedProduct.Status),
-			CreatedAt:    updatedProduct.CreatedAt.Unix(),
-			UpdatedAt:    updatedProduct.UpdatedAt.Unix(),
+			Id:                 int64(updatedProduct.Id),
+			Name:               updatedProduct.Name,
+			Type:               updatedProduct.Type,
+			MinAmount:          updatedProduct.MinAmount,
+			MaxAmount:          updatedProduct.MaxAmount,
+			MinDuration:        int32(updatedProduct.MinDuration),
+			MaxDuration:        int32(updatedProduct.MaxDuration),
+			InterestRate:       updatedProduct.InterestRate,
+			Description:        updatedProduct.Description,
+			Status:             int32(updatedProduct.Status),
+			CreatedAt:          updatedProduct.CreatedAt.Unix(),
+			UpdatedAt:          updatedProduct.UpdatedAt.Unix(),
+			RepaymentProfile:   updatedProduct.RepaymentProfile,
+			GraceMonths:        int32(updatedProduct.GraceMonths),
+			HarvestMonths:      updatedProduct.HarvestMonths,
+			PenaltyRate:        updatedProduct.PenaltyRate,
+			PrepaymentFeeRate:  updatedProduct.PrepaymentFeeRate,
+			OriginationFeeRate: updatedProduct.OriginationFeeRate,
+			ServiceFeeRate:     updatedProduct.ServiceFeeRate,
+			GuaranteeFeeRate:   updatedProduct.GuaranteeFeeRate,
+			LateFee:            updatedProduct.LateFee,
+			ApprovalChain:      updatedProduct.ApprovalChain,
+			AprMin:             aprMin,
+			AprMax:             aprMax,
+			Version:            int32(updatedProduct.Version),
+			LaunchAt:           productschedule.ToUnix(updatedProduct.LaunchAt),
+			DelistAt:           productschedule.ToUnix(updatedProduct.DelistAt),
+			TotalBudget:        updatedProduct.TotalBudget,
+			PeriodQuota:        updatedProduct.PeriodQuota,
+			QuotaPeriod:        updatedProduct.QuotaPeriod,
+			EligibilityRule:    updatedProduct.EligibilityRule,
+			RateTiers:          convertRateTiers(rateTiers),
 		},
+		Version: versionInfo,
 	}, nil
 }
 
@@ -114,5 +248,23 @@ func (l *UpdateLoanProductLogic) validateUpdateRequest(in *loanproduct.UpdateLoa
 	if in.InterestRate <= 0 {
 		return fmt.Errorf("利率必须大于0")
 	}
+	if in.PenaltyRate < 0 || in.PenaltyRate > 1 {
+		return fmt.Errorf("罚息日利率应在0到1之间")
+	}
+	if in.PrepaymentFeeRate < 0 || in.PrepaymentFeeRate > 10 {
+		return fmt.Errorf("提前还款手续费率应在0到10之间")
+	}
+	if in.OriginationFeeRate < 0 || in.OriginationFeeRate > 10 {
+		return fmt.Errorf("手续费率应在0到10之间")
+	}
+	if in.ServiceFeeRate < 0 || in.ServiceFeeRate > 2 {
+		return fmt.Errorf("服务费月费率应在0到2之间")
+	}
+	if in.GuaranteeFeeRate < 0 || in.GuaranteeFeeRate > 10 {
+		return fmt.Errorf("担保费率应在0到10之间")
+	}
+	if in.LateFee < 0 {
+		return fmt.Errorf("逾期滞纳金不能小于0")
+	}
 	return nil
 }
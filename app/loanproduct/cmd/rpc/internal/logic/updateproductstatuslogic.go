@@ -2,10 +2,11 @@ package logic
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
-	"model"
+	"common/productschedule"
 	"rpc/internal/svc"
 	"rpc/loanproduct"
 
@@ -43,28 +44,29 @@ func (l *UpdateProductStatusLogic) UpdateProductStatus(in *loanproduct.UpdatePro
 		return nil, fmt.Errorf("产品不存在")
 	}
 
-	// 更新产品状态
-	updatedProduct := &model.LoanProducts{
-		Id:           existingProduct.Id,
-		ProductCode:  existingProduct.ProductCode,
-		Name:         existingProduct.Name,
-		Type:         existingProduct.Type,
-		MaxAmount:    existingProduct.MaxAmount,
-		MinAmount:    existingProduct.MinAmount,
-		MaxDuration:  existingProduct.MaxDuration,
-		MinDuration:  existingProduct.MinDuration,
-		InterestRate: existingProduct.InterestRate,
-		Description:  existingProduct.Description,
-		Status:       uint64(in.Status), // 只更新状态
-		CreatedAt:    existingProduct.CreatedAt,
-		UpdatedAt:    time.Now(),
+	// 已过计划下架时间的产品需先调整排期才能重新上架
+	now := time.Now()
+	if uint64(in.Status) == productschedule.StatusOnSale && productschedule.Expired(existingProduct.DelistAt, now) {
+		return nil, fmt.Errorf("状态错误，产品已过计划下架时间，请先调整排期")
 	}
 
-	err = l.svcCtx.LoanProductModel.Update(l.ctx, updatedProduct)
+	// 人工上下架优先于排期,清除计划上架时间,避免后台任务再次自动上架
+	if existingProduct.Status == uint64(in.Status) && !existingProduct.LaunchAt.Valid {
+		return &loanproduct.UpdateProductStatusResp{}, nil
+	}
+
+	// 仅更新上下架状态与排期字段，其余字段保持不变
+	updated := *existingProduct
+	updated.Status = uint64(in.Status)
+	updated.LaunchAt = sql.NullTime{}
+	ok, err := l.svcCtx.LoanProductModel.UpdateScheduleIfMatch(l.ctx, &updated, existingProduct)
 	if err != nil {
 		l.Errorf("更新产品状态失败: %v", err)
 		return nil, fmt.Errorf("更新状态失败")
 	}
+	if !ok {
+		return nil, fmt.Errorf("状态错误，产品状态或排期已变化，请刷新后重试")
+	}
 
 	return &loanproduct.UpdateProductStatusResp{}, nil
 }
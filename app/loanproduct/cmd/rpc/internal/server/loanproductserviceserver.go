@@ -34,6 +34,16 @@ func (s *LoanProductServiceServer) ListLoanProducts(ctx context.Context, in *loa
 	return l.ListLoanProducts(in)
 }
 
+func (s *LoanProductServiceServer) CalculateLoanQuote(ctx context.Context, in *loanproduct.CalculateLoanQuoteReq) (*loanproduct.CalculateLoanQuoteResp, error) {
+	l := logic.NewCalculateLoanQuoteLogic(ctx, s.svcCtx)
+	return l.CalculateLoanQuote(in)
+}
+
+func (s *LoanProductServiceServer) CheckEligibility(ctx context.Context, in *loanproduct.CheckEligibilityReq) (*loanproduct.CheckEligibilityResp, error) {
+	l := logic.NewCheckEligibilityLogic(ctx, s.svcCtx)
+	return l.CheckEligibility(in)
+}
+
 // 产品管理
 func (s *LoanProductServiceServer) CreateLoanProduct(ctx context.Context, in *loanproduct.CreateLoanProductReq) (*loanproduct.CreateLoanProductResp, error) {
 	l := logic.NewCreateLoanProductLogic(ctx, s.svcCtx)
@@ -54,3 +64,24 @@ func (s *LoanProductServiceServer) UpdateProductStatus(ctx context.Context, in *
 	l := logic.NewUpdateProductStatusLogic(ctx, s.svcCtx)
 	return l.UpdateProductStatus(in)
 }
+
+func (s *LoanProductServiceServer) ScheduleLoanProduct(ctx context.Context, in *loanproduct.ScheduleLoanProductReq) (*loanproduct.ScheduleLoanProductResp, error) {
+	l := logic.NewScheduleLoanProductLogic(ctx, s.svcCtx)
+	return l.ScheduleLoanProduct(in)
+}
+
+func (s *LoanProductServiceServer) ListLoanProductVersions(ctx context.Context, in *loanproduct.ListLoanProductVersionsReq) (*loanproduct.ListLoanProductVersionsResp, error) {
+	l := logic.NewListLoanProductVersionsLogic(ctx, s.svcCtx)
+	return l.ListLoanProductVersions(in)
+}
+
+// 放贷额度
+func (s *LoanProductServiceServer) ReserveLoanQuota(ctx context.Context, in *loanproduct.ReserveLoanQuotaReq) (*loanproduct.ReserveLoanQuotaResp, error) {
+	l := logic.NewReserveLoanQuotaLogic(ctx, s.svcCtx)
+	return l.ReserveLoanQuota(in)
+}
+
+func (s *LoanProductServiceServer) ReleaseLoanQuota(ctx context.Context, in *loanproduct.ReleaseLoanQuotaReq) (*loanproduct.ReleaseLoanQuotaResp, error) {
+	l := logic.NewReleaseLoanQuotaLogic(ctx, s.svcCtx)
+	return l.ReleaseLoanQuota(in)
+}
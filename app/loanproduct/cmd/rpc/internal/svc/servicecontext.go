@@ -1,21 +1,41 @@
 package svc
 
 import (
+	"appuserrpc/appuserclient"
+	"loanrpc/loanclient"
 	"model"
 	"rpc/internal/config"
 
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
-	Config           config.Config
-	LoanProductModel model.LoanProductsModel
+	Config                   config.Config
+	LoanProductModel         model.LoanProductsModel
+	LoanProductVersionsModel model.LoanProductVersionsModel
+	// 放贷额度占用记录
+	LoanProductQuotaReservationsModel model.LoanProductQuotaReservationsModel
+	// 利率档位
+	LoanProductRateTiersModel model.LoanProductRateTiersModel
+
+	// RPC 客户端 - 删除产品前检查贷款申请引用,准入校验时获取用户资料
+	LoanClient    loanclient.Loan
+	AppUserClient appuserclient.AppUser
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	conn := sqlx.NewMysql(c.MySQL.DataSource)
 	return &ServiceContext{
-		Config:           c,
-		LoanProductModel: model.NewLoanProductsModel(conn, c.CacheConf),
+		Config:                   c,
+		LoanProductModel:         model.NewLoanProductsModel(conn, c.CacheConf),
+		LoanProductVersionsModel: model.NewLoanProductVersionsModel(conn, c.CacheConf),
+
+		LoanProductQuotaReservationsModel: model.NewLoanProductQuotaReservationsModel(conn, c.CacheConf),
+		LoanProductRateTiersModel:         model.NewLoanProductRateTiersModel(conn, c.CacheConf),
+
+		// 通过consul服务发现初始化RPC客户端
+		LoanClient:    loanclient.NewLoan(zrpc.MustNewClient(c.LoanRpc)),
+		AppUserClient: appuserclient.NewAppUser(zrpc.MustNewClient(c.AppUserRpc)),
 	}
 }
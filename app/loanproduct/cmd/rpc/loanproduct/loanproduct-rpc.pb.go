@@ -23,22 +23,45 @@ const (
 
 // 贷款产品信息
 type LoanProductInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ProductCode   string                 `protobuf:"bytes,2,opt,name=productCode,proto3" json:"productCode,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	MaxAmount     float64                `protobuf:"fixed64,5,opt,name=maxAmount,proto3" json:"maxAmount,omitempty"`
-	MinAmount     float64                `protobuf:"fixed64,6,opt,name=minAmount,proto3" json:"minAmount,omitempty"`
-	MaxDuration   int32                  `protobuf:"varint,7,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`    // 最大期限(月)
-	MinDuration   int32                  `protobuf:"varint,8,opt,name=minDuration,proto3" json:"minDuration,omitempty"`    // 最小期限(月)
-	InterestRate  float64                `protobuf:"fixed64,9,opt,name=interestRate,proto3" json:"interestRate,omitempty"` // 年利率(%)
-	Description   string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
-	Status        int32                  `protobuf:"varint,11,opt,name=status,proto3" json:"status,omitempty"` // 1:上架 2:下架
-	CreatedAt     int64                  `protobuf:"varint,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,13,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductCode        string                 `protobuf:"bytes,2,opt,name=productCode,proto3" json:"productCode,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type               string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	MaxAmount          float64                `protobuf:"fixed64,5,opt,name=maxAmount,proto3" json:"maxAmount,omitempty"`
+	MinAmount          float64                `protobuf:"fixed64,6,opt,name=minAmount,proto3" json:"minAmount,omitempty"`
+	MaxDuration        int32                  `protobuf:"varint,7,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`    // 最大期限(月)
+	MinDuration        int32                  `protobuf:"varint,8,opt,name=minDuration,proto3" json:"minDuration,omitempty"`    // 最小期限(月)
+	InterestRate       float64                `protobuf:"fixed64,9,opt,name=interestRate,proto3" json:"interestRate,omitempty"` // 年利率(%)
+	Description        string                 `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	Status             int32                  `protobuf:"varint,11,opt,name=status,proto3" json:"status,omitempty"` // 1:上架 2:下架
+	CreatedAt          int64                  `protobuf:"varint,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt          int64                  `protobuf:"varint,13,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	RepaymentProfile   string                 `protobuf:"bytes,14,opt,name=repaymentProfile,proto3" json:"repaymentProfile,omitempty"`       // 还款模式 standard:按月还款 seasonal:按收获季还款
+	GraceMonths        int32                  `protobuf:"varint,15,opt,name=graceMonths,proto3" json:"graceMonths,omitempty"`                // 宽限期(月)
+	HarvestMonths      string                 `protobuf:"bytes,16,opt,name=harvestMonths,proto3" json:"harvestMonths,omitempty"`             // 收获月份,逗号分隔 如 9,10
+	PenaltyRate        float64                `protobuf:"fixed64,17,opt,name=penaltyRate,proto3" json:"penaltyRate,omitempty"`               // 罚息日利率(%)
+	PrepaymentFeeRate  float64                `protobuf:"fixed64,18,opt,name=prepaymentFeeRate,proto3" json:"prepaymentFeeRate,omitempty"`   // 提前还款手续费率(%)
+	OriginationFeeRate float64                `protobuf:"fixed64,20,opt,name=originationFeeRate,proto3" json:"originationFeeRate,omitempty"` // 手续费率(%),放款时按本金一次性收取
+	ServiceFeeRate     float64                `protobuf:"fixed64,21,opt,name=serviceFeeRate,proto3" json:"serviceFeeRate,omitempty"`         // 服务费月费率(%),按本金随每期还款收取
+	GuaranteeFeeRate   float64                `protobuf:"fixed64,22,opt,name=guaranteeFeeRate,proto3" json:"guaranteeFeeRate,omitempty"`     // 担保费率(%),放款时按本金一次性收取
+	LateFee            float64                `protobuf:"fixed64,23,opt,name=lateFee,proto3" json:"lateFee,omitempty"`                       // 逾期滞纳金(元/期)
+	ApprovalChain      string                 `protobuf:"bytes,19,opt,name=approvalChain,proto3" json:"approvalChain,omitempty"`             // 审批链配置(JSON),为空表示单级审批
+	AprMin             float64                `protobuf:"fixed64,24,opt,name=aprMin,proto3" json:"aprMin,omitempty"`                         // 综合年化利率下限(IRR,%),含利息及各项费用
+	AprMax             float64                `protobuf:"fixed64,25,opt,name=aprMax,proto3" json:"aprMax,omitempty"`                         // 综合年化利率上限(IRR,%),含利息及各项费用
+	Version            int32                  `protobuf:"varint,26,opt,name=version,proto3" json:"version,omitempty"`                        // 当前生效的条款版本号,0表示历史数据尚未建立版本
+	LaunchAt           int64                  `protobuf:"varint,27,opt,name=launchAt,proto3" json:"launchAt,omitempty"`                      // 计划上架时间,0表示不排期
+	DelistAt           int64                  `protobuf:"varint,28,opt,name=delistAt,proto3" json:"delistAt,omitempty"`                      // 计划下架时间,0表示长期有效
+	TotalBudget        float64                `protobuf:"fixed64,29,opt,name=totalBudget,proto3" json:"totalBudget,omitempty"`               // 放贷总额度(元),0表示不限
+	PeriodQuota        float64                `protobuf:"fixed64,30,opt,name=periodQuota,proto3" json:"periodQuota,omitempty"`               // 周期放贷额度(元),0表示不限
+	QuotaPeriod        string                 `protobuf:"bytes,31,opt,name=quotaPeriod,proto3" json:"quotaPeriod,omitempty"`                 // 额度周期 month:月 quarter:季 year:年
+	BudgetUsed         float64                `protobuf:"fixed64,32,opt,name=budgetUsed,proto3" json:"budgetUsed,omitempty"`                 // 已占用总额度(元),仅产品详情返回
+	PeriodUsed         float64                `protobuf:"fixed64,33,opt,name=periodUsed,proto3" json:"periodUsed,omitempty"`                 // 本周期已占用额度(元),仅产品详情返回
+	RemainingQuota     float64                `protobuf:"fixed64,34,opt,name=remainingQuota,proto3" json:"remainingQuota,omitempty"`         // 当前可用额度(元),取总额度与周期额度剩余的较小值,-1表示不限,仅产品详情返回
+	EligibilityRule    string                 `protobuf:"bytes,35,opt,name=eligibilityRule,proto3" json:"eligibilityRule,omitempty"`         // 准入规则配置(JSON),为空表示不限制
+	RateTiers          []*LoanRateTier        `protobuf:"bytes,36,rep,name=rateTiers,proto3" json:"rateTiers,omitempty"`                     // 利率档位,为空表示统一使用产品利率,仅产品详情及创建、修改返回
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *LoanProductInfo) Reset() {
@@ -162,6 +185,260 @@ func (x *LoanProductInfo) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *LoanProductInfo) GetRepaymentProfile() string {
+	if x != nil {
+		return x.RepaymentProfile
+	}
+	return ""
+}
+
+func (x *LoanProductInfo) GetGraceMonths() int32 {
+	if x != nil {
+		return x.GraceMonths
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetHarvestMonths() string {
+	if x != nil {
+		return x.HarvestMonths
+	}
+	return ""
+}
+
+func (x *LoanProductInfo) GetPenaltyRate() float64 {
+	if x != nil {
+		return x.PenaltyRate
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetPrepaymentFeeRate() float64 {
+	if x != nil {
+		return x.PrepaymentFeeRate
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetOriginationFeeRate() float64 {
+	if x != nil {
+		return x.OriginationFeeRate
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetServiceFeeRate() float64 {
+	if x != nil {
+		return x.ServiceFeeRate
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetGuaranteeFeeRate() float64 {
+	if x != nil {
+		return x.GuaranteeFeeRate
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetLateFee() float64 {
+	if x != nil {
+		return x.LateFee
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetApprovalChain() string {
+	if x != nil {
+		return x.ApprovalChain
+	}
+	return ""
+}
+
+func (x *LoanProductInfo) GetAprMin() float64 {
+	if x != nil {
+		return x.AprMin
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetAprMax() float64 {
+	if x != nil {
+		return x.AprMax
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetLaunchAt() int64 {
+	if x != nil {
+		return x.LaunchAt
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetDelistAt() int64 {
+	if x != nil {
+		return x.DelistAt
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetTotalBudget() float64 {
+	if x != nil {
+		return x.TotalBudget
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetPeriodQuota() float64 {
+	if x != nil {
+		return x.PeriodQuota
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetQuotaPeriod() string {
+	if x != nil {
+		return x.QuotaPeriod
+	}
+	return ""
+}
+
+func (x *LoanProductInfo) GetBudgetUsed() float64 {
+	if x != nil {
+		return x.BudgetUsed
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetPeriodUsed() float64 {
+	if x != nil {
+		return x.PeriodUsed
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetRemainingQuota() float64 {
+	if x != nil {
+		return x.RemainingQuota
+	}
+	return 0
+}
+
+func (x *LoanProductInfo) GetEligibilityRule() string {
+	if x != nil {
+		return x.EligibilityRule
+	}
+	return ""
+}
+
+func (x *LoanProductInfo) GetRateTiers() []*LoanRateTier {
+	if x != nil {
+		return x.RateTiers
+	}
+	return nil
+}
+
+// 利率档位 - 金额区间左闭右开,期限区间两端均包含,上限为0表示不设上限
+type LoanRateTier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinAmount     float64                `protobuf:"fixed64,1,opt,name=minAmount,proto3" json:"minAmount,omitempty"`       // 最小金额(元),含
+	MaxAmount     float64                `protobuf:"fixed64,2,opt,name=maxAmount,proto3" json:"maxAmount,omitempty"`       // 最大金额(元),不含,0表示不设上限
+	MinDuration   int32                  `protobuf:"varint,3,opt,name=minDuration,proto3" json:"minDuration,omitempty"`    // 最小期限(月),含
+	MaxDuration   int32                  `protobuf:"varint,4,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`    // 最大期限(月),含,0表示不设上限
+	InterestRate  float64                `protobuf:"fixed64,5,opt,name=interestRate,proto3" json:"interestRate,omitempty"` // 挂牌年利率(%),报价使用
+	MinRate       float64                `protobuf:"fixed64,6,opt,name=minRate,proto3" json:"minRate,omitempty"`           // 审批可批准的最低年利率(%),0表示与挂牌利率相同
+	MaxRate       float64                `protobuf:"fixed64,7,opt,name=maxRate,proto3" json:"maxRate,omitempty"`           // 审批可批准的最高年利率(%),0表示与挂牌利率相同
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoanRateTier) Reset() {
+	*x = LoanRateTier{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanRateTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanRateTier) ProtoMessage() {}
+
+func (x *LoanRateTier) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanRateTier.ProtoReflect.Descriptor instead.
+func (*LoanRateTier) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoanRateTier) GetMinAmount() float64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *LoanRateTier) GetMaxAmount() float64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *LoanRateTier) GetMinDuration() int32 {
+	if x != nil {
+		return x.MinDuration
+	}
+	return 0
+}
+
+func (x *LoanRateTier) GetMaxDuration() int32 {
+	if x != nil {
+		return x.MaxDuration
+	}
+	return 0
+}
+
+func (x *LoanRateTier) GetInterestRate() float64 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
+
+func (x *LoanRateTier) GetMinRate() float64 {
+	if x != nil {
+		return x.MinRate
+	}
+	return 0
+}
+
+func (x *LoanRateTier) GetMaxRate() float64 {
+	if x != nil {
+		return x.MaxRate
+	}
+	return 0
+}
+
 // 添加删除操作响应
 type DeleteLoanProductResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -171,7 +448,7 @@ type DeleteLoanProductResp struct {
 
 func (x *DeleteLoanProductResp) Reset() {
 	*x = DeleteLoanProductResp{}
-	mi := &file_loanproduct_rpc_proto_msgTypes[1]
+	mi := &file_loanproduct_rpc_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -183,7 +460,7 @@ func (x *DeleteLoanProductResp) String() string {
 func (*DeleteLoanProductResp) ProtoMessage() {}
 
 func (x *DeleteLoanProductResp) ProtoReflect() protoreflect.Message {
-	mi := &file_loanproduct_rpc_proto_msgTypes[1]
+	mi := &file_loanproduct_rpc_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -196,7 +473,7 @@ func (x *DeleteLoanProductResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLoanProductResp.ProtoReflect.Descriptor instead.
 func (*DeleteLoanProductResp) Descriptor() ([]byte, []int) {
-	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{1}
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{2}
 }
 
 // 添加状态更新响应
@@ -208,7 +485,7 @@ type UpdateProductStatusResp struct {
 
 func (x *UpdateProductStatusResp) Reset() {
 	*x = UpdateProductStatusResp{}
-	mi := &file_loanproduct_rpc_proto_msgTypes[2]
+	mi := &file_loanproduct_rpc_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -220,7 +497,7 @@ func (x *UpdateProductStatusResp) String() string {
 func (*UpdateProductStatusResp) ProtoMessage() {}
 
 func (x *UpdateProductStatusResp) ProtoReflect() protoreflect.Message {
-	mi := &file_loanproduct_rpc_proto_msgTypes[2]
+	mi := &file_loanproduct_rpc_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -233,7 +510,7 @@ func (x *UpdateProductStatusResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductStatusResp.ProtoReflect.Descriptor instead.
 func (*UpdateProductStatusResp) Descriptor() ([]byte, []int) {
-	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{2}
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{3}
 }
 
 // 标准响应格式
@@ -246,7 +523,7 @@ type GetLoanProductResp struct {
 
 func (x *GetLoanProductResp) Reset() {
 	*x = GetLoanProductResp{}
-	mi := &file_loanproduct_rpc_proto_msgTypes[3]
+	mi := &file_loanproduct_rpc_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -258,7 +535,7 @@ func (x *GetLoanProductResp) String() string {
 func (*GetLoanProductResp) ProtoMessage() {}
 
 func (x *GetLoanProductResp) ProtoReflect() protoreflect.Message {
-	mi := &file_loanproduct_rpc_proto_msgTypes[3]
+	mi := &file_loanproduct_rpc_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -271,7 +548,7 @@ func (x *GetLoanProductResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLoanProductResp.ProtoReflect.Descriptor instead.
 func (*GetLoanProductResp) Descriptor() ([]byte, []int) {
-	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{3}
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetLoanProductResp) GetData() *LoanProductInfo {
@@ -290,7 +567,7 @@ type CreateLoanProductResp struct {
 
 func (x *CreateLoanProductResp) Reset() {
 	*x = CreateLoanProductResp{}
-	mi := &file_loanproduct_rpc_proto_msgTypes[4]
+	mi := &file_loanproduct_rpc_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -302,7 +579,7 @@ func (x *CreateLoanProductResp) String() string {
 func (*CreateLoanProductResp) ProtoMessage() {}
 
 func (x *CreateLoanProductResp) ProtoReflect() protoreflect.Message {
-	mi := &file_loanproduct_rpc_proto_msgTypes[4]
+	mi := &file_loanproduct_rpc_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,7 +592,7 @@ func (x *CreateLoanProductResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLoanProductResp.ProtoReflect.Descriptor instead.
 func (*CreateLoanProductResp) Descriptor() ([]byte, []int) {
-	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{4}
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateLoanProductResp) GetData() *LoanProductInfo {
@@ -326,15 +603,16 @@ func (x *CreateLoanProductResp) GetData() *LoanProductInfo {
 }
 
 type UpdateLoanProductResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *LoanProductInfo       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Data          *LoanProductInfo        `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Version       *LoanProductVersionInfo `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // 本次修改产生的版本,条款无变化时为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateLoanProductResp) Reset() {
 	*x = UpdateLoanProductResp{}
-	mi := &file_loanproduct_rpc_proto_msgTypes[5]
+	mi := &file_loanproduct_rpc_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -346,7 +624,7 @@ func (x *UpdateLoanProductResp) String() string {
 func (*UpdateLoanProductResp) ProtoMessage() {}
 
 func (x *UpdateLoanProductResp) ProtoReflect() protoreflect.Message {
-	mi := &file_loanproduct_rpc_proto_msgTypes[5]
+	mi := &file_loanproduct_rpc_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -359,7 +637,7 @@ func (x *UpdateLoanProductResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLoanProductResp.ProtoReflect.Descriptor instead.
 func (*UpdateLoanProductResp) Descriptor() ([]byte, []int) {
-	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{5}
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateLoanProductResp) GetData() *LoanProductInfo {
@@ -369,6 +647,13 @@ func (x *UpdateLoanProductResp) GetData() *LoanProductInfo {
 	return nil
 }
 
+func (x *UpdateLoanProductResp) GetVersion() *LoanProductVersionInfo {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
 // 获取贷款产品 - 支持通过ID或产品编码查询
 type GetLoanProductReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -380,7 +665,7 @@ type GetLoanProductReq struct {
 
 func (x *GetLoanProductReq) Reset() {
 	*x = GetLoanProductReq{}
-	mi := &file_loanproduct_rpc_proto_msgTypes[6]
+	mi := &file_loanproduct_rpc_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -392,7 +677,7 @@ func (x *GetLoanProductReq) String() string {
 func (*GetLoanProductReq) ProtoMessage() {}
 
 func (x *GetLoanProductReq) ProtoReflect() protoreflect.Message {
-	mi := &file_loanproduct_rpc_proto_msgTypes[6]
+	mi := &file_loanproduct_rpc_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,7 +690,7 @@ func (x *GetLoanProductReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLoanProductReq.ProtoReflect.Descriptor instead.
 func (*GetLoanProductReq) Descriptor() ([]byte, []int) {
-	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{6}
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetLoanProductReq) GetId() int64 {
@@ -430,13 +715,14 @@ type ListLoanProductsReq struct {
 	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
 	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
 	Keyword       string                 `protobuf:"bytes,5,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	UserId        int64                  `protobuf:"varint,6,opt,name=userId,proto3" json:"userId,omitempty"` // 用户ID,大于0时只返回该用户满足准入规则的产品
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListLoanProductsReq) Reset() {
 	*x = ListLoanProductsReq{}
-	mi := &file_loanproduct_rpc_proto_msgTypes[7]
+	mi := &file_loanproduct_rpc_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -448,7 +734,7 @@ func (x *ListLoanProductsReq) String() string {
 func (*ListLoanProductsReq) ProtoMessage() {}
 
 func (x *ListLoanProductsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_loanproduct_rpc_proto_msgTypes[7]
+	mi := &file_loanproduct_rpc_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +747,7 @@ func (x *ListLoanProductsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLoanProductsReq.ProtoReflect.Descriptor instead.
 func (*ListLoanProductsReq) Descriptor() ([]byte, []int) {
-	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{7}
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListLoanProductsReq) GetPage() int32 {
@@ -499,6 +785,13 @@ func (x *ListLoanProductsReq) GetKeyword() string {
 	return ""
 }
 
+func (x *ListLoanProductsReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
 type ListLoanProductsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	List          []*LoanProductInfo     `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`    // 产品列表
@@ -509,7 +802,7 @@ type ListLoanProductsResp struct {
 
 func (x *ListLoanProductsResp) Reset() {
 	*x = ListLoanProductsResp{}
-	mi := &file_loanproduct_rpc_proto_msgTypes[8]
+	mi := &file_loanproduct_rpc_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -521,7 +814,7 @@ func (x *ListLoanProductsResp) String() string {
 func (*ListLoanProductsResp) ProtoMessage() {}
 
 func (x *ListLoanProductsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_loanproduct_rpc_proto_msgTypes[8]
+	mi := &file_loanproduct_rpc_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -534,7 +827,7 @@ func (x *ListLoanProductsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLoanProductsResp.ProtoReflect.Descriptor instead.
 func (*ListLoanProductsResp) Descriptor() ([]byte, []int) {
-	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{8}
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListLoanProductsResp) GetList() []*LoanProductInfo {
@@ -553,23 +846,40 @@ func (x *ListLoanProductsResp) GetTotal() int64 {
 
 // 创建贷款产品
 type CreateLoanProductReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductCode   string                 `protobuf:"bytes,1,opt,name=productCode,proto3" json:"productCode,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	MaxAmount     float64                `protobuf:"fixed64,4,opt,name=maxAmount,proto3" json:"maxAmount,omitempty"`
-	MinAmount     float64                `protobuf:"fixed64,5,opt,name=minAmount,proto3" json:"minAmount,omitempty"`
-	MaxDuration   int32                  `protobuf:"varint,6,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`
-	MinDuration   int32                  `protobuf:"varint,7,opt,name=minDuration,proto3" json:"minDuration,omitempty"`
-	InterestRate  float64                `protobuf:"fixed64,8,opt,name=interestRate,proto3" json:"interestRate,omitempty"`
-	Description   string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode        string                 `protobuf:"bytes,1,opt,name=productCode,proto3" json:"productCode,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type               string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	MaxAmount          float64                `protobuf:"fixed64,4,opt,name=maxAmount,proto3" json:"maxAmount,omitempty"`
+	MinAmount          float64                `protobuf:"fixed64,5,opt,name=minAmount,proto3" json:"minAmount,omitempty"`
+	MaxDuration        int32                  `protobuf:"varint,6,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`
+	MinDuration        int32                  `protobuf:"varint,7,opt,name=minDuration,proto3" json:"minDuration,omitempty"`
+	InterestRate       float64                `protobuf:"fixed64,8,opt,name=interestRate,proto3" json:"interestRate,omitempty"`
+	Description        string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	RepaymentProfile   string                 `protobuf:"bytes,10,opt,name=repaymentProfile,proto3" json:"repaymentProfile,omitempty"` // standard/seasonal,默认standard
+	GraceMonths        int32                  `protobuf:"varint,11,opt,name=graceMonths,proto3" json:"graceMonths,omitempty"`
+	HarvestMonths      string                 `protobuf:"bytes,12,opt,name=harvestMonths,proto3" json:"harvestMonths,omitempty"`
+	PenaltyRate        float64                `protobuf:"fixed64,13,opt,name=penaltyRate,proto3" json:"penaltyRate,omitempty"`               // 罚息日利率(%)
+	PrepaymentFeeRate  float64                `protobuf:"fixed64,14,opt,name=prepaymentFeeRate,proto3" json:"prepaymentFeeRate,omitempty"`   // 提前还款手续费率(%)
+	OriginationFeeRate float64                `protobuf:"fixed64,16,opt,name=originationFeeRate,proto3" json:"originationFeeRate,omitempty"` // 手续费率(%),放款时按本金一次性收取
+	ServiceFeeRate     float64                `protobuf:"fixed64,17,opt,name=serviceFeeRate,proto3" json:"serviceFeeRate,omitempty"`         // 服务费月费率(%),按本金随每期还款收取
+	GuaranteeFeeRate   float64                `protobuf:"fixed64,18,opt,name=guaranteeFeeRate,proto3" json:"guaranteeFeeRate,omitempty"`     // 担保费率(%),放款时按本金一次性收取
+	LateFee            float64                `protobuf:"fixed64,19,opt,name=lateFee,proto3" json:"lateFee,omitempty"`                       // 逾期滞纳金(元/期)
+	ApprovalChain      string                 `protobuf:"bytes,15,opt,name=approvalChain,proto3" json:"approvalChain,omitempty"`             // 审批链配置(JSON),为空表示单级审批
+	OperatorId         int64                  `protobuf:"varint,20,opt,name=operatorId,proto3" json:"operatorId,omitempty"`                  // 操作人ID
+	OperatorName       string                 `protobuf:"bytes,21,opt,name=operatorName,proto3" json:"operatorName,omitempty"`               // 操作人姓名
+	TotalBudget        float64                `protobuf:"fixed64,22,opt,name=totalBudget,proto3" json:"totalBudget,omitempty"`               // 放贷总额度(元),0表示不限
+	PeriodQuota        float64                `protobuf:"fixed64,23,opt,name=periodQuota,proto3" json:"periodQuota,omitempty"`               // 周期放贷额度(元),0表示不限
+	QuotaPeriod        string                 `protobuf:"bytes,24,opt,name=quotaPeriod,proto3" json:"quotaPeriod,omitempty"`                 // 额度周期 month/quarter/year,默认year
+	EligibilityRule    string                 `protobuf:"bytes,25,opt,name=eligibilityRule,proto3" json:"eligibilityRule,omitempty"`         // 准入规则配置(JSON),为空表示不限制
+	RateTiers          []*LoanRateTier        `protobuf:"bytes,26,rep,name=rateTiers,proto3" json:"rateTiers,omitempty"`                     // 利率档位,为空表示统一使用产品利率
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *CreateLoanProductReq) Reset() {
 	*x = CreateLoanProductReq{}
-	mi := &file_loanproduct_rpc_proto_msgTypes[9]
+	mi := &file_loanproduct_rpc_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -581,7 +891,7 @@ func (x *CreateLoanProductReq) String() string {
 func (*CreateLoanProductReq) ProtoMessage() {}
 
 func (x *CreateLoanProductReq) ProtoReflect() protoreflect.Message {
-	mi := &file_loanproduct_rpc_proto_msgTypes[9]
+	mi := &file_loanproduct_rpc_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +904,7 @@ func (x *CreateLoanProductReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateLoanProductReq.ProtoReflect.Descriptor instead.
 func (*CreateLoanProductReq) Descriptor() ([]byte, []int) {
-	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{9}
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateLoanProductReq) GetProductCode() string {
@@ -660,25 +970,162 @@ func (x *CreateLoanProductReq) GetDescription() string {
 	return ""
 }
 
+func (x *CreateLoanProductReq) GetRepaymentProfile() string {
+	if x != nil {
+		return x.RepaymentProfile
+	}
+	return ""
+}
+
+func (x *CreateLoanProductReq) GetGraceMonths() int32 {
+	if x != nil {
+		return x.GraceMonths
+	}
+	return 0
+}
+
+func (x *CreateLoanProductReq) GetHarvestMonths() string {
+	if x != nil {
+		return x.HarvestMonths
+	}
+	return ""
+}
+
+func (x *CreateLoanProductReq) GetPenaltyRate() float64 {
+	if x != nil {
+		return x.PenaltyRate
+	}
+	return 0
+}
+
+func (x *CreateLoanProductReq) GetPrepaymentFeeRate() float64 {
+	if x != nil {
+		return x.PrepaymentFeeRate
+	}
+	return 0
+}
+
+func (x *CreateLoanProductReq) GetOriginationFeeRate() float64 {
+	if x != nil {
+		return x.OriginationFeeRate
+	}
+	return 0
+}
+
+func (x *CreateLoanProductReq) GetServiceFeeRate() float64 {
+	if x != nil {
+		return x.ServiceFeeRate
+	}
+	return 0
+}
+
+func (x *CreateLoanProductReq) GetGuaranteeFeeRate() float64 {
+	if x != nil {
+		return x.GuaranteeFeeRate
+	}
+	return 0
+}
+
+func (x *CreateLoanProductReq) GetLateFee() float64 {
+	if x != nil {
+		return x.LateFee
+	}
+	return 0
+}
+
+func (x *CreateLoanProductReq) GetApprovalChain() string {
+	if x != nil {
+		return x.ApprovalChain
+	}
+	return ""
+}
+
+func (x *CreateLoanProductReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *CreateLoanProductReq) GetOperatorName() string {
+	if x != nil {
+		return x.OperatorName
+	}
+	return ""
+}
+
+func (x *CreateLoanProductReq) GetTotalBudget() float64 {
+	if x != nil {
+		return x.TotalBudget
+	}
+	return 0
+}
+
+func (x *CreateLoanProductReq) GetPeriodQuota() float64 {
+	if x != nil {
+		return x.PeriodQuota
+	}
+	return 0
+}
+
+func (x *CreateLoanProductReq) GetQuotaPeriod() string {
+	if x != nil {
+		return x.QuotaPeriod
+	}
+	return ""
+}
+
+func (x *CreateLoanProductReq) GetEligibilityRule() string {
+	if x != nil {
+		return x.EligibilityRule
+	}
+	return ""
+}
+
+func (x *CreateLoanProductReq) GetRateTiers() []*LoanRateTier {
+	if x != nil {
+		return x.RateTiers
+	}
+	return nil
+}
+
 // 更新贷款产品
 type UpdateLoanProductReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	MaxAmount     float64                `protobuf:"fixed64,4,opt,name=maxAmount,proto3" json:"maxAmount,omitempty"`
-	MinAmount     float64                `protobuf:"fixed64,5,opt,name=minAmount,proto3" json:"minAmount,omitempty"`
-	MaxDuration   int32                  `protobuf:"varint,6,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`
-	MinDuration   int32                  `protobuf:"varint,7,opt,name=minDuration,proto3" json:"minDuration,omitempty"`
-	InterestRate  float64                `protobuf:"fixed64,8,opt,name=interestRate,proto3" json:"interestRate,omitempty"`
-	Description   string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type               string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	MaxAmount          float64                `protobuf:"fixed64,4,opt,name=maxAmount,proto3" json:"maxAmount,omitempty"`
+	MinAmount          float64                `protobuf:"fixed64,5,opt,name=minAmount,proto3" json:"minAmount,omitempty"`
+	MaxDuration        int32                  `protobuf:"varint,6,opt,name=maxDuration,proto3" json:"maxDuration,omitempty"`
+	MinDuration        int32                  `protobuf:"varint,7,opt,name=minDuration,proto3" json:"minDuration,omitempty"`
+	InterestRate       float64                `protobuf:"fixed64,8,opt,name=interestRate,proto3" json:"interestRate,omitempty"`
+	Description        string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	RepaymentProfile   string                 `protobuf:"bytes,10,opt,name=repaymentProfile,proto3" json:"repaymentProfile,omitempty"` // standard/seasonal,默认standard
+	GraceMonths        int32                  `protobuf:"varint,11,opt,name=graceMonths,proto3" json:"graceMonths,omitempty"`
+	HarvestMonths      string                 `protobuf:"bytes,12,opt,name=harvestMonths,proto3" json:"harvestMonths,omitempty"`
+	PenaltyRate        float64                `protobuf:"fixed64,13,opt,name=penaltyRate,proto3" json:"penaltyRate,omitempty"`               // 罚息日利率(%)
+	PrepaymentFeeRate  float64                `protobuf:"fixed64,14,opt,name=prepaymentFeeRate,proto3" json:"prepaymentFeeRate,omitempty"`   // 提前还款手续费率(%)
+	OriginationFeeRate float64                `protobuf:"fixed64,16,opt,name=originationFeeRate,proto3" json:"originationFeeRate,omitempty"` // 手续费率(%),放款时按本金一次性收取
+	ServiceFeeRate     float64                `protobuf:"fixed64,17,opt,name=serviceFeeRate,proto3" json:"serviceFeeRate,omitempty"`         // 服务费月费率(%),按本金随每期还款收取
+	GuaranteeFeeRate   float64                `protobuf:"fixed64,18,opt,name=guaranteeFeeRate,proto3" json:"guaranteeFeeRate,omitempty"`     // 担保费率(%),放款时按本金一次性收取
+	LateFee            float64                `protobuf:"fixed64,19,opt,name=lateFee,proto3" json:"lateFee,omitempty"`                       // 逾期滞纳金(元/期)
+	ApprovalChain      string                 `protobuf:"bytes,15,opt,name=approvalChain,proto3" json:"approvalChain,omitempty"`             // 审批链配置(JSON),为空表示单级审批
+	EffectiveFrom      int64                  `protobuf:"varint,20,opt,name=effectiveFrom,proto3" json:"effectiveFrom,omitempty"`            // 生效时间(Unix秒),为0或早于当前时间时立即生效,否则到期后自动生效
+	OperatorId         int64                  `protobuf:"varint,21,opt,name=operatorId,proto3" json:"operatorId,omitempty"`                  // 操作人ID
+	OperatorName       string                 `protobuf:"bytes,22,opt,name=operatorName,proto3" json:"operatorName,omitempty"`               // 操作人姓名
+	TotalBudget        float64                `protobuf:"fixed64,23,opt,name=totalBudget,proto3" json:"totalBudget,omitempty"`               // 放贷总额度(元),0表示不限,修改立即生效且不产生版本
+	PeriodQuota        float64                `protobuf:"fixed64,24,opt,name=periodQuota,proto3" json:"periodQuota,omitempty"`               // 周期放贷额度(元),0表示不限,修改立即生效且不产生版本
+	QuotaPeriod        string                 `protobuf:"bytes,25,opt,name=quotaPeriod,proto3" json:"quotaPeriod,omitempty"`                 // 额度周期 month/quarter/year,默认year
+	EligibilityRule    string                 `protobuf:"bytes,26,opt,name=eligibilityRule,proto3" json:"eligibilityRule,omitempty"`         // 准入规则配置(JSON),为空表示不限制
+	RateTiers          []*LoanRateTier        `protobuf:"bytes,27,rep,name=rateTiers,proto3" json:"rateTiers,omitempty"`                     // 利率档位,整体替换原有档位,为空表示统一使用产品利率,修改立即生效且不产生版本
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *UpdateLoanProductReq) Reset() {
 	*x = UpdateLoanProductReq{}
-	mi := &file_loanproduct_rpc_proto_msgTypes[10]
+	mi := &file_loanproduct_rpc_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -690,7 +1137,7 @@ func (x *UpdateLoanProductReq) String() string {
 func (*UpdateLoanProductReq) ProtoMessage() {}
 
 func (x *UpdateLoanProductReq) ProtoReflect() protoreflect.Message {
-	mi := &file_loanproduct_rpc_proto_msgTypes[10]
+	mi := &file_loanproduct_rpc_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,7 +1150,7 @@ func (x *UpdateLoanProductReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateLoanProductReq.ProtoReflect.Descriptor instead.
 func (*UpdateLoanProductReq) Descriptor() ([]byte, []int) {
-	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{10}
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateLoanProductReq) GetId() int64 {
@@ -769,6 +1216,132 @@ func (x *UpdateLoanProductReq) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateLoanProductReq) GetRepaymentProfile() string {
+	if x != nil {
+		return x.RepaymentProfile
+	}
+	return ""
+}
+
+func (x *UpdateLoanProductReq) GetGraceMonths() int32 {
+	if x != nil {
+		return x.GraceMonths
+	}
+	return 0
+}
+
+func (x *UpdateLoanProductReq) GetHarvestMonths() string {
+	if x != nil {
+		return x.HarvestMonths
+	}
+	return ""
+}
+
+func (x *UpdateLoanProductReq) GetPenaltyRate() float64 {
+	if x != nil {
+		return x.PenaltyRate
+	}
+	return 0
+}
+
+func (x *UpdateLoanProductReq) GetPrepaymentFeeRate() float64 {
+	if x != nil {
+		return x.PrepaymentFeeRate
+	}
+	return 0
+}
+
+func (x *UpdateLoanProductReq) GetOriginationFeeRate() float64 {
+	if x != nil {
+		return x.OriginationFeeRate
+	}
+	return 0
+}
+
+func (x *UpdateLoanProductReq) GetServiceFeeRate() float64 {
+	if x != nil {
+		return x.ServiceFeeRate
+	}
+	return 0
+}
+
+func (x *UpdateLoanProductReq) GetGuaranteeFeeRate() float64 {
+	if x != nil {
+		return x.GuaranteeFeeRate
+	}
+	return 0
+}
+
+func (x *UpdateLoanProductReq) GetLateFee() float64 {
+	if x != nil {
+		return x.LateFee
+	}
+	return 0
+}
+
+func (x *UpdateLoanProductReq) GetApprovalChain() string {
+	if x != nil {
+		return x.ApprovalChain
+	}
+	return ""
+}
+
+func (x *UpdateLoanProductReq) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+func (x *UpdateLoanProductReq) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *UpdateLoanProductReq) GetOperatorName() string {
+	if x != nil {
+		return x.OperatorName
+	}
+	return ""
+}
+
+func (x *UpdateLoanProductReq) GetTotalBudget() float64 {
+	if x != nil {
+		return x.TotalBudget
+	}
+	return 0
+}
+
+func (x *UpdateLoanProductReq) GetPeriodQuota() float64 {
+	if x != nil {
+		return x.PeriodQuota
+	}
+	return 0
+}
+
+func (x *UpdateLoanProductReq) GetQuotaPeriod() string {
+	if x != nil {
+		return x.QuotaPeriod
+	}
+	return ""
+}
+
+func (x *UpdateLoanProductReq) GetEligibilityRule() string {
+	if x != nil {
+		return x.EligibilityRule
+	}
+	return ""
+}
+
+func (x *UpdateLoanProductReq) GetRateTiers() []*LoanRateTier {
+	if x != nil {
+		return x.RateTiers
+	}
+	return nil
+}
+
 // 删除贷款产品
 type DeleteLoanProductReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -779,7 +1352,7 @@ type DeleteLoanProductReq struct {
 
 func (x *DeleteLoanProductReq) Reset() {
 	*x = DeleteLoanProductReq{}
-	mi := &file_loanproduct_rpc_proto_msgTypes[11]
+	mi := &file_loanproduct_rpc_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -791,7 +1364,7 @@ func (x *DeleteLoanProductReq) String() string {
 func (*DeleteLoanProductReq) ProtoMessage() {}
 
 func (x *DeleteLoanProductReq) ProtoReflect() protoreflect.Message {
-	mi := &file_loanproduct_rpc_proto_msgTypes[11]
+	mi := &file_loanproduct_rpc_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -804,7 +1377,7 @@ func (x *DeleteLoanProductReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLoanProductReq.ProtoReflect.Descriptor instead.
 func (*DeleteLoanProductReq) Descriptor() ([]byte, []int) {
-	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{11}
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteLoanProductReq) GetId() int64 {
@@ -825,7 +1398,7 @@ type UpdateProductStatusReq struct {
 
 func (x *UpdateProductStatusReq) Reset() {
 	*x = UpdateProductStatusReq{}
-	mi := &file_loanproduct_rpc_proto_msgTypes[12]
+	mi := &file_loanproduct_rpc_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -837,7 +1410,7 @@ func (x *UpdateProductStatusReq) String() string {
 func (*UpdateProductStatusReq) ProtoMessage() {}
 
 func (x *UpdateProductStatusReq) ProtoReflect() protoreflect.Message {
-	mi := &file_loanproduct_rpc_proto_msgTypes[12]
+	mi := &file_loanproduct_rpc_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -850,7 +1423,7 @@ func (x *UpdateProductStatusReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductStatusReq.ProtoReflect.Descriptor instead.
 func (*UpdateProductStatusReq) Descriptor() ([]byte, []int) {
-	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{12}
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateProductStatusReq) GetId() int64 {
@@ -867,78 +1440,1335 @@ func (x *UpdateProductStatusReq) GetStatus() int32 {
 	return 0
 }
 
-var File_loanproduct_rpc_proto protoreflect.FileDescriptor
+// 排期上下架 - 到期由后台任务自动切换状态,时间传0表示清除排期
+type ScheduleLoanProductReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LaunchAt      int64                  `protobuf:"varint,2,opt,name=launchAt,proto3" json:"launchAt,omitempty"` // 计划上架时间(Unix秒)
+	DelistAt      int64                  `protobuf:"varint,3,opt,name=delistAt,proto3" json:"delistAt,omitempty"` // 计划下架时间(Unix秒)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_loanproduct_rpc_proto_rawDesc = "" +
-	"\n" +
-	"\x15loanproduct-rpc.proto\x12\vloanproduct\"\x85\x03\n" +
-	"\x0fLoanProductInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12 \n" +
-	"\vproductCode\x18\x02 \x01(\tR\vproductCode\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
-	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1c\n" +
-	"\tmaxAmount\x18\x05 \x01(\x01R\tmaxAmount\x12\x1c\n" +
-	"\tminAmount\x18\x06 \x01(\x01R\tminAmount\x12 \n" +
-	"\vmaxDuration\x18\a \x01(\x05R\vmaxDuration\x12 \n" +
-	"\vminDuration\x18\b \x01(\x05R\vminDuration\x12\"\n" +
-	"\finterestRate\x18\t \x01(\x01R\finterestRate\x12 \n" +
-	"\vdescription\x18\n" +
-	" \x01(\tR\vdescription\x12\x16\n" +
-	"\x06status\x18\v \x01(\x05R\x06status\x12\x1c\n" +
-	"\tcreatedAt\x18\f \x01(\x03R\tcreatedAt\x12\x1c\n" +
-	"\tupdatedAt\x18\r \x01(\x03R\tupdatedAt\"\x17\n" +
-	"\x15DeleteLoanProductResp\"\x19\n" +
-	"\x17UpdateProductStatusResp\"F\n" +
-	"\x12GetLoanProductResp\x120\n" +
-	"\x04data\x18\x01 \x01(\v2\x1c.loanproduct.LoanProductInfoR\x04data\"I\n" +
-	"\x15CreateLoanProductResp\x120\n" +
-	"\x04data\x18\x01 \x01(\v2\x1c.loanproduct.LoanProductInfoR\x04data\"I\n" +
-	"\x15UpdateLoanProductResp\x120\n" +
-	"\x04data\x18\x01 \x01(\v2\x1c.loanproduct.LoanProductInfoR\x04data\"E\n" +
-	"\x11GetLoanProductReq\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12 \n" +
-	"\vproductCode\x18\x02 \x01(\tR\vproductCode\"\x83\x01\n" +
-	"\x13ListLoanProductsReq\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
-	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x18\n" +
-	"\akeyword\x18\x05 \x01(\tR\akeyword\"^\n" +
-	"\x14ListLoanProductsResp\x120\n" +
-	"\x04list\x18\x01 \x03(\v2\x1c.loanproduct.LoanProductInfoR\x04list\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"\xa6\x02\n" +
-	"\x14CreateLoanProductReq\x12 \n" +
-	"\vproductCode\x18\x01 \x01(\tR\vproductCode\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1c\n" +
-	"\tmaxAmount\x18\x04 \x01(\x01R\tmaxAmount\x12\x1c\n" +
-	"\tminAmount\x18\x05 \x01(\x01R\tminAmount\x12 \n" +
-	"\vmaxDuration\x18\x06 \x01(\x05R\vmaxDuration\x12 \n" +
-	"\vminDuration\x18\a \x01(\x05R\vminDuration\x12\"\n" +
-	"\finterestRate\x18\b \x01(\x01R\finterestRate\x12 \n" +
-	"\vdescription\x18\t \x01(\tR\vdescription\"\x94\x02\n" +
-	"\x14UpdateLoanProductReq\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1c\n" +
-	"\tmaxAmount\x18\x04 \x01(\x01R\tmaxAmount\x12\x1c\n" +
-	"\tminAmount\x18\x05 \x01(\x01R\tminAmount\x12 \n" +
-	"\vmaxDuration\x18\x06 \x01(\x05R\vmaxDuration\x12 \n" +
-	"\vminDuration\x18\a \x01(\x05R\vminDuration\x12\"\n" +
-	"\finterestRate\x18\b \x01(\x01R\finterestRate\x12 \n" +
-	"\vdescription\x18\t \x01(\tR\vdescription\"&\n" +
-	"\x14DeleteLoanProductReq\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"@\n" +
-	"\x16UpdateProductStatusReq\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\x05R\x06status2\xb6\x04\n" +
+func (x *ScheduleLoanProductReq) Reset() {
+	*x = ScheduleLoanProductReq{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleLoanProductReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleLoanProductReq) ProtoMessage() {}
+
+func (x *ScheduleLoanProductReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleLoanProductReq.ProtoReflect.Descriptor instead.
+func (*ScheduleLoanProductReq) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ScheduleLoanProductReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduleLoanProductReq) GetLaunchAt() int64 {
+	if x != nil {
+		return x.LaunchAt
+	}
+	return 0
+}
+
+func (x *ScheduleLoanProductReq) GetDelistAt() int64 {
+	if x != nil {
+		return x.DelistAt
+	}
+	return 0
+}
+
+type ScheduleLoanProductResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *LoanProductInfo       `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleLoanProductResp) Reset() {
+	*x = ScheduleLoanProductResp{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleLoanProductResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleLoanProductResp) ProtoMessage() {}
+
+func (x *ScheduleLoanProductResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleLoanProductResp.ProtoReflect.Descriptor instead.
+func (*ScheduleLoanProductResp) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ScheduleLoanProductResp) GetData() *LoanProductInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 占用产品放贷额度 - 贷款审批通过时调用,同一申请重复占用时直接返回
+type ReserveLoanQuotaReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=productId,proto3" json:"productId,omitempty"`
+	ApplicationId string                 `protobuf:"bytes,2,opt,name=applicationId,proto3" json:"applicationId,omitempty"` // 贷款申请编号
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`             // 占用金额(元),即批准金额
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveLoanQuotaReq) Reset() {
+	*x = ReserveLoanQuotaReq{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveLoanQuotaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveLoanQuotaReq) ProtoMessage() {}
+
+func (x *ReserveLoanQuotaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveLoanQuotaReq.ProtoReflect.Descriptor instead.
+func (*ReserveLoanQuotaReq) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReserveLoanQuotaReq) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ReserveLoanQuotaReq) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *ReserveLoanQuotaReq) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ReserveLoanQuotaResp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RemainingQuota float64                `protobuf:"fixed64,1,opt,name=remainingQuota,proto3" json:"remainingQuota,omitempty"` // 占用后的可用额度(元),-1表示不限
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReserveLoanQuotaResp) Reset() {
+	*x = ReserveLoanQuotaResp{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveLoanQuotaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveLoanQuotaResp) ProtoMessage() {}
+
+func (x *ReserveLoanQuotaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveLoanQuotaResp.ProtoReflect.Descriptor instead.
+func (*ReserveLoanQuotaResp) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReserveLoanQuotaResp) GetRemainingQuota() float64 {
+	if x != nil {
+		return x.RemainingQuota
+	}
+	return 0
+}
+
+// 释放产品放贷额度 - 贷款申请撤销或拒绝时调用,未占用或已释放时直接返回
+type ReleaseLoanQuotaReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId string                 `protobuf:"bytes,1,opt,name=applicationId,proto3" json:"applicationId,omitempty"` // 贷款申请编号
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseLoanQuotaReq) Reset() {
+	*x = ReleaseLoanQuotaReq{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseLoanQuotaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLoanQuotaReq) ProtoMessage() {}
+
+func (x *ReleaseLoanQuotaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLoanQuotaReq.ProtoReflect.Descriptor instead.
+func (*ReleaseLoanQuotaReq) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReleaseLoanQuotaReq) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+type ReleaseLoanQuotaResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Released      bool                   `protobuf:"varint,1,opt,name=released,proto3" json:"released,omitempty"` // 本次是否释放了额度
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseLoanQuotaResp) Reset() {
+	*x = ReleaseLoanQuotaResp{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseLoanQuotaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseLoanQuotaResp) ProtoMessage() {}
+
+func (x *ReleaseLoanQuotaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseLoanQuotaResp.ProtoReflect.Descriptor instead.
+func (*ReleaseLoanQuotaResp) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReleaseLoanQuotaResp) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+// 准入校验 - 按产品准入规则校验用户资料(职业/年龄/月收入)
+type CheckEligibilityReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=productId,proto3" json:"productId,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckEligibilityReq) Reset() {
+	*x = CheckEligibilityReq{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckEligibilityReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckEligibilityReq) ProtoMessage() {}
+
+func (x *CheckEligibilityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckEligibilityReq.ProtoReflect.Descriptor instead.
+func (*CheckEligibilityReq) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CheckEligibilityReq) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CheckEligibilityReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type EligibilityReason struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`       // occupation:职业不符 age:年龄不符 income:收入不足 profile_gap:资料未填写
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"` // 原因说明
+	Limit         float64                `protobuf:"fixed64,3,opt,name=limit,proto3" json:"limit,omitempty"`   // 限额
+	Actual        float64                `protobuf:"fixed64,4,opt,name=actual,proto3" json:"actual,omitempty"` // 实际值
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EligibilityReason) Reset() {
+	*x = EligibilityReason{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EligibilityReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EligibilityReason) ProtoMessage() {}
+
+func (x *EligibilityReason) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EligibilityReason.ProtoReflect.Descriptor instead.
+func (*EligibilityReason) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *EligibilityReason) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *EligibilityReason) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EligibilityReason) GetLimit() float64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *EligibilityReason) GetActual() float64 {
+	if x != nil {
+		return x.Actual
+	}
+	return 0
+}
+
+type CheckEligibilityResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Eligible      bool                   `protobuf:"varint,1,opt,name=eligible,proto3" json:"eligible,omitempty"` // 是否满足准入规则
+	Reasons       []*EligibilityReason   `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`    // 未满足的条件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckEligibilityResp) Reset() {
+	*x = CheckEligibilityResp{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckEligibilityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckEligibilityResp) ProtoMessage() {}
+
+func (x *CheckEligibilityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckEligibilityResp.ProtoReflect.Descriptor instead.
+func (*CheckEligibilityResp) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CheckEligibilityResp) GetEligible() bool {
+	if x != nil {
+		return x.Eligible
+	}
+	return false
+}
+
+func (x *CheckEligibilityResp) GetReasons() []*EligibilityReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type CalculateLoanQuoteReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`             // 产品ID
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`    // 借款金额
+	Duration      int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"` // 借款期限(月)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateLoanQuoteReq) Reset() {
+	*x = CalculateLoanQuoteReq{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateLoanQuoteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateLoanQuoteReq) ProtoMessage() {}
+
+func (x *CalculateLoanQuoteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateLoanQuoteReq.ProtoReflect.Descriptor instead.
+func (*CalculateLoanQuoteReq) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CalculateLoanQuoteReq) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CalculateLoanQuoteReq) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CalculateLoanQuoteReq) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type QuoteInstallment struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	No                 int32                  `protobuf:"varint,1,opt,name=no,proto3" json:"no,omitempty"`                                  // 期数
+	DueDate            string                 `protobuf:"bytes,2,opt,name=dueDate,proto3" json:"dueDate,omitempty"`                         // 应还日期 yyyy-MM-dd
+	Principal          float64                `protobuf:"fixed64,3,opt,name=principal,proto3" json:"principal,omitempty"`                   // 应还本金
+	Interest           float64                `protobuf:"fixed64,4,opt,name=interest,proto3" json:"interest,omitempty"`                     // 应还利息
+	Total              float64                `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`                           // 应还总额
+	RemainingPrincipal float64                `protobuf:"fixed64,6,opt,name=remainingPrincipal,proto3" json:"remainingPrincipal,omitempty"` // 剩余本金
+	ServiceFee         float64                `protobuf:"fixed64,7,opt,name=serviceFee,proto3" json:"serviceFee,omitempty"`                 // 应还服务费(不计入应还总额)
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *QuoteInstallment) Reset() {
+	*x = QuoteInstallment{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteInstallment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteInstallment) ProtoMessage() {}
+
+func (x *QuoteInstallment) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteInstallment.ProtoReflect.Descriptor instead.
+func (*QuoteInstallment) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QuoteInstallment) GetNo() int32 {
+	if x != nil {
+		return x.No
+	}
+	return 0
+}
+
+func (x *QuoteInstallment) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *QuoteInstallment) GetPrincipal() float64 {
+	if x != nil {
+		return x.Principal
+	}
+	return 0
+}
+
+func (x *QuoteInstallment) GetInterest() float64 {
+	if x != nil {
+		return x.Interest
+	}
+	return 0
+}
+
+func (x *QuoteInstallment) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *QuoteInstallment) GetRemainingPrincipal() float64 {
+	if x != nil {
+		return x.RemainingPrincipal
+	}
+	return 0
+}
+
+func (x *QuoteInstallment) GetServiceFee() float64 {
+	if x != nil {
+		return x.ServiceFee
+	}
+	return 0
+}
+
+type LoanQuote struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RepaymentMethod string                 `protobuf:"bytes,1,opt,name=repaymentMethod,proto3" json:"repaymentMethod,omitempty"`  // 还款方式
+	MonthlyPayment  float64                `protobuf:"fixed64,2,opt,name=monthlyPayment,proto3" json:"monthlyPayment,omitempty"`  // 首期应还金额
+	TotalInterest   float64                `protobuf:"fixed64,3,opt,name=totalInterest,proto3" json:"totalInterest,omitempty"`    // 利息合计
+	TotalAmount     float64                `protobuf:"fixed64,4,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"`        // 本息合计
+	Apr             float64                `protobuf:"fixed64,5,opt,name=apr,proto3" json:"apr,omitempty"`                        // 综合年化利率(IRR,%),含利息及各项费用
+	Installments    []*QuoteInstallment    `protobuf:"bytes,6,rep,name=installments,proto3" json:"installments,omitempty"`        // 还款计划
+	OriginationFee  float64                `protobuf:"fixed64,7,opt,name=originationFee,proto3" json:"originationFee,omitempty"`  // 手续费
+	GuaranteeFee    float64                `protobuf:"fixed64,8,opt,name=guaranteeFee,proto3" json:"guaranteeFee,omitempty"`      // 担保费
+	ServiceFee      float64                `protobuf:"fixed64,9,opt,name=serviceFee,proto3" json:"serviceFee,omitempty"`          // 服务费合计
+	TotalFee        float64                `protobuf:"fixed64,10,opt,name=totalFee,proto3" json:"totalFee,omitempty"`             // 费用合计
+	ReceivedAmount  float64                `protobuf:"fixed64,11,opt,name=receivedAmount,proto3" json:"receivedAmount,omitempty"` // 实际到手金额
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LoanQuote) Reset() {
+	*x = LoanQuote{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanQuote) ProtoMessage() {}
+
+func (x *LoanQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanQuote.ProtoReflect.Descriptor instead.
+func (*LoanQuote) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LoanQuote) GetRepaymentMethod() string {
+	if x != nil {
+		return x.RepaymentMethod
+	}
+	return ""
+}
+
+func (x *LoanQuote) GetMonthlyPayment() float64 {
+	if x != nil {
+		return x.MonthlyPayment
+	}
+	return 0
+}
+
+func (x *LoanQuote) GetTotalInterest() float64 {
+	if x != nil {
+		return x.TotalInterest
+	}
+	return 0
+}
+
+func (x *LoanQuote) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *LoanQuote) GetApr() float64 {
+	if x != nil {
+		return x.Apr
+	}
+	return 0
+}
+
+func (x *LoanQuote) GetInstallments() []*QuoteInstallment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+func (x *LoanQuote) GetOriginationFee() float64 {
+	if x != nil {
+		return x.OriginationFee
+	}
+	return 0
+}
+
+func (x *LoanQuote) GetGuaranteeFee() float64 {
+	if x != nil {
+		return x.GuaranteeFee
+	}
+	return 0
+}
+
+func (x *LoanQuote) GetServiceFee() float64 {
+	if x != nil {
+		return x.ServiceFee
+	}
+	return 0
+}
+
+func (x *LoanQuote) GetTotalFee() float64 {
+	if x != nil {
+		return x.TotalFee
+	}
+	return 0
+}
+
+func (x *LoanQuote) GetReceivedAmount() float64 {
+	if x != nil {
+		return x.ReceivedAmount
+	}
+	return 0
+}
+
+type CalculateLoanQuoteResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=productId,proto3" json:"productId,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Duration      int32                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	InterestRate  float64                `protobuf:"fixed64,4,opt,name=interestRate,proto3" json:"interestRate,omitempty"` // 年利率(%),配置利率档位时为适用档位的挂牌利率
+	Quotes        []*LoanQuote           `protobuf:"bytes,5,rep,name=quotes,proto3" json:"quotes,omitempty"`               // 各还款方式试算结果
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateLoanQuoteResp) Reset() {
+	*x = CalculateLoanQuoteResp{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateLoanQuoteResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateLoanQuoteResp) ProtoMessage() {}
+
+func (x *CalculateLoanQuoteResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateLoanQuoteResp.ProtoReflect.Descriptor instead.
+func (*CalculateLoanQuoteResp) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CalculateLoanQuoteResp) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CalculateLoanQuoteResp) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CalculateLoanQuoteResp) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CalculateLoanQuoteResp) GetInterestRate() float64 {
+	if x != nil {
+		return x.InterestRate
+	}
+	return 0
+}
+
+func (x *CalculateLoanQuoteResp) GetQuotes() []*LoanQuote {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
+// 产品条款版本
+type ProductVersionChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"` // 变更字段
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`   // 变更前取值(JSON)
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`       // 变更后取值(JSON)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductVersionChange) Reset() {
+	*x = ProductVersionChange{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductVersionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductVersionChange) ProtoMessage() {}
+
+func (x *ProductVersionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductVersionChange.ProtoReflect.Descriptor instead.
+func (*ProductVersionChange) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ProductVersionChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ProductVersionChange) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ProductVersionChange) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type LoanProductVersionInfo struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int64                   `protobuf:"varint,2,opt,name=productId,proto3" json:"productId,omitempty"`
+	Version       int32                   `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`             // 版本号
+	Status        string                  `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                // scheduled:待生效 active:生效中 superseded:已失效 cancelled:已取消
+	Changes       []*ProductVersionChange `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`              // 相对上一版本的变更
+	EffectiveFrom int64                   `protobuf:"varint,6,opt,name=effectiveFrom,proto3" json:"effectiveFrom,omitempty"` // 生效时间
+	EffectiveTo   int64                   `protobuf:"varint,7,opt,name=effectiveTo,proto3" json:"effectiveTo,omitempty"`     // 失效时间,生效中或待生效时为0
+	OperatorId    int64                   `protobuf:"varint,8,opt,name=operatorId,proto3" json:"operatorId,omitempty"`       // 操作人ID
+	OperatorName  string                  `protobuf:"bytes,9,opt,name=operatorName,proto3" json:"operatorName,omitempty"`    // 操作人姓名
+	CreatedAt     int64                   `protobuf:"varint,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoanProductVersionInfo) Reset() {
+	*x = LoanProductVersionInfo{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoanProductVersionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoanProductVersionInfo) ProtoMessage() {}
+
+func (x *LoanProductVersionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoanProductVersionInfo.ProtoReflect.Descriptor instead.
+func (*LoanProductVersionInfo) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LoanProductVersionInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LoanProductVersionInfo) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *LoanProductVersionInfo) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *LoanProductVersionInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LoanProductVersionInfo) GetChanges() []*ProductVersionChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *LoanProductVersionInfo) GetEffectiveFrom() int64 {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return 0
+}
+
+func (x *LoanProductVersionInfo) GetEffectiveTo() int64 {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return 0
+}
+
+func (x *LoanProductVersionInfo) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *LoanProductVersionInfo) GetOperatorName() string {
+	if x != nil {
+		return x.OperatorName
+	}
+	return ""
+}
+
+func (x *LoanProductVersionInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListLoanProductVersionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=productId,proto3" json:"productId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoanProductVersionsReq) Reset() {
+	*x = ListLoanProductVersionsReq{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoanProductVersionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoanProductVersionsReq) ProtoMessage() {}
+
+func (x *ListLoanProductVersionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoanProductVersionsReq.ProtoReflect.Descriptor instead.
+func (*ListLoanProductVersionsReq) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListLoanProductVersionsReq) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type ListLoanProductVersionsResp struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	List          []*LoanProductVersionInfo `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"` // 按版本号倒序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLoanProductVersionsResp) Reset() {
+	*x = ListLoanProductVersionsResp{}
+	mi := &file_loanproduct_rpc_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLoanProductVersionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLoanProductVersionsResp) ProtoMessage() {}
+
+func (x *ListLoanProductVersionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_loanproduct_rpc_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLoanProductVersionsResp.ProtoReflect.Descriptor instead.
+func (*ListLoanProductVersionsResp) Descriptor() ([]byte, []int) {
+	return file_loanproduct_rpc_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListLoanProductVersionsResp) GetList() []*LoanProductVersionInfo {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+var File_loanproduct_rpc_proto protoreflect.FileDescriptor
+
+const file_loanproduct_rpc_proto_rawDesc = "" +
+	"\n" +
+	"\x15loanproduct-rpc.proto\x12\vloanproduct\"\xc0\t\n" +
+	"\x0fLoanProductInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12 \n" +
+	"\vproductCode\x18\x02 \x01(\tR\vproductCode\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1c\n" +
+	"\tmaxAmount\x18\x05 \x01(\x01R\tmaxAmount\x12\x1c\n" +
+	"\tminAmount\x18\x06 \x01(\x01R\tminAmount\x12 \n" +
+	"\vmaxDuration\x18\a \x01(\x05R\vmaxDuration\x12 \n" +
+	"\vminDuration\x18\b \x01(\x05R\vminDuration\x12\"\n" +
+	"\finterestRate\x18\t \x01(\x01R\finterestRate\x12 \n" +
+	"\vdescription\x18\n" +
+	" \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\v \x01(\x05R\x06status\x12\x1c\n" +
+	"\tcreatedAt\x18\f \x01(\x03R\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\r \x01(\x03R\tupdatedAt\x12*\n" +
+	"\x10repaymentProfile\x18\x0e \x01(\tR\x10repaymentProfile\x12 \n" +
+	"\vgraceMonths\x18\x0f \x01(\x05R\vgraceMonths\x12$\n" +
+	"\rharvestMonths\x18\x10 \x01(\tR\rharvestMonths\x12 \n" +
+	"\vpenaltyRate\x18\x11 \x01(\x01R\vpenaltyRate\x12,\n" +
+	"\x11prepaymentFeeRate\x18\x12 \x01(\x01R\x11prepaymentFeeRate\x12.\n" +
+	"\x12originationFeeRate\x18\x14 \x01(\x01R\x12originationFeeRate\x12&\n" +
+	"\x0eserviceFeeRate\x18\x15 \x01(\x01R\x0eserviceFeeRate\x12*\n" +
+	"\x10guaranteeFeeRate\x18\x16 \x01(\x01R\x10guaranteeFeeRate\x12\x18\n" +
+	"\alateFee\x18\x17 \x01(\x01R\alateFee\x12$\n" +
+	"\rapprovalChain\x18\x13 \x01(\tR\rapprovalChain\x12\x16\n" +
+	"\x06aprMin\x18\x18 \x01(\x01R\x06aprMin\x12\x16\n" +
+	"\x06aprMax\x18\x19 \x01(\x01R\x06aprMax\x12\x18\n" +
+	"\aversion\x18\x1a \x01(\x05R\aversion\x12\x1a\n" +
+	"\blaunchAt\x18\x1b \x01(\x03R\blaunchAt\x12\x1a\n" +
+	"\bdelistAt\x18\x1c \x01(\x03R\bdelistAt\x12 \n" +
+	"\vtotalBudget\x18\x1d \x01(\x01R\vtotalBudget\x12 \n" +
+	"\vperiodQuota\x18\x1e \x01(\x01R\vperiodQuota\x12 \n" +
+	"\vquotaPeriod\x18\x1f \x01(\tR\vquotaPeriod\x12\x1e\n" +
+	"\n" +
+	"budgetUsed\x18  \x01(\x01R\n" +
+	"budgetUsed\x12\x1e\n" +
+	"\n" +
+	"periodUsed\x18! \x01(\x01R\n" +
+	"periodUsed\x12&\n" +
+	"\x0eremainingQuota\x18\" \x01(\x01R\x0eremainingQuota\x12(\n" +
+	"\x0feligibilityRule\x18# \x01(\tR\x0feligibilityRule\x127\n" +
+	"\trateTiers\x18$ \x03(\v2\x19.loanproduct.LoanRateTierR\trateTiers\"\xe6\x01\n" +
+	"\fLoanRateTier\x12\x1c\n" +
+	"\tminAmount\x18\x01 \x01(\x01R\tminAmount\x12\x1c\n" +
+	"\tmaxAmount\x18\x02 \x01(\x01R\tmaxAmount\x12 \n" +
+	"\vminDuration\x18\x03 \x01(\x05R\vminDuration\x12 \n" +
+	"\vmaxDuration\x18\x04 \x01(\x05R\vmaxDuration\x12\"\n" +
+	"\finterestRate\x18\x05 \x01(\x01R\finterestRate\x12\x18\n" +
+	"\aminRate\x18\x06 \x01(\x01R\aminRate\x12\x18\n" +
+	"\amaxRate\x18\a \x01(\x01R\amaxRate\"\x17\n" +
+	"\x15DeleteLoanProductResp\"\x19\n" +
+	"\x17UpdateProductStatusResp\"F\n" +
+	"\x12GetLoanProductResp\x120\n" +
+	"\x04data\x18\x01 \x01(\v2\x1c.loanproduct.LoanProductInfoR\x04data\"I\n" +
+	"\x15CreateLoanProductResp\x120\n" +
+	"\x04data\x18\x01 \x01(\v2\x1c.loanproduct.LoanProductInfoR\x04data\"\x88\x01\n" +
+	"\x15UpdateLoanProductResp\x120\n" +
+	"\x04data\x18\x01 \x01(\v2\x1c.loanproduct.LoanProductInfoR\x04data\x12=\n" +
+	"\aversion\x18\x02 \x01(\v2#.loanproduct.LoanProductVersionInfoR\aversion\"E\n" +
+	"\x11GetLoanProductReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12 \n" +
+	"\vproductCode\x18\x02 \x01(\tR\vproductCode\"\x9b\x01\n" +
+	"\x13ListLoanProductsReq\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x18\n" +
+	"\akeyword\x18\x05 \x01(\tR\akeyword\x12\x16\n" +
+	"\x06userId\x18\x06 \x01(\x03R\x06userId\"^\n" +
+	"\x14ListLoanProductsResp\x120\n" +
+	"\x04list\x18\x01 \x03(\v2\x1c.loanproduct.LoanProductInfoR\x04list\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xbb\a\n" +
+	"\x14CreateLoanProductReq\x12 \n" +
+	"\vproductCode\x18\x01 \x01(\tR\vproductCode\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1c\n" +
+	"\tmaxAmount\x18\x04 \x01(\x01R\tmaxAmount\x12\x1c\n" +
+	"\tminAmount\x18\x05 \x01(\x01R\tminAmount\x12 \n" +
+	"\vmaxDuration\x18\x06 \x01(\x05R\vmaxDuration\x12 \n" +
+	"\vminDuration\x18\a \x01(\x05R\vminDuration\x12\"\n" +
+	"\finterestRate\x18\b \x01(\x01R\finterestRate\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\x12*\n" +
+	"\x10repaymentProfile\x18\n" +
+	" \x01(\tR\x10repaymentProfile\x12 \n" +
+	"\vgraceMonths\x18\v \x01(\x05R\vgraceMonths\x12$\n" +
+	"\rharvestMonths\x18\f \x01(\tR\rharvestMonths\x12 \n" +
+	"\vpenaltyRate\x18\r \x01(\x01R\vpenaltyRate\x12,\n" +
+	"\x11prepaymentFeeRate\x18\x0e \x01(\x01R\x11prepaymentFeeRate\x12.\n" +
+	"\x12originationFeeRate\x18\x10 \x01(\x01R\x12originationFeeRate\x12&\n" +
+	"\x0eserviceFeeRate\x18\x11 \x01(\x01R\x0eserviceFeeRate\x12*\n" +
+	"\x10guaranteeFeeRate\x18\x12 \x01(\x01R\x10guaranteeFeeRate\x12\x18\n" +
+	"\alateFee\x18\x13 \x01(\x01R\alateFee\x12$\n" +
+	"\rapprovalChain\x18\x0f \x01(\tR\rapprovalChain\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\x14 \x01(\x03R\n" +
+	"operatorId\x12\"\n" +
+	"\foperatorName\x18\x15 \x01(\tR\foperatorName\x12 \n" +
+	"\vtotalBudget\x18\x16 \x01(\x01R\vtotalBudget\x12 \n" +
+	"\vperiodQuota\x18\x17 \x01(\x01R\vperiodQuota\x12 \n" +
+	"\vquotaPeriod\x18\x18 \x01(\tR\vquotaPeriod\x12(\n" +
+	"\x0feligibilityRule\x18\x19 \x01(\tR\x0feligibilityRule\x127\n" +
+	"\trateTiers\x18\x1a \x03(\v2\x19.loanproduct.LoanRateTierR\trateTiers\"\xcf\a\n" +
+	"\x14UpdateLoanProductReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1c\n" +
+	"\tmaxAmount\x18\x04 \x01(\x01R\tmaxAmount\x12\x1c\n" +
+	"\tminAmount\x18\x05 \x01(\x01R\tminAmount\x12 \n" +
+	"\vmaxDuration\x18\x06 \x01(\x05R\vmaxDuration\x12 \n" +
+	"\vminDuration\x18\a \x01(\x05R\vminDuration\x12\"\n" +
+	"\finterestRate\x18\b \x01(\x01R\finterestRate\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\x12*\n" +
+	"\x10repaymentProfile\x18\n" +
+	" \x01(\tR\x10repaymentProfile\x12 \n" +
+	"\vgraceMonths\x18\v \x01(\x05R\vgraceMonths\x12$\n" +
+	"\rharvestMonths\x18\f \x01(\tR\rharvestMonths\x12 \n" +
+	"\vpenaltyRate\x18\r \x01(\x01R\vpenaltyRate\x12,\n" +
+	"\x11prepaymentFeeRate\x18\x0e \x01(\x01R\x11prepaymentFeeRate\x12.\n" +
+	"\x12originationFeeRate\x18\x10 \x01(\x01R\x12originationFeeRate\x12&\n" +
+	"\x0eserviceFeeRate\x18\x11 \x01(\x01R\x0eserviceFeeRate\x12*\n" +
+	"\x10guaranteeFeeRate\x18\x12 \x01(\x01R\x10guaranteeFeeRate\x12\x18\n" +
+	"\alateFee\x18\x13 \x01(\x01R\alateFee\x12$\n" +
+	"\rapprovalChain\x18\x0f \x01(\tR\rapprovalChain\x12$\n" +
+	"\reffectiveFrom\x18\x14 \x01(\x03R\reffectiveFrom\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\x15 \x01(\x03R\n" +
+	"operatorId\x12\"\n" +
+	"\foperatorName\x18\x16 \x01(\tR\foperatorName\x12 \n" +
+	"\vtotalBudget\x18\x17 \x01(\x01R\vtotalBudget\x12 \n" +
+	"\vperiodQuota\x18\x18 \x01(\x01R\vperiodQuota\x12 \n" +
+	"\vquotaPeriod\x18\x19 \x01(\tR\vquotaPeriod\x12(\n" +
+	"\x0feligibilityRule\x18\x1a \x01(\tR\x0feligibilityRule\x127\n" +
+	"\trateTiers\x18\x1b \x03(\v2\x19.loanproduct.LoanRateTierR\trateTiers\"&\n" +
+	"\x14DeleteLoanProductReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"@\n" +
+	"\x16UpdateProductStatusReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\"`\n" +
+	"\x16ScheduleLoanProductReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\blaunchAt\x18\x02 \x01(\x03R\blaunchAt\x12\x1a\n" +
+	"\bdelistAt\x18\x03 \x01(\x03R\bdelistAt\"K\n" +
+	"\x17ScheduleLoanProductResp\x120\n" +
+	"\x04data\x18\x01 \x01(\v2\x1c.loanproduct.LoanProductInfoR\x04data\"q\n" +
+	"\x13ReserveLoanQuotaReq\x12\x1c\n" +
+	"\tproductId\x18\x01 \x01(\x03R\tproductId\x12$\n" +
+	"\rapplicationId\x18\x02 \x01(\tR\rapplicationId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\">\n" +
+	"\x14ReserveLoanQuotaResp\x12&\n" +
+	"\x0eremainingQuota\x18\x01 \x01(\x01R\x0eremainingQuota\";\n" +
+	"\x13ReleaseLoanQuotaReq\x12$\n" +
+	"\rapplicationId\x18\x01 \x01(\tR\rapplicationId\"2\n" +
+	"\x14ReleaseLoanQuotaResp\x12\x1a\n" +
+	"\breleased\x18\x01 \x01(\bR\breleased\"K\n" +
+	"\x13CheckEligibilityReq\x12\x1c\n" +
+	"\tproductId\x18\x01 \x01(\x03R\tproductId\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x03R\x06userId\"o\n" +
+	"\x11EligibilityReason\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x01R\x05limit\x12\x16\n" +
+	"\x06actual\x18\x04 \x01(\x01R\x06actual\"l\n" +
+	"\x14CheckEligibilityResp\x12\x1a\n" +
+	"\beligible\x18\x01 \x01(\bR\beligible\x128\n" +
+	"\areasons\x18\x02 \x03(\v2\x1e.loanproduct.EligibilityReasonR\areasons\"[\n" +
+	"\x15CalculateLoanQuoteReq\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bduration\x18\x03 \x01(\x05R\bduration\"\xdc\x01\n" +
+	"\x10QuoteInstallment\x12\x0e\n" +
+	"\x02no\x18\x01 \x01(\x05R\x02no\x12\x18\n" +
+	"\adueDate\x18\x02 \x01(\tR\adueDate\x12\x1c\n" +
+	"\tprincipal\x18\x03 \x01(\x01R\tprincipal\x12\x1a\n" +
+	"\binterest\x18\x04 \x01(\x01R\binterest\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x01R\x05total\x12.\n" +
+	"\x12remainingPrincipal\x18\x06 \x01(\x01R\x12remainingPrincipal\x12\x1e\n" +
+	"\n" +
+	"serviceFee\x18\a \x01(\x01R\n" +
+	"serviceFee\"\xaa\x03\n" +
+	"\tLoanQuote\x12(\n" +
+	"\x0frepaymentMethod\x18\x01 \x01(\tR\x0frepaymentMethod\x12&\n" +
+	"\x0emonthlyPayment\x18\x02 \x01(\x01R\x0emonthlyPayment\x12$\n" +
+	"\rtotalInterest\x18\x03 \x01(\x01R\rtotalInterest\x12 \n" +
+	"\vtotalAmount\x18\x04 \x01(\x01R\vtotalAmount\x12\x10\n" +
+	"\x03apr\x18\x05 \x01(\x01R\x03apr\x12A\n" +
+	"\finstallments\x18\x06 \x03(\v2\x1d.loanproduct.QuoteInstallmentR\finstallments\x12&\n" +
+	"\x0eoriginationFee\x18\a \x01(\x01R\x0eoriginationFee\x12\"\n" +
+	"\fguaranteeFee\x18\b \x01(\x01R\fguaranteeFee\x12\x1e\n" +
+	"\n" +
+	"serviceFee\x18\t \x01(\x01R\n" +
+	"serviceFee\x12\x1a\n" +
+	"\btotalFee\x18\n" +
+	" \x01(\x01R\btotalFee\x12&\n" +
+	"\x0ereceivedAmount\x18\v \x01(\x01R\x0ereceivedAmount\"\xbe\x01\n" +
+	"\x16CalculateLoanQuoteResp\x12\x1c\n" +
+	"\tproductId\x18\x01 \x01(\x03R\tproductId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bduration\x18\x03 \x01(\x05R\bduration\x12\"\n" +
+	"\finterestRate\x18\x04 \x01(\x01R\finterestRate\x12.\n" +
+	"\x06quotes\x18\x05 \x03(\v2\x16.loanproduct.LoanQuoteR\x06quotes\"P\n" +
+	"\x14ProductVersionChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\xdf\x02\n" +
+	"\x16LoanProductVersionInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
+	"\tproductId\x18\x02 \x01(\x03R\tproductId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12;\n" +
+	"\achanges\x18\x05 \x03(\v2!.loanproduct.ProductVersionChangeR\achanges\x12$\n" +
+	"\reffectiveFrom\x18\x06 \x01(\x03R\reffectiveFrom\x12 \n" +
+	"\veffectiveTo\x18\a \x01(\x03R\veffectiveTo\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\b \x01(\x03R\n" +
+	"operatorId\x12\"\n" +
+	"\foperatorName\x18\t \x01(\tR\foperatorName\x12\x1c\n" +
+	"\tcreatedAt\x18\n" +
+	" \x01(\x03R\tcreatedAt\":\n" +
+	"\x1aListLoanProductVersionsReq\x12\x1c\n" +
+	"\tproductId\x18\x01 \x01(\x03R\tproductId\"V\n" +
+	"\x1bListLoanProductVersionsResp\x127\n" +
+	"\x04list\x18\x01 \x03(\v2#.loanproduct.LoanProductVersionInfoR\x04list2\xf0\b\n" +
 	"\x12LoanProductService\x12Q\n" +
 	"\x0eGetLoanProduct\x12\x1e.loanproduct.GetLoanProductReq\x1a\x1f.loanproduct.GetLoanProductResp\x12W\n" +
-	"\x10ListLoanProducts\x12 .loanproduct.ListLoanProductsReq\x1a!.loanproduct.ListLoanProductsResp\x12Z\n" +
+	"\x10ListLoanProducts\x12 .loanproduct.ListLoanProductsReq\x1a!.loanproduct.ListLoanProductsResp\x12]\n" +
+	"\x12CalculateLoanQuote\x12\".loanproduct.CalculateLoanQuoteReq\x1a#.loanproduct.CalculateLoanQuoteResp\x12W\n" +
+	"\x10CheckEligibility\x12 .loanproduct.CheckEligibilityReq\x1a!.loanproduct.CheckEligibilityResp\x12Z\n" +
 	"\x11CreateLoanProduct\x12!.loanproduct.CreateLoanProductReq\x1a\".loanproduct.CreateLoanProductResp\x12Z\n" +
 	"\x11UpdateLoanProduct\x12!.loanproduct.UpdateLoanProductReq\x1a\".loanproduct.UpdateLoanProductResp\x12Z\n" +
 	"\x11DeleteLoanProduct\x12!.loanproduct.DeleteLoanProductReq\x1a\".loanproduct.DeleteLoanProductResp\x12`\n" +
-	"\x13UpdateProductStatus\x12#.loanproduct.UpdateProductStatusReq\x1a$.loanproduct.UpdateProductStatusRespB\x0fZ\r./loanproductb\x06proto3"
+	"\x13UpdateProductStatus\x12#.loanproduct.UpdateProductStatusReq\x1a$.loanproduct.UpdateProductStatusResp\x12`\n" +
+	"\x13ScheduleLoanProduct\x12#.loanproduct.ScheduleLoanProductReq\x1a$.loanproduct.ScheduleLoanProductResp\x12l\n" +
+	"\x17ListLoanProductVersions\x12'.loanproduct.ListLoanProductVersionsReq\x1a(.loanproduct.ListLoanProductVersionsResp\x12W\n" +
+	"\x10ReserveLoanQuota\x12 .loanproduct.ReserveLoanQuotaReq\x1a!.loanproduct.ReserveLoanQuotaResp\x12W\n" +
+	"\x10ReleaseLoanQuota\x12 .loanproduct.ReleaseLoanQuotaReq\x1a!.loanproduct.ReleaseLoanQuotaRespB\x0fZ\r./loanproductb\x06proto3"
 
 var (
 	file_loanproduct_rpc_proto_rawDescOnce sync.Once
@@ -952,44 +2782,84 @@ func file_loanproduct_rpc_proto_rawDescGZIP() []byte {
 	return file_loanproduct_rpc_proto_rawDescData
 }
 
-var file_loanproduct_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_loanproduct_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_loanproduct_rpc_proto_goTypes = []any{
-	(*LoanProductInfo)(nil),         // 0: loanproduct.LoanProductInfo
-	(*DeleteLoanProductResp)(nil),   // 1: loanproduct.DeleteLoanProductResp
-	(*UpdateProductStatusResp)(nil), // 2: loanproduct.UpdateProductStatusResp
-	(*GetLoanProductResp)(nil),      // 3: loanproduct.GetLoanProductResp
-	(*CreateLoanProductResp)(nil),   // 4: loanproduct.CreateLoanProductResp
-	(*UpdateLoanProductResp)(nil),   // 5: loanproduct.UpdateLoanProductResp
-	(*GetLoanProductReq)(nil),       // 6: loanproduct.GetLoanProductReq
-	(*ListLoanProductsReq)(nil),     // 7: loanproduct.ListLoanProductsReq
-	(*ListLoanProductsResp)(nil),    // 8: loanproduct.ListLoanProductsResp
-	(*CreateLoanProductReq)(nil),    // 9: loanproduct.CreateLoanProductReq
-	(*UpdateLoanProductReq)(nil),    // 10: loanproduct.UpdateLoanProductReq
-	(*DeleteLoanProductReq)(nil),    // 11: loanproduct.DeleteLoanProductReq
-	(*UpdateProductStatusReq)(nil),  // 12: loanproduct.UpdateProductStatusReq
+	(*LoanProductInfo)(nil),             // 0: loanproduct.LoanProductInfo
+	(*LoanRateTier)(nil),                // 1: loanproduct.LoanRateTier
+	(*DeleteLoanProductResp)(nil),       // 2: loanproduct.DeleteLoanProductResp
+	(*UpdateProductStatusResp)(nil),     // 3: loanproduct.UpdateProductStatusResp
+	(*GetLoanProductResp)(nil),          // 4: loanproduct.GetLoanProductResp
+	(*CreateLoanProductResp)(nil),       // 5: loanproduct.CreateLoanProductResp
+	(*UpdateLoanProductResp)(nil),       // 6: loanproduct.UpdateLoanProductResp
+	(*GetLoanProductReq)(nil),           // 7: loanproduct.GetLoanProductReq
+	(*ListLoanProductsReq)(nil),         // 8: loanproduct.ListLoanProductsReq
+	(*ListLoanProductsResp)(nil),        // 9: loanproduct.ListLoanProductsResp
+	(*CreateLoanProductReq)(nil),        // 10: loanproduct.CreateLoanProductReq
+	(*UpdateLoanProductReq)(nil),        // 11: loanproduct.UpdateLoanProductReq
+	(*DeleteLoanProductReq)(nil),        // 12: loanproduct.DeleteLoanProductReq
+	(*UpdateProductStatusReq)(nil),      // 13: loanproduct.UpdateProductStatusReq
+	(*ScheduleLoanProductReq)(nil),      // 14: loanproduct.ScheduleLoanProductReq
+	(*ScheduleLoanProductResp)(nil),     // 15: loanproduct.ScheduleLoanProductResp
+	(*ReserveLoanQuotaReq)(nil),         // 16: loanproduct.ReserveLoanQuotaReq
+	(*ReserveLoanQuotaResp)(nil),        // 17: loanproduct.ReserveLoanQuotaResp
+	(*ReleaseLoanQuotaReq)(nil),         // 18: loanproduct.ReleaseLoanQuotaReq
+	(*ReleaseLoanQuotaResp)(nil),        // 19: loanproduct.ReleaseLoanQuotaResp
+	(*CheckEligibilityReq)(nil),         // 20: loanproduct.CheckEligibilityReq
+	(*EligibilityReason)(nil),           // 21: loanproduct.EligibilityReason
+	(*CheckEligibilityResp)(nil),        // 22: loanproduct.CheckEligibilityResp
+	(*CalculateLoanQuoteReq)(nil),       // 23: loanproduct.CalculateLoanQuoteReq
+	(*QuoteInstallment)(nil),            // 24: loanproduct.QuoteInstallment
+	(*LoanQuote)(nil),                   // 25: loanproduct.LoanQuote
+	(*CalculateLoanQuoteResp)(nil),      // 26: loanproduct.CalculateLoanQuoteResp
+	(*ProductVersionChange)(nil),        // 27: loanproduct.ProductVersionChange
+	(*LoanProductVersionInfo)(nil),      // 28: loanproduct.LoanProductVersionInfo
+	(*ListLoanProductVersionsReq)(nil),  // 29: loanproduct.ListLoanProductVersionsReq
+	(*ListLoanProductVersionsResp)(nil), // 30: loanproduct.ListLoanProductVersionsResp
 }
 var file_loanproduct_rpc_proto_depIdxs = []int32{
-	0,  // 0: loanproduct.GetLoanProductResp.data:type_name -> loanproduct.LoanProductInfo
-	0,  // 1: loanproduct.CreateLoanProductResp.data:type_name -> loanproduct.LoanProductInfo
-	0,  // 2: loanproduct.UpdateLoanProductResp.data:type_name -> loanproduct.LoanProductInfo
-	0,  // 3: loanproduct.ListLoanProductsResp.list:type_name -> loanproduct.LoanProductInfo
-	6,  // 4: loanproduct.LoanProductService.GetLoanProduct:input_type -> loanproduct.GetLoanProductReq
-	7,  // 5: loanproduct.LoanProductService.ListLoanProducts:input_type -> loanproduct.ListLoanProductsReq
-	9,  // 6: loanproduct.LoanProductService.CreateLoanProduct:input_type -> loanproduct.CreateLoanProductReq
-	10, // 7: loanproduct.LoanProductService.UpdateLoanProduct:input_type -> loanproduct.UpdateLoanProductReq
-	11, // 8: loanproduct.LoanProductService.DeleteLoanProduct:input_type -> loanproduct.DeleteLoanProductReq
-	12, // 9: loanproduct.LoanProductService.UpdateProductStatus:input_type -> loanproduct.UpdateProductStatusReq
-	3,  // 10: loanproduct.LoanProductService.GetLoanProduct:output_type -> loanproduct.GetLoanProductResp
-	8,  // 11: loanproduct.LoanProductService.ListLoanProducts:output_type -> loanproduct.ListLoanProductsResp
-	4,  // 12: loanproduct.LoanProductService.CreateLoanProduct:output_type -> loanproduct.CreateLoanProductResp
-	5,  // 13: loanproduct.LoanProductService.UpdateLoanProduct:output_type -> loanproduct.UpdateLoanProductResp
-	1,  // 14: loanproduct.LoanProductService.DeleteLoanProduct:output_type -> loanproduct.DeleteLoanProductResp
-	2,  // 15: loanproduct.LoanProductService.UpdateProductStatus:output_type -> loanproduct.UpdateProductStatusResp
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	1,  // 0: loanproduct.LoanProductInfo.rateTiers:type_name -> loanproduct.LoanRateTier
+	0,  // 1: loanproduct.GetLoanProductResp.data:type_name -> loanproduct.LoanProductInfo
+	0,  // 2: loanproduct.CreateLoanProductResp.data:type_name -> loanproduct.LoanProductInfo
+	0,  // 3: loanproduct.UpdateLoanProductResp.data:type_name -> loanproduct.LoanProductInfo
+	28, // 4: loanproduct.UpdateLoanProductResp.version:type_name -> loanproduct.LoanProductVersionInfo
+	0,  // 5: loanproduct.ListLoanProductsResp.list:type_name -> loanproduct.LoanProductInfo
+	1,  // 6: loanproduct.CreateLoanProductReq.rateTiers:type_name -> loanproduct.LoanRateTier
+	1,  // 7: loanproduct.UpdateLoanProductReq.rateTiers:type_name -> loanproduct.LoanRateTier
+	0,  // 8: loanproduct.ScheduleLoanProductResp.data:type_name -> loanproduct.LoanProductInfo
+	21, // 9: loanproduct.CheckEligibilityResp.reasons:type_name -> loanproduct.EligibilityReason
+	24, // 10: loanproduct.LoanQuote.installments:type_name -> loanproduct.QuoteInstallment
+	25, // 11: loanproduct.CalculateLoanQuoteResp.quotes:type_name -> loanproduct.LoanQuote
+	27, // 12: loanproduct.LoanProductVersionInfo.changes:type_name -> loanproduct.ProductVersionChange
+	28, // 13: loanproduct.ListLoanProductVersionsResp.list:type_name -> loanproduct.LoanProductVersionInfo
+	7,  // 14: loanproduct.LoanProductService.GetLoanProduct:input_type -> loanproduct.GetLoanProductReq
+	8,  // 15: loanproduct.LoanProductService.ListLoanProducts:input_type -> loanproduct.ListLoanProductsReq
+	23, // 16: loanproduct.LoanProductService.CalculateLoanQuote:input_type -> loanproduct.CalculateLoanQuoteReq
+	20, // 17: loanproduct.LoanProductService.CheckEligibility:input_type -> loanproduct.CheckEligibilityReq
+	10, // 18: loanproduct.LoanProductService.CreateLoanProduct:input_type -> loanproduct.CreateLoanProductReq
+	11, // 19: loanproduct.LoanProductService.UpdateLoanProduct:input_type -> loanproduct.UpdateLoanProductReq
+	12, // 20: loanproduct.LoanProductService.DeleteLoanProduct:input_type -> loanproduct.DeleteLoanProductReq
+	13, // 21: loanproduct.LoanProductService.UpdateProductStatus:input_type -> loanproduct.UpdateProductStatusReq
+	14, // 22: loanproduct.LoanProductService.ScheduleLoanProduct:input_type -> loanproduct.ScheduleLoanProductReq
+	29, // 23: loanproduct.LoanProductService.ListLoanProductVersions:input_type -> loanproduct.ListLoanProductVersionsReq
+	16, // 24: loanproduct.LoanProductService.ReserveLoanQuota:input_type -> loanproduct.ReserveLoanQuotaReq
+	18, // 25: loanproduct.LoanProductService.ReleaseLoanQuota:input_type -> loanproduct.ReleaseLoanQuotaReq
+	4,  // 26: loanproduct.LoanProductService.GetLoanProduct:output_type -> loanproduct.GetLoanProductResp
+	9,  // 27: loanproduct.LoanProductService.ListLoanProducts:output_type -> loanproduct.ListLoanProductsResp
+	26, // 28: loanproduct.LoanProductService.CalculateLoanQuote:output_type -> loanproduct.CalculateLoanQuoteResp
+	22, // 29: loanproduct.LoanProductService.CheckEligibility:output_type -> loanproduct.CheckEligibilityResp
+	5,  // 30: loanproduct.LoanProductService.CreateLoanProduct:output_type -> loanproduct.CreateLoanProductResp
+	6,  // 31: loanproduct.LoanProductService.UpdateLoanProduct:output_type -> loanproduct.UpdateLoanProductResp
+	2,  // 32: loanproduct.LoanProductService.DeleteLoanProduct:output_type -> loanproduct.DeleteLoanProductResp
+	3,  // 33: loanproduct.LoanProductService.UpdateProductStatus:output_type -> loanproduct.UpdateProductStatusResp
+	15, // 34: loanproduct.LoanProductService.ScheduleLoanProduct:output_type -> loanproduct.ScheduleLoanProductResp
+	30, // 35: loanproduct.LoanProductService.ListLoanProductVersions:output_type -> loanproduct.ListLoanProductVersionsResp
+	17, // 36: loanproduct.LoanProductService.ReserveLoanQuota:output_type -> loanproduct.ReserveLoanQuotaResp
+	19, // 37: loanproduct.LoanProductService.ReleaseLoanQuota:output_type -> loanproduct.ReleaseLoanQuotaResp
+	26, // [26:38] is the sub-list for method output_type
+	14, // [14:26] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_loanproduct_rpc_proto_init() }
@@ -1003,7 +2873,7 @@ func file_loanproduct_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_loanproduct_rpc_proto_rawDesc), len(file_loanproduct_rpc_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LoanProductService_GetLoanProduct_FullMethodName      = "/loanproduct.LoanProductService/GetLoanProduct"
-	LoanProductService_ListLoanProducts_FullMethodName    = "/loanproduct.LoanProductService/ListLoanProducts"
-	LoanProductService_CreateLoanProduct_FullMethodName   = "/loanproduct.LoanProductService/CreateLoanProduct"
-	LoanProductService_UpdateLoanProduct_FullMethodName   = "/loanproduct.LoanProductService/UpdateLoanProduct"
-	LoanProductService_DeleteLoanProduct_FullMethodName   = "/loanproduct.LoanProductService/DeleteLoanProduct"
-	LoanProductService_UpdateProductStatus_FullMethodName = "/loanproduct.LoanProductService/UpdateProductStatus"
+	LoanProductService_GetLoanProduct_FullMethodName          = "/loanproduct.LoanProductService/GetLoanProduct"
+	LoanProductService_ListLoanProducts_FullMethodName        = "/loanproduct.LoanProductService/ListLoanProducts"
+	LoanProductService_CalculateLoanQuote_FullMethodName      = "/loanproduct.LoanProductService/CalculateLoanQuote"
+	LoanProductService_CheckEligibility_FullMethodName        = "/loanproduct.LoanProductService/CheckEligibility"
+	LoanProductService_CreateLoanProduct_FullMethodName       = "/loanproduct.LoanProductService/CreateLoanProduct"
+	LoanProductService_UpdateLoanProduct_FullMethodName       = "/loanproduct.LoanProductService/UpdateLoanProduct"
+	LoanProductService_DeleteLoanProduct_FullMethodName       = "/loanproduct.LoanProductService/DeleteLoanProduct"
+	LoanProductService_UpdateProductStatus_FullMethodName     = "/loanproduct.LoanProductService/UpdateProductStatus"
+	LoanProductService_ScheduleLoanProduct_FullMethodName     = "/loanproduct.LoanProductService/ScheduleLoanProduct"
+	LoanProductService_ListLoanProductVersions_FullMethodName = "/loanproduct.LoanProductService/ListLoanProductVersions"
+	LoanProductService_ReserveLoanQuota_FullMethodName        = "/loanproduct.LoanProductService/ReserveLoanQuota"
+	LoanProductService_ReleaseLoanQuota_FullMethodName        = "/loanproduct.LoanProductService/ReleaseLoanQuota"
 )
 
 // LoanProductServiceClient is the client API for LoanProductService service.
@@ -36,11 +42,18 @@ type LoanProductServiceClient interface {
 	// 产品查询
 	GetLoanProduct(ctx context.Context, in *GetLoanProductReq, opts ...grpc.CallOption) (*GetLoanProductResp, error)
 	ListLoanProducts(ctx context.Context, in *ListLoanProductsReq, opts ...grpc.CallOption) (*ListLoanProductsResp, error)
+	CalculateLoanQuote(ctx context.Context, in *CalculateLoanQuoteReq, opts ...grpc.CallOption) (*CalculateLoanQuoteResp, error)
+	CheckEligibility(ctx context.Context, in *CheckEligibilityReq, opts ...grpc.CallOption) (*CheckEligibilityResp, error)
 	// 产品管理
 	CreateLoanProduct(ctx context.Context, in *CreateLoanProductReq, opts ...grpc.CallOption) (*CreateLoanProductResp, error)
 	UpdateLoanProduct(ctx context.Context, in *UpdateLoanProductReq, opts ...grpc.CallOption) (*UpdateLoanProductResp, error)
 	DeleteLoanProduct(ctx context.Context, in *DeleteLoanProductReq, opts ...grpc.CallOption) (*DeleteLoanProductResp, error)
 	UpdateProductStatus(ctx context.Context, in *UpdateProductStatusReq, opts ...grpc.CallOption) (*UpdateProductStatusResp, error)
+	ScheduleLoanProduct(ctx context.Context, in *ScheduleLoanProductReq, opts ...grpc.CallOption) (*ScheduleLoanProductResp, error)
+	ListLoanProductVersions(ctx context.Context, in *ListLoanProductVersionsReq, opts ...grpc.CallOption) (*ListLoanProductVersionsResp, error)
+	// 放贷额度
+	ReserveLoanQuota(ctx context.Context, in *ReserveLoanQuotaReq, opts ...grpc.CallOption) (*ReserveLoanQuotaResp, error)
+	ReleaseLoanQuota(ctx context.Context, in *ReleaseLoanQuotaReq, opts ...grpc.CallOption) (*ReleaseLoanQuotaResp, error)
 }
 
 type loanProductServiceClient struct {
@@ -71,6 +84,26 @@ func (c *loanProductServiceClient) ListLoanProducts(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *loanProductServiceClient) CalculateLoanQuote(ctx context.Context, in *CalculateLoanQuoteReq, opts ...grpc.CallOption) (*CalculateLoanQuoteResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculateLoanQuoteResp)
+	err := c.cc.Invoke(ctx, LoanProductService_CalculateLoanQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanProductServiceClient) CheckEligibility(ctx context.Context, in *CheckEligibilityReq, opts ...grpc.CallOption) (*CheckEligibilityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckEligibilityResp)
+	err := c.cc.Invoke(ctx, LoanProductService_CheckEligibility_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *loanProductServiceClient) CreateLoanProduct(ctx context.Context, in *CreateLoanProductReq, opts ...grpc.CallOption) (*CreateLoanProductResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateLoanProductResp)
@@ -111,6 +144,46 @@ func (c *loanProductServiceClient) UpdateProductStatus(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *loanProductServiceClient) ScheduleLoanProduct(ctx context.Context, in *ScheduleLoanProductReq, opts ...grpc.CallOption) (*ScheduleLoanProductResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleLoanProductResp)
+	err := c.cc.Invoke(ctx, LoanProductService_ScheduleLoanProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanProductServiceClient) ListLoanProductVersions(ctx context.Context, in *ListLoanProductVersionsReq, opts ...grpc.CallOption) (*ListLoanProductVersionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoanProductVersionsResp)
+	err := c.cc.Invoke(ctx, LoanProductService_ListLoanProductVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanProductServiceClient) ReserveLoanQuota(ctx context.Context, in *ReserveLoanQuotaReq, opts ...grpc.CallOption) (*ReserveLoanQuotaResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveLoanQuotaResp)
+	err := c.cc.Invoke(ctx, LoanProductService_ReserveLoanQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loanProductServiceClient) ReleaseLoanQuota(ctx context.Context, in *ReleaseLoanQuotaReq, opts ...grpc.CallOption) (*ReleaseLoanQuotaResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseLoanQuotaResp)
+	err := c.cc.Invoke(ctx, LoanProductService_ReleaseLoanQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoanProductServiceServer is the server API for LoanProductService service.
 // All implementations must embed UnimplementedLoanProductServiceServer
 // for forward compatibility.
@@ -120,11 +193,18 @@ type LoanProductServiceServer interface {
 	// 产品查询
 	GetLoanProduct(context.Context, *GetLoanProductReq) (*GetLoanProductResp, error)
 	ListLoanProducts(context.Context, *ListLoanProductsReq) (*ListLoanProductsResp, error)
+	CalculateLoanQuote(context.Context, *CalculateLoanQuoteReq) (*CalculateLoanQuoteResp, error)
+	CheckEligibility(context.Context, *CheckEligibilityReq) (*CheckEligibilityResp, error)
 	// 产品管理
 	CreateLoanProduct(context.Context, *CreateLoanProductReq) (*CreateLoanProductResp, error)
 	UpdateLoanProduct(context.Context, *UpdateLoanProductReq) (*UpdateLoanProductResp, error)
 	DeleteLoanProduct(context.Context, *DeleteLoanProductReq) (*DeleteLoanProductResp, error)
 	UpdateProductStatus(context.Context, *UpdateProductStatusReq) (*UpdateProductStatusResp, error)
+	ScheduleLoanProduct(context.Context, *ScheduleLoanProductReq) (*ScheduleLoanProductResp, error)
+	ListLoanProductVersions(context.Context, *ListLoanProductVersionsReq) (*ListLoanProductVersionsResp, error)
+	// 放贷额度
+	ReserveLoanQuota(context.Context, *ReserveLoanQuotaReq) (*ReserveLoanQuotaResp, error)
+	ReleaseLoanQuota(context.Context, *ReleaseLoanQuotaReq) (*ReleaseLoanQuotaResp, error)
 	mustEmbedUnimplementedLoanProductServiceServer()
 }
 
@@ -141,6 +221,12 @@ func (UnimplementedLoanProductServiceServer) GetLoanProduct(context.Context, *Ge
 func (UnimplementedLoanProductServiceServer) ListLoanProducts(context.Context, *ListLoanProductsReq) (*ListLoanProductsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListLoanProducts not implemented")
 }
+func (UnimplementedLoanProductServiceServer) CalculateLoanQuote(context.Context, *CalculateLoanQuoteReq) (*CalculateLoanQuoteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateLoanQuote not implemented")
+}
+func (UnimplementedLoanProductServiceServer) CheckEligibility(context.Context, *CheckEligibilityReq) (*CheckEligibilityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckEligibility not implemented")
+}
 func (UnimplementedLoanProductServiceServer) CreateLoanProduct(context.Context, *CreateLoanProductReq) (*CreateLoanProductResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateLoanProduct not implemented")
 }
@@ -153,6 +239,18 @@ func (UnimplementedLoanProductServiceServer) DeleteLoanProduct(context.Context,
 func (UnimplementedLoanProductServiceServer) UpdateProductStatus(context.Context, *UpdateProductStatusReq) (*UpdateProductStatusResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductStatus not implemented")
 }
+func (UnimplementedLoanProductServiceServer) ScheduleLoanProduct(context.Context, *ScheduleLoanProductReq) (*ScheduleLoanProductResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleLoanProduct not implemented")
+}
+func (UnimplementedLoanProductServiceServer) ListLoanProductVersions(context.Context, *ListLoanProductVersionsReq) (*ListLoanProductVersionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoanProductVersions not implemented")
+}
+func (UnimplementedLoanProductServiceServer) ReserveLoanQuota(context.Context, *ReserveLoanQuotaReq) (*ReserveLoanQuotaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveLoanQuota not implemented")
+}
+func (UnimplementedLoanProductServiceServer) ReleaseLoanQuota(context.Context, *ReleaseLoanQuotaReq) (*ReleaseLoanQuotaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLoanQuota not implemented")
+}
 func (UnimplementedLoanProductServiceServer) mustEmbedUnimplementedLoanProductServiceServer() {}
 func (UnimplementedLoanProductServiceServer) testEmbeddedByValue()                            {}
 
@@ -210,6 +308,42 @@ func _LoanProductService_ListLoanProducts_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoanProductService_CalculateLoanQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateLoanQuoteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanProductServiceServer).CalculateLoanQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanProductService_CalculateLoanQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanProductServiceServer).CalculateLoanQuote(ctx, req.(*CalculateLoanQuoteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanProductService_CheckEligibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckEligibilityReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanProductServiceServer).CheckEligibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanProductService_CheckEligibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanProductServiceServer).CheckEligibility(ctx, req.(*CheckEligibilityReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _LoanProductService_CreateLoanProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateLoanProductReq)
 	if err := dec(in); err != nil {
@@ -282,6 +416,78 @@ func _LoanProductService_UpdateProductStatus_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LoanProductService_ScheduleLoanProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleLoanProductReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanProductServiceServer).ScheduleLoanProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanProductService_ScheduleLoanProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanProductServiceServer).ScheduleLoanProduct(ctx, req.(*ScheduleLoanProductReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanProductService_ListLoanProductVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoanProductVersionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanProductServiceServer).ListLoanProductVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanProductService_ListLoanProductVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanProductServiceServer).ListLoanProductVersions(ctx, req.(*ListLoanProductVersionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanProductService_ReserveLoanQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveLoanQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanProductServiceServer).ReserveLoanQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanProductService_ReserveLoanQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanProductServiceServer).ReserveLoanQuota(ctx, req.(*ReserveLoanQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LoanProductService_ReleaseLoanQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLoanQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoanProductServiceServer).ReleaseLoanQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LoanProductService_ReleaseLoanQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoanProductServiceServer).ReleaseLoanQuota(ctx, req.(*ReleaseLoanQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LoanProductService_ServiceDesc is the grpc.ServiceDesc for LoanProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -297,6 +503,14 @@ var LoanProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListLoanProducts",
 			Handler:    _LoanProductService_ListLoanProducts_Handler,
 		},
+		{
+			MethodName: "CalculateLoanQuote",
+			Handler:    _LoanProductService_CalculateLoanQuote_Handler,
+		},
+		{
+			MethodName: "CheckEligibility",
+			Handler:    _LoanProductService_CheckEligibility_Handler,
+		},
 		{
 			MethodName: "CreateLoanProduct",
 			Handler:    _LoanProductService_CreateLoanProduct_Handler,
@@ -313,6 +527,22 @@ var LoanProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProductStatus",
 			Handler:    _LoanProductService_UpdateProductStatus_Handler,
 		},
+		{
+			MethodName: "ScheduleLoanProduct",
+			Handler:    _LoanProductService_ScheduleLoanProduct_Handler,
+		},
+		{
+			MethodName: "ListLoanProductVersions",
+			Handler:    _LoanProductService_ListLoanProductVersions_Handler,
+		},
+		{
+			MethodName: "ReserveLoanQuota",
+			Handler:    _LoanProductService_ReserveLoanQuota_Handler,
+		},
+		{
+			MethodName: "ReleaseLoanQuota",
+			Handler:    _LoanProductService_ReleaseLoanQuota_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "loanproduct-rpc.proto",
@@ -25,7 +25,25 @@ CREATE TABLE `loan_products` (
   `min_duration` int UNSIGNED DEFAULT 1 COMMENT '最小期限(月)',
   `interest_rate` decimal(5,2) NOT NULL COMMENT '年利率(%)',
   `description` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '产品描述',
+  `repayment_profile` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT 'standard' COMMENT '还款模式 standard:按月还款 seasonal:按收获季还款',
+  `grace_months` int UNSIGNED DEFAULT 0 COMMENT '宽限期(月),宽限期内不还款,利息累计至首个还款日',
+  `harvest_months` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '收获月份,逗号分隔 如 9,10',
+  `penalty_rate` decimal(6,4) UNSIGNED DEFAULT 0.0500 COMMENT '罚息日利率(%),逾期未还本息按日计收',
+  `prepayment_fee_rate` decimal(6,4) UNSIGNED DEFAULT 0.0000 COMMENT '提前还款手续费率(%),按提前归还的未到期本金计收',
+  `origination_fee_rate` decimal(6,4) UNSIGNED DEFAULT 0.0000 COMMENT '手续费率(%),放款时按本金一次性收取',
+  `service_fee_rate` decimal(6,4) UNSIGNED DEFAULT 0.0000 COMMENT '服务费月费率(%),按本金随每期还款收取',
+  `guarantee_fee_rate` decimal(6,4) UNSIGNED DEFAULT 0.0000 COMMENT '担保费率(%),放款时按本金一次性收取',
+  `late_fee` decimal(10,2) UNSIGNED DEFAULT 0.00 COMMENT '逾期滞纳金(元/期),每期逾期时一次性收取',
+  `approval_chain` varchar(1000) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '审批链配置(JSON),如[{"name":"初审","role":"operator"},{"name":"终审","role":"admin"}],为空表示单级审批',
+  `eligibility_rule` varchar(1000) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '准入规则配置(JSON),如{"occupations":["农","牧"],"min_age":18,"max_age":60,"min_income":3000},为空表示不限制',
   `status` tinyint UNSIGNED DEFAULT 1 COMMENT '状态 1:上架 2:下架',
+  `version` int UNSIGNED NOT NULL DEFAULT 0 COMMENT '当前生效的条款版本号',
+  `launch_at` timestamp NULL DEFAULT NULL COMMENT '计划上架时间,到期后自动上架,为空表示不排期',
+  `delist_at` timestamp NULL DEFAULT NULL COMMENT '计划下架时间,到期后自动下架且不再出现在产品列表,为空表示长期有效',
+  `total_budget` decimal(15,2) UNSIGNED NOT NULL DEFAULT 0.00 COMMENT '放贷总额度(元),0表示不限',
+  `period_quota` decimal(15,2) UNSIGNED NOT NULL DEFAULT 0.00 COMMENT '周期放贷额度(元),0表示不限',
+  `quota_period` varchar(10) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'year' COMMENT '额度周期 month:月 quarter:季 year:年',
+  `deleted_at` timestamp NULL DEFAULT NULL COMMENT '删除时间,不为空表示产品已归档,不再出现在查询中',
   `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
   `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
   PRIMARY KEY (`id`),
@@ -34,13 +52,73 @@ CREATE TABLE `loan_products` (
   KEY `idx_status` (`status`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='贷款产品表';
 
+-- ----------------------------
+-- 贷款产品条款版本表
+-- ----------------------------
+DROP TABLE IF EXISTS `loan_product_versions`;
+CREATE TABLE `loan_product_versions` (
+  `id` bigint UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '版本记录ID',
+  `product_id` bigint UNSIGNED NOT NULL COMMENT '产品ID',
+  `version` int UNSIGNED NOT NULL COMMENT '版本号',
+  `terms` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '版本生效后的产品条款(JSON)',
+  `changes` text CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '相对上一版本的变更字段(JSON),如[{"field":"interest_rate","from":5.2,"to":4.8}]',
+  `status` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'scheduled' COMMENT '状态 scheduled:待生效 active:生效中 superseded:已失效 cancelled:已取消',
+  `effective_from` timestamp NOT NULL COMMENT '生效时间',
+  `effective_to` timestamp NULL DEFAULT NULL COMMENT '失效时间,生效中或待生效的版本为空',
+  `operator_id` bigint UNSIGNED DEFAULT 0 COMMENT '操作人ID',
+  `operator_name` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci DEFAULT '' COMMENT '操作人姓名',
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_product_version` (`product_id`, `version`),
+  KEY `idx_status_effective_from` (`status`, `effective_from`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='贷款产品条款版本表';
+
+-- ----------------------------
+-- 贷款产品额度占用表
+-- ----------------------------
+DROP TABLE IF EXISTS `loan_product_quota_reservations`;
+CREATE TABLE `loan_product_quota_reservations` (
+  `id` bigint UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '占用记录ID',
+  `product_id` bigint UNSIGNED NOT NULL COMMENT '产品ID',
+  `application_id` varchar(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL COMMENT '贷款申请编号',
+  `amount` decimal(15,2) UNSIGNED NOT NULL COMMENT '占用金额(元)',
+  `status` varchar(20) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL DEFAULT 'reserved' COMMENT '状态 reserved:已占用 released:已释放',
+  `released_at` timestamp NULL DEFAULT NULL COMMENT '释放时间',
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '占用时间,周期额度按占用时间所属周期统计',
+  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uk_application_id` (`application_id`),
+  KEY `idx_product_status_created` (`product_id`, `status`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='贷款产品额度占用表';
+
+-- ----------------------------
+-- 贷款产品利率档位表
+-- ----------------------------
+DROP TABLE IF EXISTS `loan_product_rate_tiers`;
+CREATE TABLE `loan_product_rate_tiers` (
+  `id` bigint UNSIGNED NOT NULL AUTO_INCREMENT COMMENT '档位ID',
+  `product_id` bigint UNSIGNED NOT NULL COMMENT '产品ID',
+  `min_amount` decimal(15,2) UNSIGNED NOT NULL DEFAULT 0.00 COMMENT '最小金额(元),含',
+  `max_amount` decimal(15,2) UNSIGNED NOT NULL DEFAULT 0.00 COMMENT '最大金额(元),不含,0表示不设上限',
+  `min_duration` int UNSIGNED NOT NULL DEFAULT 0 COMMENT '最小期限(月),含',
+  `max_duration` int UNSIGNED NOT NULL DEFAULT 0 COMMENT '最大期限(月),含,0表示不设上限',
+  `interest_rate` decimal(5,2) NOT NULL COMMENT '挂牌年利率(%),报价使用',
+  `min_rate` decimal(5,2) NOT NULL COMMENT '审批可批准的最低年利率(%)',
+  `max_rate` decimal(5,2) NOT NULL COMMENT '审批可批准的最高年利率(%)',
+  `created_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+  `updated_at` timestamp NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_product_id` (`product_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='贷款产品利率档位表';
+
 -- ----------------------------
 -- 初始化数据
 -- ----------------------------
-INSERT INTO `loan_products` (`product_code`, `name`, `type`, `max_amount`, `min_amount`, `max_duration`, `min_duration`, `interest_rate`, `description`, `status`) VALUES
-('LOAN001', '农业生产贷', '农业贷', 500000.00, 5000.00, 36, 3, 5.20, '专为农业生产提供的资金支持，支持种植、养殖等农业项目', 1),
-('LOAN002', '农村创业贷', '创业贷', 300000.00, 10000.00, 60, 6, 6.50, '支持农村创业项目的专项贷款，助力乡村振兴发展', 1),
-('LOAN003', '农村消费贷', '消费贷', 100000.00, 1000.00, 24, 1, 7.80, '满足农村居民日常消费需求的个人贷款产品', 1),
-('LOAN004', '农机设备贷', '经营贷', 800000.00, 20000.00, 60, 12, 5.80, '专门用于购买农机设备的经营性贷款', 1);
+INSERT INTO `loan_products` (`product_code`, `name`, `type`, `max_amount`, `min_amount`, `max_duration`, `min_duration`, `interest_rate`, `description`, `repayment_profile`, `grace_months`, `harvest_months`, `eligibility_rule`, `status`) VALUES
+('LOAN001', '农业生产贷', '农业贷', 500000.00, 5000.00, 36, 3, 5.20, '专为农业生产提供的资金支持，支持种植、养殖等农业项目', 'seasonal', 2, '9,10', '{"occupations":["农","牧","渔","林"],"min_age":18,"max_age":65,"min_income":2000}', 1),
+('LOAN002', '农村创业贷', '创业贷', 300000.00, 10000.00, 60, 6, 6.50, '支持农村创业项目的专项贷款，助力乡村振兴发展', 'standard', 0, '', '', 1),
+('LOAN003', '农村消费贷', '消费贷', 100000.00, 1000.00, 24, 1, 7.80, '满足农村居民日常消费需求的个人贷款产品', 'standard', 0, '', '', 1),
+('LOAN004', '农机设备贷', '经营贷', 800000.00, 20000.00, 60, 12, 5.80, '专门用于购买农机设备的经营性贷款', 'standard', 0, '', '', 1);
 
 SET FOREIGN_KEY_CHECKS = 1;